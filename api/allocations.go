@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net/url"
 	"sort"
 	"strings"
 	"time"
@@ -201,8 +202,19 @@ func (a *Allocations) Stop(alloc *Allocation, q *QueryOptions) (*AllocStopRespon
 		}
 	}
 
+	// WriteOptions don't carry query parameters, so pass the ones used by
+	// the endpoint, such as no_shutdown_delay and checkpoint, in the path
+	path := "/v1/allocation/" + alloc.ID + "/stop"
+	if q != nil && len(q.Params) > 0 {
+		params := url.Values{}
+		for k, v := range q.Params {
+			params.Set(k, v)
+		}
+		path += "?" + params.Encode()
+	}
+
 	var resp AllocStopResponse
-	wm, err := a.client.put("/v1/allocation/"+alloc.ID+"/stop", nil, &resp, w)
+	wm, err := a.client.put(path, nil, &resp, w)
 	if wm != nil {
 		resp.LastIndex = wm.LastIndex
 		resp.RequestTime = wm.RequestTime
@@ -453,6 +465,7 @@ type AllocatedTaskResources struct {
 
 type AllocatedSharedResources struct {
 	DiskMB   int64
+	DiskIOPS int64
 	Networks []*NetworkResource
 	Ports    []PortMapping
 }
@@ -589,6 +602,14 @@ type DesiredTransition struct {
 	// Reschedule is used to indicate that this allocation is eligible to be
 	// rescheduled.
 	Reschedule *bool
+
+	// Checkpoint is used to indicate that the tasks of a migrating
+	// allocation should be checkpointed and restored by its replacement.
+	Checkpoint *bool
+
+	// RebalanceNodeID is the node a migrating allocation was fit on by the
+	// node pool rebalance core job.
+	RebalanceNodeID string
 }
 
 // ShouldMigrate returns whether the transition object dictates a migration.
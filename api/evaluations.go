@@ -87,6 +87,17 @@ func (e *Evaluations) Allocations(evalID string, q *QueryOptions) ([]*Allocation
 	return resp, qm, nil
 }
 
+// Trace is used to retrieve the scheduler decision trace of an evaluation.
+// Traces are only recorded when enabled in the scheduler configuration.
+func (e *Evaluations) Trace(evalID string, q *QueryOptions) (*EvalTrace, *QueryMeta, error) {
+	var resp EvalTrace
+	qm, err := e.client.query("/v1/evaluation/"+evalID+"/trace", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
 const (
 	EvalStatusBlocked   = "blocked"
 	EvalStatusPending   = "pending"
@@ -166,6 +177,37 @@ type EvalCountResponse struct {
 	QueryMeta
 }
 
+const (
+	EvalTraceDecisionFiltered  = "filtered"
+	EvalTraceDecisionExhausted = "exhausted"
+	EvalTraceDecisionScored    = "scored"
+	EvalTraceDecisionSelected  = "selected"
+	EvalTraceDecisionFailed    = "failed"
+)
+
+// EvalTrace is the record of every scheduler decision made while processing
+// an evaluation.
+type EvalTrace struct {
+	EvalID      string
+	Namespace   string
+	JobID       string
+	Events      []*EvalTraceEvent
+	Truncated   bool
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// EvalTraceEvent is a single scheduler decision about a node.
+type EvalTraceEvent struct {
+	TaskGroup string
+	AllocName string
+	NodeID    string
+	Decision  string
+	Reason    string
+	Scores    map[string]float64
+	NormScore float64
+}
+
 // EvalIndexSort is a wrapper to sort evaluations by CreateIndex.
 // We reverse the test so that we get the highest index first.
 type EvalIndexSort []*Evaluation
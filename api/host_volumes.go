@@ -62,6 +62,15 @@ type HostVolume struct {
 	// created. We record this to make debugging easier.
 	HostPath string `mapstructure:"host_path" hcl:"host_path"`
 
+	// SourceVolumeID is the ID of a volume to clone when the volume is
+	// created. The volume is created on the node of the source volume, with
+	// its plugin.
+	SourceVolumeID string `mapstructure:"source_volume_id" hcl:"source_volume_id"`
+
+	// SnapshotID is the ID of a snapshot of the source volume to create the
+	// volume from, instead of the current contents of the source volume.
+	SnapshotID string `mapstructure:"snapshot_id" hcl:"snapshot_id"`
+
 	// State represents the overall state of the volume. One of pending, ready,
 	// deleted.
 	State HostVolumeState
@@ -179,6 +188,33 @@ type HostVolumeDeleteRequest struct {
 
 type HostVolumeDeleteResponse struct{}
 
+// HostVolumeSnapshot is a point-in-time copy of the contents of a host volume,
+// kept by the host volume plugin on the node of the volume.
+type HostVolumeSnapshot struct {
+	ID         string
+	Name       string
+	VolumeID   string
+	SizeBytes  int64
+	CreateTime int64
+}
+
+type HostVolumeSnapshotCreateRequest struct {
+	VolumeID string
+	Name     string
+
+	// Parameters are an opaque map of parameters for the host volume plugin.
+	Parameters map[string]string `json:",omitempty"`
+}
+
+type HostVolumeSnapshotCreateResponse struct {
+	Snapshot *HostVolumeSnapshot
+}
+
+type HostVolumeSnapshotDeleteRequest struct {
+	VolumeID   string
+	SnapshotID string
+}
+
 // Create forwards to client agents so a host volume can be created on those
 // hosts, and registers the volume with Nomad servers.
 func (hv *HostVolumes) Create(req *HostVolumeCreateRequest, opts *WriteOptions) (*HostVolumeCreateResponse, *WriteMeta, error) {
@@ -247,3 +283,41 @@ func (hv *HostVolumes) Delete(req *HostVolumeDeleteRequest, opts *WriteOptions)
 	wm, err := hv.client.delete(path, nil, resp, opts)
 	return resp, wm, err
 }
+
+// CreateSnapshot takes a snapshot of a host volume with its plugin.
+func (hv *HostVolumes) CreateSnapshot(req *HostVolumeSnapshotCreateRequest, opts *WriteOptions) (*HostVolumeSnapshotCreateResponse, *WriteMeta, error) {
+	var out *HostVolumeSnapshotCreateResponse
+	path, err := url.JoinPath("/v1/volume/host/", url.PathEscape(req.VolumeID), "snapshot")
+	if err != nil {
+		return nil, nil, err
+	}
+	wm, err := hv.client.put(path, req, &out, opts)
+	if err != nil {
+		return nil, wm, err
+	}
+	return out, wm, nil
+}
+
+// ListSnapshots queries the plugin of a host volume for its snapshots.
+func (hv *HostVolumes) ListSnapshots(volID string, opts *QueryOptions) ([]*HostVolumeSnapshot, *QueryMeta, error) {
+	var out []*HostVolumeSnapshot
+	path, err := url.JoinPath("/v1/volume/host/", url.PathEscape(volID), "snapshots")
+	if err != nil {
+		return nil, nil, err
+	}
+	qm, err := hv.client.query(path, &out, opts)
+	if err != nil {
+		return nil, qm, err
+	}
+	return out, qm, nil
+}
+
+// DeleteSnapshot deletes a snapshot of a host volume
+func (hv *HostVolumes) DeleteSnapshot(req *HostVolumeSnapshotDeleteRequest, opts *WriteOptions) (*WriteMeta, error) {
+	path, err := url.JoinPath("/v1/volume/host/", url.PathEscape(req.VolumeID),
+		"snapshot", url.PathEscape(req.SnapshotID))
+	if err != nil {
+		return nil, err
+	}
+	return hv.client.delete(path, nil, nil, opts)
+}
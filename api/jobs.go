@@ -10,6 +10,7 @@ import (
 	"io"
 	"maps"
 	"net/url"
+	"slices"
 	"sort"
 	"strconv"
 	"time"
@@ -982,6 +983,67 @@ type JobSubmission struct {
 	Variables string
 }
 
+// JobGang configures all-or-nothing placement for a set of task groups. If
+// Groups is empty, all task groups of the job are part of the gang.
+type JobGang struct {
+	Groups []string `hcl:"groups,optional"`
+}
+
+func (g *JobGang) Canonicalize() {
+	if g == nil {
+		return
+	}
+
+	if len(g.Groups) == 0 {
+		g.Groups = nil
+	}
+}
+
+func (g *JobGang) Copy() *JobGang {
+	if g == nil {
+		return nil
+	}
+
+	return &JobGang{
+		Groups: slices.Clone(g.Groups),
+	}
+}
+
+// PreemptionBudget limits the number of allocations that can be preempted
+// within a sliding window of time.
+type PreemptionBudget struct {
+	MaxAllocs *int           `mapstructure:"max_allocs" hcl:"max_allocs,optional"`
+	Window    *time.Duration `hcl:"window,optional"`
+}
+
+func (b *PreemptionBudget) Canonicalize() {
+	if b == nil {
+		return
+	}
+
+	if b.MaxAllocs == nil {
+		b.MaxAllocs = pointerOf(0)
+	}
+	if b.Window == nil {
+		b.Window = pointerOf(time.Duration(0))
+	}
+}
+
+func (b *PreemptionBudget) Copy() *PreemptionBudget {
+	if b == nil {
+		return nil
+	}
+
+	nb := new(PreemptionBudget)
+	if b.MaxAllocs != nil {
+		nb.MaxAllocs = pointerOf(*b.MaxAllocs)
+	}
+	if b.Window != nil {
+		nb.Window = pointerOf(*b.Window)
+	}
+	return nb
+}
+
 type JobUIConfig struct {
 	Description string       `hcl:"description,optional"`
 	Links       []*JobUILink `hcl:"link,block"`
@@ -1094,6 +1156,10 @@ type Job struct {
 	TaskGroups       []*TaskGroup            `hcl:"group,block"`
 	Update           *UpdateStrategy         `hcl:"update,block"`
 	Multiregion      *Multiregion            `hcl:"multiregion,block"`
+	Gang             *JobGang                `hcl:"gang,block"`
+	Reservation      *string                 `hcl:"reservation,optional"`
+	PreemptionCost   *int                    `mapstructure:"preemption_cost" hcl:"preemption_cost,optional"`
+	PreemptionBudget *PreemptionBudget       `hcl:"preemption_budget,block"`
 	Spreads          []*Spread               `hcl:"spread,block"`
 	Periodic         *PeriodicConfig         `hcl:"periodic,block"`
 	ParameterizedJob *ParameterizedJobConfig `hcl:"parameterized,block"`
@@ -1210,6 +1276,12 @@ func (j *Job) Canonicalize() {
 	if j.Multiregion != nil {
 		j.Multiregion.Canonicalize()
 	}
+	if j.Gang != nil {
+		j.Gang.Canonicalize()
+	}
+	if j.PreemptionBudget != nil {
+		j.PreemptionBudget.Canonicalize()
+	}
 
 	for _, tg := range j.TaskGroups {
 		tg.Canonicalize(j)
@@ -1551,8 +1623,18 @@ type ObjectDiff struct {
 }
 
 type PlanAnnotations struct {
-	DesiredTGUpdates map[string]*DesiredUpdates
-	PreemptedAllocs  []*AllocationListStub
+	DesiredTGUpdates  map[string]*DesiredUpdates
+	PreemptedAllocs   []*AllocationListStub
+	PreemptionReasons map[string]*PreemptionReason
+}
+
+// PreemptionReason explains why the scheduler chose to preempt an allocation.
+type PreemptionReason struct {
+	PreemptedFor   string
+	NodeID         string
+	Resources      []string
+	JobPriority    int
+	PreemptionCost int
 }
 
 type DesiredUpdates struct {
@@ -77,6 +77,7 @@ type Namespace struct {
 	NodePoolConfiguration *NamespaceNodePoolConfiguration `hcl:"node_pool_config,block"`
 	VaultConfiguration    *NamespaceVaultConfiguration    `hcl:"vault,block"`
 	ConsulConfiguration   *NamespaceConsulConfiguration   `hcl:"consul,block"`
+	PreemptionBudget      *PreemptionBudget               `hcl:"preemption_budget,block"`
 	Meta                  map[string]string
 	CreateIndex           uint64
 	ModifyIndex           uint64
@@ -597,6 +597,7 @@ type NodeMemoryResources struct {
 
 type NodeDiskResources struct {
 	DiskMB int64
+	IOPS   int64
 }
 
 type NodeReservedResources struct {
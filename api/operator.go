@@ -180,6 +180,10 @@ type SchedulerConfiguration struct {
 	// until the configuration is updated and written to the Nomad servers.
 	PauseEvalBroker bool
 
+	// EvalTraceEnabled specifies whether the schedulers record a trace of
+	// every node decision made while processing an evaluation.
+	EvalTraceEnabled bool
+
 	// CreateIndex/ModifyIndex store the create/modify indexes of this configuration.
 	CreateIndex uint64
 	ModifyIndex uint64
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+// Reservations is used to access reservations endpoints.
+type Reservations struct {
+	client *Client
+}
+
+// Reservations returns a handle on the reservations endpoints.
+func (c *Client) Reservations() *Reservations {
+	return &Reservations{client: c}
+}
+
+// List is used to list all reservations.
+func (r *Reservations) List(q *QueryOptions) ([]*Reservation, *QueryMeta, error) {
+	var resp []*Reservation
+	qm, err := r.client.query("/v1/reservations", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, qm, nil
+}
+
+// PrefixList is used to list reservations that match a given prefix.
+func (r *Reservations) PrefixList(prefix string, q *QueryOptions) ([]*Reservation, *QueryMeta, error) {
+	if q == nil {
+		q = &QueryOptions{}
+	}
+	q.Prefix = prefix
+	return r.List(q)
+}
+
+// Info is used to fetch details of a specific reservation.
+func (r *Reservations) Info(name string, q *QueryOptions) (*Reservation, *QueryMeta, error) {
+	if name == "" {
+		return nil, nil, errors.New("missing reservation name")
+	}
+
+	var resp Reservation
+	qm, err := r.client.query("/v1/reservation/"+url.PathEscape(name), &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
+// Register is used to create or update a reservation.
+func (r *Reservations) Register(res *Reservation, w *WriteOptions) (*WriteMeta, error) {
+	if res == nil {
+		return nil, errors.New("missing reservation")
+	}
+	if res.Name == "" {
+		return nil, errors.New("missing reservation name")
+	}
+
+	wm, err := r.client.put("/v1/reservations", res, nil, w)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Delete is used to delete a reservation.
+func (r *Reservations) Delete(name string, w *WriteOptions) (*WriteMeta, error) {
+	if name == "" {
+		return nil, errors.New("missing reservation name")
+	}
+
+	wm, err := r.client.delete("/v1/reservation/"+url.PathEscape(name), nil, nil, w)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Reservation is used to serialize a reservation of node capacity for a
+// window of time.
+type Reservation struct {
+	Name        string
+	Namespace   string
+	Description string
+	NodePool    string
+	NodeClass   string
+	Constraints []*Constraint
+	Resources   *ReservationResources
+	StartTime   time.Time
+	EndTime     time.Time
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ReservationResources is used to serialize the resources reserved on each
+// node that matches a reservation.
+type ReservationResources struct {
+	CPU      int
+	MemoryMB int
+	Devices  []*RequestedDevice
+}
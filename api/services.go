@@ -59,6 +59,15 @@ type ServiceRegistration struct {
 	// is determined by a combination of factors on the client.
 	Port int
 
+	// Meta is determined from either Service.Meta or Service.CanaryMeta. The
+	// "weight" key sets the weight of the registration when choosing services.
+	Meta map[string]string
+
+	// CheckStatus is the aggregate status of the checks of the service: success
+	// once all checks pass, failure if any check fails, and pending otherwise.
+	// It is empty for services without checks.
+	CheckStatus string
+
 	CreateIndex uint64
 	ModifyIndex uint64
 }
@@ -111,7 +120,9 @@ func (s *Services) List(q *QueryOptions) ([]*ServiceRegistrationListStub, *Query
 }
 
 // Get is used to return a list of service registrations whose name matches the
-// specified parameter.
+// specified parameter. The "choose" query parameter selects a subset of the
+// registrations and the "passing" query parameter filters out registrations
+// with checks that are not passing; see the HTTP API documentation.
 func (s *Services) Get(serviceName string, q *QueryOptions) ([]*ServiceRegistration, *QueryMeta, error) {
 	var resp []*ServiceRegistration
 	qm, err := s.client.query("/v1/service/"+url.PathEscape(serviceName), &resp, q)
@@ -59,6 +59,16 @@ type ResourceUsage struct {
 	MemoryStats *MemoryStats
 	CpuStats    *CpuStats
 	DeviceStats []*DeviceGroupStats
+
+	// Process identifies the process when the usage is of a single process
+	// of a task
+	Process *ProcessInfo
+}
+
+// ProcessInfo identifies a process in the process tree of a task
+type ProcessInfo struct {
+	PPid    int
+	Command string
 }
 
 // TaskResourceUsage holds aggregated resource usage of all processes in a Task
@@ -330,6 +340,7 @@ func (p *ReschedulePolicy) String() string {
 // Spread is used to serialize task group allocation spread preferences
 type Spread struct {
 	Attribute    string          `hcl:"attribute,optional"`
+	Hierarchy    []string        `hcl:"hierarchy,optional"`
 	Weight       *int8           `hcl:"weight,optional"`
 	SpreadTarget []*SpreadTarget `hcl:"target,block"`
 }
@@ -366,6 +377,7 @@ type EphemeralDisk struct {
 	Sticky  *bool `hcl:"sticky,optional"`
 	Migrate *bool `hcl:"migrate,optional"`
 	SizeMB  *int  `mapstructure:"size" hcl:"size,optional"`
+	IOPS    *int  `mapstructure:"iops" hcl:"iops,optional"`
 }
 
 func DefaultEphemeralDisk() *EphemeralDisk {
@@ -520,6 +532,7 @@ type TaskGroup struct {
 	Consul              *Consul        `hcl:"consul,block"`
 	// Deprecated: PreventRescheduleOnLost is deprecated in Nomad 1.8.0 and ignored in Nomad 1.10. Use Disconnect.Replace.
 	PreventRescheduleOnLost *bool `hcl:"prevent_reschedule_on_lost,optional"`
+	Checkpoint              *bool `hcl:"checkpoint,optional"`
 }
 
 // NewTaskGroup creates a new TaskGroup.
@@ -1130,6 +1143,12 @@ const (
 	TaskLeaderDead             = "Leader Task Dead"
 	TaskBuildingTaskDir        = "Building Task Directory"
 	TaskClientReconnected      = "Reconnected"
+	TaskCheckpointed           = "Checkpointed"
+	TaskCheckpointFailed       = "Checkpoint Failed"
+	TaskRestoredFromCheckpoint = "Restored From Checkpoint"
+	TaskResourcesUpdated       = "Resources Updated"
+	TaskHibernated             = "Hibernated"
+	TaskWoken                  = "Woken"
 )
 
 // TaskEvent is an event that effects the state of a task and contains meta-data
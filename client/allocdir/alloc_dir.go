@@ -76,6 +76,10 @@ var (
 	// directory
 	TaskPrivate = "private"
 
+	// TaskCheckpoint is the name of the directory inside each task directory
+	// that holds the checkpoint image of the task, if one was taken
+	TaskCheckpoint = "checkpoint"
+
 	// TaskDirs is the set of directories created in each tasks directory.
 	TaskDirs = map[string]os.FileMode{TmpDirName: os.ModeSticky | fileMode777}
 
@@ -201,6 +205,9 @@ func (d *AllocDir) Snapshot(w io.Writer) error {
 	rootPaths := []string{allocDataDir}
 	for _, taskdir := range d.TaskDirs {
 		rootPaths = append(rootPaths, taskdir.LocalDir)
+		if pathExists(taskdir.CheckpointDir) {
+			rootPaths = append(rootPaths, taskdir.CheckpointDir)
+		}
 	}
 
 	tw := tar.NewWriter(w)
@@ -312,6 +319,20 @@ func (d *AllocDir) Move(other Interface, tasks []*structs.Task) error {
 				return fmt.Errorf("error moving task %q local dir: %w", task.Name, err)
 			}
 		}
+
+		// Move the checkpoint image of the task, if one was taken
+		otherTaskCheckpoint := filepath.Join(otherTaskDir, TaskCheckpoint)
+		if fileInfo, err := os.Stat(otherTaskCheckpoint); fileInfo != nil && err == nil {
+			newTaskDir := filepath.Join(d.AllocDir, task.Name)
+			if err := os.MkdirAll(newTaskDir, fileMode777); err != nil {
+				return fmt.Errorf("error creating task %q dir: %w", task.Name, err)
+			}
+			checkpointDir := filepath.Join(newTaskDir, TaskCheckpoint)
+			os.RemoveAll(checkpointDir) // remove a stale checkpoint if it exists
+			if err := os.Rename(otherTaskCheckpoint, checkpointDir); err != nil {
+				return fmt.Errorf("error moving task %q checkpoint dir: %w", task.Name, err)
+			}
+		}
 	}
 
 	return nil
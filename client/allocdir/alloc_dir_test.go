@@ -253,6 +253,52 @@ func TestAllocDir_Move(t *testing.T) {
 	must.NotNil(t, fi)
 }
 
+func TestAllocDir_Move_Checkpoint(t *testing.T) {
+	ci.Parallel(t)
+
+	tmp1 := t.TempDir()
+	tmp2 := t.TempDir()
+
+	d1 := NewAllocDir(testlog.HCLogger(t), tmp1, tmp1, "test")
+	must.NoError(t, d1.Build())
+	defer d1.Destroy()
+
+	d2 := NewAllocDir(testlog.HCLogger(t), tmp2, tmp2, "test")
+	must.NoError(t, d2.Build())
+	defer d2.Destroy()
+
+	td1 := d1.NewTaskDir(t1)
+	must.NoError(t, td1.Build(fsisolation.None, nil, "nobody"))
+	d2.NewTaskDir(t1)
+
+	// Write a checkpoint image for the task
+	must.NoError(t, os.MkdirAll(td1.CheckpointDir, 0o700))
+	must.NoError(t, os.WriteFile(filepath.Join(td1.CheckpointDir, "pages-1.img"), []byte("foo"), 0o600))
+
+	// The image is included in snapshots
+	var b bytes.Buffer
+	must.NoError(t, d1.Snapshot(&b))
+	var names []string
+	tr := tar.NewReader(&b)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		must.NoError(t, err)
+		names = append(names, hdr.Name)
+	}
+	must.SliceContains(t, names, filepath.Join(t1.Name, TaskCheckpoint, "pages-1.img"))
+
+	// And moved along with the task local dir
+	must.NoError(t, d2.Move(d1, []*structs.Task{t1}))
+
+	fi, err := os.Stat(filepath.Join(d2.TaskDirs[t1.Name].CheckpointDir, "pages-1.img"))
+	must.NoError(t, err)
+	must.NotNil(t, fi)
+	must.False(t, pathExists(td1.CheckpointDir))
+}
+
 func TestAllocDir_EscapeChecking(t *testing.T) {
 	ci.Parallel(t)
 
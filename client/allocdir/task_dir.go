@@ -86,6 +86,12 @@ type TaskDir struct {
 	// <task_dir>/private/
 	PrivateDir string
 
+	// CheckpointDir is the path to the checkpoint image of the task on the
+	// host. It only exists once the task has been checkpointed.
+	//
+	// <task_dir>/checkpoint/
+	CheckpointDir string
+
 	// skip embedding these paths in chroots. Used for avoiding embedding
 	// client.alloc_dir and client.mounts_dir recursively.
 	skip *set.Set[string]
@@ -115,6 +121,7 @@ func (d *AllocDir) newTaskDir(taskName string, secretsInMB int) *TaskDir {
 		LocalDir:         filepath.Join(taskDir, TaskLocal),
 		SecretsDir:       filepath.Join(taskDir, TaskSecrets),
 		PrivateDir:       filepath.Join(taskDir, TaskPrivate),
+		CheckpointDir:    filepath.Join(taskDir, TaskCheckpoint),
 		MountsAllocDir:   filepath.Join(d.clientAllocMountsDir, taskUnique, "alloc"),
 		MountsTaskDir:    filepath.Join(d.clientAllocMountsDir, taskUnique),
 		MountsSecretsDir: filepath.Join(d.clientAllocMountsDir, taskUnique, "secrets"),
@@ -30,6 +30,7 @@ import (
 	"github.com/hashicorp/nomad/client/pluginmanager/csimanager"
 	"github.com/hashicorp/nomad/client/pluginmanager/drivermanager"
 	"github.com/hashicorp/nomad/client/serviceregistration"
+	"github.com/hashicorp/nomad/client/serviceregistration/checks"
 	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
 	"github.com/hashicorp/nomad/client/serviceregistration/wrapper"
 	cstate "github.com/hashicorp/nomad/client/state"
@@ -228,6 +229,10 @@ type allocRunner struct {
 
 	// users manages a pool of dynamic workload users
 	users dynamic.Pool
+
+	// dnsServer serves DNS for Nomad native services within the network
+	// namespace of the alloc. It's nil unless enabled.
+	dnsServer config.NetNSDNSServer
 }
 
 // NewAllocRunner returns a new allocation runner.
@@ -273,6 +278,7 @@ func NewAllocRunner(config *config.AllocRunnerConfig) (interfaces.AllocRunner, e
 		hookResources:            cstructs.NewAllocHookResources(),
 		widsigner:                config.WIDSigner,
 		users:                    config.Users,
+		dnsServer:                config.DNSServer,
 	}
 
 	// Create the logger based on the allocation ID
@@ -1344,6 +1350,37 @@ func (ar *allocRunner) RestartTask(taskName string, event *structs.TaskEvent) er
 	return tr.Restart(context.TODO(), event, false)
 }
 
+// HibernateTask freezes the given task through its driver, keeping its
+// memory until it's woken by WakeTask.
+func (ar *allocRunner) HibernateTask(taskName string) error {
+	tr, ok := ar.tasks[taskName]
+	if !ok {
+		return fmt.Errorf("Could not find task runner for task: %s", taskName)
+	}
+
+	return tr.Hibernate()
+}
+
+// WakeTask resumes the given task if it's hibernated.
+func (ar *allocRunner) WakeTask(taskName string) error {
+	tr, ok := ar.tasks[taskName]
+	if !ok {
+		return fmt.Errorf("Could not find task runner for task: %s", taskName)
+	}
+
+	return tr.Wake()
+}
+
+// IsTaskHibernated returns whether the given task is hibernated.
+func (ar *allocRunner) IsTaskHibernated(taskName string) (bool, error) {
+	tr, ok := ar.tasks[taskName]
+	if !ok {
+		return false, fmt.Errorf("Could not find task runner for task: %s", taskName)
+	}
+
+	return tr.IsHibernated(), nil
+}
+
 // RestartRunning restarts all tasks that are currently running.
 func (ar *allocRunner) RestartRunning(event *structs.TaskEvent) error {
 	return ar.restartTasks(context.TODO(), event, false, false)
@@ -1478,6 +1515,17 @@ func (ar *allocRunner) GetTaskExecHandler(taskName string) drivermanager.TaskExe
 	return tr.TaskExecHandler()
 }
 
+// taskScriptExecutor returns the executor of a task for Nomad script checks,
+// or nil if the task does not exist or is not running.
+func (ar *allocRunner) taskScriptExecutor(taskName string) checks.ScriptExecutor {
+	tr, ok := ar.tasks[taskName]
+	if !ok {
+		return nil
+	}
+
+	return tr.ScriptExecutor()
+}
+
 func (ar *allocRunner) GetTaskDriverCapabilities(taskName string) (*drivers.Capabilities, error) {
 	tr, ok := ar.tasks[taskName]
 	if !ok {
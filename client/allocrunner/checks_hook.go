@@ -85,6 +85,9 @@ type checksHook struct {
 	allocID string
 	taskEnv *taskenv.TaskEnv
 
+	// taskExecutor provides the executors of tasks for script checks
+	taskExecutor func(string) checks.ScriptExecutor
+
 	// fields that get re-initialized on allocation update
 	lock      sync.RWMutex
 	ctx       context.Context
@@ -98,16 +101,18 @@ func newChecksHook(
 	alloc *structs.Allocation,
 	shim checkstore.Shim,
 	network structs.NetworkStatus,
+	taskExecutor func(string) checks.ScriptExecutor,
 	taskEnv *taskenv.TaskEnv,
 ) *checksHook {
 	h := &checksHook{
-		logger:  logger.Named(checksHookName),
-		allocID: alloc.ID,
-		alloc:   alloc,
-		shim:    shim,
-		network: network,
-		checker: checks.New(logger),
-		taskEnv: taskEnv,
+		logger:       logger.Named(checksHookName),
+		allocID:      alloc.ID,
+		alloc:        alloc,
+		shim:         shim,
+		network:      network,
+		checker:      checks.New(logger),
+		taskEnv:      taskEnv,
+		taskExecutor: taskExecutor,
 	}
 	h.initialize(alloc)
 	return h
@@ -179,6 +184,7 @@ func (h *checksHook) observe(alloc *structs.Allocation, services []*structs.Serv
 					Ports:            ports,
 					Networks:         networks,
 					NetworkStatus:    h.network,
+					TaskExecutor:     h.taskExecutor,
 					Group:            alloc.Name,
 					Task:             service.TaskName,
 					Service:          service.Name,
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/client/serviceregistration/checks"
 	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
 	"github.com/hashicorp/nomad/client/state"
 	"github.com/hashicorp/nomad/client/taskenv"
@@ -172,7 +174,7 @@ func TestCheckHook_Checks_ResultsSet(t *testing.T) {
 
 		envBuilder := taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region)
 
-		h := newChecksHook(logger, alloc, checkStore, network, envBuilder.Build())
+		h := newChecksHook(logger, alloc, checkStore, network, nil, envBuilder.Build())
 
 		// initialize is called; observers are created but not started yet
 		must.MapEmpty(t, h.observers)
@@ -239,7 +241,7 @@ func TestCheckHook_Checks_UpdateSet(t *testing.T) {
 
 	envBuilder := taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region)
 
-	h := newChecksHook(logger, alloc, shim, network, envBuilder.Build())
+	h := newChecksHook(logger, alloc, shim, network, nil, envBuilder.Build())
 
 	// calling pre-run starts the observers
 	err := h.Prerun()
@@ -318,3 +320,99 @@ func TestCheckHook_Checks_UpdateSet(t *testing.T) {
 	results := shim.List(alloc.ID)
 	must.MapEmpty(t, results)
 }
+
+// exitCodeExecutor is a checks.ScriptExecutor returning the exit code of the
+// command named by its only argument.
+type exitCodeExecutor struct{}
+
+func (exitCodeExecutor) Exec(_ time.Duration, _ string, args []string) ([]byte, int, error) {
+	code, err := strconv.Atoi(args[0])
+	return []byte("exit " + args[0]), code, err
+}
+
+func TestCheckHook_Checks_Script(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+	shim := makeCheckStore(logger)
+
+	alloc := mock.Alloc()
+	group := alloc.Job.LookupTaskGroup(alloc.TaskGroup)
+	group.Tasks[0].Services = nil
+	group.Services = []*structs.Service{{
+		Name:     "service-one",
+		Provider: "nomad",
+		Checks: []*structs.ServiceCheck{
+			{
+				Name:     "script-ok",
+				Type:     "script",
+				Command:  "/bin/check",
+				Args:     []string{"0"},
+				Interval: 250 * time.Millisecond,
+				Timeout:  1 * time.Second,
+				TaskName: "web",
+			},
+			{
+				Name:     "script-error",
+				Type:     "script",
+				Command:  "/bin/check",
+				Args:     []string{"2"},
+				Interval: 250 * time.Millisecond,
+				Timeout:  1 * time.Second,
+				TaskName: "web",
+			},
+			{
+				Name:     "script-not-running",
+				Type:     "script",
+				Command:  "/bin/check",
+				Args:     []string{"0"},
+				Interval: 250 * time.Millisecond,
+				Timeout:  1 * time.Second,
+				TaskName: "sidecar",
+			},
+		},
+	}}
+
+	taskExecutor := func(task string) checks.ScriptExecutor {
+		if task != "web" {
+			return nil
+		}
+		return exitCodeExecutor{}
+	}
+
+	network := mock.NewNetworkStatus("127.0.0.1")
+	envBuilder := taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region)
+	h := newChecksHook(logger, alloc, shim, network, taskExecutor, envBuilder.Build())
+
+	err := h.Prerun()
+	must.NoError(t, err)
+
+	testutil.WaitForResultUntil(
+		2*time.Second,
+		func() (bool, error) {
+			results := make(map[string]*structs.CheckQueryResult)
+			for _, result := range shim.List(alloc.ID) {
+				results[result.Check] = result
+			}
+			if len(results) != 3 {
+				return false, fmt.Errorf("expected 3 results, got %d", len(results))
+			}
+			if r := results["script-ok"]; r.Status != structs.CheckSuccess || r.Output != "exit 0" {
+				return false, fmt.Errorf("unexpected script-ok result: %#v", r)
+			}
+			if r := results["script-error"]; r.Status != structs.CheckFailure || r.Output != "exit 2" {
+				return false, fmt.Errorf("unexpected script-error result: %#v", r)
+			}
+			if r := results["script-not-running"]; r.Status != structs.CheckFailure ||
+				r.Output != `nomad: task "sidecar" is not running` {
+				return false, fmt.Errorf("unexpected script-not-running result: %#v", r)
+			}
+			return true, nil
+		},
+		func(err error) {
+			t.Fatal(err)
+		},
+	)
+
+	h.PreKill()
+}
@@ -53,6 +53,9 @@ type AllocRunner interface {
 	GetAllocDir() allocdir.Interface
 	SetTaskPauseState(taskName string, ps structs.TaskScheduleState) error
 	GetTaskPauseState(taskName string) (structs.TaskScheduleState, error)
+	HibernateTask(taskName string) error
+	WakeTask(taskName string) error
+	IsTaskHibernated(taskName string) (bool, error)
 }
 
 // TaskStateHandler exposes a handler to be called when a task's state changes
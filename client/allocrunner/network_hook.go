@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	hclog "github.com/hashicorp/go-hclog"
+	clientconfig "github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/plugins/drivers"
@@ -81,6 +82,12 @@ type networkHook struct {
 	// taskEnv is used to perform interpolation within the network blocks.
 	taskEnv *taskenv.TaskEnv
 
+	// dnsServer serves DNS for Nomad native services within the network
+	// namespace of allocs in bridge networking mode, and is nil unless
+	// enabled. stopDNS stops serving it for this alloc.
+	dnsServer clientconfig.NetNSDNSServer
+	stopDNS   func()
+
 	logger hclog.Logger
 }
 
@@ -91,6 +98,7 @@ func newNetworkHook(logger hclog.Logger,
 	netConfigurator NetworkConfigurator,
 	networkStatusSetter networkStatusSetter,
 	taskEnv *taskenv.TaskEnv,
+	dnsServer clientconfig.NetNSDNSServer,
 ) *networkHook {
 	return &networkHook{
 		isolationSetter:     ns,
@@ -99,6 +107,7 @@ func newNetworkHook(logger hclog.Logger,
 		manager:             netManager,
 		networkConfigurator: netConfigurator,
 		taskEnv:             taskEnv,
+		dnsServer:           dnsServer,
 		logger:              logger,
 	}
 }
@@ -166,7 +175,9 @@ CREATE:
 			return fmt.Errorf("failed to configure networking for alloc: %v", err)
 		}
 		if status == nil {
-			return nil // netns already existed and was correctly configured
+			// netns already existed and was correctly configured, along with
+			// its nameserver
+			return h.serveDNS(tg, spec, nil)
 		}
 
 		// If the driver set the sandbox hostname label, then we will use that
@@ -193,12 +204,49 @@ CREATE:
 			}
 		}
 
+		if err := h.serveDNS(tg, spec, status); err != nil {
+			return err
+		}
+
 		h.networkStatusSetter.SetNetworkStatus(status)
 	}
 	return nil
 }
 
+// serveDNS serves DNS for Nomad native services within the network namespace
+// of allocs in bridge networking mode, and makes it their nameserver. Allocs
+// that set their own nameservers, or use Consul DNS through a transparent
+// proxy, are left alone. The status is nil if the network namespace was
+// restored, in which case its nameserver is already set.
+func (h *networkHook) serveDNS(tg *structs.TaskGroup, spec *drivers.NetworkIsolationSpec, status *structs.AllocNetworkStatus) error {
+	if h.dnsServer == nil || !tgFirstNetworkIsBridge(tg) || tg.Networks[0].DNS != nil {
+		return nil
+	}
+	for _, service := range tg.Services {
+		if service.Connect.HasTransparentProxy() {
+			return nil
+		}
+	}
+
+	addr, stop, err := h.dnsServer.ServeNetNS(spec.Path, h.alloc.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to serve DNS for Nomad services: %w", err)
+	}
+	h.stopDNS = stop
+
+	if status != nil {
+		if status.DNS == nil {
+			status.DNS = &structs.DNSConfig{}
+		}
+		status.DNS.Servers = []string{addr}
+	}
+	return nil
+}
+
 func (h *networkHook) Postrun() error {
+	if h.stopDNS != nil {
+		h.stopDNS()
+	}
 
 	// we need the spec for network teardown
 	if h.spec != nil {
@@ -4,11 +4,13 @@
 package allocrunner
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	clientconfig "github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/nomad/mock"
@@ -89,7 +91,7 @@ func TestNetworkHook_Prerun_Postrun_group(t *testing.T) {
 
 	envBuilder := taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region)
 	logger := testlog.HCLogger(t)
-	hook := newNetworkHook(logger, setter, alloc, nm, &hostNetworkConfigurator{}, statusSetter, envBuilder.Build())
+	hook := newNetworkHook(logger, setter, alloc, nm, &hostNetworkConfigurator{}, statusSetter, envBuilder.Build(), nil)
 	must.NoError(t, hook.Prerun())
 	must.True(t, setter.called)
 	must.False(t, destroyCalled)
@@ -132,7 +134,7 @@ func TestNetworkHook_Prerun_Postrun_host(t *testing.T) {
 
 	envBuilder := taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region)
 	logger := testlog.HCLogger(t)
-	hook := newNetworkHook(logger, setter, alloc, nm, &hostNetworkConfigurator{}, statusSetter, envBuilder.Build())
+	hook := newNetworkHook(logger, setter, alloc, nm, &hostNetworkConfigurator{}, statusSetter, envBuilder.Build(), nil)
 	must.NoError(t, hook.Prerun())
 	must.False(t, setter.called)
 	must.False(t, destroyCalled)
@@ -256,7 +258,7 @@ func TestNetworkHook_Prerun_Postrun_ExistingNetNS(t *testing.T) {
 			fakePlugin.checkErrors = tc.checkErrs
 			configurator.nodeAttrs["plugins.cni.version.bridge"] = tc.cniVersion
 			hook := newNetworkHook(testlog.HCLogger(t), isolationSetter,
-				alloc, nm, configurator, statusSetter, envBuilder.Build())
+				alloc, nm, configurator, statusSetter, envBuilder.Build(), nil)
 
 			err := hook.Prerun()
 			if tc.expectPrerunError == "" {
@@ -281,3 +283,123 @@ func TestNetworkHook_Prerun_Postrun_ExistingNetNS(t *testing.T) {
 
 	}
 }
+
+// mockNetworkConfigurator returns the network status it's set to.
+type mockNetworkConfigurator struct {
+	status *structs.AllocNetworkStatus
+}
+
+func (m *mockNetworkConfigurator) Setup(context.Context, *structs.Allocation, *drivers.NetworkIsolationSpec, bool) (*structs.AllocNetworkStatus, error) {
+	return m.status.Copy(), nil
+}
+
+func (m *mockNetworkConfigurator) Teardown(context.Context, *structs.Allocation, *drivers.NetworkIsolationSpec) error {
+	return nil
+}
+
+// mockDNSServer records the network namespaces it serves.
+type mockDNSServer struct {
+	nsPath    string
+	namespace string
+	stopped   bool
+}
+
+func (m *mockDNSServer) ServeNetNS(nsPath, namespace string) (string, func(), error) {
+	m.nsPath = nsPath
+	m.namespace = namespace
+	return "127.0.0.1", func() { m.stopped = true }, nil
+}
+
+// Test that the network hook serves DNS for Nomad services within the network
+// namespace of bridge allocs, and makes it their nameserver.
+func TestNetworkHook_Prerun_Postrun_dns(t *testing.T) {
+	ci.Parallel(t)
+
+	spec := &drivers.NetworkIsolationSpec{
+		Mode: drivers.NetIsolationModeGroup,
+		Path: "/var/run/netns/test",
+	}
+	nm := &testutils.MockDriver{
+		MockNetworkManager: testutils.MockNetworkManager{
+			CreateNetworkF: func(string, *drivers.NetworkCreateRequest) (*drivers.NetworkIsolationSpec, bool, error) {
+				return spec, true, nil
+			},
+			DestroyNetworkF: func(string, *drivers.NetworkIsolationSpec) error {
+				return nil
+			},
+		},
+	}
+	configurator := &mockNetworkConfigurator{status: &structs.AllocNetworkStatus{
+		InterfaceName: "eth0",
+		Address:       "172.26.64.2",
+		DNS:           &structs.DNSConfig{Servers: []string{"192.0.2.1"}, Searches: []string{"example.com"}},
+	}}
+
+	testCases := []struct {
+		name      string
+		network   *structs.NetworkResource
+		dnsServer *mockDNSServer
+		expectDNS *structs.DNSConfig
+	}{
+		{
+			name:      "bridge",
+			network:   &structs.NetworkResource{Mode: "bridge"},
+			dnsServer: &mockDNSServer{},
+			expectDNS: &structs.DNSConfig{Servers: []string{"127.0.0.1"}, Searches: []string{"example.com"}},
+		},
+		{
+			name:      "disabled",
+			network:   &structs.NetworkResource{Mode: "bridge"},
+			expectDNS: configurator.status.DNS,
+		},
+		{
+			name: "job nameservers",
+			network: &structs.NetworkResource{Mode: "bridge",
+				DNS: &structs.DNSConfig{Servers: []string{"192.0.2.2"}}},
+			dnsServer: &mockDNSServer{},
+			expectDNS: configurator.status.DNS,
+		},
+		{
+			name:      "cni",
+			network:   &structs.NetworkResource{Mode: "cni/custom"},
+			dnsServer: &mockDNSServer{},
+			expectDNS: configurator.status.DNS,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			alloc := mock.Alloc()
+			alloc.Namespace = "platform"
+			alloc.Job.TaskGroups[0].Networks = []*structs.NetworkResource{tc.network}
+
+			expectStatus := configurator.status.Copy()
+			expectStatus.DNS = tc.expectDNS
+			setter := &mockNetworkIsolationSetter{t: t, expectedSpec: spec}
+			statusSetter := &mockNetworkStatusSetter{t: t, expectedStatus: expectStatus}
+
+			var dnsServer clientconfig.NetNSDNSServer
+			if tc.dnsServer != nil {
+				dnsServer = tc.dnsServer
+			}
+
+			envBuilder := taskenv.NewBuilder(mock.Node(), alloc, nil, alloc.Job.Region)
+			hook := newNetworkHook(testlog.HCLogger(t), setter, alloc, nm, configurator,
+				statusSetter, envBuilder.Build(), dnsServer)
+			must.NoError(t, hook.Prerun())
+			must.True(t, statusSetter.called)
+
+			served := tc.dnsServer != nil && tc.dnsServer.nsPath != ""
+			must.Eq(t, tc.expectDNS.Servers[0] == "127.0.0.1", served)
+			if served {
+				must.Eq(t, spec.Path, tc.dnsServer.nsPath)
+				must.Eq(t, "platform", tc.dnsServer.namespace)
+			}
+
+			must.NoError(t, hook.Postrun())
+			if served {
+				must.True(t, tc.dnsServer.stopped)
+			}
+		})
+	}
+}
@@ -5,11 +5,17 @@ package taskrunner
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
 )
 
+// errDriverPauseNotSupported is returned when the driver cannot pause a
+// running task.
+var errDriverPauseNotSupported = errors.New("driver does not support hibernating tasks")
+
 // Restart restarts a task that is already running. Returns an error if the
 // task is not running. Blocks until existing task exits or passed-in context
 // is canceled.
@@ -157,6 +163,93 @@ func (tr *TaskRunner) Signal(event *structs.TaskEvent, s string) error {
 	return handle.Signal(s)
 }
 
+// Hibernate freezes the running task through its driver. The task keeps its
+// memory but isn't scheduled on the CPU until Wake is called. Hibernating a
+// hibernated task is a no-op.
+func (tr *TaskRunner) Hibernate() error {
+	tr.logger.Trace("Hibernate requested")
+
+	tr.hibernateLock.Lock()
+	defer tr.hibernateLock.Unlock()
+
+	handle := tr.getDriverHandle()
+	if handle == nil {
+		return ErrTaskNotRunning
+	}
+
+	pauser, ok := tr.driver.(drivers.DriverPauser)
+	if !ok || !tr.driverCapabilities.Pause {
+		return errDriverPauseNotSupported
+	}
+
+	if tr.IsHibernated() {
+		return nil
+	}
+
+	if err := pauser.PauseTask(handle.ID()); err != nil {
+		return err
+	}
+
+	tr.setHibernated(true)
+	tr.EmitEvent(structs.NewTaskEvent(structs.TaskHibernated))
+	return nil
+}
+
+// Wake resumes a task frozen by Hibernate. Waking a task that isn't
+// hibernated is a no-op.
+func (tr *TaskRunner) Wake() error {
+	tr.logger.Trace("Wake requested")
+
+	handle := tr.getDriverHandle()
+	if handle == nil {
+		return ErrTaskNotRunning
+	}
+
+	return tr.wakeTask(handle)
+}
+
+// wakeTask resumes the task of the handle if it's hibernated.
+func (tr *TaskRunner) wakeTask(handle *DriverHandle) error {
+	tr.hibernateLock.Lock()
+	defer tr.hibernateLock.Unlock()
+
+	if !tr.IsHibernated() {
+		return nil
+	}
+
+	pauser, ok := tr.driver.(drivers.DriverPauser)
+	if !ok || !tr.driverCapabilities.Pause {
+		return errDriverPauseNotSupported
+	}
+
+	if err := pauser.ResumeTask(handle.ID()); err != nil {
+		return err
+	}
+
+	tr.setHibernated(false)
+	tr.EmitEvent(structs.NewTaskEvent(structs.TaskWoken))
+	return nil
+}
+
+// IsHibernated returns true if the task is frozen by Hibernate.
+func (tr *TaskRunner) IsHibernated() bool {
+	tr.stateLock.RLock()
+	defer tr.stateLock.RUnlock()
+	return tr.localState.Hibernated
+}
+
+// setHibernated records whether the task is hibernated and persists it, so
+// that the task is still known to be frozen after the agent restarts.
+func (tr *TaskRunner) setHibernated(hibernated bool) {
+	tr.stateLock.Lock()
+	tr.localState.Hibernated = hibernated
+	tr.stateLock.Unlock()
+
+	if err := tr.persistLocalState(); err != nil {
+		tr.logger.Warn("error persisting hibernation state", "error", err)
+	}
+}
+
 // Kill a task. Blocks until task exits or context is canceled. State is set to
 // dead.
 func (tr *TaskRunner) Kill(ctx context.Context, event *structs.TaskEvent) error {
@@ -189,6 +189,9 @@ func (h *scriptCheckHook) newScriptChecks() map[string]*scriptCheck {
 	scriptChecks := make(map[string]*scriptCheck)
 	interpolatedTaskServices := taskenv.InterpolateServices(h.taskEnv, h.task.Services)
 	for _, service := range interpolatedTaskServices {
+		if service.Provider == structs.ServiceProviderNomad {
+			continue // executed by the alloc runner checks hook
+		}
 		for _, check := range service.Checks {
 			if check.Type != structs.ServiceCheckScript {
 				continue
@@ -225,6 +228,9 @@ func (h *scriptCheckHook) newScriptChecks() map[string]*scriptCheck {
 	tg := h.alloc.Job.LookupTaskGroup(h.alloc.TaskGroup)
 	interpolatedGroupServices := taskenv.InterpolateServices(h.taskEnv, tg.Services)
 	for _, service := range interpolatedGroupServices {
+		if service.Provider == structs.ServiceProviderNomad {
+			continue // executed by the alloc runner checks hook
+		}
 		for _, check := range service.Checks {
 			if check.Type != structs.ServiceCheckScript {
 				continue
@@ -331,3 +331,31 @@ func TestScript_associated(t *testing.T) {
 		require.False(t, new(scriptCheckHook).associated("task1", "task2", "task2"))
 	})
 }
+
+// TestScript_NomadServices asserts script checks of services using the nomad
+// provider are left to the alloc runner checks hook.
+func TestScript_NomadServices(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+	consulClient := regMock.NewServiceRegistrationHandler(logger)
+	exec, cancel := newBlockingScriptExec()
+	defer cancel()
+
+	alloc := mock.ConnectAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Services[0].Provider = structs.ServiceProviderNomad
+	alloc.Job.Canonicalize()
+
+	scHook := newScriptCheckHook(scriptCheckHookConfig{
+		alloc:        alloc,
+		task:         task,
+		consul:       consulClient,
+		logger:       logger,
+		shutdownWait: time.Hour,
+	})
+	scHook.taskEnv = taskenv.NewBuilder(mock.Node(), alloc, task, "global").Build()
+	scHook.driverExec = exec
+
+	must.MapEmpty(t, scHook.newScriptChecks())
+}
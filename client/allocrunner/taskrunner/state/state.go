@@ -25,6 +25,10 @@ type LocalState struct {
 	// It is used to distinguish between a dead task that could be restarted
 	// and one that will never run again.
 	RunComplete bool
+
+	// Hibernated is set to true while the task is frozen by its driver. It
+	// is reset when the task is started again.
+	Hibernated bool
 }
 
 func NewLocalState() *LocalState {
@@ -62,6 +66,7 @@ func (s *LocalState) Copy() *LocalState {
 		DriverNetwork: s.DriverNetwork.Copy(),
 		TaskHandle:    s.TaskHandle.Copy(),
 		RunComplete:   s.RunComplete,
+		Hibernated:    s.Hibernated,
 	}
 
 	// Copy the hook state
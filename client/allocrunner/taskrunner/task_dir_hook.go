@@ -5,6 +5,7 @@ package taskrunner
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 	"strings"
 
@@ -14,7 +15,9 @@ import (
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
 	cconfig "github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/client/taskenv"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
 	"github.com/hashicorp/nomad/plugins/drivers/fsisolation"
 )
 
@@ -62,6 +65,18 @@ func (h *taskDirHook) Prestart(ctx context.Context, req *interfaces.TaskPrestart
 		chroot = cc.ChrootEnv
 	}
 
+	// Drivers that populate the root filesystem of the task themselves, such
+	// as from an image, don't need anything copied from the host.
+	if fsi == fsisolation.Chroot {
+		provided, err := h.driverProvidesRootFS(req.Task)
+		if err != nil {
+			return err
+		}
+		if provided {
+			chroot = nil
+		}
+	}
+
 	// Emit the event that we are going to be building the task directory
 	h.runner.EmitEvent(structs.NewTaskEvent(structs.TaskSetup).SetMessage(structs.TaskBuildingTaskDir))
 
@@ -79,6 +94,38 @@ func (h *taskDirHook) Prestart(ctx context.Context, req *interfaces.TaskPrestart
 	return nil
 }
 
+// driverProvidesRootFS returns true if the task driver populates the root
+// filesystem of the task itself, so the chroot_env must not be copied into it.
+func (h *taskDirHook) driverProvidesRootFS(task *structs.Task) (bool, error) {
+	provider, ok := h.runner.driver.(drivers.DriverRootFSProvider)
+	if !ok || !h.runner.driverCapabilities.ProvidesRootFS {
+		return false, nil
+	}
+
+	vars, _, err := h.runner.envBuilder.Build().AllValues()
+	if err != nil {
+		return false, fmt.Errorf("error building environment variables: %v", err)
+	}
+
+	// Invalid driver configs are reported when the task is started, so fall
+	// back to building a regular chroot here.
+	val, diag, _ := hclutils.ParseHclInterface(task.Config, h.runner.taskSchema, vars)
+	if diag.HasErrors() {
+		return false, nil
+	}
+
+	taskConfig := h.runner.buildTaskConfig()
+	if err := taskConfig.EncodeDriverConfig(val); err != nil {
+		return false, nil
+	}
+
+	provided, err := provider.TaskProvidesRootFS(taskConfig)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if driver provides the root filesystem: %v", err)
+	}
+	return provided, nil
+}
+
 // setEnvvars sets path and host env vars depending on the FS isolation used.
 func setEnvvars(envBuilder *taskenv.Builder, fsi fsisolation.Mode, taskDir *allocdir.TaskDir, conf *cconfig.Config) {
 
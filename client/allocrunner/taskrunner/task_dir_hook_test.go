@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package taskrunner
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/shoenig/test/must"
+)
+
+func TestTaskDirHook_DriverProvidesRootFS(t *testing.T) {
+	ci.Parallel(t)
+
+	for _, tc := range []struct {
+		name     string
+		config   map[string]interface{}
+		expected bool
+	}{
+		{
+			name:     "chroot",
+			config:   map[string]interface{}{"run_for": "10s"},
+			expected: false,
+		},
+		{
+			name:     "driver rootfs",
+			config:   map[string]interface{}{"run_for": "10s", "provides_rootfs": true},
+			expected: true,
+		},
+		{
+			name:     "invalid config",
+			config:   map[string]interface{}{"provides_rootfs": "maybe"},
+			expected: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			alloc := mock.BatchAlloc()
+			task := alloc.Job.TaskGroups[0].Tasks[0]
+			task.Driver = "mock_driver"
+			task.Config = tc.config
+
+			conf, cleanup := testTaskRunnerConfig(t, alloc, task.Name, nil)
+			t.Cleanup(cleanup)
+
+			tr, err := NewTaskRunner(conf)
+			must.NoError(t, err)
+
+			hook := newTaskDirHook(tr, testlog.HCLogger(t))
+			provided, err := hook.driverProvidesRootFS(task)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, provided)
+		})
+	}
+}
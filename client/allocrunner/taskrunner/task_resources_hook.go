@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package taskrunner
+
+import (
+	"context"
+	"errors"
+
+	log "github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+// errDriverResizeNotSupported is returned when the driver cannot update the
+// resources of a running task.
+var errDriverResizeNotSupported = errors.New("driver does not support updating task resources")
+
+// taskResourcesHook applies CPU and memory changes of in-place updates to the
+// running task. The scheduler updates allocations in place when only these
+// resources change, so the task must either be resized by its driver or
+// restarted for the new limits to take effect.
+type taskResourcesHook struct {
+	runner *TaskRunner
+	logger log.Logger
+}
+
+func newTaskResourcesHook(runner *TaskRunner, logger log.Logger) *taskResourcesHook {
+	h := &taskResourcesHook{
+		runner: runner,
+	}
+	h.logger = logger.Named(h.Name())
+	return h
+}
+
+func (*taskResourcesHook) Name() string {
+	return "task_resources"
+}
+
+func (h *taskResourcesHook) Update(ctx context.Context, req *interfaces.TaskUpdateRequest, _ *interfaces.TaskUpdateResponse) error {
+	tr := h.runner
+
+	if req.Alloc.AllocatedResources == nil {
+		return nil
+	}
+	tres, ok := req.Alloc.AllocatedResources.Tasks[tr.taskName]
+	if !ok {
+		return nil
+	}
+	task := req.Alloc.LookupTask(tr.taskName)
+	if task == nil {
+		return nil
+	}
+
+	// the memory given to the driver excludes the secrets tmpfs, as when the
+	// task runner was created
+	memoryMB := tres.Memory.MemoryMB - int64(task.Resources.SecretsMB)
+
+	current := tr.TaskResources()
+	if current.Cpu.CpuShares == tres.Cpu.CpuShares &&
+		current.Memory.MemoryMB == memoryMB &&
+		current.Memory.MemoryMaxMB == tres.Memory.MemoryMaxMB {
+		return nil
+	}
+
+	updated := current.Copy()
+	updated.Cpu.CpuShares = tres.Cpu.CpuShares
+	updated.Memory.MemoryMB = memoryMB
+	updated.Memory.MemoryMaxMB = tres.Memory.MemoryMaxMB
+	tr.setTaskResources(updated)
+
+	// if the task isn't running the new resources are used when it starts
+	handle := tr.getDriverHandle()
+	if handle == nil {
+		return nil
+	}
+
+	h.logger.Debug("updating task resources",
+		"cpu", updated.Cpu.CpuShares, "memory", updated.Memory.MemoryMB, "memory_max", updated.Memory.MemoryMaxMB)
+
+	err := h.resize(handle, updated)
+	if err == nil {
+		tr.EmitEvent(structs.NewTaskEvent(structs.TaskResourcesUpdated))
+		return nil
+	}
+
+	h.logger.Info("unable to update task resources in place, restarting task", "reason", err)
+	event := structs.NewTaskEvent(structs.TaskRestartSignal).
+		SetRestartReason("Restarting task to apply updated resources")
+	if err := tr.Restart(ctx, event, false); err != nil && !errors.Is(err, ErrTaskNotRunning) {
+		return err
+	}
+	return nil
+}
+
+// resize asks the driver to apply the resources to the running task.
+func (h *taskResourcesHook) resize(handle *DriverHandle, tres *structs.AllocatedTaskResources) error {
+	tr := h.runner
+
+	updater, ok := tr.driver.(drivers.DriverTaskResourceUpdater)
+	if !ok || !tr.driverCapabilities.UpdateResources {
+		return errDriverResizeNotSupported
+	}
+
+	return updater.UpdateTaskResources(handle.ID(), tr.buildDriverResources(tres))
+}
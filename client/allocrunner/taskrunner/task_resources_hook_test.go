@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package taskrunner
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	mockdriver "github.com/hashicorp/nomad/drivers/mock"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+)
+
+// countEvents returns the number of task events of the given type.
+func countEvents(tr *TaskRunner, eventType string) int {
+	n := 0
+	for _, ev := range tr.TaskState().Events {
+		if ev.Type == eventType {
+			n++
+		}
+	}
+	return n
+}
+
+// resizeAlloc returns a copy of alloc with the CPU and memory of the task
+// increased.
+func resizeAlloc(alloc *structs.Allocation, taskName string) *structs.Allocation {
+	updated := alloc.Copy()
+	tres := updated.AllocatedResources.Tasks[taskName]
+	tres.Cpu.CpuShares += 100
+	tres.Memory.MemoryMB += 64
+	tres.Memory.MemoryMaxMB = tres.Memory.MemoryMB * 2
+	return updated
+}
+
+func TestTaskResourcesHook_UpdateInPlace(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "10s",
+	}
+
+	tr, conf, cleanup := runTestTaskRunner(t, alloc, task.Name)
+	defer cleanup()
+	testWaitForTaskToStart(t, tr)
+
+	updated := resizeAlloc(alloc, task.Name)
+	tres := updated.AllocatedResources.Tasks[task.Name]
+	tr.Update(updated)
+
+	testutil.WaitForResult(func() (bool, error) {
+		if n := countEvents(tr, structs.TaskResourcesUpdated); n != 1 {
+			return false, fmt.Errorf("expected 1 resources updated event, got %d", n)
+		}
+		return true, nil
+	}, func(err error) {
+		t.Fatal(err)
+	})
+
+	driverPlugin, err := conf.DriverManager.Dispense(mockdriver.PluginID.Name)
+	must.NoError(t, err)
+	mockDriver := driverPlugin.(*mockdriver.Driver)
+
+	resources := mockDriver.GetTaskResources(tr.getDriverHandle().ID())
+	must.NotNil(t, resources)
+	must.Eq(t, tres.Cpu.CpuShares, resources.LinuxResources.CPUShares)
+	must.Eq(t, tres.Memory.MemoryMaxMB*1024*1024, resources.LinuxResources.MemoryLimitBytes)
+	must.Eq(t, tres.Memory.MemoryMB, tr.TaskResources().Memory.MemoryMB)
+
+	// the task was not restarted
+	must.Eq(t, 1, countEvents(tr, structs.TaskStarted))
+}
+
+func TestTaskResourcesHook_RestartOnError(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for":                "10s",
+		"update_resources_error": "cgroup busy",
+	}
+
+	tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+	defer cleanup()
+	testWaitForTaskToStart(t, tr)
+
+	tr.Update(resizeAlloc(alloc, task.Name))
+
+	testutil.WaitForResult(func() (bool, error) {
+		if n := countEvents(tr, structs.TaskStarted); n != 2 {
+			return false, fmt.Errorf("expected task to be started twice, got %d", n)
+		}
+		return true, nil
+	}, func(err error) {
+		t.Fatal(err)
+	})
+
+	must.Eq(t, 0, countEvents(tr, structs.TaskResourcesUpdated))
+	must.Eq(t, 1, countEvents(tr, structs.TaskRestartSignal))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"slices"
 	"strings"
 	"sync"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/nomad/client/allocdir"
 	"github.com/hashicorp/nomad/client/allocrunner/hookstats"
 	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	tinterfaces "github.com/hashicorp/nomad/client/allocrunner/taskrunner/interfaces"
 	"github.com/hashicorp/nomad/client/allocrunner/taskrunner/restarts"
 	"github.com/hashicorp/nomad/client/allocrunner/taskrunner/state"
 	"github.com/hashicorp/nomad/client/config"
@@ -76,12 +78,17 @@ const (
 )
 
 type TaskRunner struct {
-	// allocID, taskName, taskLeader, and taskResources are immutable so these fields may
+	// allocID, taskName, and taskLeader are immutable so these fields may
 	// be accessed without locks
-	allocID       string
-	taskName      string
-	taskLeader    bool
-	taskResources *structs.AllocatedTaskResources
+	allocID    string
+	taskName   string
+	taskLeader bool
+
+	// taskResources are the resources allocated to the task. The CPU and
+	// memory may be updated in place while the task is running, so it must
+	// be accessed with TaskResources.
+	taskResources     *structs.AllocatedTaskResources
+	taskResourcesLock sync.RWMutex
 
 	alloc     *structs.Allocation
 	allocLock sync.Mutex
@@ -151,6 +158,9 @@ type TaskRunner struct {
 	// driverCapabilities is the set capabilities the driver supports
 	driverCapabilities *drivers.Capabilities
 
+	// hibernateLock serializes pausing and resuming the task
+	hibernateLock sync.Mutex
+
 	// taskSchema is the hcl spec for the task driver configuration
 	taskSchema hcldec.Spec
 
@@ -457,7 +467,9 @@ func NewTaskRunner(config *Config) (*TaskRunner, error) {
 
 	// we had to allocate the tmpfs with the memory to get correct scheduling
 	// and tracking on the node, but now that we're creating the task driver
-	// config we only care about the memory without the secrets.
+	// config we only care about the memory without the secrets. The
+	// resources are copied so that the allocation is left untouched.
+	tres = tres.Copy()
 	tres.Memory.MemoryMB -= int64(tr.task.Resources.SecretsMB)
 	tr.taskResources = tres
 
@@ -914,7 +926,7 @@ func (tr *TaskRunner) shouldRestart() (bool, time.Duration) {
 }
 
 func (tr *TaskRunner) assignCgroup(taskConfig *drivers.TaskConfig) {
-	reserveCores := len(tr.taskResources.Cpu.ReservedCores) > 0
+	reserveCores := len(tr.TaskResources().Cpu.ReservedCores) > 0
 	p := cgroupslib.LinuxResourcesPath(taskConfig.AllocID, taskConfig.Name, reserveCores)
 	taskConfig.Resources.LinuxResources.CpusetCgroupPath = p
 }
@@ -972,8 +984,12 @@ func (tr *TaskRunner) runDriver() error {
 		return nil
 	}
 
-	// Start the job if there's no existing handle (or if RecoverTask failed)
-	handle, net, err := tr.driver.StartTask(taskConfig)
+	// Start the job if there's no existing handle (or if RecoverTask failed),
+	// unless it can be restored from the checkpoint of the previous alloc
+	handle, net, restored := tr.restoreCheckpoint(taskConfig)
+	if !restored {
+		handle, net, err = tr.driver.StartTask(taskConfig)
+	}
 	if err != nil {
 		// The plugin has died, try relaunching it
 		if err == bstructs.ErrPluginShutdown {
@@ -1001,6 +1017,7 @@ func (tr *TaskRunner) runDriver() error {
 	tr.stateLock.Lock()
 	tr.localState.TaskHandle = handle
 	tr.localState.DriverNetwork = net
+	tr.localState.Hibernated = false
 	if err := tr.stateDB.PutTaskRunnerLocalState(tr.allocID, tr.taskName, tr.localState); err != nil {
 		//TODO Nomad will be unable to restore this task; try to kill
 		//     it now and fail? In general we prefer to leave running
@@ -1013,10 +1030,67 @@ func (tr *TaskRunner) runDriver() error {
 	tr.setDriverHandle(NewDriverHandle(tr.driver, taskConfig.ID, tr.Task(), tr.clientConfig.MaxKillTimeout, net))
 
 	// Emit an event that we started
+	if restored {
+		tr.EmitEvent(structs.NewTaskEvent(structs.TaskRestoredFromCheckpoint))
+	}
 	tr.UpdateState(structs.TaskStateRunning, structs.NewTaskEvent(structs.TaskStarted))
 	return nil
 }
 
+// restoreCheckpoint restores the task from the checkpoint image migrated
+// from the previous allocation, if there is one and the driver supports it.
+// The image is removed afterwards so that restarts start the task from
+// scratch. It returns false if the task wasn't restored and must be started.
+func (tr *TaskRunner) restoreCheckpoint(taskConfig *drivers.TaskConfig) (*drivers.TaskHandle, *drivers.DriverNetwork, bool) {
+	imagePath := tr.taskDir.CheckpointDir
+	if _, err := os.Stat(imagePath); err != nil {
+		return nil, nil, false
+	}
+	defer os.RemoveAll(imagePath)
+
+	cp, ok := tr.driver.(drivers.DriverCheckpointer)
+	if !ok || !tr.driverCapabilities.Checkpoint {
+		tr.logger.Warn("driver does not support restoring checkpoints, starting task instead")
+		return nil, nil, false
+	}
+
+	tr.logger.Info("restoring task from checkpoint", "image", imagePath)
+	handle, net, err := cp.RestoreTask(taskConfig, imagePath)
+	if err != nil {
+		tr.logger.Error("failed to restore task from checkpoint, starting task instead", "error", err)
+		tr.EmitEvent(structs.NewTaskEvent(structs.TaskDriverMessage).
+			SetDriverMessage(fmt.Sprintf("Failed to restore task from checkpoint: %v", err)))
+		return nil, nil, false
+	}
+
+	return handle, net, true
+}
+
+// checkpointTask checkpoints the task into its checkpoint dir so that the
+// replacement allocation can restore it. The driver stops the task once the
+// image is written. On failure the task is left running to be killed as
+// usual.
+func (tr *TaskRunner) checkpointTask(handle *DriverHandle) {
+	cp, ok := tr.driver.(drivers.DriverCheckpointer)
+	if !ok || !tr.driverCapabilities.Checkpoint {
+		tr.logger.Debug("driver does not support checkpoints, skipping checkpoint")
+		return
+	}
+
+	imagePath := tr.taskDir.CheckpointDir
+	os.RemoveAll(imagePath) // remove any stale image
+
+	tr.logger.Info("checkpointing task", "image", imagePath)
+	if err := cp.CheckpointTask(handle.ID(), imagePath, false); err != nil {
+		tr.logger.Error("failed to checkpoint task", "error", err)
+		os.RemoveAll(imagePath) // don't migrate a partial image
+		tr.EmitEvent(structs.NewTaskEvent(structs.TaskCheckpointFailed).SetMessage(err.Error()))
+		return
+	}
+
+	tr.EmitEvent(structs.NewTaskEvent(structs.TaskCheckpointed))
+}
+
 // initDriver retrives the DriverPlugin from the plugin loader for this task
 func (tr *TaskRunner) initDriver() error {
 	driver, err := tr.driverManager.Dispense(tr.Task().Driver)
@@ -1092,6 +1166,12 @@ func (tr *TaskRunner) handleKill(resultCh <-chan *drivers.ExitResult) *drivers.E
 		return nil
 	}
 
+	// Checkpoint the task first if it is being migrated and its replacement
+	// should restore it.
+	if tr.Alloc().ShouldCheckpoint() {
+		tr.checkpointTask(handle)
+	}
+
 	// Kill the task using an exponential backoff in-case of failures.
 	result, killErr := tr.killTask(handle, resultCh)
 	if killErr != nil {
@@ -1133,6 +1213,11 @@ func (tr *TaskRunner) handleKill(resultCh <-chan *drivers.ExitResult) *drivers.E
 // killTask will retry with an exponential backoff and will give up at a
 // given limit. Returns an error if the task could not be killed.
 func (tr *TaskRunner) killTask(handle *DriverHandle, resultCh <-chan *drivers.ExitResult) (*drivers.ExitResult, error) {
+	// A hibernated task can't handle the kill signal, so wake it first.
+	if err := tr.wakeTask(handle); err != nil {
+		tr.logger.Warn("failed to wake hibernated task before killing it", "error", err)
+	}
+
 	// Cap the number of times we attempt to kill the task.
 	var err error
 	for i := 0; i < killFailureLimit; i++ {
@@ -1169,6 +1254,33 @@ func (tr *TaskRunner) persistLocalState() error {
 	return tr.stateDB.PutTaskRunnerLocalState(tr.allocID, tr.taskName, tr.localState)
 }
 
+// buildDriverResources builds the drivers.Resources passed to the driver from
+// the resources allocated to the task.
+func (tr *TaskRunner) buildDriverResources(taskResources *structs.AllocatedTaskResources) *drivers.Resources {
+	ports := tr.Alloc().AllocatedResources.Shared.Ports
+
+	memoryLimit := taskResources.Memory.MemoryMB
+	if max := taskResources.Memory.MemoryMaxMB; max > memoryLimit {
+		memoryLimit = max
+	}
+
+	cpusetCpus := make([]string, len(taskResources.Cpu.ReservedCores))
+	for i, v := range taskResources.Cpu.ReservedCores {
+		cpusetCpus[i] = fmt.Sprintf("%d", v)
+	}
+
+	return &drivers.Resources{
+		NomadResources: taskResources,
+		LinuxResources: &drivers.LinuxResources{
+			MemoryLimitBytes: memoryLimit * 1024 * 1024,
+			CPUShares:        taskResources.Cpu.CpuShares,
+			CpusetCpus:       strings.Join(cpusetCpus, ","),
+			PercentTicks:     float64(taskResources.Cpu.CpuShares) / float64(tr.clientConfig.Node.NodeResources.Processors.Topology.UsableCompute()),
+		},
+		Ports: &ports,
+	}
+}
+
 // buildTaskConfig builds a drivers.TaskConfig with an unique ID for the task.
 // The ID is unique for every invocation, it is built from the alloc ID, task
 // name and 8 random characters.
@@ -1176,8 +1288,6 @@ func (tr *TaskRunner) buildTaskConfig() *drivers.TaskConfig {
 	task := tr.Task()
 	alloc := tr.Alloc()
 	invocationid := uuid.Short()
-	taskResources := tr.taskResources
-	ports := tr.Alloc().AllocatedResources.Shared.Ports
 	env := tr.envBuilder.Build()
 	tr.networkIsolationLock.Lock()
 	defer tr.networkIsolationLock.Unlock()
@@ -1207,36 +1317,17 @@ func (tr *TaskRunner) buildTaskConfig() *drivers.TaskConfig {
 		}
 	}
 
-	memoryLimit := taskResources.Memory.MemoryMB
-	if max := taskResources.Memory.MemoryMaxMB; max > memoryLimit {
-		memoryLimit = max
-	}
-
-	cpusetCpus := make([]string, len(taskResources.Cpu.ReservedCores))
-	for i, v := range taskResources.Cpu.ReservedCores {
-		cpusetCpus[i] = fmt.Sprintf("%d", v)
-	}
-
 	return &drivers.TaskConfig{
-		ID:            fmt.Sprintf("%s/%s/%s", alloc.ID, task.Name, invocationid),
-		Name:          task.Name,
-		JobName:       alloc.Job.Name,
-		JobID:         alloc.Job.ID,
-		TaskGroupName: alloc.TaskGroup,
-		Namespace:     alloc.Namespace,
-		NodeName:      alloc.NodeName,
-		NodeID:        alloc.NodeID,
-		ParentJobID:   alloc.Job.ParentID,
-		Resources: &drivers.Resources{
-			NomadResources: taskResources,
-			LinuxResources: &drivers.LinuxResources{
-				MemoryLimitBytes: memoryLimit * 1024 * 1024,
-				CPUShares:        taskResources.Cpu.CpuShares,
-				CpusetCpus:       strings.Join(cpusetCpus, ","),
-				PercentTicks:     float64(taskResources.Cpu.CpuShares) / float64(tr.clientConfig.Node.NodeResources.Processors.Topology.UsableCompute()),
-			},
-			Ports: &ports,
-		},
+		ID:               fmt.Sprintf("%s/%s/%s", alloc.ID, task.Name, invocationid),
+		Name:             task.Name,
+		JobName:          alloc.Job.Name,
+		JobID:            alloc.Job.ID,
+		TaskGroupName:    alloc.TaskGroup,
+		Namespace:        alloc.Namespace,
+		NodeName:         alloc.NodeName,
+		NodeID:           alloc.NodeID,
+		ParentJobID:      alloc.Job.ParentID,
+		Resources:        tr.buildDriverResources(tr.TaskResources()),
 		Devices:          tr.hookResources.getDevices(),
 		Mounts:           tr.hookResources.getMounts(),
 		Env:              env.Map(),
@@ -1551,7 +1642,7 @@ func (tr *TaskRunner) LatestResourceUsage() *cstructs.TaskResourceUsage {
 
 	// Look up device statistics lazily when fetched, as currently we do not emit any stats for them yet
 	if ru != nil && tr.deviceStatsReporter != nil {
-		deviceResources := tr.taskResources.Devices
+		deviceResources := tr.TaskResources().Devices
 		ru.ResourceUsage.DeviceStats = tr.deviceStatsReporter.LatestDeviceResourceStats(deviceResources)
 	}
 	return ru
@@ -1680,6 +1771,16 @@ func (tr *TaskRunner) TaskExecHandler() drivermanager.TaskExecHandler {
 	return handle.ExecStreaming
 }
 
+// ScriptExecutor returns the executor used to run script checks in the task,
+// or nil if the task is not running.
+func (tr *TaskRunner) ScriptExecutor() tinterfaces.ScriptExecutor {
+	handle := tr.getDriverHandle()
+	if handle == nil {
+		return nil
+	}
+	return handle
+}
+
 func (tr *TaskRunner) DriverCapabilities() (*drivers.Capabilities, error) {
 	return tr.driver.Capabilities()
 }
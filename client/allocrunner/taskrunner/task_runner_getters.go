@@ -47,6 +47,21 @@ func (tr *TaskRunner) Task() *structs.Task {
 	return tr.task
 }
 
+// TaskResources returns the resources allocated to the task. The returned
+// value must not be modified.
+func (tr *TaskRunner) TaskResources() *structs.AllocatedTaskResources {
+	tr.taskResourcesLock.RLock()
+	defer tr.taskResourcesLock.RUnlock()
+	return tr.taskResources
+}
+
+// setTaskResources replaces the resources allocated to the task.
+func (tr *TaskRunner) setTaskResources(tres *structs.AllocatedTaskResources) {
+	tr.taskResourcesLock.Lock()
+	defer tr.taskResourcesLock.Unlock()
+	tr.taskResources = tres
+}
+
 func (tr *TaskRunner) TaskState() *structs.TaskState {
 	tr.stateLock.Lock()
 	defer tr.stateLock.Unlock()
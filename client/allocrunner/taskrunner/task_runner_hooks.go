@@ -190,6 +190,10 @@ func (tr *TaskRunner) initHooks() {
 	if task.Schedule != nil {
 		tr.runnerHooks = append(tr.runnerHooks, newPauseHook(tr, hookLogger))
 	}
+
+	// The task resources hook is last as it may block restarting the task
+	// when an in-place update can't be applied by the driver.
+	tr.runnerHooks = append(tr.runnerHooks, newTaskResourcesHook(tr, hookLogger))
 }
 
 func (tr *TaskRunner) emitHookError(err error, hookName string) {
@@ -243,7 +247,7 @@ func (tr *TaskRunner) prestart() error {
 			Task:          tr.Task(),
 			TaskDir:       tr.taskDir,
 			TaskEnv:       tr.envBuilder.Build(),
-			TaskResources: tr.taskResources,
+			TaskResources: tr.TaskResources(),
 		}
 
 		origHookState := tr.hookState(name)
@@ -3092,3 +3092,80 @@ func TestTaskRunner_setHookStatsHandler(t *testing.T) {
 	must.True(t, ok)
 	must.NotNil(t, noopHandler)
 }
+
+// TestTaskRunner_Hibernate asserts that a task is paused and resumed through
+// its driver, and that a hibernated task is woken before it's killed.
+func TestTaskRunner_Hibernate(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "10s",
+	}
+
+	conf, cleanup := testTaskRunnerConfig(t, alloc, task.Name, nil)
+	conf.StateDB = cstate.NewMemDB(conf.Logger) // inspect the persisted state
+	defer cleanup()
+
+	tr, err := NewTaskRunner(conf)
+	must.NoError(t, err)
+	go tr.Run()
+	defer tr.Kill(context.Background(), structs.NewTaskEvent("cleanup"))
+	testWaitForTaskToStart(t, tr)
+
+	driverPlugin, err := conf.DriverManager.Dispense(mockdriver.PluginID.Name)
+	must.NoError(t, err)
+	mockDriver := driverPlugin.(*mockdriver.Driver)
+	taskID := tr.getDriverHandle().ID()
+
+	must.NoError(t, tr.Hibernate())
+	must.True(t, tr.IsHibernated())
+	must.True(t, mockDriver.IsTaskPaused(taskID))
+
+	// hibernating again is a no-op
+	must.NoError(t, tr.Hibernate())
+	must.Eq(t, 1, countEvents(tr, structs.TaskHibernated))
+
+	// the hibernation state is persisted
+	ls, _, err := conf.StateDB.GetTaskRunnerState(alloc.ID, task.Name)
+	must.NoError(t, err)
+	must.True(t, ls.Hibernated)
+
+	must.NoError(t, tr.Wake())
+	must.False(t, tr.IsHibernated())
+	must.False(t, mockDriver.IsTaskPaused(taskID))
+	must.Eq(t, 1, countEvents(tr, structs.TaskWoken))
+
+	// killing a hibernated task wakes it first
+	must.NoError(t, tr.Hibernate())
+	must.NoError(t, tr.Kill(context.Background(), structs.NewTaskEvent("kill")))
+	must.False(t, tr.IsHibernated())
+	must.Eq(t, 2, countEvents(tr, structs.TaskWoken))
+}
+
+// TestTaskRunner_Hibernate_Error asserts that a task is not marked hibernated
+// when its driver fails to pause it.
+func TestTaskRunner_Hibernate_Error(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for":     "10s",
+		"pause_error": "freezer unavailable",
+	}
+
+	tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+	defer cleanup()
+	testWaitForTaskToStart(t, tr)
+
+	must.ErrorContains(t, tr.Hibernate(), "freezer unavailable")
+	must.False(t, tr.IsHibernated())
+	must.Eq(t, 0, countEvents(tr, structs.TaskHibernated))
+
+	// waking a task that isn't hibernated is a no-op
+	must.NoError(t, tr.Wake())
+}
@@ -89,6 +89,7 @@ func newMigratorForAlloc(c Config, tg *structs.TaskGroup, watchedAllocID string,
 			prevAllocID:  watchedAllocID,
 			tasks:        tasks,
 			sticky:       sticky,
+			checkpoint:   m.Alloc().ShouldCheckpoint(),
 			prevAllocDir: m.GetAllocDir(),
 			prevListener: m.Listener(),
 			prevStatus:   m.Alloc(),
@@ -192,6 +193,11 @@ type localPrevAlloc struct {
 	// sticky is true if data should be moved
 	sticky bool
 
+	// checkpoint is true if the previous alloc was checkpointed, in which
+	// case its data and checkpoint images are moved for the tasks to be
+	// restored
+	checkpoint bool
+
 	// prevAllocDir is the alloc dir for the previous alloc
 	prevAllocDir allocdir.Interface
 
@@ -253,7 +259,11 @@ func (p *localPrevAlloc) Wait(ctx context.Context) error {
 	for {
 		select {
 		case prevAlloc, ok := <-p.prevListener.Ch():
-			if !ok || prevAlloc.Terminated() {
+			if !ok {
+				return nil
+			}
+			if prevAlloc.Terminated() {
+				p.checkpoint = p.checkpoint || prevAlloc.ShouldCheckpoint()
 				return nil
 			}
 		case <-ctx.Done():
@@ -264,8 +274,8 @@ func (p *localPrevAlloc) Wait(ctx context.Context) error {
 
 // Migrate from previous local alloc dir to destination alloc dir.
 func (p *localPrevAlloc) Migrate(ctx context.Context, dest allocdir.Interface) error {
-	if !p.sticky {
-		// Not a sticky volume, nothing to migrate
+	if !p.sticky && !p.checkpoint {
+		// Not a sticky volume or a checkpoint, nothing to migrate
 		return nil
 	}
 
@@ -301,6 +311,11 @@ type remotePrevAlloc struct {
 	// migrate is true if data should be moved between nodes
 	migrate bool
 
+	// checkpoint is true if the previous alloc was checkpointed, in which
+	// case its data and checkpoint images are moved for the tasks to be
+	// restored. Set by Wait().
+	checkpoint bool
+
 	// rpc provides an RPC method for watching for updates to the previous
 	// alloc and determining what node it was on.
 	rpc RPCer
@@ -411,6 +426,7 @@ func (p *remotePrevAlloc) Wait(ctx context.Context) error {
 		}
 		if resp.Alloc.Terminated() || resp.Alloc.ClientStatus == structs.AllocClientStatusUnknown {
 			p.nodeID = resp.Alloc.NodeID
+			p.checkpoint = resp.Alloc.ShouldCheckpoint()
 			return nil
 		}
 
@@ -427,8 +443,9 @@ func (p *remotePrevAlloc) Wait(ctx context.Context) error {
 // Migrate alloc data from a remote node if the new alloc has migration enabled
 // and the old alloc hasn't been GC'd.
 func (p *remotePrevAlloc) Migrate(ctx context.Context, dest allocdir.Interface) error {
-	if !p.migrate {
-		// Volume wasn't configured to be migrated, return early
+	if !p.migrate && !p.checkpoint {
+		// Volume wasn't configured to be migrated and there's no checkpoint
+		// to restore, return early
 		return nil
 	}
 
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/client/allocdir"
 	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -206,6 +207,45 @@ func TestPrevAlloc_LocalPrevAlloc_Terminated(t *testing.T) {
 	require.NoError(t, waiter.Wait(ctx))
 }
 
+// TestPrevAlloc_LocalPrevAlloc_Checkpoint asserts that the alloc dir of a
+// previous alloc that was checkpointed is migrated even if its ephemeral disk
+// isn't sticky.
+func TestPrevAlloc_LocalPrevAlloc_Checkpoint(t *testing.T) {
+	ci.Parallel(t)
+
+	conf, cleanup := newConfig(t)
+	defer cleanup()
+
+	conf.Alloc.Job.TaskGroups[0].EphemeralDisk.Sticky = false
+	conf.Alloc.Job.TaskGroups[0].EphemeralDisk.Migrate = false
+
+	prevAlloc := conf.PreviousRunner.Alloc()
+	prevAlloc.ClientStatus = structs.AllocClientStatusComplete
+	prevAlloc.DesiredTransition = structs.DesiredTransition{
+		Migrate:    pointer.Of(true),
+		Checkpoint: pointer.Of(true),
+	}
+
+	prevAllocDir := conf.PreviousRunner.GetAllocDir().(*allocdir.AllocDir)
+	must.NoError(t, prevAllocDir.Build())
+	task := conf.Alloc.Job.TaskGroups[0].Tasks[0]
+	prevTaskDir := prevAllocDir.NewTaskDir(task)
+	must.NoError(t, os.MkdirAll(prevTaskDir.CheckpointDir, 0o700))
+	must.NoError(t, os.WriteFile(filepath.Join(prevTaskDir.CheckpointDir, "core.img"), []byte("foo"), 0o600))
+
+	waiter, migrator := NewAllocWatcher(conf)
+	must.NoError(t, waiter.Wait(context.Background()))
+
+	dest := allocdir.NewAllocDir(conf.Logger, t.TempDir(), t.TempDir(), conf.Alloc.ID)
+	must.NoError(t, dest.Build())
+	defer dest.Destroy()
+	destTaskDir := dest.NewTaskDir(task)
+
+	must.NoError(t, migrator.Migrate(context.Background(), dest))
+	_, err := os.Stat(filepath.Join(destTaskDir.CheckpointDir, "core.img"))
+	must.NoError(t, err)
+}
+
 // TestPrevAlloc_StreamAllocDir_Error asserts that errors encountered while
 // streaming a tar cause the migration to be cancelled and no files are written
 // (migrations are atomic).
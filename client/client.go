@@ -30,6 +30,7 @@ import (
 	"github.com/hashicorp/nomad/client/config"
 	consulApiShim "github.com/hashicorp/nomad/client/consul"
 	"github.com/hashicorp/nomad/client/devicemanager"
+	"github.com/hashicorp/nomad/client/dnsserver"
 	"github.com/hashicorp/nomad/client/dynamicplugins"
 	"github.com/hashicorp/nomad/client/fingerprint"
 	"github.com/hashicorp/nomad/client/hoststats"
@@ -226,6 +227,16 @@ type Client struct {
 	// status.
 	checkStore checkstore.Shim
 
+	// serviceCache caches the registrations of Nomad services for the DNS
+	// server, which answers queries for them. Both are nil unless the DNS
+	// server is enabled.
+	serviceCache *nsd.ServiceCache
+	dnsServer    *dnsserver.Server
+
+	// bridgeDNSServer serves DNS within the network namespace of allocations
+	// in bridge networking mode. It's nil unless enabled.
+	bridgeDNSServer config.NetNSDNSServer
+
 	// serviceRegWrapper wraps the consulService and nomadService
 	// implementations so that the alloc and task runner service hooks can call
 	// this without needing to identify which backend provider should be used.
@@ -548,6 +559,11 @@ func NewClient(cfg *config.Config, consulCatalog consul.CatalogAPI, consulProxie
 	c.setupNomadServiceRegistrationHandler()
 	c.serviceRegWrapper = wrapper.NewHandlerWrapper(c.logger, c.consulServices, c.nomadService)
 
+	// Set up the DNS server for Nomad native services, if enabled.
+	if err := c.setupDNSServer(); err != nil {
+		return nil, fmt.Errorf("failed to setup DNS server: %v", err)
+	}
+
 	// Batching of initial fingerprints is done to reduce the number of node
 	// updates sent to the server on startup.
 	go c.batchFirstFingerprints()
@@ -950,6 +966,11 @@ func (c *Client) Shutdown() error {
 		h.Shutdown()
 	}
 
+	if c.dnsServer != nil {
+		c.dnsServer.Shutdown()
+		c.serviceCache.Shutdown()
+	}
+
 	// Shutdown the plugin managers
 	c.pluginManagers.Shutdown()
 
@@ -1008,11 +1029,20 @@ func (c *Client) SignalAllocation(allocID, task, signal string) error {
 }
 
 // PauseAllocation sets the pause state of the given task for the allocation.
+// The hibernate and wake states freeze and thaw the running task through its
+// driver instead of stopping it.
 func (c *Client) PauseAllocation(allocID, task string, scheduleState structs.TaskScheduleState) error {
 	ar, err := c.getAllocRunner(allocID)
 	if err != nil {
 		return err
 	}
+
+	switch scheduleState {
+	case structs.TaskScheduleStateHibernate:
+		return ar.HibernateTask(task)
+	case structs.TaskScheduleStateWake:
+		return ar.WakeTask(task)
+	}
 	return ar.SetTaskPauseState(task, scheduleState)
 }
 
@@ -1022,6 +1052,14 @@ func (c *Client) GetPauseAllocation(allocID, task string) (structs.TaskScheduleS
 	if err != nil {
 		return "", err
 	}
+
+	hibernated, err := ar.IsTaskHibernated(task)
+	if err != nil {
+		return "", err
+	}
+	if hibernated {
+		return structs.TaskScheduleStateHibernate, nil
+	}
 	return ar.GetTaskPauseState(task)
 }
 
@@ -2841,6 +2879,7 @@ func (c *Client) newAllocRunnerConfig(
 		Wranglers:           c.wranglers,
 		Partitions:          c.partitions,
 		Users:               c.users,
+		DNSServer:           c.bridgeDNSServer,
 	}
 }
 
@@ -2893,10 +2932,43 @@ func (c *Client) setupNomadServiceRegistrationHandler() {
 		CheckWatcher: serviceregistration.NewCheckWatcher(
 			c.logger, nsd.NewStatusGetter(c.checkStore),
 		),
+		CheckStatusGetter: nsd.NewStatusGetter(c.checkStore),
 	}
 	c.nomadService = nsd.NewServiceRegistrationHandler(c.logger, &cfg)
 }
 
+// setupDNSServer sets up the DNS server for Nomad native services, which
+// answers queries from a cache of their registrations kept up to date with
+// blocking queries.
+func (c *Client) setupDNSServer() error {
+	dnsConfig := c.GetConfig().DNS
+	if dnsConfig == nil {
+		return nil
+	}
+
+	c.serviceCache = nsd.NewServiceCache(c.logger, &nsd.ServiceCacheCfg{
+		Region:     c.Region(),
+		NodeSecret: c.secretNodeID(),
+		RPCFn:      c.RPC,
+	})
+	c.dnsServer = dnsserver.NewServer(c.logger, &dnsserver.Config{
+		Domain:    dnsConfig.Domain,
+		TTL:       dnsConfig.TTL,
+		Recursors: dnsConfig.Recursors,
+	}, c.serviceCache)
+
+	if dnsConfig.Addr != "" {
+		if err := c.dnsServer.ListenAndServe(dnsConfig.Addr); err != nil {
+			c.serviceCache.Shutdown()
+			return err
+		}
+	}
+	if dnsConfig.BindBridge {
+		c.bridgeDNSServer = c.dnsServer
+	}
+	return nil
+}
+
 // verifiedTasks asserts each task in taskNames actually exists in the given alloc,
 // otherwise an error is returned.
 func verifiedTasks(logger hclog.Logger, alloc *structs.Allocation, taskNames []string) ([]string, error) {
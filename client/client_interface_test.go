@@ -173,3 +173,10 @@ func (ar *emptyAllocRunner) SetTaskPauseState(taskName string, ps structs.TaskSc
 func (ar *emptyAllocRunner) GetTaskPauseState(taskName string) (structs.TaskScheduleState, error) {
 	return "", nil
 }
+
+func (ar *emptyAllocRunner) HibernateTask(taskName string) error { return nil }
+func (ar *emptyAllocRunner) WakeTask(taskName string) error      { return nil }
+
+func (ar *emptyAllocRunner) IsTaskHibernated(taskName string) (bool, error) {
+	return false, nil
+}
@@ -126,6 +126,20 @@ type AllocRunnerConfig struct {
 
 	// Users manages a pool of dynamic workload users
 	Users dynamic.Pool
+
+	// DNSServer serves DNS for Nomad native services within the network
+	// namespace of allocations in bridge networking mode. It's nil unless
+	// enabled.
+	DNSServer NetNSDNSServer
+}
+
+// NetNSDNSServer is the interface needed by the network hook to serve DNS for
+// Nomad native services within the network namespace of an allocation.
+type NetNSDNSServer interface {
+	// ServeNetNS serves DNS for the services of the namespace within the
+	// network namespace at nsPath. It returns the address of the nameserver
+	// and a function to stop serving it.
+	ServeNetNS(nsPath, namespace string) (string, func(), error)
 }
 
 // PrevAllocWatcher allows AllocRunners to wait for a previous allocation to
@@ -142,6 +142,10 @@ type Config struct {
 	// determined dynamically.
 	DiskFreeMB int
 
+	// DiskIOPS is the disk IO operations per second capacity of the node. If
+	// it is 0, allocations aren't limited on disk IOPS.
+	DiskIOPS int
+
 	// MaxKillTimeout allows capping the user-specifiable KillTimeout. If the
 	// task's KillTimeout is greater than the MaxKillTimeout, MaxKillTimeout is
 	// used.
@@ -366,6 +370,10 @@ type Config struct {
 	// Drain configuration from the agent's config file.
 	Drain *DrainConfig
 
+	// DNS configuration of the DNS server for Nomad native services, which is
+	// nil if it isn't enabled.
+	DNS *DNSConfig
+
 	// Uesrs configuration from the agent's config file.
 	Users *UsersConfig
 
@@ -398,6 +406,11 @@ type ClientTemplateConfig struct {
 	// the task directory.
 	DisableSandbox bool `hcl:"disable_file_sandbox"`
 
+	// NomadServicePassing makes the nomadService function only return the
+	// instances whose Nomad checks are all passing, including when choosing
+	// instances with rendezvous hashing.
+	NomadServicePassing bool `hcl:"nomad_service_passing"`
+
 	// This is the maximum interval to allow "stale" data. By default, only the
 	// Consul leader will respond to queries; any requests to a follower will
 	// forward to the leader. In large clusters with many requests, this is not as
@@ -531,6 +544,7 @@ func (c *ClientTemplateConfig) IsEmpty() bool {
 	}
 
 	return !c.DisableSandbox &&
+		!c.NomadServicePassing &&
 		c.FunctionDenylist == nil &&
 		c.FunctionBlacklist == nil &&
 		c.BlockQueryWaitTime == nil &&
@@ -564,6 +578,10 @@ func (c *ClientTemplateConfig) Merge(o *ClientTemplateConfig) *ClientTemplateCon
 		result.DisableSandbox = true
 	}
 
+	if o.NomadServicePassing {
+		result.NomadServicePassing = true
+	}
+
 	result.MaxStale = pointer.Merge(result.MaxStale, o.MaxStale)
 	result.BlockQueryWaitTime = pointer.Merge(result.BlockQueryWaitTime, o.BlockQueryWaitTime)
 
@@ -872,6 +890,7 @@ func (c *Config) Copy() *Config {
 	nc.ReservableCores = slices.Clone(c.ReservableCores)
 	nc.Artifact = c.Artifact.Copy()
 	nc.Users = c.Users.Copy()
+	nc.DNS = c.DNS.Copy()
 	return &nc
 }
 
@@ -1045,6 +1064,7 @@ func (c *Config) NomadPluginConfig(topology *numalib.Topology) *base.AgentConfig
 			ClientMinPort: c.ClientMinPort,
 			ClientMaxPort: c.ClientMaxPort,
 			Topology:      topology,
+			DataDir:       c.StateDir,
 		},
 	}
 }
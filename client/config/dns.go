@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/nomad/nomad/structs/config"
+)
+
+const (
+	// DefaultDNSAddress is the address the DNS server listens on by default.
+	DefaultDNSAddress = "127.0.0.1"
+
+	// DefaultDNSPort is the port the DNS server listens on by default.
+	DefaultDNSPort = 4653
+
+	// DefaultDNSDomain is the domain of Nomad services by default.
+	DefaultDNSDomain = "nomad"
+)
+
+// DNSConfig describes the client's DNS server for Nomad native services.
+type DNSConfig struct {
+	// Addr is the TCP and UDP address the DNS server listens on, for the
+	// host. It's empty if the DNS server only serves allocations.
+	Addr string
+
+	// Domain is the domain of Nomad services.
+	Domain string
+
+	// TTL is the time-to-live of the records of Nomad services.
+	TTL time.Duration
+
+	// Recursors are the addresses of the DNS servers that queries outside of
+	// the domain are forwarded to. The nameservers of the host are used if
+	// it's empty.
+	Recursors []string
+
+	// BindBridge serves DNS within the network namespace of allocations in
+	// bridge networking mode, and makes it their nameserver.
+	BindBridge bool
+}
+
+func (d *DNSConfig) Copy() *DNSConfig {
+	if d == nil {
+		return nil
+	}
+
+	nd := *d
+	nd.Recursors = slices.Clone(d.Recursors)
+	return &nd
+}
+
+// DNSConfigFromAgent creates the internal read-only copy of the client
+// agent's DNSConfig. It returns nil if the DNS server isn't enabled.
+func DNSConfigFromAgent(c *config.DNSConfig) (*DNSConfig, error) {
+	if c == nil || c.Enabled == nil || !*c.Enabled {
+		return nil, nil
+	}
+
+	address := DefaultDNSAddress
+	port := DefaultDNSPort
+	dc := &DNSConfig{
+		Domain:    DefaultDNSDomain,
+		Recursors: slices.Clone(c.Recursors),
+	}
+
+	if c.Address != nil {
+		address = *c.Address
+	}
+	if c.Port != nil {
+		port = *c.Port
+		if port < 1 || port > 65535 {
+			return nil, fmt.Errorf("invalid port %d", port)
+		}
+	}
+	if address != "" {
+		dc.Addr = net.JoinHostPort(address, strconv.Itoa(port))
+	}
+	if c.Domain != nil && *c.Domain != "" {
+		dc.Domain = *c.Domain
+	}
+	if c.TTL != nil {
+		ttl, err := time.ParseDuration(*c.TTL)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing TTL: %w", err)
+		}
+		if ttl < 0 {
+			return nil, fmt.Errorf("TTL must not be negative")
+		}
+		dc.TTL = ttl
+	}
+	if c.BindBridge != nil {
+		dc.BindBridge = *c.BindBridge
+	}
+	for _, r := range dc.Recursors {
+		if r == "" {
+			return nil, fmt.Errorf("recursors must not be empty")
+		}
+	}
+
+	return dc, nil
+}
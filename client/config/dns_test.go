@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/hashicorp/nomad/nomad/structs/config"
+	"github.com/shoenig/test/must"
+)
+
+func TestDNSConfigFromAgent(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name   string
+		config *config.DNSConfig
+		exp    *DNSConfig
+		expErr string
+	}{
+		{
+			name:   "nil",
+			config: nil,
+			exp:    nil,
+		},
+		{
+			name:   "disabled",
+			config: &config.DNSConfig{Port: pointer.Of(53)},
+			exp:    nil,
+		},
+		{
+			name:   "defaults",
+			config: &config.DNSConfig{Enabled: pointer.Of(true)},
+			exp: &DNSConfig{
+				Addr:   "127.0.0.1:4653",
+				Domain: "nomad",
+			},
+		},
+		{
+			name: "full",
+			config: &config.DNSConfig{
+				Enabled:    pointer.Of(true),
+				Address:    pointer.Of("::1"),
+				Port:       pointer.Of(53),
+				Domain:     pointer.Of("example"),
+				TTL:        pointer.Of("10s"),
+				Recursors:  []string{"192.0.2.1"},
+				BindBridge: pointer.Of(true),
+			},
+			exp: &DNSConfig{
+				Addr:       "[::1]:53",
+				Domain:     "example",
+				TTL:        10 * time.Second,
+				Recursors:  []string{"192.0.2.1"},
+				BindBridge: true,
+			},
+		},
+		{
+			name: "allocations only",
+			config: &config.DNSConfig{
+				Enabled:    pointer.Of(true),
+				Address:    pointer.Of(""),
+				BindBridge: pointer.Of(true),
+			},
+			exp: &DNSConfig{
+				Domain:     "nomad",
+				BindBridge: true,
+			},
+		},
+		{
+			name:   "invalid port",
+			config: &config.DNSConfig{Enabled: pointer.Of(true), Port: pointer.Of(0)},
+			expErr: "invalid port 0",
+		},
+		{
+			name:   "invalid ttl",
+			config: &config.DNSConfig{Enabled: pointer.Of(true), TTL: pointer.Of("soon")},
+			expErr: "error parsing TTL",
+		},
+		{
+			name:   "negative ttl",
+			config: &config.DNSConfig{Enabled: pointer.Of(true), TTL: pointer.Of("-1s")},
+			expErr: "TTL must not be negative",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := DNSConfigFromAgent(tc.config)
+			if tc.expErr != "" {
+				must.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			must.NoError(t, err)
+			must.Eq(t, tc.exp, got)
+		})
+	}
+}
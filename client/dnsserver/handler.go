@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsserver
+
+import (
+	"context"
+	"encoding/hex"
+	"math"
+	"math/rand"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/miekg/dns"
+)
+
+// handler answers the queries received on a listener.
+type handler struct {
+	server *Server
+
+	// namespace is the namespace of the services queried without one.
+	namespace string
+
+	// fixedNamespace restricts queries to the services of namespace, for
+	// listeners serving a single allocation.
+	fixedNamespace bool
+}
+
+func (h *handler) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	var resp *dns.Msg
+	switch {
+	case req.Opcode != dns.OpcodeQuery:
+		resp = new(dns.Msg).SetRcode(req, dns.RcodeNotImplemented)
+	case len(req.Question) != 1:
+		resp = new(dns.Msg).SetRcode(req, dns.RcodeFormatError)
+	case dns.IsSubDomain(h.server.domain, strings.ToLower(req.Question[0].Name)):
+		resp = h.answer(req)
+		if _, ok := w.RemoteAddr().(*net.UDPAddr); ok {
+			size := dns.MinMsgSize
+			if opt := req.IsEdns0(); opt != nil {
+				size = int(opt.UDPSize())
+			}
+			resp.Truncate(size)
+		}
+	default:
+		resp = h.forward(req, w.RemoteAddr().Network())
+	}
+
+	if err := w.WriteMsg(resp); err != nil {
+		h.server.log.Debug("failed to write DNS response", "error", err)
+	}
+}
+
+// answer answers a query within the domain.
+func (h *handler) answer(req *dns.Msg) *dns.Msg {
+	q := req.Question[0]
+	resp := new(dns.Msg).SetReply(req)
+	resp.Authoritative = true
+	resp.RecursionAvailable = len(h.server.recursors) > 0
+
+	// names are matched case-insensitively, except for the service and tag
+	// labels which are matched as registered
+	labels := dns.SplitDomainName(q.Name[:len(q.Name)-len(h.server.domain)])
+	n := len(labels)
+
+	switch {
+	case n == 2 && strings.EqualFold(labels[1], "addr"):
+		ip := parseAddrLabel(labels[0])
+		if ip == nil {
+			return h.nameError(resp)
+		}
+		resp.Answer = h.appendAddrRecord(resp.Answer, q.Name, ip, q.Qtype)
+
+	case n >= 2 && n <= 4:
+		tag, name, namespace, ok := h.parseServiceLabels(labels)
+		if !ok {
+			return h.nameError(resp)
+		}
+		if h.fixedNamespace && namespace != h.namespace {
+			return resp.SetRcode(req, dns.RcodeRefused)
+		}
+
+		services, err := h.lookup(namespace, name, tag)
+		if structs.IsErrPermissionDenied(err) {
+			// clients can only read the namespaces of their allocations
+			return resp.SetRcode(req, dns.RcodeRefused)
+		}
+		if err != nil {
+			h.server.log.Debug("failed to look up service", "namespace", namespace,
+				"service", name, "error", err)
+			return resp.SetRcode(req, dns.RcodeServerFailure)
+		}
+		if len(services) == 0 {
+			return h.nameError(resp)
+		}
+		h.appendServiceRecords(resp, q, services)
+
+	default:
+		return h.nameError(resp)
+	}
+
+	if len(resp.Answer) == 0 {
+		resp.Ns = []dns.RR{h.soa()}
+	}
+	return resp
+}
+
+// parseServiceLabels parses the labels of queries of the form
+// "[<tag>.]<service>.service[.<namespace>]".
+func (h *handler) parseServiceLabels(labels []string) (tag, name, namespace string, ok bool) {
+	i := slices.IndexFunc(labels, func(l string) bool { return strings.EqualFold(l, "service") })
+	if i != 1 && i != 2 {
+		return "", "", "", false
+	}
+	if i == 2 {
+		tag = labels[0]
+	}
+	name = labels[i-1]
+
+	switch len(labels) - i {
+	case 1:
+		namespace = h.namespace
+	case 2:
+		namespace = strings.ToLower(labels[i+1])
+	default:
+		return "", "", "", false
+	}
+	return tag, name, namespace, true
+}
+
+// lookup returns the passing registrations of the service with the tag.
+func (h *handler) lookup(namespace, name, tag string) ([]*structs.ServiceRegistration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+	defer cancel()
+
+	services, err := h.server.resolver.Services(ctx, namespace, name)
+	if err != nil && len(services) == 0 {
+		return nil, err
+	}
+
+	// the registrations are shared, so shuffle a copy of the ones to answer
+	// with
+	matching := make([]*structs.ServiceRegistration, 0, len(services))
+	for _, s := range services {
+		if tag == "" || slices.Contains(s.Tags, tag) {
+			matching = append(matching, s)
+		}
+	}
+	rand.Shuffle(len(matching), func(i, j int) {
+		matching[i], matching[j] = matching[j], matching[i]
+	})
+	return matching, nil
+}
+
+// appendServiceRecords answers the question with the records of services.
+// SRV records target the addresses of services, and are weighted by the
+// weight of services.
+func (h *handler) appendServiceRecords(resp *dns.Msg, q dns.Question, services []*structs.ServiceRegistration) {
+	seen := make(map[string]struct{}, len(services))
+
+	for _, s := range services {
+		ip := net.ParseIP(s.Address)
+
+		switch q.Qtype {
+		case dns.TypeSRV:
+			target := dns.Fqdn(s.Address)
+			if ip != nil {
+				target = h.addrName(ip)
+			}
+			resp.Answer = append(resp.Answer, &dns.SRV{
+				Hdr:      h.header(q.Name, dns.TypeSRV),
+				Priority: 1,
+				Weight:   uint16(min(s.Weight(), math.MaxUint16)),
+				Port:     uint16(s.Port),
+				Target:   target,
+			})
+			if _, ok := seen[target]; ip != nil && !ok {
+				seen[target] = struct{}{}
+				resp.Extra = h.appendAddrRecord(resp.Extra, target, ip, dns.TypeANY)
+			}
+
+		default:
+			if _, ok := seen[s.Address]; ip != nil && !ok {
+				seen[s.Address] = struct{}{}
+				resp.Answer = h.appendAddrRecord(resp.Answer, q.Name, ip, q.Qtype)
+			}
+		}
+	}
+}
+
+// appendAddrRecord appends the A or AAAA record of name resolving to the IP,
+// if it answers the query type.
+func (h *handler) appendAddrRecord(rrs []dns.RR, name string, ip net.IP, qtype uint16) []dns.RR {
+	if ip4 := ip.To4(); ip4 != nil {
+		if qtype == dns.TypeA || qtype == dns.TypeANY {
+			rrs = append(rrs, &dns.A{Hdr: h.header(name, dns.TypeA), A: ip4})
+		}
+	} else if qtype == dns.TypeAAAA || qtype == dns.TypeANY {
+		rrs = append(rrs, &dns.AAAA{Hdr: h.header(name, dns.TypeAAAA), AAAA: ip})
+	}
+	return rrs
+}
+
+// addrName returns the name resolving to the IP, of the form
+// "<hex encoded IP>.addr.<domain>".
+func (h *handler) addrName(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	return hex.EncodeToString(ip) + ".addr." + h.server.domain
+}
+
+// parseAddrLabel returns the IP encoded by addrName, or nil if it's invalid.
+func parseAddrLabel(label string) net.IP {
+	buf, err := hex.DecodeString(label)
+	if err != nil || (len(buf) != net.IPv4len && len(buf) != net.IPv6len) {
+		return nil
+	}
+	return net.IP(buf)
+}
+
+// header returns the header of records of the type answering for name.
+func (h *handler) header(name string, rrtype uint16) dns.RR_Header {
+	return dns.RR_Header{
+		Name:   name,
+		Rrtype: rrtype,
+		Class:  dns.ClassINET,
+		Ttl:    h.server.ttl,
+	}
+}
+
+// nameError responds that the name doesn't exist.
+func (h *handler) nameError(resp *dns.Msg) *dns.Msg {
+	resp.Rcode = dns.RcodeNameError
+	resp.Ns = []dns.RR{h.soa()}
+	return resp
+}
+
+// soa returns the SOA record of the domain, which is included in negative
+// responses so they can be cached for the TTL.
+func (h *handler) soa() dns.RR {
+	return &dns.SOA{
+		Hdr: dns.RR_Header{
+			Name:   h.server.domain,
+			Rrtype: dns.TypeSOA,
+			Class:  dns.ClassINET,
+			Ttl:    h.server.ttl,
+		},
+		Ns:      "ns." + h.server.domain,
+		Mbox:    "hostmaster." + h.server.domain,
+		Serial:  1,
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  h.server.ttl,
+	}
+}
+
+// forward forwards a query outside of the domain to the recursors, over the
+// network it was received on, and returns the first response.
+func (h *handler) forward(req *dns.Msg, network string) *dns.Msg {
+	if len(h.server.recursors) == 0 {
+		return new(dns.Msg).SetRcode(req, dns.RcodeRefused)
+	}
+
+	client := &dns.Client{Net: network, Timeout: recursorTimeout}
+	for _, recursor := range h.server.recursors {
+		resp, _, err := client.Exchange(req, recursor)
+		if err == nil {
+			return resp
+		}
+		h.server.log.Debug("failed to forward DNS query", "recursor", recursor, "error", err)
+	}
+	return new(dns.Msg).SetRcode(req, dns.RcodeServerFailure)
+}
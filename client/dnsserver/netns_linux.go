@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsserver
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/nomad/client/lib/nsutil"
+)
+
+// ServeNetNS answers queries for the services of the namespace on port 53 of
+// the loopback interface of the network namespace at nsPath, such as the
+// network namespace of an allocation. It returns the nameserver address to
+// configure within the network namespace, and a function to stop serving it.
+func (s *Server) ServeNetNS(nsPath, namespace string) (string, func(), error) {
+	addr := net.JoinHostPort(NetNSAddr, "53")
+
+	// sockets belong to the network namespace they're created in, so they can
+	// be served from any thread
+	var pc net.PacketConn
+	var l net.Listener
+	err := nsutil.WithNetNSPath(nsPath, func(nsutil.NetNS) error {
+		var err error
+		if pc, err = net.ListenPacket("udp", addr); err != nil {
+			return err
+		}
+		if l, err = net.Listen("tcp", addr); err != nil {
+			_ = pc.Close()
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to listen within network namespace %q: %w", nsPath, err)
+	}
+
+	stop := s.serve(pc, l, &handler{server: s, namespace: namespace, fixedNamespace: true})
+	return NetNSAddr, stop, nil
+}
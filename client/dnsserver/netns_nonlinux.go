@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+
+package dnsserver
+
+import "errors"
+
+// ServeNetNS is only supported on Linux.
+func (s *Server) ServeNetNS(nsPath, namespace string) (string, func(), error) {
+	return "", nil, errors.New("serving DNS within network namespaces is only supported on Linux")
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package dnsserver provides a DNS server answering queries for Nomad native
+// services from the client's view of their registrations, so that workloads
+// can discover services without using the Nomad HTTP API or templates.
+package dnsserver
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/miekg/dns"
+)
+
+const (
+	// DefaultDomain is the domain of Nomad services if none is configured.
+	DefaultDomain = "nomad"
+
+	// lookupTimeout is how long to wait for the registrations of a service
+	// that isn't cached yet.
+	lookupTimeout = 2 * time.Second
+
+	// recursorTimeout is how long to wait for each recursor to answer
+	// forwarded queries.
+	recursorTimeout = 2 * time.Second
+
+	// NetNSAddr is the address served within network namespaces.
+	NetNSAddr = "127.0.0.1"
+
+	// hostResolvConf is read for the recursors to forward queries to when
+	// none are configured.
+	hostResolvConf = "/etc/resolv.conf"
+)
+
+// ServiceResolver returns the registrations of the Nomad services to answer
+// queries with. Only registrations whose checks are passing are expected.
+type ServiceResolver interface {
+	Services(ctx context.Context, namespace, name string) ([]*structs.ServiceRegistration, error)
+}
+
+// Config is the configuration of the Server.
+type Config struct {
+	// Domain is the domain of Nomad services, defaults to "nomad".
+	Domain string
+
+	// TTL is the time-to-live of the records of Nomad services.
+	TTL time.Duration
+
+	// Recursors are the addresses of the DNS servers that queries outside of
+	// the domain are forwarded to. Defaults to the nameservers of the host.
+	Recursors []string
+}
+
+// Server answers DNS queries for Nomad services on any number of listeners.
+// Queries are of the form "[<tag>.]<service>.service[.<namespace>].<domain>",
+// and answered with A, AAAA, and SRV records of the service registrations.
+type Server struct {
+	log      hclog.Logger
+	resolver ServiceResolver
+
+	domain    string
+	ttl       uint32
+	recursors []string
+
+	// servers are the listeners being served, which are shut down along with
+	// the Server.
+	servers     map[*dns.Server]struct{}
+	serversLock sync.Mutex
+}
+
+// NewServer returns a Server that is ready to serve listeners.
+func NewServer(log hclog.Logger, cfg *Config, resolver ServiceResolver) *Server {
+	log = log.Named("dns")
+
+	domain := strings.Trim(strings.ToLower(cfg.Domain), ".")
+	if domain == "" {
+		domain = DefaultDomain
+	}
+
+	recursors := slices.Clone(cfg.Recursors)
+	if len(recursors) == 0 {
+		recursors = hostRecursors(log)
+	}
+	for i, r := range recursors {
+		recursors[i] = recursorAddr(r)
+	}
+
+	return &Server{
+		log:       log,
+		resolver:  resolver,
+		domain:    dns.Fqdn(domain),
+		ttl:       uint32(cfg.TTL / time.Second),
+		recursors: recursors,
+		servers:   make(map[*dns.Server]struct{}),
+	}
+}
+
+// hostRecursors returns the nameservers of the host, if any.
+func hostRecursors(log hclog.Logger) []string {
+	conf, err := dns.ClientConfigFromFile(hostResolvConf)
+	if err != nil {
+		log.Warn("failed to read host nameservers, queries outside of the domain will be refused",
+			"error", err)
+		return nil
+	}
+	recursors := make([]string, 0, len(conf.Servers))
+	for _, s := range conf.Servers {
+		recursors = append(recursors, net.JoinHostPort(s, conf.Port))
+	}
+	return recursors
+}
+
+// recursorAddr adds the default DNS port to recursor addresses without one.
+func recursorAddr(addr string) string {
+	if _, _, err := net.SplitHostPort(addr); err == nil {
+		return addr
+	}
+	return net.JoinHostPort(strings.Trim(addr, "[]"), "53")
+}
+
+// ListenAndServe answers queries for the services of any namespace on the TCP
+// and UDP address, until the Server is shut down. Queries that don't specify a
+// namespace are answered from the default namespace.
+func (s *Server) ListenAndServe(addr string) error {
+	pc, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		_ = pc.Close()
+		return err
+	}
+
+	s.log.Info("serving DNS for Nomad services", "address", addr, "domain", s.domain)
+	s.serve(pc, l, &handler{server: s, namespace: structs.DefaultNamespace})
+	return nil
+}
+
+// serve answers queries received on the listeners with the handler, and
+// returns a function stopping it.
+func (s *Server) serve(pc net.PacketConn, l net.Listener, h dns.Handler) func() {
+	var started sync.WaitGroup
+	started.Add(2)
+	udp := &dns.Server{PacketConn: pc, Handler: h, NotifyStartedFunc: started.Done}
+	tcp := &dns.Server{Listener: l, Handler: h, NotifyStartedFunc: started.Done}
+
+	for _, srv := range []*dns.Server{udp, tcp} {
+		go func(srv *dns.Server) {
+			if err := srv.ActivateAndServe(); err != nil {
+				s.log.Error("failed to serve DNS", "error", err)
+			}
+		}(srv)
+	}
+
+	// wait for the servers to start, so they can be shut down
+	started.Wait()
+
+	s.serversLock.Lock()
+	s.servers[udp] = struct{}{}
+	s.servers[tcp] = struct{}{}
+	s.serversLock.Unlock()
+
+	return func() {
+		s.serversLock.Lock()
+		defer s.serversLock.Unlock()
+		s.shutdown(udp)
+		s.shutdown(tcp)
+	}
+}
+
+// shutdown stops serving the listener of srv. The caller must hold the
+// serversLock.
+func (s *Server) shutdown(srv *dns.Server) {
+	if _, ok := s.servers[srv]; !ok {
+		return
+	}
+	delete(s.servers, srv)
+	if err := srv.Shutdown(); err != nil {
+		s.log.Warn("failed to stop serving DNS", "error", err)
+	}
+}
+
+// Shutdown stops serving all the listeners.
+func (s *Server) Shutdown() {
+	s.serversLock.Lock()
+	defer s.serversLock.Unlock()
+	for srv := range s.servers {
+		s.shutdown(srv)
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/miekg/dns"
+	"github.com/shoenig/test/must"
+)
+
+type mockResolver struct {
+	services map[string][]*structs.ServiceRegistration
+
+	lock sync.Mutex
+	err  error
+}
+
+func (m *mockResolver) Services(_ context.Context, namespace, name string) ([]*structs.ServiceRegistration, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.services[namespace+"/"+name], m.err
+}
+
+func (m *mockResolver) setErr(err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.err = err
+}
+
+func testResolver() *mockResolver {
+	return &mockResolver{services: map[string][]*structs.ServiceRegistration{
+		"default/web": {
+			{ServiceName: "web", Namespace: "default", Address: "10.0.0.1", Port: 8080, Tags: []string{"v1"}},
+			{ServiceName: "web", Namespace: "default", Address: "10.0.0.1", Port: 8081, Tags: []string{"v2"}},
+			{ServiceName: "web", Namespace: "default", Address: "10.0.0.2", Port: 8080, Tags: []string{"v2"},
+				Meta: map[string]string{"weight": "3"}},
+			{ServiceName: "web", Namespace: "default", Address: "fd00::1", Port: 8080},
+		},
+		"platform/db": {
+			{ServiceName: "db", Namespace: "platform", Address: "10.0.1.1", Port: 5432},
+		},
+	}}
+}
+
+// testServe starts serving the handler on a random port of the loopback
+// interface, and returns its address.
+func testServe(t *testing.T, s *Server, h *handler) string {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	must.NoError(t, err)
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	must.NoError(t, err)
+
+	stop := s.serve(pc, l, h)
+	t.Cleanup(stop)
+	return pc.LocalAddr().String()
+}
+
+func testServer(t *testing.T, resolver ServiceResolver, recursors ...string) *Server {
+	s := NewServer(testlog.HCLogger(t), &Config{
+		TTL:       5 * time.Second,
+		Recursors: recursors,
+	}, resolver)
+	if len(recursors) == 0 {
+		s.recursors = nil // don't use the nameservers of the host
+	}
+	return s
+}
+
+func exchange(t *testing.T, addr, name string, qtype uint16) *dns.Msg {
+	req := new(dns.Msg).SetQuestion(name, qtype)
+	resp, _, err := new(dns.Client).Exchange(req, addr)
+	must.NoError(t, err)
+	return resp
+}
+
+func TestServer_A(t *testing.T) {
+	ci.Parallel(t)
+
+	s := testServer(t, testResolver())
+	addr := testServe(t, s, &handler{server: s, namespace: structs.DefaultNamespace})
+
+	resp := exchange(t, addr, "web.service.nomad.", dns.TypeA)
+	must.Eq(t, dns.RcodeSuccess, resp.Rcode)
+	must.True(t, resp.Authoritative)
+
+	// addresses are deduplicated, and IPv6 addresses are left out
+	var ips []string
+	for _, rr := range resp.Answer {
+		a := rr.(*dns.A)
+		must.Eq(t, "web.service.nomad.", a.Hdr.Name)
+		must.Eq(t, 5, a.Hdr.Ttl)
+		ips = append(ips, a.A.String())
+	}
+	must.SliceContainsAll(t, []string{"10.0.0.1", "10.0.0.2"}, ips)
+
+	resp = exchange(t, addr, "web.service.nomad.", dns.TypeAAAA)
+	must.Len(t, 1, resp.Answer)
+	must.Eq(t, "fd00::1", resp.Answer[0].(*dns.AAAA).AAAA.String())
+
+	// tags filter services
+	resp = exchange(t, addr, "v1.web.service.nomad.", dns.TypeA)
+	must.Len(t, 1, resp.Answer)
+	must.Eq(t, "10.0.0.1", resp.Answer[0].(*dns.A).A.String())
+
+	resp = exchange(t, addr, "v3.web.service.nomad.", dns.TypeA)
+	must.Eq(t, dns.RcodeNameError, resp.Rcode)
+
+	// the domain is case-insensitive
+	resp = exchange(t, addr, "web.SERVICE.Nomad.", dns.TypeA)
+	must.Len(t, 2, resp.Answer)
+
+	// names with no records of the type are answered with no records
+	resp = exchange(t, addr, "db.service.platform.nomad.", dns.TypeAAAA)
+	must.Eq(t, dns.RcodeSuccess, resp.Rcode)
+	must.Len(t, 0, resp.Answer)
+	must.Len(t, 1, resp.Ns)
+}
+
+func TestServer_SRV(t *testing.T) {
+	ci.Parallel(t)
+
+	s := testServer(t, testResolver())
+	addr := testServe(t, s, &handler{server: s, namespace: structs.DefaultNamespace})
+
+	resp := exchange(t, addr, "v2.web.service.nomad.", dns.TypeSRV)
+	must.Eq(t, dns.RcodeSuccess, resp.Rcode)
+	must.Len(t, 2, resp.Answer)
+
+	srvs := map[uint16]*dns.SRV{}
+	for _, rr := range resp.Answer {
+		srv := rr.(*dns.SRV)
+		srvs[srv.Port] = srv
+	}
+	must.Eq(t, "0a000001.addr.nomad.", srvs[8081].Target)
+	must.Eq(t, 1, srvs[8081].Weight)
+	must.Eq(t, "0a000002.addr.nomad.", srvs[8080].Target)
+	must.Eq(t, 3, srvs[8080].Weight)
+
+	// the targets are resolved in the additional section, and on their own
+	must.Len(t, 2, resp.Extra)
+	h := &handler{server: s}
+	for _, rr := range resp.Extra {
+		a := rr.(*dns.A)
+		must.Eq(t, h.addrName(a.A), a.Hdr.Name)
+	}
+
+	resp = exchange(t, addr, "0a000002.addr.nomad.", dns.TypeA)
+	must.Len(t, 1, resp.Answer)
+	must.Eq(t, "10.0.0.2", resp.Answer[0].(*dns.A).A.String())
+
+	resp = exchange(t, addr, "fd000000000000000000000000000001.addr.nomad.", dns.TypeAAAA)
+	must.Len(t, 1, resp.Answer)
+	must.Eq(t, "fd00::1", resp.Answer[0].(*dns.AAAA).AAAA.String())
+
+	resp = exchange(t, addr, "nothex.addr.nomad.", dns.TypeA)
+	must.Eq(t, dns.RcodeNameError, resp.Rcode)
+}
+
+func TestServer_Namespaces(t *testing.T) {
+	ci.Parallel(t)
+
+	s := testServer(t, testResolver())
+
+	// listeners of the host can query any namespace
+	addr := testServe(t, s, &handler{server: s, namespace: structs.DefaultNamespace})
+
+	resp := exchange(t, addr, "db.service.nomad.", dns.TypeA)
+	must.Eq(t, dns.RcodeNameError, resp.Rcode)
+	must.Len(t, 1, resp.Ns)
+	must.Eq(t, dns.TypeSOA, resp.Ns[0].Header().Rrtype)
+
+	resp = exchange(t, addr, "db.service.platform.nomad.", dns.TypeA)
+	must.Len(t, 1, resp.Answer)
+
+	// listeners of allocations can only query their namespace
+	addr = testServe(t, s, &handler{server: s, namespace: "platform", fixedNamespace: true})
+
+	resp = exchange(t, addr, "db.service.nomad.", dns.TypeA)
+	must.Len(t, 1, resp.Answer)
+
+	resp = exchange(t, addr, "db.service.platform.nomad.", dns.TypeA)
+	must.Len(t, 1, resp.Answer)
+
+	resp = exchange(t, addr, "web.service.default.nomad.", dns.TypeA)
+	must.Eq(t, dns.RcodeRefused, resp.Rcode)
+}
+
+func TestServer_Errors(t *testing.T) {
+	ci.Parallel(t)
+
+	resolver := testResolver()
+	s := testServer(t, resolver)
+	addr := testServe(t, s, &handler{server: s, namespace: structs.DefaultNamespace})
+
+	for _, name := range []string{
+		"nomad.",
+		"web.nomad.",
+		"web.node.nomad.",
+		"a.b.web.service.nomad.",
+		"web.service.default.extra.nomad.",
+	} {
+		resp := exchange(t, addr, name, dns.TypeA)
+		must.Eq(t, dns.RcodeNameError, resp.Rcode, must.Sprint(name))
+	}
+
+	// cached registrations are served along with errors
+	resolver.setErr(errors.New("no servers"))
+	resp := exchange(t, addr, "web.service.nomad.", dns.TypeA)
+	must.Len(t, 2, resp.Answer)
+
+	resp = exchange(t, addr, "api.service.nomad.", dns.TypeA)
+	must.Eq(t, dns.RcodeServerFailure, resp.Rcode)
+
+	// namespaces the client can't read are refused
+	resolver.setErr(structs.ErrPermissionDenied)
+	resp = exchange(t, addr, "api.service.nomad.", dns.TypeA)
+	must.Eq(t, dns.RcodeRefused, resp.Rcode)
+}
+
+func TestServer_Truncate(t *testing.T) {
+	ci.Parallel(t)
+
+	resolver := &mockResolver{services: map[string][]*structs.ServiceRegistration{}}
+	for i := range 100 {
+		resolver.services["default/web"] = append(resolver.services["default/web"],
+			&structs.ServiceRegistration{Address: net.IPv4(10, 0, 0, byte(i)).String(), Port: 8080})
+	}
+
+	s := testServer(t, resolver)
+	addr := testServe(t, s, &handler{server: s, namespace: structs.DefaultNamespace})
+
+	resp := exchange(t, addr, "web.service.nomad.", dns.TypeA)
+	must.True(t, resp.Truncated)
+	must.Less(t, 100, len(resp.Answer))
+
+	req := new(dns.Msg).SetQuestion("web.service.nomad.", dns.TypeA)
+	resp, _, err := (&dns.Client{Net: "tcp"}).Exchange(req, addr)
+	must.NoError(t, err)
+	must.False(t, resp.Truncated)
+	must.Len(t, 100, resp.Answer)
+}
+
+func TestServer_Forward(t *testing.T) {
+	ci.Parallel(t)
+
+	// the recursor answers every query
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	must.NoError(t, err)
+	recursor := &dns.Server{PacketConn: pc, Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+		resp := new(dns.Msg).SetReply(req)
+		resp.Answer = []dns.RR{&dns.A{
+			Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET},
+			A:   net.IPv4(192, 0, 2, 1),
+		}}
+		_ = w.WriteMsg(resp)
+	})}
+	go recursor.ActivateAndServe()
+	t.Cleanup(func() { _ = recursor.Shutdown() })
+
+	// queries outside of the domain are forwarded
+	s := testServer(t, testResolver(), pc.LocalAddr().String())
+	addr := testServe(t, s, &handler{server: s, namespace: structs.DefaultNamespace})
+
+	resp := exchange(t, addr, "example.com.", dns.TypeA)
+	must.Eq(t, dns.RcodeSuccess, resp.Rcode)
+	must.Len(t, 1, resp.Answer)
+	must.Eq(t, "192.0.2.1", resp.Answer[0].(*dns.A).A.String())
+
+	// queries within the domain are not
+	resp = exchange(t, addr, "web.service.nomad.", dns.TypeA)
+	must.Len(t, 2, resp.Answer)
+
+	// queries are refused without recursors
+	s = testServer(t, testResolver())
+	addr = testServe(t, s, &handler{server: s, namespace: structs.DefaultNamespace})
+
+	resp = exchange(t, addr, "example.com.", dns.TypeA)
+	must.Eq(t, dns.RcodeRefused, resp.Rcode)
+}
+
+func TestServer_recursorAddr(t *testing.T) {
+	ci.Parallel(t)
+
+	must.Eq(t, "192.0.2.1:53", recursorAddr("192.0.2.1"))
+	must.Eq(t, "192.0.2.1:5353", recursorAddr("192.0.2.1:5353"))
+	must.Eq(t, "[2001:db8::1]:53", recursorAddr("2001:db8::1"))
+	must.Eq(t, "[2001:db8::1]:53", recursorAddr("[2001:db8::1]"))
+	must.Eq(t, "[2001:db8::1]:5353", recursorAddr("[2001:db8::1]:5353"))
+}
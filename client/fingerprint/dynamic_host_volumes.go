@@ -36,6 +36,13 @@ func (h *DynamicHostVolumePluginFingerprint) Fingerprint(request *FingerprintReq
 	h.logger.Debug("detected plugin built-in",
 		"plugin_id", hvm.HostVolumePluginMkdirID, "version", hvm.HostVolumePluginMkdirVersion)
 	defer response.AddAttribute("plugins.host_volume."+hvm.HostVolumePluginMkdirID+".version", hvm.HostVolumePluginMkdirVersion)
+
+	// "loop" plugin is built-in where it's supported
+	if hvm.HostVolumePluginLoopSupported {
+		h.logger.Debug("detected plugin built-in",
+			"plugin_id", hvm.HostVolumePluginLoopID, "version", hvm.HostVolumePluginLoopVersion)
+		defer response.AddAttribute("plugins.host_volume."+hvm.HostVolumePluginLoopID+".version", hvm.HostVolumePluginLoopVersion)
+	}
 	response.Detected = true
 
 	// this config value will be empty in -dev mode
@@ -68,10 +68,14 @@ func TestPluginsHostVolumeFingerprint(t *testing.T) {
 	resp := FingerprintResponse{}
 	err := fp.Fingerprint(req, &resp)
 	must.NoError(t, err)
-	must.Eq(t, map[string]string{
+	expect := map[string]string{
 		"plugins.host_volume.mkdir.version":        hvm.HostVolumePluginMkdirVersion, // built-in
 		"plugins.host_volume.happy-plugin.version": "0.0.1",
-	}, resp.Attributes)
+	}
+	if hvm.HostVolumePluginLoopSupported {
+		expect["plugins.host_volume.loop.version"] = hvm.HostVolumePluginLoopVersion // built-in
+	}
+	must.Eq(t, expect, resp.Attributes)
 
 	// do it again after deleting our one good plugin.
 	// repeat runs should wipe attributes, so nothing should remain.
@@ -81,9 +85,13 @@ func TestPluginsHostVolumeFingerprint(t *testing.T) {
 	resp = FingerprintResponse{}
 	err = fp.Fingerprint(req, &resp)
 	must.NoError(t, err)
-	must.Eq(t, map[string]string{
+	expect = map[string]string{
 		"plugins.host_volume.happy-plugin.version": "", // empty value means removed
 
 		"plugins.host_volume.mkdir.version": hvm.HostVolumePluginMkdirVersion, // built-in
-	}, resp.Attributes)
+	}
+	if hvm.HostVolumePluginLoopSupported {
+		expect["plugins.host_volume.loop.version"] = hvm.HostVolumePluginLoopVersion // built-in
+	}
+	must.Eq(t, expect, resp.Attributes)
 }
@@ -51,6 +51,11 @@ func (f *StorageFingerprint) Fingerprint(req *FingerprintRequest, resp *Fingerpr
 		free = uint64(cfg.DiskFreeMB) * bytesPerMegabyte
 	}
 
+	iops := cfg.DiskIOPS
+	if iops == 0 {
+		iops = f.diskIOPS(storageDir)
+	}
+
 	if total < free {
 		return fmt.Errorf("detected more free disk space (%d) than total disk space (%d), use disk_total_mb and disk_free_mb to correct", free, total)
 	}
@@ -58,11 +63,15 @@ func (f *StorageFingerprint) Fingerprint(req *FingerprintRequest, resp *Fingerpr
 	resp.AddAttribute("unique.storage.volume", volume)
 	resp.AddAttribute("unique.storage.bytestotal", strconv.FormatUint(total, 10))
 	resp.AddAttribute("unique.storage.bytesfree", strconv.FormatUint(free, 10))
+	if iops > 0 {
+		resp.AddAttribute("storage.iops", strconv.Itoa(iops))
+	}
 
-	// set the disk size for the response
+	// set the disk size and IOPS for the response
 	resp.NodeResources = &structs.NodeResources{
 		Disk: structs.NodeDiskResources{
 			DiskMB: int64(free / bytesPerMegabyte),
+			IOPS:   int64(iops),
 		},
 	}
 	resp.Detected = true
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+// +build !linux
+
+package fingerprint
+
+// diskIOPS returns 0 since disk IOPS can't be detected on this platform
+func (f *StorageFingerprint) diskIOPS(path string) int {
+	return 0
+}
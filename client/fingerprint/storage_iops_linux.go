@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package fingerprint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// The disk IOPS capacities assumed for each class of block device, since
+	// the kernel doesn't expose the IOPS a device can sustain. They are
+	// conservative estimates that can be overridden with disk_iops.
+	rotationalDiskIOPS = 150
+	ssdDiskIOPS        = 10_000
+	nvmeDiskIOPS       = 100_000
+)
+
+// diskIOPS returns the disk IOPS capacity of the block device backing path,
+// or 0 when unable to determine it.
+func (f *StorageFingerprint) diskIOPS(path string) int {
+	var st unix.Stat_t
+	if err := unix.Stat(path, &st); err != nil {
+		f.logger.Debug("unable to stat path for disk iops", "path", path, "error", err)
+		return 0
+	}
+	dev := uint64(st.Dev)
+	return f.diskIOPSSys("/sys", unix.Major(dev), unix.Minor(dev))
+}
+
+// diskIOPSSys determines the disk IOPS capacity of the block device with the
+// given major and minor numbers from its class in /sys.
+func (f *StorageFingerprint) diskIOPSSys(sysDir string, major, minor uint32) int {
+	devPath := filepath.Join(sysDir, "dev", "block", fmt.Sprintf("%d:%d", major, minor))
+	devDir, err := filepath.EvalSymlinks(devPath)
+	if err != nil {
+		// not backed by a block device, such as tmpfs or overlay
+		f.logger.Debug("unable to find block device for disk iops", "path", devPath)
+		return 0
+	}
+
+	// partitions don't have a queue, so use the disk they are part of
+	if _, err := os.Stat(filepath.Join(devDir, "partition")); err == nil {
+		devDir = filepath.Dir(devDir)
+	}
+
+	rotationalPath := filepath.Join(devDir, "queue", "rotational")
+	content, err := os.ReadFile(rotationalPath)
+	if err != nil {
+		f.logger.Debug("unable to read block device queue", "path", rotationalPath)
+		return 0
+	}
+
+	switch {
+	case strings.TrimSpace(string(content)) == "1":
+		return rotationalDiskIOPS
+	case strings.HasPrefix(filepath.Base(devDir), "nvme"):
+		return nvmeDiskIOPS
+	default:
+		return ssdDiskIOPS
+	}
+}
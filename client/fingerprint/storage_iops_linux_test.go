@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package fingerprint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/shoenig/test/must"
+)
+
+func TestStorageFingerprint_diskIOPSSys(t *testing.T) {
+	ci.Parallel(t)
+
+	// build a fake /sys with a disk, one of its partitions and an nvme disk
+	sysDir := t.TempDir()
+	addDevice := func(dev, name string, rotational string) {
+		devDir := filepath.Join(sysDir, "devices", name)
+		must.NoError(t, os.MkdirAll(devDir, 0o755))
+		if rotational != "" {
+			must.NoError(t, os.MkdirAll(filepath.Join(devDir, "queue"), 0o755))
+			must.NoError(t, os.WriteFile(filepath.Join(devDir, "queue", "rotational"), []byte(rotational+"\n"), 0o644))
+		} else {
+			must.NoError(t, os.WriteFile(filepath.Join(devDir, "partition"), []byte("1\n"), 0o644))
+		}
+		must.NoError(t, os.MkdirAll(filepath.Join(sysDir, "dev", "block"), 0o755))
+		must.NoError(t, os.Symlink(devDir, filepath.Join(sysDir, "dev", "block", dev)))
+	}
+	addDevice("8:0", "sda", "1")
+	addDevice("8:1", "sda/sda1", "")
+	addDevice("8:16", "sdb", "0")
+	addDevice("259:0", "nvme0n1", "0")
+
+	fp := NewStorageFingerprint(testlog.HCLogger(t)).(*StorageFingerprint)
+	must.Eq(t, rotationalDiskIOPS, fp.diskIOPSSys(sysDir, 8, 0))
+	must.Eq(t, rotationalDiskIOPS, fp.diskIOPSSys(sysDir, 8, 1))
+	must.Eq(t, ssdDiskIOPS, fp.diskIOPSSys(sysDir, 8, 16))
+	must.Eq(t, nvmeDiskIOPS, fp.diskIOPSSys(sysDir, 259, 0))
+
+	// devices that aren't block devices aren't detected
+	must.Eq(t, 0, fp.diskIOPSSys(sysDir, 0, 42))
+}
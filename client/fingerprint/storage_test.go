@@ -8,8 +8,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/helper/testlog"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
 )
 
 func TestStorageFingerprint(t *testing.T) {
@@ -47,3 +49,20 @@ func TestStorageFingerprint(t *testing.T) {
 		t.Errorf("Expected node.Resources.DiskMB to be non-zero")
 	}
 }
+
+func TestStorageFingerprint_DiskIOPS(t *testing.T) {
+	ci.Parallel(t)
+
+	fp := NewStorageFingerprint(testlog.HCLogger(t))
+	node := &structs.Node{
+		Attributes: make(map[string]string),
+	}
+
+	request := &FingerprintRequest{Config: &config.Config{DiskIOPS: 3000}, Node: node}
+	var response FingerprintResponse
+	must.NoError(t, fp.Fingerprint(request, &response))
+
+	assertNodeAttributeEquals(t, response.Attributes, "storage.iops", "3000")
+	must.NotNil(t, response.NodeResources)
+	must.Eq(t, 3000, response.NodeResources.Disk.IOPS)
+}
@@ -78,6 +78,63 @@ func (v *HostVolume) Delete(
 	return nil
 }
 
+func (v *HostVolume) CreateSnapshot(
+	req *cstructs.ClientHostVolumeSnapshotCreateRequest,
+	resp *cstructs.ClientHostVolumeSnapshotCreateResponse) error {
+
+	defer metrics.MeasureSince([]string{"client", "host_volume", "create_snapshot"}, time.Now())
+	ctx, cancelFn := v.requestContext()
+	defer cancelFn()
+
+	snap, err := v.c.hostVolumeManager.CreateSnapshot(ctx, req)
+	if err != nil {
+		v.c.logger.Error("failed to snapshot host volume", "id", req.VolumeID, "error", err)
+		return err
+	}
+
+	resp.Snapshot = snap
+
+	v.c.logger.Info("created host volume snapshot", "id", req.VolumeID, "snapshot_id", req.ID)
+	return nil
+}
+
+func (v *HostVolume) ListSnapshots(
+	req *cstructs.ClientHostVolumeSnapshotListRequest,
+	resp *cstructs.ClientHostVolumeSnapshotListResponse) error {
+
+	defer metrics.MeasureSince([]string{"client", "host_volume", "list_snapshots"}, time.Now())
+	ctx, cancelFn := v.requestContext()
+	defer cancelFn()
+
+	snaps, err := v.c.hostVolumeManager.ListSnapshots(ctx, req)
+	if err != nil {
+		v.c.logger.Error("failed to list host volume snapshots", "id", req.VolumeID, "error", err)
+		return err
+	}
+
+	resp.Snapshots = snaps
+	return nil
+}
+
+func (v *HostVolume) DeleteSnapshot(
+	req *cstructs.ClientHostVolumeSnapshotDeleteRequest,
+	resp *cstructs.ClientHostVolumeSnapshotDeleteResponse) error {
+
+	defer metrics.MeasureSince([]string{"client", "host_volume", "delete_snapshot"}, time.Now())
+	ctx, cancelFn := v.requestContext()
+	defer cancelFn()
+
+	err := v.c.hostVolumeManager.DeleteSnapshot(ctx, req)
+	if err != nil {
+		v.c.logger.Error("failed to delete host volume snapshot", "id", req.VolumeID,
+			"snapshot_id", req.ID, "error", err)
+		return err
+	}
+
+	v.c.logger.Info("deleted host volume snapshot", "id", req.VolumeID, "snapshot_id", req.ID)
+	return nil
+}
+
 func (v *HostVolume) requestContext() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(context.Background(), hostVolumeRequestTimeout)
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-version"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/structs"
 )
 
 const (
@@ -36,6 +37,11 @@ const (
 	EnvCapacityMin = "DHV_CAPACITY_MIN_BYTES"
 	EnvCapacityMax = "DHV_CAPACITY_MAX_BYTES"
 	EnvParameters  = "DHV_PARAMETERS"
+
+	EnvSourceVolumeID     = "DHV_SOURCE_VOLUME_ID"
+	EnvSnapshotID         = "DHV_SNAPSHOT_ID"
+	EnvSnapshotName       = "DHV_SNAPSHOT_NAME"
+	EnvSnapshotParameters = "DHV_SNAPSHOT_PARAMETERS"
 )
 
 // HostVolumePlugin manages the lifecycle of volumes.
@@ -72,6 +78,7 @@ const HostVolumePluginMkdirID = "mkdir"
 const HostVolumePluginMkdirVersion = "0.0.1"
 
 var _ HostVolumePlugin = &HostVolumePluginMkdir{}
+var _ HostVolumeSnapshotPlugin = &HostVolumePluginMkdir{}
 
 // HostVolumePluginMkdir is a plugin that creates a directory within the
 // specified VolumesDir. It is built-in to Nomad, so is always available.
@@ -120,6 +127,16 @@ func (p *HostVolumePluginMkdir) Create(_ context.Context,
 		return nil, err
 	}
 
+	if req.SourceVolumeID != "" {
+		err = dirSnapshots{p.VolumesDir}.restore(path, req)
+		if err != nil {
+			// remove the partial copy, so the next attempt starts over
+			_ = os.RemoveAll(path)
+			log.Debug("error with plugin", "error", err)
+			return nil, err
+		}
+	}
+
 	log.Debug("plugin ran successfully")
 	return resp, nil
 }
@@ -132,7 +149,55 @@ func (p *HostVolumePluginMkdir) Delete(_ context.Context, req *cstructs.ClientHo
 		"path", path)
 	log.Debug("running plugin")
 
-	err := os.RemoveAll(path)
+	err := dirSnapshots{p.VolumesDir}.checkDelete(req.ID)
+	if err == nil {
+		err = os.RemoveAll(path)
+	}
+	if err != nil {
+		log.Debug("error with plugin", "error", err)
+		return err
+	}
+
+	log.Debug("plugin ran successfully")
+	return nil
+}
+
+func (p *HostVolumePluginMkdir) CreateSnapshot(_ context.Context,
+	req *cstructs.ClientHostVolumeSnapshotCreateRequest) (*structs.HostVolumeSnapshot, error) {
+
+	path := filepath.Join(p.VolumesDir, req.VolumeID)
+	log := p.log.With(
+		"operation", "create-snapshot",
+		"volume_id", req.VolumeID,
+		"snapshot_id", req.ID,
+		"path", path)
+	log.Debug("running plugin")
+
+	snap, err := dirSnapshots{p.VolumesDir}.create(path, req)
+	if err != nil {
+		log.Debug("error with plugin", "error", err)
+		return nil, err
+	}
+
+	log.Debug("plugin ran successfully")
+	return snap, nil
+}
+
+func (p *HostVolumePluginMkdir) ListSnapshots(_ context.Context,
+	req *cstructs.ClientHostVolumeSnapshotListRequest) ([]*structs.HostVolumeSnapshot, error) {
+	return dirSnapshots{p.VolumesDir}.list(req.VolumeID)
+}
+
+func (p *HostVolumePluginMkdir) DeleteSnapshot(_ context.Context,
+	req *cstructs.ClientHostVolumeSnapshotDeleteRequest) error {
+
+	log := p.log.With(
+		"operation", "delete-snapshot",
+		"volume_id", req.VolumeID,
+		"snapshot_id", req.ID)
+	log.Debug("running plugin")
+
+	err := dirSnapshots{p.VolumesDir}.delete(req.VolumeID, req.ID)
 	if err != nil {
 		log.Debug("error with plugin", "error", err)
 		return err
@@ -143,6 +208,7 @@ func (p *HostVolumePluginMkdir) Delete(_ context.Context, req *cstructs.ClientHo
 }
 
 var _ HostVolumePlugin = &HostVolumePluginExternal{}
+var _ HostVolumeSnapshotPlugin = &HostVolumePluginExternal{}
 
 // NewHostVolumePluginExternal returns an external host volume plugin
 // if the specified executable exists on disk.
@@ -232,6 +298,8 @@ func (p *HostVolumePluginExternal) Fingerprint(ctx context.Context) (*PluginFing
 // - DHV_CAPACITY_MIN_BYTES={capacity_min from the volume spec, expressed in bytes}
 // - DHV_CAPACITY_MAX_BYTES={capacity_max from the volume spec, expressed in bytes}
 // - DHV_PARAMETERS={stringified json of parameters from the volume spec}
+// - DHV_SOURCE_VOLUME_ID={ID of the volume to clone, if any}
+// - DHV_SNAPSHOT_ID={ID of the snapshot of the source volume to clone, if any}
 //
 // Response should be valid JSON on stdout with "path" and "bytes", e.g.:
 // {"path": "/path/that/was/created", "bytes": 50000000}
@@ -261,6 +329,8 @@ func (p *HostVolumePluginExternal) Create(ctx context.Context,
 		fmt.Sprintf("%s=%d", EnvCapacityMax, req.RequestedCapacityMaxBytes),
 		fmt.Sprintf("%s=%s", EnvNodeID, req.NodeID),
 		fmt.Sprintf("%s=%s", EnvParameters, params),
+		fmt.Sprintf("%s=%s", EnvSourceVolumeID, req.SourceVolumeID),
+		fmt.Sprintf("%s=%s", EnvSnapshotID, req.SnapshotID),
 	}
 
 	var pluginResp HostVolumePluginCreateResponse
@@ -346,6 +416,174 @@ func (p *HostVolumePluginExternal) Delete(ctx context.Context,
 	return nil
 }
 
+// snapshotEnv returns the environment shared by snapshot operations.
+func (p *HostVolumePluginExternal) snapshotEnv(op, volID, volName, namespace,
+	nodeID, hostPath string, parameters map[string]string) ([]string, error) {
+
+	params, err := json.Marshal(parameters)
+	if err != nil {
+		// should never happen; parameters is a simple map[string]string
+		return nil, fmt.Errorf("error marshaling volume parameters: %w", err)
+	}
+	return []string{
+		fmt.Sprintf("%s=%s", EnvOperation, op),
+		fmt.Sprintf("%s=%s", EnvVolumesDir, p.VolumesDir),
+		fmt.Sprintf("%s=%s", EnvPluginDir, p.PluginDir),
+		fmt.Sprintf("%s=%s", EnvNodePool, p.NodePool),
+		// from create response
+		fmt.Sprintf("%s=%s", EnvCreatedPath, hostPath),
+		// values from volume spec
+		fmt.Sprintf("%s=%s", EnvNamespace, namespace),
+		fmt.Sprintf("%s=%s", EnvVolumeName, volName),
+		fmt.Sprintf("%s=%s", EnvVolumeID, volID),
+		fmt.Sprintf("%s=%s", EnvNodeID, nodeID),
+		fmt.Sprintf("%s=%s", EnvParameters, params),
+	}, nil
+}
+
+// CreateSnapshot calls the executable with the following parameters:
+// arguments: $1=create-snapshot
+// environment:
+// - DHV_OPERATION=create-snapshot
+// - DHV_CREATED_PATH={path that `create` returned}
+// - DHV_VOLUMES_DIR={directory that volumes should be put in}
+// - DHV_PLUGIN_DIR={path to directory containing plugins}
+// - DHV_NAMESPACE={volume namespace}
+// - DHV_VOLUME_NAME={name from the volume specification}
+// - DHV_VOLUME_ID={volume ID generated by Nomad}
+// - DHV_NODE_ID={Nomad node ID}
+// - DHV_NODE_POOL={Nomad node pool}
+// - DHV_PARAMETERS={stringified json of parameters from the volume spec}
+// - DHV_SNAPSHOT_ID={snapshot ID generated by Nomad}
+// - DHV_SNAPSHOT_NAME={snapshot name given by the user}
+// - DHV_SNAPSHOT_PARAMETERS={stringified json of parameters for the snapshot}
+//
+// Response should be valid JSON on stdout with "bytes" and "create_time",
+// e.g.:
+// {"bytes": 50000000, "create_time": 1735689600}
+// "bytes" is the size of the snapshot, and "create_time" is the Unix time in
+// seconds when it was taken. If the snapshot already exists, the plugin
+// should respond with the existing snapshot.
+//
+// Must complete within 60 seconds (timeout on RPC)
+func (p *HostVolumePluginExternal) CreateSnapshot(ctx context.Context,
+	req *cstructs.ClientHostVolumeSnapshotCreateRequest) (*structs.HostVolumeSnapshot, error) {
+
+	envVars, err := p.snapshotEnv("create-snapshot", req.VolumeID, req.VolumeName,
+		req.Namespace, req.NodeID, req.HostPath, req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	snapParams, err := json.Marshal(req.SnapshotParameters)
+	if err != nil {
+		// should never happen; req.SnapshotParameters is a simple map[string]string
+		return nil, fmt.Errorf("error marshaling snapshot parameters: %w", err)
+	}
+	envVars = append(envVars,
+		fmt.Sprintf("%s=%s", EnvSnapshotID, req.ID),
+		fmt.Sprintf("%s=%s", EnvSnapshotName, req.Name),
+		fmt.Sprintf("%s=%s", EnvSnapshotParameters, snapParams),
+	)
+
+	var pluginResp HostVolumePluginSnapshot
+	log := p.log.With("volume_name", req.VolumeName, "volume_id", req.VolumeID, "snapshot_id", req.ID)
+	stdout, _, err := p.runPlugin(ctx, log, "create-snapshot", envVars)
+	if err != nil {
+		if jsonErr := json.Unmarshal(stdout, &pluginResp); jsonErr != nil {
+			return nil, fmt.Errorf("error snapshotting volume %q with plugin %q: %w",
+				req.VolumeID, p.ID, err)
+		}
+		return nil, fmt.Errorf("error snapshotting volume %q with plugin %q: %w: %s",
+			req.VolumeID, p.ID, err, pluginResp.Error)
+	}
+	if err := json.Unmarshal(stdout, &pluginResp); err != nil {
+		return nil, err
+	}
+
+	// Nomad knows the ID and name, so the plugin may leave them out
+	pluginResp.ID = req.ID
+	pluginResp.Name = req.Name
+	if pluginResp.CreateTime == 0 {
+		pluginResp.CreateTime = time.Now().Unix()
+	}
+	return pluginResp.toStruct(req.VolumeID), nil
+}
+
+// ListSnapshots calls the executable with the following parameters:
+// arguments: $1=list-snapshots
+// environment:
+// - DHV_OPERATION=list-snapshots
+// - and the same volume variables as delete
+//
+// Response should be valid JSON on stdout with a "snapshots" list, e.g.:
+// {"snapshots": [{"id": "...", "name": "nightly", "bytes": 50000000, "create_time": 1735689600}]}
+//
+// Must complete within 60 seconds (timeout on RPC)
+func (p *HostVolumePluginExternal) ListSnapshots(ctx context.Context,
+	req *cstructs.ClientHostVolumeSnapshotListRequest) ([]*structs.HostVolumeSnapshot, error) {
+
+	envVars, err := p.snapshotEnv("list-snapshots", req.VolumeID, req.VolumeName,
+		req.Namespace, req.NodeID, req.HostPath, req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	var pluginResp HostVolumePluginListSnapshotsResponse
+	log := p.log.With("volume_name", req.VolumeName, "volume_id", req.VolumeID)
+	stdout, _, err := p.runPlugin(ctx, log, "list-snapshots", envVars)
+	if err != nil {
+		if jsonErr := json.Unmarshal(stdout, &pluginResp); jsonErr != nil {
+			return nil, fmt.Errorf("error listing snapshots of volume %q with plugin %q: %w",
+				req.VolumeID, p.ID, err)
+		}
+		return nil, fmt.Errorf("error listing snapshots of volume %q with plugin %q: %w: %s",
+			req.VolumeID, p.ID, err, pluginResp.Error)
+	}
+	if err := json.Unmarshal(stdout, &pluginResp); err != nil {
+		return nil, err
+	}
+
+	return helper.ConvertSlice(pluginResp.Snapshots,
+		func(s *HostVolumePluginSnapshot) *structs.HostVolumeSnapshot {
+			return s.toStruct(req.VolumeID)
+		}), nil
+}
+
+// DeleteSnapshot calls the executable with the following parameters:
+// arguments: $1=delete-snapshot
+// environment:
+// - DHV_OPERATION=delete-snapshot
+// - DHV_SNAPSHOT_ID={ID of the snapshot to delete}
+// - and the same volume variables as delete
+//
+// Response on stdout is discarded.
+//
+// Must complete within 60 seconds (timeout on RPC)
+func (p *HostVolumePluginExternal) DeleteSnapshot(ctx context.Context,
+	req *cstructs.ClientHostVolumeSnapshotDeleteRequest) error {
+
+	envVars, err := p.snapshotEnv("delete-snapshot", req.VolumeID, req.VolumeName,
+		req.Namespace, req.NodeID, req.HostPath, req.Parameters)
+	if err != nil {
+		return err
+	}
+	envVars = append(envVars, fmt.Sprintf("%s=%s", EnvSnapshotID, req.ID))
+
+	log := p.log.With("volume_name", req.VolumeName, "volume_id", req.VolumeID, "snapshot_id", req.ID)
+	stdout, _, err := p.runPlugin(ctx, log, "delete-snapshot", envVars)
+	if err != nil {
+		var pluginResp HostVolumePluginDeleteResponse
+		if jsonErr := json.Unmarshal(stdout, &pluginResp); jsonErr != nil {
+			return fmt.Errorf("error deleting snapshot %q of volume %q with plugin %q: %w",
+				req.ID, req.VolumeID, p.ID, err)
+		}
+		return fmt.Errorf("error deleting snapshot %q of volume %q with plugin %q: %w: %s",
+			req.ID, req.VolumeID, p.ID, err, pluginResp.Error)
+	}
+
+	return nil
+}
+
 // runPlugin executes the... executable
 func (p *HostVolumePluginExternal) runPlugin(ctx context.Context, log hclog.Logger,
 	op string, env []string) (stdout, stderr []byte, err error) {
@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package hostvolumemanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-version"
+	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const HostVolumePluginLoopID = "loop"
+const HostVolumePluginLoopVersion = "0.0.1"
+
+const (
+	// parameters accepted by the "loop" plugin
+
+	loopParamMode       = "mode"
+	loopParamFilesystem = "filesystem"
+
+	// loopModeAuto uses an XFS project quota if the volumes dir is on an XFS
+	// filesystem mounted with project quotas, and a loop device otherwise.
+	loopModeAuto  = "auto"
+	loopModeLoop  = "loop"
+	loopModeQuota = "quota"
+
+	loopFSExt4 = "ext4"
+	loopFSXFS  = "xfs"
+
+	// loopBlockSize is the alignment of volume sizes
+	loopBlockSize = 4096
+
+	// quotaProjectsDirName is the directory of the volumes dir where the XFS
+	// project ID of each quota volume is kept, in a file named after the
+	// volume.
+	quotaProjectsDirName = ".quota-projects"
+)
+
+// loopMinSizes are the smallest filesystems the mkfs tools will create.
+var loopMinSizes = map[string]int64{
+	loopFSExt4: 16 << 20,
+	loopFSXFS:  300 << 20,
+}
+
+var _ HostVolumePlugin = &HostVolumePluginLoop{}
+var _ HostVolumeSnapshotPlugin = &HostVolumePluginLoop{}
+
+// HostVolumePluginLoop is a plugin that enforces the requested capacity of
+// volumes created within the specified VolumesDir. Each volume is either a
+// sparse image file, formatted and loop-mounted, or a directory limited by an
+// XFS project quota. It is built-in to Nomad, but is only available on Linux.
+type HostVolumePluginLoop struct {
+	ID         string
+	VolumesDir string
+
+	log hclog.Logger
+
+	// projectsLock serializes the assignment of XFS project IDs
+	projectsLock sync.Mutex
+}
+
+func (p *HostVolumePluginLoop) Fingerprint(_ context.Context) (*PluginFingerprint, error) {
+	v, err := version.NewVersion(HostVolumePluginLoopVersion)
+	return &PluginFingerprint{
+		Version: v,
+	}, err
+}
+
+// CreateSnapshot copies the contents of the volume, like the "mkdir" plugin.
+// Snapshots are kept in the volumes dir, outside of the capacity of the
+// volume.
+func (p *HostVolumePluginLoop) CreateSnapshot(_ context.Context,
+	req *cstructs.ClientHostVolumeSnapshotCreateRequest) (*structs.HostVolumeSnapshot, error) {
+
+	path := filepath.Join(p.VolumesDir, req.VolumeID)
+	log := p.log.With(
+		"operation", "create-snapshot",
+		"volume_id", req.VolumeID,
+		"snapshot_id", req.ID,
+		"path", path)
+	log.Debug("running plugin")
+
+	snap, err := dirSnapshots{p.VolumesDir}.create(path, req)
+	if err != nil {
+		log.Debug("error with plugin", "error", err)
+		return nil, err
+	}
+
+	log.Debug("plugin ran successfully")
+	return snap, nil
+}
+
+func (p *HostVolumePluginLoop) ListSnapshots(_ context.Context,
+	req *cstructs.ClientHostVolumeSnapshotListRequest) ([]*structs.HostVolumeSnapshot, error) {
+	return dirSnapshots{p.VolumesDir}.list(req.VolumeID)
+}
+
+func (p *HostVolumePluginLoop) DeleteSnapshot(_ context.Context,
+	req *cstructs.ClientHostVolumeSnapshotDeleteRequest) error {
+
+	log := p.log.With(
+		"operation", "delete-snapshot",
+		"volume_id", req.VolumeID,
+		"snapshot_id", req.ID)
+	log.Debug("running plugin")
+
+	err := dirSnapshots{p.VolumesDir}.delete(req.VolumeID, req.ID)
+	if err != nil {
+		log.Debug("error with plugin", "error", err)
+		return err
+	}
+
+	log.Debug("plugin ran successfully")
+	return nil
+}
+
+// imagePath is the path of the image file backing a loop-mounted volume.
+func (p *HostVolumePluginLoop) imagePath(id string) string {
+	return filepath.Join(p.VolumesDir, id+".img")
+}
+
+// loopParams are the parsed parameters of a create request.
+type loopParams struct {
+	mode       string
+	filesystem string
+}
+
+func parseLoopParams(params map[string]string) (*loopParams, error) {
+	lp := &loopParams{
+		mode:       loopModeAuto,
+		filesystem: loopFSExt4,
+	}
+	for k, v := range params {
+		switch k {
+		case loopParamMode:
+			switch v {
+			case loopModeAuto, loopModeLoop, loopModeQuota:
+				lp.mode = v
+			default:
+				return nil, fmt.Errorf("invalid %s parameter %q: must be one of %q, %q or %q",
+					k, v, loopModeAuto, loopModeLoop, loopModeQuota)
+			}
+		case loopParamFilesystem:
+			switch v {
+			case loopFSExt4, loopFSXFS:
+				lp.filesystem = v
+			default:
+				return nil, fmt.Errorf("invalid %s parameter %q: must be %q or %q",
+					k, v, loopFSExt4, loopFSXFS)
+			}
+		default:
+			return nil, fmt.Errorf("unknown parameter %q", k)
+		}
+	}
+	return lp, nil
+}
+
+// loopVolumeSize returns the size of the volume to provision for the request.
+// The volume gets the maximum requested capacity if there is one, so that it
+// can grow into it, and the minimum otherwise. Sizes are aligned on the
+// filesystem block size without going outside the requested range.
+func loopVolumeSize(req *cstructs.ClientHostVolumeCreateRequest, minSize int64) (int64, error) {
+	minCap := req.RequestedCapacityMinBytes
+	maxCap := req.RequestedCapacityMaxBytes
+
+	if minCap == 0 && maxCap == 0 {
+		return 0, errors.New("capacity_min or capacity_max is required")
+	}
+	if maxCap != 0 && minCap > maxCap {
+		return 0, fmt.Errorf("capacity_min (%d) is greater than capacity_max (%d)", minCap, maxCap)
+	}
+
+	var size int64
+	if maxCap != 0 {
+		size = maxCap - maxCap%loopBlockSize
+	} else {
+		size = (minCap + loopBlockSize - 1) / loopBlockSize * loopBlockSize
+		size = max(size, minSize)
+	}
+
+	if size < minCap || size == 0 {
+		return 0, fmt.Errorf("no multiple of %d bytes between capacity_min (%d) and capacity_max (%d)",
+			loopBlockSize, minCap, maxCap)
+	}
+	if size < minSize {
+		return 0, fmt.Errorf("capacity_max (%d) is less than the minimum volume size of %d bytes", maxCap, minSize)
+	}
+	return size, nil
+}
+
+func (p *HostVolumePluginLoop) quotaProjectPath(id string) string {
+	return filepath.Join(p.VolumesDir, quotaProjectsDirName, id)
+}
+
+// quotaProject returns the XFS project ID assigned to the volume, or 0 if it
+// has none.
+func (p *HostVolumePluginLoop) quotaProject(id string) (uint32, error) {
+	buf, err := os.ReadFile(p.quotaProjectPath(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	project, err := strconv.ParseUint(strings.TrimSpace(string(buf)), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid project ID for volume %s: %w", id, err)
+	}
+	return uint32(project), nil
+}
+
+// assignQuotaProject returns the XFS project ID of the volume, assigning it
+// one if it has none. The ID starts from a hash of the volume ID and is the
+// next one unused by other volumes if it collides. Project 0 is the default
+// project of every file, so it's never assigned.
+func (p *HostVolumePluginLoop) assignQuotaProject(id string) (uint32, error) {
+	p.projectsLock.Lock()
+	defer p.projectsLock.Unlock()
+
+	if project, err := p.quotaProject(id); err != nil || project != 0 {
+		return project, err
+	}
+
+	dir := filepath.Join(p.VolumesDir, quotaProjectsDirName)
+	entries, err := os.ReadDir(dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return 0, err
+	}
+	used := make(map[uint32]struct{}, len(entries))
+	for _, entry := range entries {
+		project, err := p.quotaProject(entry.Name())
+		if err != nil {
+			return 0, err
+		}
+		used[project] = struct{}{}
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(id))
+	project := h.Sum32()
+	for {
+		if _, ok := used[project]; !ok && project != 0 {
+			break
+		}
+		project++
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return 0, err
+	}
+	if err := os.WriteFile(p.quotaProjectPath(id),
+		[]byte(strconv.FormatUint(uint64(project), 10)), 0o600); err != nil {
+		return 0, err
+	}
+	return project, nil
+}
+
+// releaseQuotaProject makes the XFS project ID of the volume available to
+// other volumes.
+func (p *HostVolumePluginLoop) releaseQuotaProject(id string) error {
+	p.projectsLock.Lock()
+	defer p.projectsLock.Unlock()
+
+	err := os.Remove(p.quotaProjectPath(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+
+package hostvolumemanager
+
+import (
+	"context"
+	"errors"
+
+	cstructs "github.com/hashicorp/nomad/client/structs"
+)
+
+// HostVolumePluginLoopSupported is true on platforms where the "loop" plugin
+// can run.
+const HostVolumePluginLoopSupported = false
+
+var errLoopUnsupported = errors.New("the loop host volume plugin is only supported on Linux")
+
+func (p *HostVolumePluginLoop) Create(_ context.Context,
+	_ *cstructs.ClientHostVolumeCreateRequest) (*HostVolumePluginCreateResponse, error) {
+	return nil, errLoopUnsupported
+}
+
+func (p *HostVolumePluginLoop) Delete(_ context.Context, _ *cstructs.ClientHostVolumeDeleteRequest) error {
+	return errLoopUnsupported
+}
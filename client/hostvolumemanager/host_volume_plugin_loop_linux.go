@@ -0,0 +1,405 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package hostvolumemanager
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/moby/sys/mountinfo"
+)
+
+// HostVolumePluginLoopSupported is true on platforms where the "loop" plugin
+// can run.
+const HostVolumePluginLoopSupported = true
+
+func (p *HostVolumePluginLoop) Create(ctx context.Context,
+	req *cstructs.ClientHostVolumeCreateRequest) (*HostVolumePluginCreateResponse, error) {
+
+	path := filepath.Join(p.VolumesDir, req.ID)
+	log := p.log.With(
+		"operation", "create",
+		"volume_id", req.ID,
+		"path", path)
+	log.Debug("running plugin")
+
+	resp, err := p.create(ctx, log, path, req)
+	if err != nil {
+		log.Debug("error with plugin", "error", err)
+		return nil, err
+	}
+
+	log.Debug("plugin ran successfully", "bytes", resp.SizeBytes)
+	return resp, nil
+}
+
+func (p *HostVolumePluginLoop) create(ctx context.Context, log hclog.Logger, path string,
+	req *cstructs.ClientHostVolumeCreateRequest) (*HostVolumePluginCreateResponse, error) {
+
+	params, err := parseLoopParams(req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	mode := params.mode
+	if mode == loopModeAuto {
+		// an existing volume keeps its mode, so that it's restored the same
+		// way it was created
+		mode = loopModeLoop
+		if _, err := os.Stat(p.imagePath(req.ID)); errors.Is(err, fs.ErrNotExist) {
+			if mnt, err := quotaMount(p.VolumesDir); err != nil {
+				return nil, err
+			} else if mnt != nil {
+				mode = loopModeQuota
+			}
+		}
+	}
+
+	if mode == loopModeQuota {
+		return p.createQuota(ctx, log, path, req)
+	}
+	return p.createLoop(ctx, log, path, req, params.filesystem)
+}
+
+// createLoop creates or resizes a volume backed by a sparse image file that's
+// loop-mounted at path.
+func (p *HostVolumePluginLoop) createLoop(ctx context.Context, log hclog.Logger, path string,
+	req *cstructs.ClientHostVolumeCreateRequest, filesystem string) (*HostVolumePluginCreateResponse, error) {
+
+	size, err := loopVolumeSize(req, loopMinSizes[filesystem])
+	if err != nil {
+		return nil, err
+	}
+
+	image := p.imagePath(req.ID)
+	var isNew, grow bool
+
+	info, err := os.Stat(image)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		isNew = true
+		if err := p.newImage(ctx, log, image, size, filesystem); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case info.Size() > size:
+		return nil, fmt.Errorf("volume can't shrink from %d to %d bytes", info.Size(), size)
+	case info.Size() < size:
+		// the image is sparse, so growing it doesn't allocate the space
+		log.Debug("growing volume", "from_bytes", info.Size(), "to_bytes", size)
+		if err := os.Truncate(image, size); err != nil {
+			return nil, err
+		}
+		grow = true
+	}
+
+	if err := os.MkdirAll(path, 0o700); err != nil {
+		return nil, err
+	}
+
+	// loop mounts don't survive a host reboot, so the volume is mounted again
+	// when the agent restores it
+	mounted, err := mountinfo.Mounted(path)
+	if err != nil {
+		return nil, err
+	}
+	if !mounted {
+		if _, err := runLoopCommand(ctx, log, "mount", "-o", "loop", image, path); err != nil {
+			return nil, err
+		}
+	}
+
+	if isNew {
+		// the root of the new filesystem is owned by root, so give it the same
+		// permissions as the directories of the "mkdir" plugin
+		if err := os.Chmod(path, 0o700); err != nil {
+			return nil, err
+		}
+		// lost+found is only useful to fsck on the host
+		if err := os.RemoveAll(filepath.Join(path, "lost+found")); err != nil {
+			return nil, err
+		}
+		if req.SourceVolumeID != "" {
+			if err := p.restore(ctx, log, path, image, req); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if grow {
+		if err := growLoop(ctx, log, path); err != nil {
+			return nil, err
+		}
+	}
+
+	return &HostVolumePluginCreateResponse{
+		Path:      path,
+		SizeBytes: size,
+	}, nil
+}
+
+// newImage creates a sparse image file of the given size and formats it.
+func (p *HostVolumePluginLoop) newImage(ctx context.Context, log hclog.Logger,
+	image string, size int64, filesystem string) error {
+
+	f, err := os.OpenFile(image, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	err = f.Truncate(size)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		switch filesystem {
+		case loopFSXFS:
+			_, err = runLoopCommand(ctx, log, "mkfs.xfs", "-q", image)
+		default:
+			// no blocks reserved for root, tasks should get the whole volume
+			_, err = runLoopCommand(ctx, log, "mkfs.ext4", "-q", "-F", "-m", "0", image)
+		}
+	}
+
+	if err != nil {
+		// don't leave an unformatted image for the next attempt to mount
+		_ = os.Remove(image)
+		return err
+	}
+	return nil
+}
+
+// growLoop grows the loop device mounted at path and its filesystem to the
+// size of the image file backing it.
+func growLoop(ctx context.Context, log hclog.Logger, path string) error {
+	mounts, err := mountinfo.GetMounts(mountinfo.SingleEntryFilter(path))
+	if err != nil {
+		return err
+	}
+	if len(mounts) == 0 {
+		return fmt.Errorf("volume isn't mounted at %s", path)
+	}
+	mnt := mounts[0]
+
+	if _, err := runLoopCommand(ctx, log, "losetup", "--set-capacity", mnt.Source); err != nil {
+		return err
+	}
+
+	switch mnt.FSType {
+	case loopFSXFS:
+		_, err = runLoopCommand(ctx, log, "xfs_growfs", path)
+	default:
+		_, err = runLoopCommand(ctx, log, "resize2fs", mnt.Source)
+	}
+	return err
+}
+
+// createQuota creates a directory at path and limits it to the volume size
+// with an XFS project quota.
+func (p *HostVolumePluginLoop) createQuota(ctx context.Context, log hclog.Logger, path string,
+	req *cstructs.ClientHostVolumeCreateRequest) (*HostVolumePluginCreateResponse, error) {
+
+	mnt, err := quotaMount(p.VolumesDir)
+	if err != nil {
+		return nil, err
+	}
+	if mnt == nil {
+		return nil, fmt.Errorf("volumes dir %s isn't on an XFS filesystem mounted with project quotas", p.VolumesDir)
+	}
+
+	size, err := loopVolumeSize(req, loopBlockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = os.Stat(path)
+	isNew := errors.Is(err, fs.ErrNotExist)
+	if err := os.MkdirAll(path, 0o700); err != nil {
+		return nil, err
+	}
+
+	// setting the project and its limit is idempotent, and resizes the volume
+	// if the requested capacity changed
+	project, err := p.assignQuotaProject(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := runLoopCommand(ctx, log, "xfs_quota", "-x", "-c",
+		fmt.Sprintf("project -s -p %s %d", path, project), mnt.Mountpoint); err != nil {
+		return nil, err
+	}
+	if _, err := runLoopCommand(ctx, log, "xfs_quota", "-x", "-c",
+		fmt.Sprintf("limit -p bhard=%d %d", size, project), mnt.Mountpoint); err != nil {
+		return nil, err
+	}
+
+	// the limit is set first so that the copy is limited too
+	if isNew && req.SourceVolumeID != "" {
+		if err := (dirSnapshots{p.VolumesDir}).restore(path, req); err != nil {
+			_ = os.RemoveAll(path)
+			return nil, err
+		}
+	}
+
+	return &HostVolumePluginCreateResponse{
+		Path:      path,
+		SizeBytes: size,
+	}, nil
+}
+
+func (p *HostVolumePluginLoop) Delete(ctx context.Context, req *cstructs.ClientHostVolumeDeleteRequest) error {
+	path := filepath.Join(p.VolumesDir, req.ID)
+	log := p.log.With(
+		"operation", "delete",
+		"volume_id", req.ID,
+		"path", path)
+	log.Debug("running plugin")
+
+	err := p.delete(ctx, log, path, req.ID)
+	if err != nil {
+		log.Debug("error with plugin", "error", err)
+		return err
+	}
+
+	log.Debug("plugin ran successfully")
+	return nil
+}
+
+// restore copies the source of a cloned volume into the newly mounted volume.
+// If the copy fails, the volume is removed so the next attempt starts over.
+func (p *HostVolumePluginLoop) restore(ctx context.Context, log hclog.Logger,
+	path, image string, req *cstructs.ClientHostVolumeCreateRequest) error {
+
+	err := dirSnapshots{p.VolumesDir}.restore(path, req)
+	if err == nil {
+		return nil
+	}
+	if _, umountErr := runLoopCommand(ctx, log, "umount", path); umountErr == nil {
+		_ = os.Remove(image)
+		_ = os.RemoveAll(path)
+	}
+	return err
+}
+
+func (p *HostVolumePluginLoop) delete(ctx context.Context, log hclog.Logger, path, id string) error {
+	if err := (dirSnapshots{p.VolumesDir}).checkDelete(id); err != nil {
+		return err
+	}
+
+	mounted, err := mountinfo.Mounted(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if mounted {
+		if _, err := runLoopCommand(ctx, log, "umount", path); err != nil {
+			return err
+		}
+	}
+
+	image := p.imagePath(id)
+	if _, err := os.Stat(image); err == nil {
+		// mount sets up loop devices to detach on unmount, but a device may
+		// have been left behind if the agent stopped while mounting
+		if err := detachLoops(ctx, log, image); err != nil {
+			return err
+		}
+		if err := os.Remove(image); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	} else if project, err := p.quotaProject(id); err != nil {
+		return err
+	} else if project != 0 {
+		// a volume without an image is limited by a project quota, which
+		// should be lifted before its directory goes away
+		if mnt, err := quotaMount(p.VolumesDir); err != nil {
+			return err
+		} else if mnt != nil {
+			if _, err := runLoopCommand(ctx, log, "xfs_quota", "-x", "-c",
+				fmt.Sprintf("limit -p bhard=0 %d", project), mnt.Mountpoint); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := os.RemoveAll(path); err != nil {
+		return err
+	}
+	return p.releaseQuotaProject(id)
+}
+
+// detachLoops detaches any loop device backed by image.
+func detachLoops(ctx context.Context, log hclog.Logger, image string) error {
+	out, err := runLoopCommand(ctx, log, "losetup", "--associated", image)
+	if err != nil {
+		return err
+	}
+	// each line looks like "/dev/loop0: []: (/path/to/image)"
+	for _, line := range strings.Split(string(out), "\n") {
+		device, _, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		if _, err := runLoopCommand(ctx, log, "losetup", "--detach", device); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// quotaMount returns the mount containing dir if it's an XFS filesystem with
+// project quotas enabled, or nil otherwise.
+func quotaMount(dir string) (*mountinfo.Info, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	mounts, err := mountinfo.GetMounts(mountinfo.ParentsFilter(dir))
+	if err != nil {
+		return nil, err
+	}
+
+	// the mount of dir is its parent with the longest mount point
+	var mnt *mountinfo.Info
+	for _, m := range mounts {
+		if mnt == nil || len(m.Mountpoint) > len(mnt.Mountpoint) {
+			mnt = m
+		}
+	}
+	if mnt == nil || mnt.FSType != loopFSXFS {
+		return nil, nil
+	}
+
+	for _, opt := range strings.Split(mnt.VFSOptions, ",") {
+		switch opt {
+		case "prjquota", "pquota":
+			return mnt, nil
+		}
+	}
+	return nil, nil
+}
+
+// runLoopCommand runs the command and returns its output, or an error that
+// includes its output if it fails.
+func runLoopCommand(ctx context.Context, log hclog.Logger, name string, args ...string) ([]byte, error) {
+	log.Trace("running command", "command", name, "args", args)
+	out, err := exec.CommandContext(ctx, name, args...).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %w: %s", name, err, bytes.TrimSpace(out))
+	}
+	return out, nil
+}
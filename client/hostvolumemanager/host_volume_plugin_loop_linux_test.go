@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package hostvolumemanager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/client/testutil"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/moby/sys/mountinfo"
+	"github.com/shoenig/test/must"
+	"golang.org/x/sys/unix"
+)
+
+func TestHostVolumePluginLoop(t *testing.T) {
+	testutil.RequireRoot(t)
+
+	volID := "test-vol-id"
+	tmp := t.TempDir()
+	target := filepath.Join(tmp, volID)
+	image := filepath.Join(tmp, volID+".img")
+
+	plug := &HostVolumePluginLoop{
+		ID:         "test-loop-plugin",
+		VolumesDir: tmp,
+		log:        testlog.HCLogger(t),
+	}
+
+	// formatting and mounting takes longer than the other plugins
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	_, err := plug.Fingerprint(ctx)
+	must.NoError(t, err)
+
+	req := &cstructs.ClientHostVolumeCreateRequest{
+		ID:                        volID,
+		RequestedCapacityMinBytes: 16 << 20,
+		RequestedCapacityMaxBytes: 32 << 20,
+		Parameters:                map[string]string{"mode": "loop"},
+	}
+	t.Cleanup(func() {
+		_ = plug.Delete(context.Background(), &cstructs.ClientHostVolumeDeleteRequest{ID: volID})
+	})
+
+	// fsSize returns the size of the filesystem mounted at the target
+	fsSize := func() int64 {
+		t.Helper()
+		var st unix.Statfs_t
+		must.NoError(t, unix.Statfs(target, &st))
+		return int64(st.Blocks) * st.Bsize
+	}
+
+	// run multiple times, should be idempotent
+	for range 2 {
+		resp, err := plug.Create(ctx, req)
+		must.NoError(t, err)
+		must.Eq(t, &HostVolumePluginCreateResponse{
+			Path:      target,
+			SizeBytes: 32 << 20,
+		}, resp)
+	}
+	mounted, err := mountinfo.Mounted(target)
+	must.NoError(t, err)
+	must.True(t, mounted)
+	must.Less(t, 32<<20, fsSize())
+
+	info, err := os.Stat(target)
+	must.NoError(t, err)
+	must.Eq(t, os.FileMode(0o700), info.Mode().Perm())
+
+	// writing past the capacity fails
+	err = os.WriteFile(filepath.Join(target, "big"), make([]byte, 40<<20), 0o600)
+	must.ErrorContains(t, err, "no space left on device")
+	must.NoError(t, os.Remove(filepath.Join(target, "big")))
+
+	// a file written to the volume survives it being remounted, like it
+	// would be after a host reboot
+	must.NoError(t, os.WriteFile(filepath.Join(target, "data"), []byte("hello"), 0o600))
+	must.NoError(t, unix.Unmount(target, 0))
+	_, err = plug.Create(ctx, req)
+	must.NoError(t, err)
+	must.FileContains(t, filepath.Join(target, "data"), "hello")
+
+	// the volume can't shrink
+	req.RequestedCapacityMaxBytes = 16 << 20
+	_, err = plug.Create(ctx, req)
+	must.ErrorContains(t, err, "volume can't shrink from 33554432 to 16777216 bytes")
+
+	t.Run("grow", func(t *testing.T) {
+		// online resizing of a mounted filesystem needs CAP_SYS_RESOURCE, which
+		// isn't available in every container running the tests
+		hdr := unix.CapUserHeader{Version: unix.LINUX_CAPABILITY_VERSION_3}
+		var data [2]unix.CapUserData
+		must.NoError(t, unix.Capget(&hdr, &data[0]))
+		if data[0].Effective&(1<<unix.CAP_SYS_RESOURCE) == 0 {
+			t.Skip("test requires CAP_SYS_RESOURCE")
+		}
+
+		// growing the volume resizes it in place
+		before := fsSize()
+		req.RequestedCapacityMaxBytes = 64 << 20
+		resp, err := plug.Create(ctx, req)
+		must.NoError(t, err)
+		must.Eq(t, 64<<20, resp.SizeBytes)
+		must.Greater(t, before, fsSize())
+		must.FileContains(t, filepath.Join(target, "data"), "hello")
+	})
+
+	// delete should be idempotent, too
+	for range 2 {
+		err = plug.Delete(ctx, &cstructs.ClientHostVolumeDeleteRequest{ID: volID})
+		must.NoError(t, err)
+		must.DirNotExists(t, target)
+		must.FileNotExists(t, image)
+	}
+}
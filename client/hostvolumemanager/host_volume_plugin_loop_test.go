@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package hostvolumemanager
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/shoenig/test/must"
+)
+
+func TestLoopVolumeSize(t *testing.T) {
+	const minSize = 16 << 20
+
+	cases := []struct {
+		name     string
+		min, max int64
+		expect   int64
+		err      string
+	}{
+		{name: "none", err: "capacity_min or capacity_max is required"},
+		{name: "max wins", min: 20 << 20, max: 30 << 20, expect: 30 << 20},
+		{name: "max rounds down", max: 30<<20 + 100, expect: 30 << 20},
+		{name: "min rounds up", min: 20<<20 + 100, expect: 20<<20 + 4096},
+		{name: "min raised to minimum", min: 1 << 20, expect: minSize},
+		{name: "min over max", min: 30 << 20, max: 20 << 20,
+			err: "capacity_min (31457280) is greater than capacity_max (20971520)"},
+		{name: "no block in range", min: 20<<20 + 1, max: 20<<20 + 100,
+			err: "no multiple of 4096 bytes"},
+		{name: "max under minimum", max: 1 << 20,
+			err: "less than the minimum volume size of 16777216 bytes"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			size, err := loopVolumeSize(&cstructs.ClientHostVolumeCreateRequest{
+				RequestedCapacityMinBytes: tc.min,
+				RequestedCapacityMaxBytes: tc.max,
+			}, minSize)
+			if tc.err != "" {
+				must.ErrorContains(t, err, tc.err)
+				return
+			}
+			must.NoError(t, err)
+			must.Eq(t, tc.expect, size)
+		})
+	}
+}
+
+func TestParseLoopParams(t *testing.T) {
+	params, err := parseLoopParams(nil)
+	must.NoError(t, err)
+	must.Eq(t, &loopParams{mode: loopModeAuto, filesystem: loopFSExt4}, params)
+
+	params, err = parseLoopParams(map[string]string{"mode": "loop", "filesystem": "xfs"})
+	must.NoError(t, err)
+	must.Eq(t, &loopParams{mode: loopModeLoop, filesystem: loopFSXFS}, params)
+
+	_, err = parseLoopParams(map[string]string{"mode": "lvm"})
+	must.ErrorContains(t, err, `invalid mode parameter "lvm"`)
+
+	_, err = parseLoopParams(map[string]string{"filesystem": "btrfs"})
+	must.ErrorContains(t, err, `invalid filesystem parameter "btrfs"`)
+
+	_, err = parseLoopParams(map[string]string{"size": "1G"})
+	must.ErrorContains(t, err, `unknown parameter "size"`)
+}
+
+func TestHostVolumePluginLoop_assignQuotaProject(t *testing.T) {
+	plug := &HostVolumePluginLoop{VolumesDir: t.TempDir()}
+
+	volID := uuid.Generate()
+	project, err := plug.assignQuotaProject(volID)
+	must.NoError(t, err)
+	must.NonZero(t, project)
+
+	// the ID is kept for the lifetime of the volume
+	again, err := plug.assignQuotaProject(volID)
+	must.NoError(t, err)
+	must.Eq(t, project, again)
+
+	// a volume whose hash collides gets the next unused ID
+	must.NoError(t, os.WriteFile(plug.quotaProjectPath(uuid.Generate()),
+		[]byte(strconv.FormatUint(uint64(project+1), 10)), 0o600))
+	must.NoError(t, plug.releaseQuotaProject(volID))
+	must.NoError(t, os.WriteFile(plug.quotaProjectPath(uuid.Generate()),
+		[]byte(strconv.FormatUint(uint64(project), 10)), 0o600))
+
+	collided, err := plug.assignQuotaProject(volID)
+	must.NoError(t, err)
+	must.Eq(t, project+2, collided)
+
+	// released IDs are forgotten
+	must.NoError(t, plug.releaseQuotaProject(volID))
+	must.NoError(t, plug.releaseQuotaProject(volID))
+	released, err := plug.quotaProject(volID)
+	must.NoError(t, err)
+	must.Zero(t, released)
+}
@@ -4,13 +4,17 @@
 package hostvolumemanager
 
 import (
+	"os"
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/shoenig/test/must"
 )
 
@@ -150,6 +154,57 @@ func TestHostVolumePluginExternal(t *testing.T) {
 		must.StrContains(t, logged, "OPERATION=create") // stderr from `env`
 		must.StrContains(t, logged, `stdout="{`)        // stdout from printf
 
+		// snapshots
+		snap, err := plug.CreateSnapshot(timeout(t),
+			&cstructs.ClientHostVolumeSnapshotCreateRequest{
+				ID:                 "test-snap-id",
+				Name:               "test-snap-name",
+				VolumeID:           volID,
+				VolumeName:         "test-vol-name",
+				Namespace:          "test-namespace",
+				NodeID:             "test-node",
+				HostPath:           resp.Path,
+				Parameters:         map[string]string{"key": "val"},
+				SnapshotParameters: map[string]string{"snap": "val"},
+			})
+		logged = getLogs()
+		must.NoError(t, err, must.Sprintf("logs: %s", logged))
+		expectSnap := &structs.HostVolumeSnapshot{
+			ID:         "test-snap-id",
+			Name:       "test-snap-name",
+			VolumeID:   volID,
+			SizeBytes:  5,
+			CreateTime: time.Unix(1735689600, 0).UnixNano(),
+		}
+		must.Eq(t, expectSnap, snap)
+
+		snaps, err := plug.ListSnapshots(timeout(t),
+			&cstructs.ClientHostVolumeSnapshotListRequest{
+				VolumeID:   volID,
+				VolumeName: "test-vol-name",
+				Namespace:  "test-namespace",
+				NodeID:     "test-node",
+				HostPath:   resp.Path,
+				Parameters: map[string]string{"key": "val"},
+			})
+		logged = getLogs()
+		must.NoError(t, err, must.Sprintf("logs: %s", logged))
+		must.Eq(t, []*structs.HostVolumeSnapshot{expectSnap}, snaps)
+
+		err = plug.DeleteSnapshot(timeout(t),
+			&cstructs.ClientHostVolumeSnapshotDeleteRequest{
+				ID:         "test-snap-id",
+				VolumeID:   volID,
+				VolumeName: "test-vol-name",
+				Namespace:  "test-namespace",
+				NodeID:     "test-node",
+				HostPath:   resp.Path,
+				Parameters: map[string]string{"key": "val"},
+			})
+		logged = getLogs()
+		must.NoError(t, err, must.Sprintf("logs: %s", logged))
+		must.StrContains(t, logged, "OPERATION=delete-snapshot")
+
 		// delete
 		err = plug.Delete(timeout(t),
 			&cstructs.ClientHostVolumeDeleteRequest{
@@ -205,5 +260,114 @@ func TestHostVolumePluginExternal(t *testing.T) {
 		logged = getLogs()
 		must.StrContains(t, logged, "delete: sad plugin is sad")
 		must.StrContains(t, logged, "delete: it tells you all about it in stderr")
+
+		snap, err := plug.CreateSnapshot(timeout(t),
+			&cstructs.ClientHostVolumeSnapshotCreateRequest{
+				ID:       "test-snap-id",
+				VolumeID: volID,
+			})
+		must.EqError(t, err, `error snapshotting volume "test-vol-id" with plugin "test_plugin_sad.sh": exit status 1: create-snapshot: sad plugin is sad`)
+		must.Nil(t, snap)
+
+		snaps, err := plug.ListSnapshots(timeout(t),
+			&cstructs.ClientHostVolumeSnapshotListRequest{
+				VolumeID: volID,
+			})
+		must.EqError(t, err, `error listing snapshots of volume "test-vol-id" with plugin "test_plugin_sad.sh": exit status 1: list-snapshots: sad plugin is sad`)
+		must.Nil(t, snaps)
+
+		err = plug.DeleteSnapshot(timeout(t),
+			&cstructs.ClientHostVolumeSnapshotDeleteRequest{
+				ID:       "test-snap-id",
+				VolumeID: volID,
+			})
+		must.EqError(t, err, `error deleting snapshot "test-snap-id" of volume "test-vol-id" with plugin "test_plugin_sad.sh": exit status 1: delete-snapshot: sad plugin is sad`)
 	})
 }
+
+func TestHostVolumePluginMkdir_Snapshots(t *testing.T) {
+	tmp := t.TempDir()
+	volID := uuid.Generate()
+	snapID := uuid.Generate()
+
+	plug := &HostVolumePluginMkdir{
+		ID:         "test-mkdir-plugin",
+		VolumesDir: tmp,
+		log:        testlog.HCLogger(t),
+	}
+
+	resp, err := plug.Create(timeout(t),
+		&cstructs.ClientHostVolumeCreateRequest{ID: volID})
+	must.NoError(t, err)
+	must.NoError(t, os.WriteFile(filepath.Join(resp.Path, "file"), []byte("snapshotted"), 0o600))
+
+	// create should be idempotent
+	snapReq := &cstructs.ClientHostVolumeSnapshotCreateRequest{
+		ID:       snapID,
+		Name:     "test-snap-name",
+		VolumeID: volID,
+	}
+	snap, err := plug.CreateSnapshot(timeout(t), snapReq)
+	must.NoError(t, err)
+	must.Eq(t, snapID, snap.ID)
+	must.Eq(t, "test-snap-name", snap.Name)
+	must.Eq(t, int64(len("snapshotted")), snap.SizeBytes)
+
+	again, err := plug.CreateSnapshot(timeout(t), snapReq)
+	must.NoError(t, err)
+	must.Eq(t, snap, again)
+
+	snaps, err := plug.ListSnapshots(timeout(t),
+		&cstructs.ClientHostVolumeSnapshotListRequest{VolumeID: volID})
+	must.NoError(t, err)
+	must.Eq(t, []*structs.HostVolumeSnapshot{snap}, snaps)
+
+	// changes after the snapshot aren't in it
+	must.NoError(t, os.WriteFile(filepath.Join(resp.Path, "file"), []byte("changed"), 0o600))
+
+	// clone from the snapshot and from the volume itself
+	fromSnap, err := plug.Create(timeout(t),
+		&cstructs.ClientHostVolumeCreateRequest{
+			ID:             uuid.Generate(),
+			SourceVolumeID: volID,
+			SnapshotID:     snapID,
+		})
+	must.NoError(t, err)
+	must.FileContains(t, filepath.Join(fromSnap.Path, "file"), "snapshotted")
+
+	fromVol, err := plug.Create(timeout(t),
+		&cstructs.ClientHostVolumeCreateRequest{
+			ID:             uuid.Generate(),
+			SourceVolumeID: volID,
+		})
+	must.NoError(t, err)
+	must.FileContains(t, filepath.Join(fromVol.Path, "file"), "changed")
+
+	// a failed clone doesn't leave a volume behind
+	badID := uuid.Generate()
+	_, err = plug.Create(timeout(t),
+		&cstructs.ClientHostVolumeCreateRequest{
+			ID:             badID,
+			SourceVolumeID: volID,
+			SnapshotID:     uuid.Generate(),
+		})
+	must.ErrorContains(t, err, "could not find snapshot")
+	must.DirNotExists(t, filepath.Join(tmp, badID))
+
+	// the volume can't be deleted until its snapshots are
+	err = plug.Delete(timeout(t), &cstructs.ClientHostVolumeDeleteRequest{ID: volID})
+	must.EqError(t, err, "volume has 1 snapshot(s) that must be deleted first")
+	must.DirExists(t, resp.Path)
+
+	// delete should be idempotent
+	for range 2 {
+		err = plug.DeleteSnapshot(timeout(t),
+			&cstructs.ClientHostVolumeSnapshotDeleteRequest{ID: snapID, VolumeID: volID})
+		must.NoError(t, err)
+	}
+	must.DirNotExists(t, filepath.Join(tmp, snapshotsDirName, volID))
+
+	err = plug.Delete(timeout(t), &cstructs.ClientHostVolumeDeleteRequest{ID: volID})
+	must.NoError(t, err)
+	must.DirNotExists(t, resp.Path)
+}
@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package hostvolumemanager
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// HostVolumeSnapshotPlugin is implemented by plugins that can snapshot
+// volumes. Like the other operations, snapshot operations *must* be
+// idempotent.
+type HostVolumeSnapshotPlugin interface {
+	CreateSnapshot(ctx context.Context, req *cstructs.ClientHostVolumeSnapshotCreateRequest) (*structs.HostVolumeSnapshot, error)
+	ListSnapshots(ctx context.Context, req *cstructs.ClientHostVolumeSnapshotListRequest) ([]*structs.HostVolumeSnapshot, error)
+	DeleteSnapshot(ctx context.Context, req *cstructs.ClientHostVolumeSnapshotDeleteRequest) error
+}
+
+// HostVolumePluginSnapshot describes a snapshot to Nomad. Plugins are expected
+// to respond to 'create-snapshot' calls with json that unmarshals to this
+// struct, and to 'list-snapshots' calls with a list of them.
+type HostVolumePluginSnapshot struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	SizeBytes  int64  `json:"bytes"`
+	CreateTime int64  `json:"create_time"` // Unix timestamp in seconds
+	Error      string `json:"error"`
+}
+
+// HostVolumePluginListSnapshotsResponse is the response of plugins to
+// 'list-snapshots' calls.
+type HostVolumePluginListSnapshotsResponse struct {
+	Snapshots []*HostVolumePluginSnapshot `json:"snapshots"`
+	Error     string                      `json:"error"`
+}
+
+func (s *HostVolumePluginSnapshot) toStruct(volID string) *structs.HostVolumeSnapshot {
+	return &structs.HostVolumeSnapshot{
+		ID:         s.ID,
+		Name:       s.Name,
+		VolumeID:   volID,
+		SizeBytes:  s.SizeBytes,
+		CreateTime: time.Unix(s.CreateTime, 0).UnixNano(),
+	}
+}
+
+// snapshotsDirName is the directory of the volumes dir where built-in plugins
+// keep snapshots. Volume IDs are UUIDs, so it can't collide with a volume.
+const snapshotsDirName = ".snapshots"
+
+// snapshotMetaFile holds the metadata of a snapshot next to its data. It's
+// written last, so snapshots without one are incomplete.
+const snapshotMetaFile = "snapshot.json"
+
+// dirSnapshots keeps the snapshots of the volumes of built-in plugins as
+// copies of the volume directories, under
+// {volumes dir}/.snapshots/{volume ID}/{snapshot ID}/data.
+type dirSnapshots struct {
+	volumesDir string
+}
+
+func (d dirSnapshots) volumeDir(volID string) string {
+	return filepath.Join(d.volumesDir, snapshotsDirName, volID)
+}
+
+func (d dirSnapshots) snapshotDir(volID, snapID string) string {
+	return filepath.Join(d.volumeDir(volID), snapID)
+}
+
+func (d dirSnapshots) dataDir(volID, snapID string) string {
+	return filepath.Join(d.snapshotDir(volID, snapID), "data")
+}
+
+// validIDs guards against IDs that would escape the snapshots dir. Both
+// volume and snapshot IDs are generated by the server.
+func validIDs(ids ...string) error {
+	for _, id := range ids {
+		if !helper.IsUUID(id) {
+			return fmt.Errorf("invalid ID %q", id)
+		}
+	}
+	return nil
+}
+
+// create copies the volume at volPath into a new snapshot, or returns the
+// snapshot if it already exists. The volume isn't quiesced, so files written
+// during the copy may be inconsistent in the snapshot.
+func (d dirSnapshots) create(volPath string, req *cstructs.ClientHostVolumeSnapshotCreateRequest) (*structs.HostVolumeSnapshot, error) {
+	if err := validIDs(req.VolumeID, req.ID); err != nil {
+		return nil, err
+	}
+	if snap, err := d.read(req.VolumeID, req.ID); err == nil {
+		return snap, nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	if _, err := os.Stat(volPath); err != nil {
+		return nil, fmt.Errorf("could not find volume: %w", err)
+	}
+
+	// start over from any incomplete snapshot left by a previous attempt
+	dir := d.snapshotDir(req.VolumeID, req.ID)
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	size, err := copyDir(volPath, d.dataDir(req.VolumeID, req.ID))
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, fmt.Errorf("could not copy volume: %w", err)
+	}
+
+	snap := &structs.HostVolumeSnapshot{
+		ID:         req.ID,
+		Name:       req.Name,
+		VolumeID:   req.VolumeID,
+		SizeBytes:  size,
+		CreateTime: time.Now().UnixNano(),
+	}
+	buf, err := json.Marshal(snap)
+	if err == nil {
+		err = os.WriteFile(filepath.Join(dir, snapshotMetaFile), buf, 0o600)
+	}
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+	return snap, nil
+}
+
+// read returns the metadata of a complete snapshot.
+func (d dirSnapshots) read(volID, snapID string) (*structs.HostVolumeSnapshot, error) {
+	buf, err := os.ReadFile(filepath.Join(d.snapshotDir(volID, snapID), snapshotMetaFile))
+	if err != nil {
+		return nil, err
+	}
+	var snap structs.HostVolumeSnapshot
+	if err := json.Unmarshal(buf, &snap); err != nil {
+		return nil, fmt.Errorf("could not read snapshot %q: %w", snapID, err)
+	}
+	return &snap, nil
+}
+
+// list returns the complete snapshots of the volume, oldest first.
+func (d dirSnapshots) list(volID string) ([]*structs.HostVolumeSnapshot, error) {
+	if err := validIDs(volID); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(d.volumeDir(volID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return []*structs.HostVolumeSnapshot{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	snaps := make([]*structs.HostVolumeSnapshot, 0, len(entries))
+	for _, e := range entries {
+		snap, err := d.read(volID, e.Name())
+		if errors.Is(err, fs.ErrNotExist) {
+			continue // incomplete
+		} else if err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, snap)
+	}
+	slices.SortFunc(snaps, func(a, b *structs.HostVolumeSnapshot) int {
+		return cmp.Compare(a.CreateTime, b.CreateTime)
+	})
+	return snaps, nil
+}
+
+func (d dirSnapshots) delete(volID, snapID string) error {
+	if err := validIDs(volID, snapID); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(d.snapshotDir(volID, snapID)); err != nil {
+		return err
+	}
+	// clean up after the last snapshot of the volume, ignoring the error if
+	// there are others left
+	_ = os.Remove(d.volumeDir(volID))
+	return nil
+}
+
+// checkDelete returns an error if the volume has snapshots, so they're not
+// orphaned by deleting it. Snapshots can only be listed through their volume.
+func (d dirSnapshots) checkDelete(volID string) error {
+	if !helper.IsUUID(volID) {
+		return nil // can't have snapshots
+	}
+	snaps, err := d.list(volID)
+	if err != nil {
+		return err
+	}
+	if len(snaps) > 0 {
+		return fmt.Errorf("volume has %d snapshot(s) that must be deleted first", len(snaps))
+	}
+	return nil
+}
+
+// restore copies the source of a cloned volume into the new volume at path:
+// either a snapshot of the source volume, or the source volume itself.
+func (d dirSnapshots) restore(path string, req *cstructs.ClientHostVolumeCreateRequest) error {
+	if err := validIDs(req.SourceVolumeID); err != nil {
+		return err
+	}
+
+	src := filepath.Join(d.volumesDir, req.SourceVolumeID)
+	if req.SnapshotID != "" {
+		if err := validIDs(req.SnapshotID); err != nil {
+			return err
+		}
+		if _, err := d.read(req.SourceVolumeID, req.SnapshotID); err != nil {
+			return fmt.Errorf("could not find snapshot %q of volume %q: %w",
+				req.SnapshotID, req.SourceVolumeID, err)
+		}
+		src = d.dataDir(req.SourceVolumeID, req.SnapshotID)
+	} else if _, err := os.Stat(src); err != nil {
+		return fmt.Errorf("could not find source volume: %w", err)
+	}
+
+	if _, err := copyDir(src, path); err != nil {
+		return fmt.Errorf("could not copy source volume: %w", err)
+	}
+	return nil
+}
+
+// copyDir copies the contents of src into dst, preserving modes, ownership,
+// modification times and symlinks, and returns the number of bytes copied.
+// Files that aren't regular files, directories or symlinks, like sockets, are
+// skipped.
+//
+// The source is a volume that tasks can write to while it's copied, so it's
+// read through an os.Root: files or directories swapped for symlinks mid-walk
+// can't resolve to paths outside the volume.
+func copyDir(src, dst string) (int64, error) {
+	var size int64
+
+	root, err := os.OpenRoot(src)
+	if err != nil {
+		return 0, err
+	}
+	defer root.Close()
+
+	// directories are finalized once their contents are written, so that
+	// read-only directories can be filled
+	type dirInfo struct {
+		path string
+		info fs.FileInfo
+	}
+	var dirs []dirInfo
+
+	err = fs.WalkDir(root.FS(), ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, filepath.FromSlash(path))
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case d.IsDir():
+			if err := os.MkdirAll(target, 0o700); err != nil {
+				return err
+			}
+			dirs = append(dirs, dirInfo{target, info})
+			return nil
+
+		case d.Type()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(filepath.Join(src, filepath.FromSlash(path)))
+			if err != nil {
+				return err
+			}
+			if err := os.Symlink(link, target); err != nil {
+				return err
+			}
+			return lchownLike(target, info)
+
+		case d.Type().IsRegular():
+			n, err := copyFile(root, path, target)
+			size += n
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return size, err
+	}
+
+	for _, d := range slices.Backward(dirs) {
+		if err := os.Chmod(d.path, d.info.Mode().Perm()); err != nil {
+			return size, err
+		}
+		if err := lchownLike(d.path, d.info); err != nil {
+			return size, err
+		}
+		if err := os.Chtimes(d.path, d.info.ModTime(), d.info.ModTime()); err != nil {
+			return size, err
+		}
+	}
+	return size, nil
+}
+
+// copyFile copies the regular file at src within root to dst. The mode,
+// owner and modification time are taken from the opened file rather than the
+// directory walk, and the copy fails if src is no longer a regular file.
+func copyFile(root *os.Root, src, dst string) (int64, error) {
+	r, err := root.OpenFile(src, os.O_RDONLY|copyOpenFlags, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	info, err := r.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if !info.Mode().IsRegular() {
+		return 0, fmt.Errorf("%q is not a regular file", src)
+	}
+
+	w, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, info.Mode().Perm())
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(w, r)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return n, err
+	}
+
+	if err := lchownLike(dst, info); err != nil {
+		return n, err
+	}
+	return n, os.Chtimes(dst, info.ModTime(), info.ModTime())
+}
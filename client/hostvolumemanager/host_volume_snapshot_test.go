@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package hostvolumemanager
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/shoenig/test/must"
+)
+
+func TestCopyDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipped because windows")
+	}
+
+	src := filepath.Join(t.TempDir(), "src")
+	dst := filepath.Join(t.TempDir(), "dst")
+
+	mtime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	must.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o755))
+	must.NoError(t, os.WriteFile(filepath.Join(src, "sub", "file"), []byte("hello"), 0o640))
+	must.NoError(t, os.Chtimes(filepath.Join(src, "sub", "file"), mtime, mtime))
+	must.NoError(t, os.Symlink("sub/file", filepath.Join(src, "link")))
+	// directories that can't be written to are filled in before their mode
+	// is set
+	must.NoError(t, os.Mkdir(filepath.Join(src, "ro"), 0o755))
+	must.NoError(t, os.WriteFile(filepath.Join(src, "ro", "file"), []byte("world"), 0o600))
+	must.NoError(t, os.Chmod(filepath.Join(src, "ro"), 0o555))
+	t.Cleanup(func() { _ = os.Chmod(filepath.Join(dst, "ro"), 0o755) })
+
+	size, err := copyDir(src, dst)
+	must.NoError(t, err)
+	must.Eq(t, int64(len("hello")+len("world")), size)
+
+	must.FileContains(t, filepath.Join(dst, "sub", "file"), "hello")
+	must.FileContains(t, filepath.Join(dst, "ro", "file"), "world")
+
+	info, err := os.Stat(filepath.Join(dst, "sub", "file"))
+	must.NoError(t, err)
+	must.Eq(t, os.FileMode(0o640), info.Mode().Perm())
+	must.Eq(t, mtime, info.ModTime().UTC())
+
+	info, err = os.Stat(filepath.Join(dst, "ro"))
+	must.NoError(t, err)
+	must.Eq(t, os.FileMode(0o555), info.Mode().Perm())
+
+	link, err := os.Readlink(filepath.Join(dst, "link"))
+	must.NoError(t, err)
+	must.Eq(t, "sub/file", link)
+}
+
+func TestDirSnapshots(t *testing.T) {
+	tmp := t.TempDir()
+	d := dirSnapshots{tmp}
+	volID := uuid.Generate()
+	volPath := filepath.Join(tmp, volID)
+	must.NoError(t, os.Mkdir(volPath, 0o700))
+
+	t.Run("invalid IDs", func(t *testing.T) {
+		_, err := d.create(volPath, &cstructs.ClientHostVolumeSnapshotCreateRequest{
+			ID:       "../escape",
+			VolumeID: volID,
+		})
+		must.EqError(t, err, `invalid ID "../escape"`)
+
+		err = d.delete("..", uuid.Generate())
+		must.EqError(t, err, `invalid ID ".."`)
+
+		err = d.restore(filepath.Join(tmp, "new"), &cstructs.ClientHostVolumeCreateRequest{
+			SourceVolumeID: "/",
+		})
+		must.EqError(t, err, `invalid ID "/"`)
+	})
+
+	t.Run("incomplete snapshots", func(t *testing.T) {
+		// a snapshot without metadata is left over from a failed attempt
+		snapID := uuid.Generate()
+		must.NoError(t, os.MkdirAll(d.dataDir(volID, snapID), 0o700))
+
+		snaps, err := d.list(volID)
+		must.NoError(t, err)
+		must.SliceEmpty(t, snaps)
+		must.NoError(t, d.checkDelete(volID))
+
+		// and is replaced by the next attempt
+		must.NoError(t, os.WriteFile(filepath.Join(d.dataDir(volID, snapID), "stale"), nil, 0o600))
+		snap, err := d.create(volPath, &cstructs.ClientHostVolumeSnapshotCreateRequest{
+			ID:       snapID,
+			VolumeID: volID,
+		})
+		must.NoError(t, err)
+		must.Eq(t, snapID, snap.ID)
+		must.FileNotExists(t, filepath.Join(d.dataDir(volID, snapID), "stale"))
+
+		snaps, err = d.list(volID)
+		must.NoError(t, err)
+		must.Len(t, 1, snaps)
+		must.EqError(t, d.checkDelete(volID), "volume has 1 snapshot(s) that must be deleted first")
+	})
+
+	t.Run("missing volume", func(t *testing.T) {
+		missing := uuid.Generate()
+		_, err := d.create(filepath.Join(tmp, missing),
+			&cstructs.ClientHostVolumeSnapshotCreateRequest{
+				ID:       uuid.Generate(),
+				VolumeID: missing,
+			})
+		must.ErrorContains(t, err, "could not find volume")
+
+		err = d.restore(filepath.Join(tmp, "new"), &cstructs.ClientHostVolumeCreateRequest{
+			SourceVolumeID: missing,
+		})
+		must.ErrorContains(t, err, "could not find source volume")
+	})
+}
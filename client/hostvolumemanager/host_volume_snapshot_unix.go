@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !windows
+
+package hostvolumemanager
+
+import (
+	"io/fs"
+	"os"
+	"syscall"
+)
+
+// copyOpenFlags are added when opening source files to copy, so that opening
+// a file swapped for a FIFO doesn't block.
+const copyOpenFlags = syscall.O_NONBLOCK
+
+// lchownLike gives path the owner of the file described by info.
+func lchownLike(path string, info fs.FileInfo) error {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+	return os.Lchown(path, int(stat.Uid), int(stat.Gid))
+}
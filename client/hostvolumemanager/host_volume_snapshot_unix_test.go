@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !windows
+
+package hostvolumemanager
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func TestCopyFile_Swapped(t *testing.T) {
+	src := t.TempDir()
+	dst := t.TempDir()
+
+	secret := filepath.Join(t.TempDir(), "secret")
+	must.NoError(t, os.WriteFile(secret, []byte("secret"), 0o600))
+
+	// files swapped for symlinks or FIFOs after the walk saw a regular file
+	// must not be copied
+	must.NoError(t, os.Symlink(secret, filepath.Join(src, "file")))
+	must.NoError(t, os.Mkdir(filepath.Join(src, "dir"), 0o755))
+	must.NoError(t, os.Symlink(filepath.Dir(secret), filepath.Join(src, "dir", "sub")))
+	must.NoError(t, syscall.Mkfifo(filepath.Join(src, "fifo"), 0o600))
+
+	root, err := os.OpenRoot(src)
+	must.NoError(t, err)
+	defer root.Close()
+
+	for _, name := range []string{"file", "dir/sub/secret", "fifo"} {
+		t.Run(name, func(t *testing.T) {
+			target := filepath.Join(dst, filepath.Base(name))
+			_, err := copyFile(root, name, target)
+			must.Error(t, err)
+			must.FileNotExists(t, target)
+		})
+	}
+}
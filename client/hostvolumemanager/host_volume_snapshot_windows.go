@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build windows
+
+package hostvolumemanager
+
+import "io/fs"
+
+// copyOpenFlags are added when opening source files to copy.
+const copyOpenFlags = 0
+
+// lchownLike is a no-op on Windows, where copies keep the default owner.
+func lchownLike(string, fs.FileInfo) error {
+	return nil
+}
@@ -21,6 +21,8 @@ var (
 	ErrPluginNotExists     = errors.New("no such plugin")
 	ErrPluginNotExecutable = errors.New("plugin not executable")
 	ErrVolumeNameExists    = errors.New("volume name already exists on this node")
+
+	ErrSnapshotsNotSupported = errors.New("volume plugin does not support snapshots")
 )
 
 // HostVolumeStateManager manages the lifecycle of volumes in client state.
@@ -66,7 +68,7 @@ type HostVolumeManager struct {
 // NewHostVolumeManager includes default builtin plugins.
 func NewHostVolumeManager(logger hclog.Logger, config Config) *HostVolumeManager {
 	logger = logger.Named("host_volume_manager")
-	return &HostVolumeManager{
+	hvm := &HostVolumeManager{
 		pluginDir:      config.PluginDir,
 		volumesDir:     config.VolumesDir,
 		nodePool:       config.NodePool,
@@ -82,6 +84,14 @@ func NewHostVolumeManager(logger hclog.Logger, config Config) *HostVolumeManager
 		locker: &volLocker{},
 		log:    logger,
 	}
+	if HostVolumePluginLoopSupported {
+		hvm.builtIns[HostVolumePluginLoopID] = &HostVolumePluginLoop{
+			ID:         HostVolumePluginLoopID,
+			VolumesDir: config.VolumesDir,
+			log:        logger.With("plugin_id", HostVolumePluginLoopID),
+		}
+	}
+	return hvm
 }
 
 // Create runs the appropriate plugin for the given request, saves the request
@@ -222,6 +232,56 @@ func (hvm *HostVolumeManager) Delete(ctx context.Context,
 	return resp, nil
 }
 
+// CreateSnapshot runs the plugin of the volume to snapshot it.
+func (hvm *HostVolumeManager) CreateSnapshot(ctx context.Context,
+	req *cstructs.ClientHostVolumeSnapshotCreateRequest) (*structs.HostVolumeSnapshot, error) {
+
+	plug, err := hvm.getSnapshotPlugin(req.PluginID)
+	if err != nil {
+		return nil, err
+	}
+	return plug.CreateSnapshot(ctx, req)
+}
+
+// ListSnapshots runs the plugin of the volume to list its snapshots.
+func (hvm *HostVolumeManager) ListSnapshots(ctx context.Context,
+	req *cstructs.ClientHostVolumeSnapshotListRequest) ([]*structs.HostVolumeSnapshot, error) {
+
+	plug, err := hvm.getSnapshotPlugin(req.PluginID)
+	if err != nil {
+		return nil, err
+	}
+	return plug.ListSnapshots(ctx, req)
+}
+
+// DeleteSnapshot runs the plugin of the volume to delete one of its snapshots.
+func (hvm *HostVolumeManager) DeleteSnapshot(ctx context.Context,
+	req *cstructs.ClientHostVolumeSnapshotDeleteRequest) error {
+
+	plug, err := hvm.getSnapshotPlugin(req.PluginID)
+	if err != nil {
+		return err
+	}
+	return plug.DeleteSnapshot(ctx, req)
+}
+
+// getSnapshotPlugin finds a plugin that supports snapshots.
+func (hvm *HostVolumeManager) getSnapshotPlugin(id string) (HostVolumeSnapshotPlugin, error) {
+	if id == "" {
+		// registered volumes aren't managed by a plugin
+		return nil, ErrSnapshotsNotSupported
+	}
+	plug, err := hvm.getPlugin(id)
+	if err != nil {
+		return nil, err
+	}
+	snapPlug, ok := plug.(HostVolumeSnapshotPlugin)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrSnapshotsNotSupported, id)
+	}
+	return snapPlug, nil
+}
+
 // getPlugin finds either a built-in plugin or an external plugin.
 func (hvm *HostVolumeManager) getPlugin(id string) (HostVolumePlugin, error) {
 	if plug, ok := hvm.builtIns[id]; ok {
@@ -18,6 +18,7 @@ import (
 	cstate "github.com/hashicorp/nomad/client/state"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/shoenig/test"
 	"github.com/shoenig/test/must"
@@ -214,6 +215,37 @@ func TestHostVolumeManager(t *testing.T) {
 		must.Nil(t, stateVols, must.Sprint("all volumes should be deleted"))
 		assertNotLocked(t, hvm, "registered-volume")
 	})
+
+	t.Run("snapshots", func(t *testing.T) {
+		// registered volumes and plugins without snapshots
+		for _, pluginID := range []string{"", "test-plugin"} {
+			_, err := hvm.CreateSnapshot(ctx, &cstructs.ClientHostVolumeSnapshotCreateRequest{
+				PluginID: pluginID,
+			})
+			must.ErrorIs(t, err, ErrSnapshotsNotSupported)
+			_, err = hvm.ListSnapshots(ctx, &cstructs.ClientHostVolumeSnapshotListRequest{
+				PluginID: pluginID,
+			})
+			must.ErrorIs(t, err, ErrSnapshotsNotSupported)
+			err = hvm.DeleteSnapshot(ctx, &cstructs.ClientHostVolumeSnapshotDeleteRequest{
+				PluginID: pluginID,
+			})
+			must.ErrorIs(t, err, ErrSnapshotsNotSupported)
+		}
+
+		_, err := hvm.ListSnapshots(ctx, &cstructs.ClientHostVolumeSnapshotListRequest{
+			PluginID: "nope",
+		})
+		must.ErrorIs(t, err, ErrPluginNotExists)
+
+		// built-in plugin
+		snaps, err := hvm.ListSnapshots(ctx, &cstructs.ClientHostVolumeSnapshotListRequest{
+			PluginID: HostVolumePluginMkdirID,
+			VolumeID: uuid.Generate(),
+		})
+		must.NoError(t, err)
+		must.SliceEmpty(t, snaps)
+	})
 }
 
 type fakePlugin struct {
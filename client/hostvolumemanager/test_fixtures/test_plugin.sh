@@ -44,6 +44,25 @@ case $1 in
     test "$DHV_CREATED_PATH" == "$target"
     rm -rfv "$target"
     ;;
+  create-snapshot)
+    test "$DHV_VOLUME_ID" == 'test-vol-id'
+    test "$DHV_SNAPSHOT_ID" == 'test-snap-id'
+    test "$DHV_SNAPSHOT_NAME" == 'test-snap-name'
+    test "$DHV_SNAPSHOT_PARAMETERS" == '{"snap":"val"}'
+    test "$DHV_PARAMETERS" == '{"key":"val"}'
+    test "$DHV_CREATED_PATH" == "$DHV_VOLUMES_DIR/$DHV_VOLUME_ID"
+    echo '{"bytes": 5, "create_time": 1735689600}'
+    ;;
+  list-snapshots)
+    test "$DHV_VOLUME_ID" == 'test-vol-id'
+    test "$DHV_CREATED_PATH" == "$DHV_VOLUMES_DIR/$DHV_VOLUME_ID"
+    echo '{"snapshots": [{"id": "test-snap-id", "name": "test-snap-name", "bytes": 5, "create_time": 1735689600}]}'
+    ;;
+  delete-snapshot)
+    test "$DHV_VOLUME_ID" == 'test-vol-id'
+    test "$DHV_SNAPSHOT_ID" == 'test-snap-id'
+    test "$DHV_CREATED_PATH" == "$DHV_VOLUMES_DIR/$DHV_VOLUME_ID"
+    ;;
   *)
     echo "unknown operation $1"
     exit 1 ;;
@@ -5,6 +5,8 @@
 
 package cgroupslib
 
+import "errors"
+
 // LinuxResourcesPath does nothing on non-Linux systems
 func LinuxResourcesPath(string, string, bool) string {
 	return ""
@@ -14,3 +16,29 @@ func LinuxResourcesPath(string, string, bool) string {
 func MaybeDisableMemorySwappiness() *uint64 {
 	return nil
 }
+
+// MemoryNoLimit is the value of Limits.MemoryMaxBytes indicating the task has
+// no hard memory limit.
+const MemoryNoLimit = -1
+
+// Limits are the CPU and memory limits of a task cgroup.
+type Limits struct {
+	CPUShares      uint64
+	MemoryMaxBytes int64
+	MemoryLowBytes int64
+}
+
+// UpdateLimits is not supported on non-Linux systems
+func UpdateLimits(string, string, bool, *Limits) error {
+	return errors.New("cgroups are not supported on this platform")
+}
+
+// Freeze is not supported on non-Linux systems
+func Freeze(string) error {
+	return errors.New("cgroups are not supported on this platform")
+}
+
+// Thaw is not supported on non-Linux systems
+func Thaw(string) error {
+	return errors.New("cgroups are not supported on this platform")
+}
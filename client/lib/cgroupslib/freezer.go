@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package cgroupslib
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// freezeTimeout is how long Freeze and Thaw wait for the kernel to
+	// change the state of every process in the cgroup
+	freezeTimeout = 5 * time.Second
+
+	// freezePollInterval is how often the freezer state is checked while
+	// waiting for it to change
+	freezePollInterval = 10 * time.Millisecond
+)
+
+// Freeze stops every process in the cgroup at dir from being scheduled, so
+// the processes no longer use the CPU but keep their memory. On cgroups v1
+// dir must be the freezer cgroup of the task.
+func Freeze(dir string) error {
+	return setFrozen(dir, true)
+}
+
+// Thaw resumes the processes in the cgroup at dir stopped by Freeze.
+func Thaw(dir string) error {
+	return setFrozen(dir, false)
+}
+
+func setFrozen(dir string, frozen bool) error {
+	switch GetMode() {
+	case CG1:
+		return setFrozenCG1(OpenPath(dir), frozen, freezeTimeout)
+	case CG2:
+		return setFrozenCG2(OpenPath(dir), frozen, freezeTimeout)
+	default:
+		return errors.New("cgroups are not enabled on this client")
+	}
+}
+
+func setFrozenCG1(ed Interface, frozen bool, timeout time.Duration) error {
+	state := "THAWED"
+	if frozen {
+		state = "FROZEN"
+	}
+	if err := ed.Write("freezer.state", state); err != nil {
+		return err
+	}
+
+	// the state reads FREEZING until every process is frozen
+	return waitFrozen(timeout, func() (bool, error) {
+		current, err := ed.Read("freezer.state")
+		return current == state, err
+	})
+}
+
+func setFrozenCG2(ed Interface, frozen bool, timeout time.Duration) error {
+	value := "0"
+	if frozen {
+		value = "1"
+	}
+	if err := ed.Write("cgroup.freeze", value); err != nil {
+		return err
+	}
+
+	// cgroup.events reports "frozen 1" once every process is frozen
+	return waitFrozen(timeout, func() (bool, error) {
+		events, err := ed.Read("cgroup.events")
+		if err != nil {
+			return false, err
+		}
+		for _, line := range strings.Split(events, "\n") {
+			if key, current, ok := strings.Cut(line, " "); ok && key == "frozen" {
+				return current == value, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+func waitFrozen(timeout time.Duration, done func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		ok, err := done()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %v waiting for cgroup freezer", timeout)
+		}
+		time.Sleep(freezePollInterval)
+	}
+}
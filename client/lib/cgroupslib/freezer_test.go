@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package cgroupslib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+)
+
+func Test_setFrozenCG1(t *testing.T) {
+	ed := OpenPath(t.TempDir())
+
+	must.NoError(t, setFrozenCG1(ed, true, time.Second))
+	must.Eq(t, "FROZEN", readLimit(t, ed, "freezer.state"))
+
+	must.NoError(t, setFrozenCG1(ed, false, time.Second))
+	must.Eq(t, "THAWED", readLimit(t, ed, "freezer.state"))
+}
+
+func Test_setFrozenCG2(t *testing.T) {
+	ed := OpenPath(t.TempDir())
+
+	must.NoError(t, ed.Write("cgroup.events", "populated 1\nfrozen 1\n"))
+	must.NoError(t, setFrozenCG2(ed, true, time.Second))
+	must.Eq(t, "1", readLimit(t, ed, "cgroup.freeze"))
+
+	// the kernel never reports the cgroup as thawed
+	err := setFrozenCG2(ed, false, 50*time.Millisecond)
+	must.ErrorContains(t, err, "timed out")
+	must.Eq(t, "0", readLimit(t, ed, "cgroup.freeze"))
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package cgroupslib
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/opencontainers/runc/libcontainer/cgroups"
+)
+
+// MemoryNoLimit is the value of Limits.MemoryMaxBytes indicating the task has
+// no hard memory limit.
+const MemoryNoLimit = -1
+
+// Limits are the CPU and memory limits of a task cgroup that can be changed
+// while the task is running.
+type Limits struct {
+	// CPUShares is the relative CPU weight of the task, expressed in cgroups
+	// v1 shares. It is converted to cpu.weight on cgroups v2.
+	CPUShares uint64
+
+	// MemoryMaxBytes is the hard memory limit, or MemoryNoLimit.
+	MemoryMaxBytes int64
+
+	// MemoryLowBytes is the soft memory limit, or 0 if the task has none.
+	MemoryLowBytes int64
+}
+
+// UpdateLimits writes limits to the cgroup of the task. The kernel rejects a
+// hard memory limit below the current usage of the cgroup, in which case an
+// error is returned and the caller should restart the task instead.
+func UpdateLimits(allocID, task string, cores bool, limits *Limits) error {
+	switch GetMode() {
+	case CG1:
+		return writeLimitsCG1(
+			OpenPath(PathCG1(allocID, task, "memory")),
+			OpenPath(PathCG1(allocID, task, "cpu")),
+			limits,
+		)
+	case CG2:
+		return writeLimitsCG2(OpenPath(pathCG2(allocID, task, cores)), limits)
+	default:
+		return errors.New("cgroups are not enabled on this client")
+	}
+}
+
+func writeLimitsCG1(mem, cpu Interface, limits *Limits) error {
+	if err := mem.Write("memory.limit_in_bytes", strconv.FormatInt(limits.MemoryMaxBytes, 10)); err != nil {
+		return err
+	}
+
+	// -1 resets the soft limit to the kernel default of no limit
+	soft := int64(-1)
+	if limits.MemoryLowBytes > 0 {
+		soft = limits.MemoryLowBytes
+	}
+	if err := mem.Write("memory.soft_limit_in_bytes", strconv.FormatInt(soft, 10)); err != nil {
+		return err
+	}
+
+	return cpu.Write("cpu.shares", strconv.FormatUint(limits.CPUShares, 10))
+}
+
+func writeLimitsCG2(ed Interface, limits *Limits) error {
+	memMax := "max"
+	if limits.MemoryMaxBytes != MemoryNoLimit {
+		memMax = strconv.FormatInt(limits.MemoryMaxBytes, 10)
+	}
+	if err := ed.Write("memory.max", memMax); err != nil {
+		return err
+	}
+	if err := ed.Write("memory.low", strconv.FormatInt(limits.MemoryLowBytes, 10)); err != nil {
+		return err
+	}
+
+	weight := cgroups.ConvertCPUSharesToCgroupV2Value(limits.CPUShares)
+	return ed.Write("cpu.weight", strconv.FormatUint(weight, 10))
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package cgroupslib
+
+import (
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func readLimit(t *testing.T, ed Interface, filename string) string {
+	t.Helper()
+	s, err := ed.Read(filename)
+	must.NoError(t, err)
+	return s
+}
+
+func Test_writeLimitsCG1(t *testing.T) {
+	mem, cpu := OpenPath(t.TempDir()), OpenPath(t.TempDir())
+
+	err := writeLimitsCG1(mem, cpu, &Limits{
+		CPUShares:      500,
+		MemoryMaxBytes: 512 << 20,
+		MemoryLowBytes: 256 << 20,
+	})
+	must.NoError(t, err)
+	must.Eq(t, "536870912", readLimit(t, mem, "memory.limit_in_bytes"))
+	must.Eq(t, "268435456", readLimit(t, mem, "memory.soft_limit_in_bytes"))
+	must.Eq(t, "500", readLimit(t, cpu, "cpu.shares"))
+
+	err = writeLimitsCG1(mem, cpu, &Limits{
+		CPUShares:      100,
+		MemoryMaxBytes: 128 << 20,
+	})
+	must.NoError(t, err)
+	must.Eq(t, "-1", readLimit(t, mem, "memory.soft_limit_in_bytes"))
+}
+
+func Test_writeLimitsCG2(t *testing.T) {
+	ed := OpenPath(t.TempDir())
+
+	err := writeLimitsCG2(ed, &Limits{
+		CPUShares:      1024,
+		MemoryMaxBytes: MemoryNoLimit,
+		MemoryLowBytes: 256 << 20,
+	})
+	must.NoError(t, err)
+	must.Eq(t, "max", readLimit(t, ed, "memory.max"))
+	must.Eq(t, "268435456", readLimit(t, ed, "memory.low"))
+	must.Eq(t, "39", readLimit(t, ed, "cpu.weight"))
+}
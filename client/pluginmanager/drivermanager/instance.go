@@ -111,6 +111,11 @@ type instanceManager struct {
 	// lastHealthState is the last known health fingerprinted by the manager
 	lastHealthState   drivers.HealthState
 	lastHealthStateMu sync.Mutex
+
+	// updateResources is whether the driver can apply cpu and memory changes
+	// to running tasks. It is set when fingerprinting starts and is only
+	// accessed by the fingerprinting goroutine.
+	updateResources bool
 }
 
 // newInstanceManager returns a new driver instance manager. It is expected that
@@ -272,6 +277,14 @@ func (i *instanceManager) dispenseFingerprintCh() (<-chan *drivers.Fingerprint,
 		return nil, nil, err
 	}
 
+	// the scheduler only updates resources in place on nodes that report the
+	// driver can apply them to running tasks
+	caps, err := driver.Capabilities()
+	if err != nil {
+		i.logger.Warn("failed to get driver capabilities", "error", err)
+	}
+	i.updateResources = caps != nil && caps.UpdateResources
+
 	ctx, cancel := context.WithCancel(i.ctx)
 	fingerCh, err := driver.Fingerprint(ctx)
 	if err != nil {
@@ -362,6 +375,9 @@ func (i *instanceManager) handleFingerprint(fp *drivers.Fingerprint) {
 	for key, attr := range fp.Attributes {
 		attrs[key] = attr.GoString()
 	}
+	if i.updateResources {
+		attrs[structs.DriverAttrUpdateResources(i.id.Name)] = "true"
+	}
 	di := &structs.DriverInfo{
 		Attributes:        attrs,
 		Detected:          fp.Health != drivers.HealthStateUndetected,
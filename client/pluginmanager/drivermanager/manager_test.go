@@ -51,6 +51,9 @@ func testSetup(t *testing.T) (chan *drivers.Fingerprint, chan *drivers.TaskEvent
 
 func mockDriver(fpChan chan *drivers.Fingerprint, evChan chan *drivers.TaskEvent) drivers.DriverPlugin {
 	return &dtu.MockDriver{
+		CapabilitiesF: func() (*drivers.Capabilities, error) {
+			return &drivers.Capabilities{UpdateResources: true}, nil
+		},
 		FingerprintF: func(ctx context.Context) (<-chan *drivers.Fingerprint, error) {
 			return fpChan, nil
 		},
@@ -165,6 +168,7 @@ func TestManager_Fingerprint(t *testing.T) {
 	require.Len(infos, 3)
 	require.True(infos[0].Healthy)
 	require.True(infos[0].Detected)
+	require.Equal("true", infos[0].Attributes[structs.DriverAttrUpdateResources("mock")])
 	require.False(infos[1].Healthy)
 	require.True(infos[1].Detected)
 	require.False(infos[2].Healthy)
@@ -21,6 +21,10 @@ import (
 	"github.com/hashicorp/nomad/client/serviceregistration"
 	"github.com/hashicorp/nomad/helper/useragent"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"oss.indeed.com/go/libtime"
 )
 
@@ -36,7 +40,14 @@ type Checker interface {
 	Do(context.Context, *QueryContext, *Query) *structs.CheckQueryResult
 }
 
-// New creates a new Checker capable of executing HTTP and TCP checks.
+// ScriptExecutor executes a command in the context of a task. It is
+// implemented by the driver handle of a running task.
+type ScriptExecutor interface {
+	Exec(timeout time.Duration, cmd string, args []string) ([]byte, int, error)
+}
+
+// New creates a new Checker capable of executing HTTP, TCP, gRPC and script
+// checks.
 func New(log hclog.Logger) Checker {
 	httpClient := cleanhttp.DefaultPooledClient()
 	httpClient.Timeout = maxTimeoutHTTP
@@ -67,6 +78,10 @@ func (c *checker) Do(ctx context.Context, qc *QueryContext, q *Query) *structs.C
 	switch q.Type {
 	case "http":
 		qr = c.checkHTTP(timeout, qc, q)
+	case "grpc":
+		qr = c.checkGRPC(timeout, qc, q)
+	case "script":
+		qr = c.checkScript(qc, q)
 	default:
 		qr = c.checkTCP(timeout, qc, q)
 	}
@@ -234,6 +249,100 @@ func (c *checker) checkHTTP(ctx context.Context, qc *QueryContext, q *Query) *st
 	return qr
 }
 
+func (c *checker) checkGRPC(ctx context.Context, qc *QueryContext, q *Query) *structs.CheckQueryResult {
+	qr := &structs.CheckQueryResult{
+		Mode:      q.Mode,
+		Timestamp: c.now(),
+		Status:    structs.CheckPending,
+	}
+
+	addr, err := address(qc, q)
+	if err != nil {
+		qr.Output = err.Error()
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+
+	creds := insecure.NewCredentials()
+	if q.GRPCUseTLS {
+		creds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: q.TLSSkipVerify})
+	}
+
+	conn, err := grpc.NewClient(
+		"passthrough:///"+addr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUserAgent(useragent.String()),
+	)
+	if err != nil {
+		qr.Output = fmt.Sprintf("nomad: %s", err.Error())
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	response, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{
+		Service: q.GRPCService,
+	})
+	if err != nil {
+		qr.Output = fmt.Sprintf("nomad: %s", err.Error())
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+
+	if status := response.GetStatus(); status != healthpb.HealthCheckResponse_SERVING {
+		qr.Output = fmt.Sprintf("nomad: grpc health status %s", status)
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+
+	qr.Output = "nomad: grpc ok"
+	qr.Status = structs.CheckSuccess
+	return qr
+}
+
+// checkScript runs the script of the check in its task through the task
+// driver. Unlike Consul script checks there is no warning state, so any non-zero
+// exit code is a failure.
+func (c *checker) checkScript(qc *QueryContext, q *Query) *structs.CheckQueryResult {
+	qr := &structs.CheckQueryResult{
+		Mode:      q.Mode,
+		Timestamp: c.now(),
+		Status:    structs.CheckPending,
+	}
+
+	var exec ScriptExecutor
+	if qc.TaskExecutor != nil {
+		exec = qc.TaskExecutor(q.TaskName)
+	}
+	if exec == nil {
+		// like a network check against a task that is not listening yet, the
+		// check fails until the task is running
+		qr.Output = fmt.Sprintf("nomad: task %q is not running", q.TaskName)
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+
+	output, code, err := exec.Exec(q.Timeout, q.Command, q.Args)
+	if err != nil {
+		qr.Output = fmt.Sprintf("nomad: %s", err.Error())
+		qr.Status = structs.CheckFailure
+		return qr
+	}
+
+	if code == 0 {
+		qr.Status = structs.CheckSuccess
+	} else {
+		qr.Status = structs.CheckFailure
+	}
+	qr.Output = limitRead(bytes.NewReader(output))
+	if qr.Output == "" {
+		qr.Output = fmt.Sprintf("nomad: script exited with code %d", code)
+	}
+	return qr
+}
+
 const (
 	// outputSizeLimit is the maximum number of bytes to read and store of an http
 	// check output. Set to 3kb which fits in 1 page with room for other fields.
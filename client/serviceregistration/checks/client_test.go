@@ -21,6 +21,9 @@ import (
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/shoenig/test/must"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"oss.indeed.com/go/libtime/libtimetest"
 )
 
@@ -617,3 +620,208 @@ func tcpServer(t *testing.T, ctx context.Context, port int) {
 		}
 	}()
 }
+
+func TestChecker_Do_GRPC(t *testing.T) {
+	ci.Parallel(t)
+
+	now := time.Date(2022, 1, 2, 3, 4, 5, 6, time.UTC)
+	clock := libtimetest.NewClockMock(t).NowMock.Return(now)
+
+	// create a grpc server with a healthy and an unhealthy service
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	must.NoError(t, err)
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("up", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("down", healthpb.HealthCheckResponse_NOT_SERVING)
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	go func() { _ = server.Serve(l) }()
+	t.Cleanup(server.Stop)
+
+	addr, port, err := net.SplitHostPort(l.Addr().String())
+	must.NoError(t, err)
+	closed := ci.PortAllocator.Grab(1)[0]
+
+	cases := []struct {
+		name      string
+		port      string
+		service   string
+		useTLS    bool
+		expStatus structs.CheckStatus
+		expOutput string
+	}{{
+		name:      "server ok",
+		port:      port,
+		expStatus: structs.CheckSuccess,
+		expOutput: "nomad: grpc ok",
+	}, {
+		name:      "service ok",
+		port:      port,
+		service:   "up",
+		expStatus: structs.CheckSuccess,
+		expOutput: "nomad: grpc ok",
+	}, {
+		name:      "service not serving",
+		port:      port,
+		service:   "down",
+		expStatus: structs.CheckFailure,
+		expOutput: "nomad: grpc health status NOT_SERVING",
+	}, {
+		name:      "service unknown",
+		port:      port,
+		service:   "other",
+		expStatus: structs.CheckFailure,
+		expOutput: "code = NotFound",
+	}, {
+		name:      "tls to plaintext server",
+		port:      port,
+		useTLS:    true,
+		expStatus: structs.CheckFailure,
+		expOutput: "code = Unavailable",
+	}, {
+		name:      "not listening",
+		port:      fmt.Sprintf("%d", closed),
+		expStatus: structs.CheckFailure,
+		expOutput: "code = Unavailable",
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			qc := &QueryContext{
+				ID:               "abc123",
+				CustomAddress:    addr,
+				ServicePortLabel: tc.port,
+				NetworkStatus:    mock.NewNetworkStatus(addr),
+				Group:            "group",
+				Task:             "task",
+				Service:          "service",
+				Check:            "check",
+			}
+			q := &Query{
+				Mode:          structs.Healthiness,
+				Type:          "grpc",
+				Timeout:       time.Second,
+				AddressMode:   "auto",
+				PortLabel:     tc.port,
+				GRPCService:   tc.service,
+				GRPCUseTLS:    tc.useTLS,
+				TLSSkipVerify: tc.useTLS,
+			}
+
+			c := New(testlog.HCLogger(t))
+			c.(*checker).clock = clock
+
+			result := c.Do(context.Background(), qc, q)
+			must.Eq(t, tc.expStatus, result.Status)
+			must.StrContains(t, result.Output, tc.expOutput)
+			must.Eq(t, now.Unix(), result.Timestamp)
+			must.Eq(t, "check", result.Check)
+		})
+	}
+}
+
+// fakeScriptExecutor returns the configured output and exit code, and records
+// the command it was asked to run.
+type fakeScriptExecutor struct {
+	output []byte
+	code   int
+	err    error
+
+	cmd  string
+	args []string
+}
+
+func (e *fakeScriptExecutor) Exec(_ time.Duration, cmd string, args []string) ([]byte, int, error) {
+	e.cmd, e.args = cmd, args
+	return e.output, e.code, e.err
+}
+
+func TestChecker_Do_Script(t *testing.T) {
+	ci.Parallel(t)
+
+	now := time.Date(2022, 1, 2, 3, 4, 5, 6, time.UTC)
+	clock := libtimetest.NewClockMock(t).NowMock.Return(now)
+
+	tooLong, truncate := bigResponse()
+
+	cases := []struct {
+		name      string
+		exec      *fakeScriptExecutor
+		expStatus structs.CheckStatus
+		expOutput string
+	}{{
+		name:      "task not running",
+		expStatus: structs.CheckFailure,
+		expOutput: `nomad: task "web" is not running`,
+	}, {
+		name:      "exit zero",
+		exec:      &fakeScriptExecutor{output: []byte("all good")},
+		expStatus: structs.CheckSuccess,
+		expOutput: "all good",
+	}, {
+		name:      "exit zero without output",
+		exec:      &fakeScriptExecutor{},
+		expStatus: structs.CheckSuccess,
+		expOutput: "nomad: script exited with code 0",
+	}, {
+		name:      "exit one",
+		exec:      &fakeScriptExecutor{output: []byte("warning"), code: 1},
+		expStatus: structs.CheckFailure,
+		expOutput: "warning",
+	}, {
+		name:      "exit two",
+		exec:      &fakeScriptExecutor{code: 2},
+		expStatus: structs.CheckFailure,
+		expOutput: "nomad: script exited with code 2",
+	}, {
+		name:      "exec error",
+		exec:      &fakeScriptExecutor{err: fmt.Errorf("oops")},
+		expStatus: structs.CheckFailure,
+		expOutput: "nomad: oops",
+	}, {
+		name:      "truncated output",
+		exec:      &fakeScriptExecutor{output: []byte(tooLong)},
+		expStatus: structs.CheckSuccess,
+		expOutput: truncate,
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			qc := &QueryContext{
+				ID:      "abc123",
+				Group:   "group",
+				Task:    "web",
+				Service: "service",
+				Check:   "check",
+				TaskExecutor: func(task string) ScriptExecutor {
+					must.Eq(t, "web", task)
+					if tc.exec == nil {
+						return nil
+					}
+					return tc.exec
+				},
+			}
+			q := &Query{
+				Mode:     structs.Healthiness,
+				Type:     "script",
+				Timeout:  time.Second,
+				TaskName: "web",
+				Command:  "/bin/check",
+				Args:     []string{"-v"},
+			}
+
+			c := New(testlog.HCLogger(t))
+			c.(*checker).clock = clock
+
+			result := c.Do(context.Background(), qc, q)
+			must.Eq(t, tc.expStatus, result.Status)
+			must.Eq(t, tc.expOutput, result.Output)
+			must.Eq(t, "web", result.Task)
+
+			if tc.exec != nil {
+				must.Eq(t, "/bin/check", tc.exec.cmd)
+				must.Eq(t, []string{"-v"}, tc.exec.args)
+			}
+		})
+	}
+}
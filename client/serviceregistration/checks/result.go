@@ -6,6 +6,7 @@ package checks
 import (
 	"maps"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -17,6 +18,7 @@ func GetCheckQuery(c *structs.ServiceCheck) *Query {
 	if c.Type == "http" && protocol == "" {
 		protocol = "http"
 	}
+
 	return &Query{
 		Mode:          structs.GetCheckMode(c),
 		Type:          c.Type,
@@ -29,6 +31,11 @@ func GetCheckQuery(c *structs.ServiceCheck) *Query {
 		Headers:       maps.Clone(c.Header),
 		Body:          c.Body,
 		TLSSkipVerify: c.TLSSkipVerify,
+		GRPCService:   c.GRPCService,
+		GRPCUseTLS:    c.GRPCUseTLS,
+		TaskName:      c.TaskName,
+		Command:       c.Command,
+		Args:          slices.Clone(c.Args),
 	}
 }
 
@@ -36,7 +43,7 @@ func GetCheckQuery(c *structs.ServiceCheck) *Query {
 // amount of information needed to actually execute that check.
 type Query struct {
 	Mode structs.CheckMode // readiness or healthiness
-	Type string            // tcp or http
+	Type string            // tcp, http, grpc or script
 
 	Timeout time.Duration // connection / request timeout
 
@@ -48,11 +55,18 @@ type Query struct {
 	Method        string      // http checks only
 	Headers       http.Header // http checks only
 	Body          string      // http checks only
-	TLSSkipVerify bool        // http checks only, https protocol
+	TLSSkipVerify bool        // http checks with https protocol, grpc checks with tls
+
+	GRPCService string // grpc checks only
+	GRPCUseTLS  bool   // grpc checks only
+
+	TaskName string   // script checks only
+	Command  string   // script checks only
+	Args     []string // script checks only
 }
 
 // A QueryContext contains allocation and service parameters necessary for
-// address resolution.
+// address resolution, and access to the tasks script checks run in.
 type QueryContext struct {
 	ID               structs.CheckID
 	CustomAddress    string
@@ -61,6 +75,10 @@ type QueryContext struct {
 	NetworkStatus    structs.NetworkStatus
 	Ports            structs.AllocatedPorts
 
+	// TaskExecutor returns the executor of the named task for script checks,
+	// or nil if the task is not running.
+	TaskExecutor func(task string) ScriptExecutor
+
 	Group   string
 	Task    string
 	Service string
@@ -108,6 +108,42 @@ func TestChecks_GetCheckQuery(t *testing.T) {
 	}
 }
 
+func TestChecks_GetCheckQuery_grpc_script(t *testing.T) {
+	grpcCheck := &structs.ServiceCheck{
+		Type:          "grpc",
+		PortLabel:     "web",
+		Interval:      10 * time.Second,
+		Timeout:       2 * time.Second,
+		GRPCService:   "api.v1",
+		GRPCUseTLS:    true,
+		TLSSkipVerify: true,
+	}
+	query := GetCheckQuery(grpcCheck)
+	must.Eq(t, "grpc", query.Type)
+	must.Eq(t, "api.v1", query.GRPCService)
+	must.True(t, query.GRPCUseTLS)
+	must.True(t, query.TLSSkipVerify)
+	must.Eq(t, "", query.Protocol)
+
+	scriptCheck := &structs.ServiceCheck{
+		Type:     "script",
+		Interval: 10 * time.Second,
+		Timeout:  2 * time.Second,
+		TaskName: "web",
+		Command:  "/bin/check",
+		Args:     []string{"-v", "--port", "8080"},
+	}
+	query = GetCheckQuery(scriptCheck)
+	must.Eq(t, "script", query.Type)
+	must.Eq(t, "web", query.TaskName)
+	must.Eq(t, "/bin/check", query.Command)
+	must.Eq(t, []string{"-v", "--port", "8080"}, query.Args)
+
+	// the query must not alias the check
+	query.Args[0] = "-q"
+	must.Eq(t, "-v", scriptCheck.Args[0])
+}
+
 func TestChecks_Stub(t *testing.T) {
 	now := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC).Unix()
 	result := Stub(
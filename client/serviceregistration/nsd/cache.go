@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nsd
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// defaultCacheMaxQueryTime is how long the blocking queries keeping cached
+	// services up to date wait for changes.
+	defaultCacheMaxQueryTime = 5 * time.Minute
+
+	// defaultCacheIdleTimeout is how long services are kept up to date after
+	// they were last read.
+	defaultCacheIdleTimeout = 10 * time.Minute
+
+	// defaultCacheMaxEntries is how many services are kept up to date at most.
+	defaultCacheMaxEntries = 1024
+
+	// cacheRetryInterval is how long to wait before retrying failed queries.
+	cacheRetryInterval = 5 * time.Second
+)
+
+// ServiceCache is a client-side cache of the passing registrations of Nomad
+// services. Services are fetched from the servers on first read, and then
+// kept up to date with blocking queries for as long as they're read, so that
+// reads are served locally. Services without registrations, and services read
+// while the cache is full, are not cached and are fetched on every read.
+type ServiceCache struct {
+	log hclog.Logger
+	cfg *ServiceCacheCfg
+
+	entries     map[serviceCacheKey]*serviceCacheEntry
+	entriesLock sync.Mutex
+
+	shutdownCh   chan struct{}
+	shutdownOnce sync.Once
+}
+
+// ServiceCacheCfg holds the information used by the ServiceCache to query the
+// servers.
+type ServiceCacheCfg struct {
+	// Region is the region of the Nomad client.
+	Region string
+
+	// NodeSecret is the secret ID of the node and is used to authenticate RPC
+	// requests.
+	NodeSecret string
+
+	// RPCFn is the client RPC function used to query the servers.
+	RPCFn func(method string, args, resp interface{}) error
+
+	// MaxQueryTime is how long blocking queries wait for changes, defaults to
+	// 5m.
+	MaxQueryTime time.Duration
+
+	// IdleTimeout is how long services are kept up to date after they were
+	// last read, defaults to 10m.
+	IdleTimeout time.Duration
+
+	// MaxEntries is how many services are kept up to date at most, defaults
+	// to 1024.
+	MaxEntries int
+}
+
+type serviceCacheKey struct {
+	namespace string
+	name      string
+}
+
+type serviceCacheEntry struct {
+	// readyCh is closed once the first query returns.
+	readyCh chan struct{}
+
+	// services, err, and lastRead are protected by the cache entriesLock.
+	services []*structs.ServiceRegistration
+	err      error
+	lastRead time.Time
+}
+
+// NewServiceCache returns a ServiceCache ready to be read.
+func NewServiceCache(log hclog.Logger, cfg *ServiceCacheCfg) *ServiceCache {
+	if cfg.MaxQueryTime == 0 {
+		cfg.MaxQueryTime = defaultCacheMaxQueryTime
+	}
+	if cfg.IdleTimeout == 0 {
+		cfg.IdleTimeout = defaultCacheIdleTimeout
+	}
+	if cfg.MaxEntries == 0 {
+		cfg.MaxEntries = defaultCacheMaxEntries
+	}
+	return &ServiceCache{
+		log:        log.Named("service_cache.nomad"),
+		cfg:        cfg,
+		entries:    make(map[serviceCacheKey]*serviceCacheEntry),
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+// Services returns the registrations of the named service whose checks are
+// passing. The first read of a service waits for the servers to respond, and
+// later reads return the cached registrations, along with the error of the
+// last query if it failed. The returned registrations must not be modified.
+func (c *ServiceCache) Services(ctx context.Context, namespace, name string) ([]*structs.ServiceRegistration, error) {
+	key := serviceCacheKey{namespace: namespace, name: name}
+
+	c.entriesLock.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &serviceCacheEntry{readyCh: make(chan struct{})}
+		if len(c.entries) < c.cfg.MaxEntries {
+			c.entries[key] = entry
+			go c.watch(key, entry)
+		} else {
+			// the entry is only used by this read
+			go c.fetch(key, entry)
+		}
+	}
+	entry.lastRead = time.Now()
+	c.entriesLock.Unlock()
+
+	select {
+	case <-entry.readyCh:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.shutdownCh:
+		return nil, context.Canceled
+	}
+
+	c.entriesLock.Lock()
+	defer c.entriesLock.Unlock()
+	return entry.services, entry.err
+}
+
+// fetch queries the services of an entry that isn't cached.
+func (c *ServiceCache) fetch(key serviceCacheKey, entry *serviceCacheEntry) {
+	args := c.queryArgs(key)
+	var reply structs.ServiceRegistrationByNameResponse
+	err := c.cfg.RPCFn(structs.ServiceRegistrationGetServiceRPCMethod, &args, &reply)
+
+	c.entriesLock.Lock()
+	entry.services, entry.err = reply.Services, err
+	c.entriesLock.Unlock()
+	close(entry.readyCh)
+}
+
+// queryArgs returns the arguments of the query for the services of key.
+func (c *ServiceCache) queryArgs(key serviceCacheKey) structs.ServiceRegistrationByNameRequest {
+	return structs.ServiceRegistrationByNameRequest{
+		ServiceName: key.name,
+		Passing:     true,
+		QueryOptions: structs.QueryOptions{
+			Region:       c.cfg.Region,
+			Namespace:    key.namespace,
+			AllowStale:   true,
+			MaxQueryTime: c.cfg.MaxQueryTime,
+			AuthToken:    c.cfg.NodeSecret,
+		},
+	}
+}
+
+// watch keeps the entry up to date until it's idle or the cache is shut down.
+// Services that have no registrations when first read are not watched, so
+// that lookups of unknown names don't each leave a query running.
+func (c *ServiceCache) watch(key serviceCacheKey, entry *serviceCacheEntry) {
+	log := c.log.With("namespace", key.namespace, "service", key.name)
+	args := c.queryArgs(key)
+
+	timer, stop := helper.NewSafeTimer(0)
+	defer stop()
+
+	var ready bool
+	for {
+		var reply structs.ServiceRegistrationByNameResponse
+		err := c.cfg.RPCFn(structs.ServiceRegistrationGetServiceRPCMethod, &args, &reply)
+
+		select {
+		case <-c.shutdownCh:
+			return
+		default:
+		}
+
+		c.entriesLock.Lock()
+		if err != nil {
+			// keep serving the last known registrations
+			log.Warn("failed to query service registrations", "error", err)
+			entry.err = err
+		} else {
+			entry.services = reply.Services
+			entry.err = nil
+		}
+		idle := time.Since(entry.lastRead) > c.cfg.IdleTimeout
+		unknown := !ready && len(entry.services) == 0
+		if idle || unknown {
+			delete(c.entries, key)
+		}
+		c.entriesLock.Unlock()
+
+		if !ready {
+			close(entry.readyCh)
+			ready = true
+		}
+		if idle || unknown {
+			log.Trace("stopped watching service", "idle", idle)
+			return
+		}
+
+		if err != nil {
+			timer.Reset(helper.RandomStagger(cacheRetryInterval) + cacheRetryInterval)
+			select {
+			case <-timer.C:
+			case <-c.shutdownCh:
+				return
+			}
+			continue
+		}
+
+		// Queries can return stale or unchanged results, so only move the index
+		// forward.
+		if reply.Index > args.MinQueryIndex {
+			args.MinQueryIndex = reply.Index
+		}
+	}
+}
+
+// Shutdown stops keeping services up to date.
+func (c *ServiceCache) Shutdown() {
+	c.shutdownOnce.Do(func() { close(c.shutdownCh) })
+}
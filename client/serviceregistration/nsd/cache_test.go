@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nsd
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
+)
+
+// mockServiceServer answers blocking service queries with the services it's
+// set to.
+type mockServiceServer struct {
+	lock     sync.Mutex
+	index    uint64
+	services []*structs.ServiceRegistration
+	err      error
+	queries  []structs.ServiceRegistrationByNameRequest
+	changeCh chan struct{}
+}
+
+func newMockServiceServer() *mockServiceServer {
+	return &mockServiceServer{index: 1, changeCh: make(chan struct{})}
+}
+
+func (m *mockServiceServer) RPC(method string, args, reply interface{}) error {
+	if method != structs.ServiceRegistrationGetServiceRPCMethod {
+		return errors.New("unexpected method")
+	}
+	req := args.(*structs.ServiceRegistrationByNameRequest)
+
+	m.lock.Lock()
+	m.queries = append(m.queries, *req)
+	if req.MinQueryIndex >= m.index {
+		changeCh := m.changeCh
+		m.lock.Unlock()
+		select {
+		case <-changeCh:
+		case <-time.After(req.MaxQueryTime):
+		}
+		m.lock.Lock()
+	}
+	defer m.lock.Unlock()
+
+	if m.err != nil {
+		return m.err
+	}
+	resp := reply.(*structs.ServiceRegistrationByNameResponse)
+	resp.Services = m.services
+	resp.Index = m.index
+	return nil
+}
+
+func (m *mockServiceServer) set(services []*structs.ServiceRegistration, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.services = services
+	m.err = err
+	m.index++
+	close(m.changeCh)
+	m.changeCh = make(chan struct{})
+}
+
+func (m *mockServiceServer) numQueries() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return len(m.queries)
+}
+
+func TestServiceCache_Services(t *testing.T) {
+	ci.Parallel(t)
+
+	server := newMockServiceServer()
+	web1 := &structs.ServiceRegistration{ID: "web1", ServiceName: "web", Namespace: "platform"}
+	web2 := &structs.ServiceRegistration{ID: "web2", ServiceName: "web", Namespace: "platform"}
+	server.set([]*structs.ServiceRegistration{web1}, nil)
+
+	cache := NewServiceCache(hclog.NewNullLogger(), &ServiceCacheCfg{
+		Region:       "global",
+		NodeSecret:   "secret",
+		RPCFn:        server.RPC,
+		MaxQueryTime: time.Minute,
+	})
+	t.Cleanup(cache.Shutdown)
+
+	// the first read waits for the servers
+	services, err := cache.Services(context.Background(), "platform", "web")
+	must.NoError(t, err)
+	must.Eq(t, []*structs.ServiceRegistration{web1}, services)
+
+	server.lock.Lock()
+	query := server.queries[0]
+	server.lock.Unlock()
+	must.Eq(t, "web", query.ServiceName)
+	must.True(t, query.Passing)
+	must.Eq(t, "platform", query.Namespace)
+	must.Eq(t, "global", query.Region)
+	must.Eq(t, "secret", query.AuthToken)
+	must.True(t, query.AllowStale)
+
+	// changes are picked up by the blocking query
+	server.set([]*structs.ServiceRegistration{web1, web2}, nil)
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			services, err := cache.Services(context.Background(), "platform", "web")
+			return err == nil && len(services) == 2
+		}),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+
+	// the last known services are served along with errors
+	server.set(nil, errors.New("no servers"))
+	must.Wait(t, wait.InitialSuccess(
+		wait.ErrorFunc(func() error {
+			services, err := cache.Services(context.Background(), "platform", "web")
+			if err == nil {
+				return errors.New("expected error")
+			}
+			if len(services) != 2 {
+				return errors.New("expected cached services")
+			}
+			return nil
+		}),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+}
+
+func TestServiceCache_Idle(t *testing.T) {
+	ci.Parallel(t)
+
+	server := newMockServiceServer()
+	server.set([]*structs.ServiceRegistration{{ID: "web1", ServiceName: "web"}}, nil)
+	cache := NewServiceCache(hclog.NewNullLogger(), &ServiceCacheCfg{
+		RPCFn:        server.RPC,
+		MaxQueryTime: 10 * time.Millisecond,
+		IdleTimeout:  50 * time.Millisecond,
+	})
+	t.Cleanup(cache.Shutdown)
+
+	_, err := cache.Services(context.Background(), "default", "web")
+	must.NoError(t, err)
+
+	// idle services are no longer queried
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			cache.entriesLock.Lock()
+			defer cache.entriesLock.Unlock()
+			return len(cache.entries) == 0
+		}),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+	queries := server.numQueries()
+	time.Sleep(50 * time.Millisecond)
+	must.Eq(t, queries, server.numQueries())
+
+	// and are queried again when read
+	_, err = cache.Services(context.Background(), "default", "web")
+	must.NoError(t, err)
+	must.Greater(t, queries, server.numQueries())
+}
+
+func TestServiceCache_Unwatched(t *testing.T) {
+	ci.Parallel(t)
+
+	server := newMockServiceServer()
+	cache := NewServiceCache(hclog.NewNullLogger(), &ServiceCacheCfg{
+		RPCFn:        server.RPC,
+		MaxQueryTime: time.Minute,
+		MaxEntries:   1,
+	})
+	t.Cleanup(cache.Shutdown)
+
+	numEntries := func() int {
+		cache.entriesLock.Lock()
+		defer cache.entriesLock.Unlock()
+		return len(cache.entries)
+	}
+
+	// services without registrations are not watched
+	services, err := cache.Services(context.Background(), "default", "unknown")
+	must.NoError(t, err)
+	must.Len(t, 0, services)
+	must.Zero(t, numEntries())
+
+	// reads beyond the maximum number of entries are not cached
+	web1 := &structs.ServiceRegistration{ID: "web1", ServiceName: "web"}
+	server.set([]*structs.ServiceRegistration{web1}, nil)
+	_, err = cache.Services(context.Background(), "default", "web")
+	must.NoError(t, err)
+	must.Eq(t, 1, numEntries())
+
+	// wait for the blocking query of the cached service
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool { return server.numQueries() == 3 }),
+		wait.Timeout(5*time.Second),
+		wait.Gap(10*time.Millisecond),
+	))
+	queries := server.numQueries()
+	services, err = cache.Services(context.Background(), "default", "api")
+	must.NoError(t, err)
+	must.Eq(t, []*structs.ServiceRegistration{web1}, services)
+	must.Eq(t, 1, numEntries())
+	must.Eq(t, queries+1, server.numQueries())
+
+	// fetched services are read without blocking
+	server.lock.Lock()
+	must.Zero(t, server.queries[queries].MinQueryIndex)
+	server.lock.Unlock()
+}
+
+func TestServiceCache_Cancel(t *testing.T) {
+	ci.Parallel(t)
+
+	// the servers never respond
+	blockCh := make(chan struct{})
+	t.Cleanup(func() { close(blockCh) })
+	rpcFn := func(string, interface{}, interface{}) error {
+		<-blockCh
+		return errors.New("shut down")
+	}
+
+	cache := NewServiceCache(hclog.NewNullLogger(), &ServiceCacheCfg{RPCFn: rpcFn})
+	t.Cleanup(cache.Shutdown)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := cache.Services(ctx, "default", "web")
+	must.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
 	"sync"
 	"time"
@@ -14,7 +15,9 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/nomad/client/serviceregistration"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"golang.org/x/time/rate"
 	"oss.indeed.com/go/libtime/decay"
 )
 
@@ -33,6 +36,22 @@ type ServiceRegistrationHandler struct {
 	// registering new ones.
 	registrationEnabled bool
 
+	// checkStatuses is used to read the status of the checks of services, so
+	// that the aggregate check status of their registrations can be kept up to
+	// date.
+	checkStatuses serviceregistration.CheckStatusGetter
+
+	// checkStatusLimiter limits how often check status changes are written,
+	// so that flapping checks don't cause a Raft write on every change.
+	checkStatusLimiter *rate.Limiter
+
+	// registrations tracks the registrations of services with checks, along
+	// with the IDs of their checks. registrationsLock serializes the RPCs
+	// upserting and deleting them, so that a check status update never
+	// overwrites a newer registration or resurrects a removed one.
+	registrations     map[string]*checkedRegistration
+	registrationsLock sync.Mutex
+
 	// shutDownCh coordinates shutting down the handler and any long-running
 	// processes, such as the RPC retry.
 	shutDownCh chan struct{}
@@ -68,6 +87,20 @@ type ServiceRegistrationHandlerCfg struct {
 	// and restarts associated tasks in accordance with their check_restart block.
 	CheckWatcher serviceregistration.CheckWatcher
 
+	// CheckStatusGetter reads the status of the checks of services in the
+	// Nomad service provider, to report their aggregate status in service
+	// registrations. Check statuses are not reported if it is nil.
+	CheckStatusGetter serviceregistration.CheckStatusGetter
+
+	// CheckStatusInterval is how often changes in check statuses are looked
+	// for, defaults to 1s
+	CheckStatusInterval time.Duration
+
+	// CheckStatusUpdateInterval is the minimum time between updates of the
+	// check status of registrations, defaults to 10s. Changes within the
+	// interval are batched, and only the latest status is written.
+	CheckStatusUpdateInterval time.Duration
+
 	// BackoffMax is the maximum amont of time failed RemoveWorkload RPCs will
 	// be retried, defaults to 1s
 	BackoffMax time.Duration
@@ -89,6 +122,8 @@ func NewServiceRegistrationHandler(log hclog.Logger, cfg *ServiceRegistrationHan
 		log:                 log.Named("service_registration.nomad"),
 		registrationEnabled: cfg.Enabled,
 		checkWatcher:        cfg.CheckWatcher,
+		checkStatuses:       cfg.CheckStatusGetter,
+		registrations:       make(map[string]*checkedRegistration),
 		shutDownCh:          make(chan struct{}),
 		backoffMax:          cfg.BackoffMax,
 		backoffInitial:      cfg.BackoffInitial,
@@ -99,9 +134,28 @@ func NewServiceRegistrationHandler(log hclog.Logger, cfg *ServiceRegistrationHan
 	if s.backoffMax == 0 {
 		s.backoffMax = time.Second
 	}
+	if s.checkStatuses != nil {
+		interval := cfg.CheckStatusInterval
+		if interval == 0 {
+			interval = time.Second
+		}
+		updateInterval := cfg.CheckStatusUpdateInterval
+		if updateInterval == 0 {
+			updateInterval = 10 * time.Second
+		}
+		s.checkStatusLimiter = rate.NewLimiter(rate.Every(updateInterval), 1)
+		go s.syncCheckStatuses(interval)
+	}
 	return s
 }
 
+// checkedRegistration is a service registration with checks, along with the
+// IDs of its checks.
+type checkedRegistration struct {
+	registration *structs.ServiceRegistration
+	checkIDs     []string
+}
+
 func (s *ServiceRegistrationHandler) RegisterWorkload(workload *serviceregistration.WorkloadServices) error {
 	// Check whether we are enabled or not first. Hitting this likely means
 	// there is a bug within the implicit constraint, or process using it, as
@@ -114,6 +168,8 @@ func (s *ServiceRegistrationHandler) RegisterWorkload(workload *serviceregistrat
 	var mErr multierror.Error
 
 	registrations := make([]*structs.ServiceRegistration, len(workload.Services))
+	checked := make([]*checkedRegistration, 0, len(workload.Services))
+	statuses := s.getCheckStatuses()
 
 	// Iterate over the services and generate a hydrated registration object for
 	// each. All services are part of a single allocation, therefore we cannot
@@ -124,6 +180,18 @@ func (s *ServiceRegistrationHandler) RegisterWorkload(workload *serviceregistrat
 			mErr.Errors = append(mErr.Errors, err)
 		} else if mErr.ErrorOrNil() == nil {
 			registrations[i] = serviceRegistration
+
+			if statuses != nil && len(serviceSpec.Checks) > 0 {
+				checkIDs := make([]string, len(serviceSpec.Checks))
+				for j, check := range serviceSpec.Checks {
+					checkIDs[j] = string(structs.NomadCheckID(workload.AllocInfo.AllocID, workload.AllocInfo.Group, check))
+				}
+				serviceRegistration.CheckStatus = aggregateCheckStatus(statuses, checkIDs)
+				checked = append(checked, &checkedRegistration{
+					registration: serviceRegistration.Copy(),
+					checkIDs:     checkIDs,
+				})
+			}
 		}
 	}
 
@@ -154,7 +222,23 @@ func (s *ServiceRegistrationHandler) RegisterWorkload(workload *serviceregistrat
 
 	var resp structs.ServiceRegistrationUpsertResponse
 
-	return s.cfg.RPCFn(structs.ServiceRegistrationUpsertRPCMethod, &args, &resp)
+	s.registrationsLock.Lock()
+	defer s.registrationsLock.Unlock()
+
+	if err := s.cfg.RPCFn(structs.ServiceRegistrationUpsertRPCMethod, &args, &resp); err != nil {
+		return err
+	}
+
+	// Track the registrations with checks so their check status is updated
+	// as their checks change, and stop tracking those that no longer have
+	// checks.
+	for _, registration := range registrations {
+		delete(s.registrations, registration.ID)
+	}
+	for _, c := range checked {
+		s.registrations[c.registration.ID] = c
+	}
+	return nil
 }
 
 // RemoveWorkload iterates the services and removes them from the service
@@ -196,6 +280,11 @@ func (s *ServiceRegistrationHandler) removeWorkload(
 	// Generate the consistent ID for this service, so we know what to remove.
 	id := serviceregistration.MakeAllocServiceID(workload.AllocInfo.AllocID, workload.Name(), serviceSpec)
 
+	// Stop updating the check status of the registration.
+	s.registrationsLock.Lock()
+	delete(s.registrations, id)
+	s.registrationsLock.Unlock()
+
 	deleteArgs := structs.ServiceRegistrationDeleteByIDRequest{
 		ID: id,
 		WriteRequest: structs.WriteRequest{
@@ -377,6 +466,15 @@ func (s *ServiceRegistrationHandler) generateNomadServiceRegistration(
 		copy(tags, serviceSpec.Tags)
 	}
 
+	// Build the meta in the same way as the tags.
+	var meta map[string]string
+
+	if workload.Canary && len(serviceSpec.CanaryMeta) > 0 {
+		meta = maps.Clone(serviceSpec.CanaryMeta)
+	} else if len(serviceSpec.Meta) > 0 {
+		meta = maps.Clone(serviceSpec.Meta)
+	}
+
 	return &structs.ServiceRegistration{
 		ID:          serviceregistration.MakeAllocServiceID(workload.AllocInfo.AllocID, workload.Name(), serviceSpec),
 		ServiceName: serviceSpec.Name,
@@ -386,7 +484,103 @@ func (s *ServiceRegistrationHandler) generateNomadServiceRegistration(
 		Namespace:   workload.ProviderNamespace,
 		Datacenter:  s.cfg.Datacenter,
 		Tags:        tags,
+		Meta:        meta,
 		Address:     ip,
 		Port:        port,
 	}, nil
 }
+
+// getCheckStatuses returns the current status of every check, or nil if check
+// statuses are not reported.
+func (s *ServiceRegistrationHandler) getCheckStatuses() map[string]string {
+	if s.checkStatuses == nil {
+		return nil
+	}
+	statuses, err := s.checkStatuses.Get()
+	if err != nil {
+		s.log.Warn("failed to get check statuses", "error", err)
+		return nil
+	}
+	return statuses
+}
+
+// syncCheckStatuses periodically updates the registrations of services whose
+// aggregate check status changed, until the handler is shut down.
+func (s *ServiceRegistrationHandler) syncCheckStatuses(interval time.Duration) {
+	timer, stop := helper.NewSafeTimer(interval)
+	defer stop()
+
+	for {
+		select {
+		case <-s.shutDownCh:
+			return
+		case <-timer.C:
+			s.updateCheckStatuses(time.Now())
+			timer.Reset(interval)
+		}
+	}
+}
+
+// updateCheckStatuses upserts the registrations whose aggregate check status
+// changed since they were last registered. Changes are left for a later call
+// if the registrations were updated too recently, and registrations that fail
+// to update are retried on the next call.
+func (s *ServiceRegistrationHandler) updateCheckStatuses(now time.Time) {
+	statuses := s.getCheckStatuses()
+	if statuses == nil {
+		return
+	}
+
+	s.registrationsLock.Lock()
+	defer s.registrationsLock.Unlock()
+
+	var updates []*structs.ServiceRegistration
+	for _, c := range s.registrations {
+		status := aggregateCheckStatus(statuses, c.checkIDs)
+		if status == c.registration.CheckStatus {
+			continue
+		}
+		update := c.registration.Copy()
+		update.CheckStatus = status
+		updates = append(updates, update)
+	}
+	if len(updates) == 0 || !s.checkStatusLimiter.AllowN(now, 1) {
+		return
+	}
+
+	args := structs.ServiceRegistrationUpsertRequest{
+		Services: updates,
+		WriteRequest: structs.WriteRequest{
+			Region:    s.cfg.Region,
+			AuthToken: s.cfg.NodeSecret,
+		},
+	}
+	var resp structs.ServiceRegistrationUpsertResponse
+
+	if err := s.cfg.RPCFn(structs.ServiceRegistrationUpsertRPCMethod, &args, &resp); err != nil {
+		s.log.Warn("failed to update check status of service registrations", "error", err)
+		return
+	}
+
+	for _, update := range updates {
+		s.registrations[update.ID].registration = update
+	}
+}
+
+// aggregateCheckStatus returns the status of a service given the statuses of
+// its checks: failure if any check is failing, success if all checks are
+// passing, and pending otherwise.
+func aggregateCheckStatus(statuses map[string]string, checkIDs []string) structs.CheckStatus {
+	status := structs.CheckSuccess
+	for _, id := range checkIDs {
+		switch structs.CheckStatus(statuses[id]) {
+		case structs.CheckSuccess:
+		case structs.CheckFailure:
+			return structs.CheckFailure
+		default:
+			// pending, or not observed yet
+			status = structs.CheckPending
+		}
+	}
+	return status
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"sync"
 	"testing"
 	"time"
@@ -107,6 +108,112 @@ func TestServiceRegistrationHandler_RegisterWorkload(t *testing.T) {
 	}
 }
 
+// mockCheckStatuses is a CheckStatusGetter returning the statuses it's set to.
+type mockCheckStatuses struct {
+	lock     sync.Mutex
+	statuses map[string]string
+}
+
+func (m *mockCheckStatuses) Get() (map[string]string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return maps.Clone(m.statuses), nil
+}
+
+func (m *mockCheckStatuses) set(checkID string, status structs.CheckStatus) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.statuses[checkID] = string(status)
+}
+
+func TestServiceRegistrationHandler_CheckStatus(t *testing.T) {
+	workload := mockWorkload()
+	workload.Services[1].Meta = map[string]string{"weight": "2"}
+	checkID := string(structs.NomadCheckID(workload.AllocInfo.AllocID,
+		workload.AllocInfo.Group, workload.Services[1].Checks[0]))
+
+	statuses := &mockCheckStatuses{statuses: map[string]string{}}
+
+	// record the registrations of every upsert
+	var upserts [][]*structs.ServiceRegistration
+	rpcFn := func(method string, args, _ interface{}) error {
+		switch method {
+		case structs.ServiceRegistrationUpsertRPCMethod:
+			upserts = append(upserts, args.(*structs.ServiceRegistrationUpsertRequest).Services)
+		}
+		return nil
+	}
+
+	h := NewServiceRegistrationHandler(hclog.NewNullLogger(), &ServiceRegistrationHandlerCfg{
+		Enabled:                   true,
+		CheckWatcher:              new(mockCheckWatcher),
+		CheckStatusGetter:         statuses,
+		CheckStatusInterval:       time.Hour, // updated manually below
+		CheckStatusUpdateInterval: 10 * time.Second,
+		RPCFn:                     rpcFn,
+	}).(*ServiceRegistrationHandler)
+
+	// now advances the time of the updates by offset
+	start := time.Now()
+	now := func(offset time.Duration) time.Time {
+		start = start.Add(offset)
+		return start
+	}
+	t.Cleanup(h.Shutdown)
+
+	// services are registered with the status of their checks, and meta
+	must.NoError(t, h.RegisterWorkload(workload))
+	must.Len(t, 1, upserts)
+	must.Len(t, 2, upserts[0])
+	must.Eq(t, "", upserts[0][0].CheckStatus)
+	must.Eq(t, structs.CheckPending, upserts[0][1].CheckStatus)
+	must.Eq(t, map[string]string{"weight": "2"}, upserts[0][1].Meta)
+
+	// nothing is updated while the status of the checks is unchanged
+	h.updateCheckStatuses(now(10 * time.Second))
+	must.Len(t, 1, upserts)
+
+	// services are updated when the status of their checks changes
+	statuses.set(checkID, structs.CheckSuccess)
+	h.updateCheckStatuses(now(0))
+	must.Len(t, 2, upserts)
+	must.Len(t, 1, upserts[1])
+	must.Eq(t, upserts[0][1].ID, upserts[1][0].ID)
+	must.Eq(t, structs.CheckSuccess, upserts[1][0].CheckStatus)
+	must.Eq(t, map[string]string{"weight": "2"}, upserts[1][0].Meta)
+
+	// changes are not written again until the update interval passes, and
+	// then only the latest status is written
+	statuses.set(checkID, structs.CheckFailure)
+	h.updateCheckStatuses(now(time.Second))
+	must.Len(t, 2, upserts)
+	statuses.set(checkID, structs.CheckPending)
+	h.updateCheckStatuses(now(time.Second))
+	must.Len(t, 2, upserts)
+	h.updateCheckStatuses(now(8 * time.Second))
+	must.Len(t, 3, upserts)
+	must.Eq(t, structs.CheckPending, upserts[2][0].CheckStatus)
+
+	// removed services are no longer updated
+	h.RemoveWorkload(workload)
+	statuses.set(checkID, structs.CheckSuccess)
+	h.updateCheckStatuses(now(10 * time.Second))
+	must.Len(t, 3, upserts)
+}
+
+func TestServiceRegistrationHandler_aggregateCheckStatus(t *testing.T) {
+	statuses := map[string]string{
+		"ok":      string(structs.CheckSuccess),
+		"fail":    string(structs.CheckFailure),
+		"pending": string(structs.CheckPending),
+	}
+
+	must.Eq(t, structs.CheckSuccess, aggregateCheckStatus(statuses, []string{"ok"}))
+	must.Eq(t, structs.CheckPending, aggregateCheckStatus(statuses, []string{"ok", "pending"}))
+	must.Eq(t, structs.CheckPending, aggregateCheckStatus(statuses, []string{"ok", "unknown"}))
+	must.Eq(t, structs.CheckFailure, aggregateCheckStatus(statuses, []string{"pending", "fail", "ok"}))
+}
+
 func TestServiceRegistrationHandler_RemoveWorkload(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -3,6 +3,8 @@
 
 package structs
 
+import "github.com/hashicorp/nomad/nomad/structs"
+
 type HostVolumeState struct {
 	ID        string
 	HostPath  string
@@ -39,6 +41,14 @@ type ClientHostVolumeCreateRequest struct {
 
 	// Parameters are an opaque map of parameters for the host volume plugin.
 	Parameters map[string]string
+
+	// SourceVolumeID is the ID of a volume on the same node to clone the new
+	// volume from.
+	SourceVolumeID string
+
+	// SnapshotID is the ID of a snapshot of the source volume to create the
+	// new volume from, instead of the current contents of the source volume.
+	SnapshotID string
 }
 
 type ClientHostVolumeCreateResponse struct {
@@ -113,3 +123,79 @@ type ClientHostVolumeDeleteResponse struct {
 	VolumeName string
 	VolumeID   string
 }
+
+type ClientHostVolumeSnapshotCreateRequest struct {
+	// ID is a UUID-like string generated by the server for the snapshot.
+	ID string
+
+	// Name is the name given to the snapshot by the user.
+	Name string
+
+	// VolumeID is the ID of the volume to snapshot.
+	VolumeID string
+
+	VolumeName string
+
+	// PluginID is the name of the host volume plugin that created the volume.
+	PluginID string
+
+	// Namespace is the Nomad namespace for the volume.
+	// It's in the client RPC to be included in plugin execution environment.
+	Namespace string
+
+	// NodeID is the node where the volume is placed. It's included in the
+	// client RPC request so that the server can route the request to the
+	// correct node.
+	NodeID string
+
+	// HostPath is the host path where the volume's mount point was created.
+	HostPath string
+
+	// Parameters are the parameters of the volume.
+	Parameters map[string]string
+
+	// SnapshotParameters are an opaque map of parameters for the host volume
+	// plugin to create the snapshot.
+	SnapshotParameters map[string]string
+}
+
+type ClientHostVolumeSnapshotCreateResponse struct {
+	Snapshot *structs.HostVolumeSnapshot
+}
+
+type ClientHostVolumeSnapshotListRequest struct {
+	// VolumeID is the ID of the volume to list the snapshots of.
+	VolumeID string
+
+	VolumeName string
+	PluginID   string
+	Namespace  string
+	NodeID     string
+	HostPath   string
+
+	// Parameters are the parameters of the volume.
+	Parameters map[string]string
+}
+
+type ClientHostVolumeSnapshotListResponse struct {
+	Snapshots []*structs.HostVolumeSnapshot
+}
+
+type ClientHostVolumeSnapshotDeleteRequest struct {
+	// ID is the ID of the snapshot to delete.
+	ID string
+
+	// VolumeID is the ID of the volume the snapshot was taken from.
+	VolumeID string
+
+	VolumeName string
+	PluginID   string
+	Namespace  string
+	NodeID     string
+	HostPath   string
+
+	// Parameters are the parameters of the volume.
+	Parameters map[string]string
+}
+
+type ClientHostVolumeSnapshotDeleteResponse struct{}
@@ -292,6 +292,19 @@ type ResourceUsage struct {
 	MemoryStats *MemoryStats
 	CpuStats    *CpuStats
 	DeviceStats []*device.DeviceGroupStats
+
+	// Process identifies the process when the usage is of a single process
+	// of a task. It is only set by drivers tracking the task's process tree.
+	Process *ProcessInfo
+}
+
+// ProcessInfo identifies a process in the process tree of a task
+type ProcessInfo struct {
+	// PPid is the process ID of the parent process
+	PPid int
+
+	// Command is the command line of the process
+	Command string
 }
 
 func (ru *ResourceUsage) Add(other *ResourceUsage) {
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"io"
 	golog "log"
+	"maps"
 	"net"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -274,6 +276,11 @@ func convertServerConfig(agentConfig *Config) (*nomad.Config, error) {
 		conf.EnabledSchedulers = schedulers
 
 	}
+	for _, name := range agentConfig.Server.ScorerPlugins {
+		if !slices.Contains(conf.ScorerPlugins, name) {
+			conf.ScorerPlugins = append(conf.ScorerPlugins, name)
+		}
+	}
 	if agentConfig.ACL.Enabled {
 		conf.ACLEnabled = true
 	}
@@ -579,6 +586,47 @@ func convertServerConfig(agentConfig *Config) (*nomad.Config, error) {
 		}
 	}
 
+	// Set node pool rebalance configuration.
+	if rebalanceConf := agentConfig.Server.NodePoolRebalance; rebalanceConf != nil {
+		if rebalanceConf.Enabled != nil {
+			conf.NodePoolRebalanceEnabled = *rebalanceConf.Enabled
+		}
+		if rebalanceConf.Interval != "" {
+			dur, err := time.ParseDuration(rebalanceConf.Interval)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse node_pool_rebalance.interval: %v", err)
+			} else if dur <= time.Duration(0) {
+				return nil, fmt.Errorf("node_pool_rebalance.interval should be greater than 0s")
+			}
+			conf.NodePoolRebalanceInterval = dur
+		}
+		if threshold := rebalanceConf.UtilizationThreshold; threshold != 0 {
+			if threshold < 0 || threshold > 1 {
+				return nil, fmt.Errorf("node_pool_rebalance.utilization_threshold must be between 0 and 1")
+			}
+			conf.NodePoolRebalanceUtilizationThreshold = threshold
+		}
+		if maxAllocs := rebalanceConf.MaxAllocs; maxAllocs != 0 {
+			if maxAllocs < 0 {
+				return nil, fmt.Errorf("node_pool_rebalance.max_allocs must be greater than 0")
+			}
+			conf.NodePoolRebalanceMaxAllocs = maxAllocs
+		}
+	}
+
+	// Set eval broker fair share configuration.
+	if fairShareConf := agentConfig.Server.EvalFairShare; fairShareConf != nil {
+		if fairShareConf.Enabled != nil {
+			conf.EvalFairShareEnabled = *fairShareConf.Enabled
+		}
+		for ns, weight := range fairShareConf.NamespaceWeights {
+			if weight < 1 {
+				return nil, fmt.Errorf("eval_fair_share.namespace_weights for namespace %q must be greater than 0", ns)
+			}
+		}
+		conf.EvalFairShareWeights = maps.Clone(fairShareConf.NamespaceWeights)
+	}
+
 	// Add Enterprise license configs
 	conf.LicenseConfig = &nomad.LicenseConfig{
 		BuildDate:         agentConfig.Version.BuildDate,
@@ -770,6 +818,9 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 	if agentConfig.Client.DiskFreeMB != 0 {
 		conf.DiskFreeMB = agentConfig.Client.DiskFreeMB
 	}
+	if agentConfig.Client.DiskIOPS != 0 {
+		conf.DiskIOPS = agentConfig.Client.DiskIOPS
+	}
 	if agentConfig.Client.MaxKillTimeout != "" {
 		dur, err := time.ParseDuration(agentConfig.Client.MaxKillTimeout)
 		if err != nil {
@@ -951,6 +1002,12 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 	}
 	conf.Drain = drainConfig
 
+	dnsConfig, err := clientconfig.DNSConfigFromAgent(agentConfig.Client.DNS)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dns config: %v", err)
+	}
+	conf.DNS = dnsConfig
+
 	conf.Users = clientconfig.UsersConfigFromAgent(agentConfig.Client.Users)
 
 	return conf, nil
@@ -979,6 +1036,15 @@ func (a *Agent) setupServer() error {
 		return fmt.Errorf("failed to configure keyring: %v", err)
 	}
 
+	// Scorer plugins are loaded by the same plugin loaders as the client
+	// plugins, so they must be setup before the server is created.
+	if len(conf.ScorerPlugins) > 0 {
+		if err := a.setupPlugins(); err != nil {
+			return err
+		}
+		conf.PluginLoader = a.pluginLoader
+	}
+
 	// Create the server
 	server, err := nomad.NewServer(conf,
 		a.consulCatalog,           // self service discovery
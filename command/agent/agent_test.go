@@ -17,6 +17,7 @@ import (
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/nomad/structs/config"
 	"github.com/hashicorp/nomad/testutil"
@@ -448,6 +449,146 @@ func TestAgent_ServerConfig_PlanRejectionTracker(t *testing.T) {
 	}
 }
 
+func TestAgent_ServerConfig_NodePoolRebalance(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name            string
+		rebalanceConfig *NodePoolRebalance
+		expectedErr     string
+		check           func(*testing.T, *nomad.Config)
+	}{
+		{
+			name:            "default",
+			rebalanceConfig: nil,
+			check: func(t *testing.T, c *nomad.Config) {
+				must.False(t, c.NodePoolRebalanceEnabled)
+				must.Eq(t, 10*time.Minute, c.NodePoolRebalanceInterval)
+				must.Eq(t, 0.25, c.NodePoolRebalanceUtilizationThreshold)
+				must.Eq(t, 10, c.NodePoolRebalanceMaxAllocs)
+			},
+		},
+		{
+			name: "valid config",
+			rebalanceConfig: &NodePoolRebalance{
+				Enabled:              pointer.Of(true),
+				Interval:             "30m",
+				UtilizationThreshold: 0.5,
+				MaxAllocs:            3,
+			},
+			check: func(t *testing.T, c *nomad.Config) {
+				must.True(t, c.NodePoolRebalanceEnabled)
+				must.Eq(t, 30*time.Minute, c.NodePoolRebalanceInterval)
+				must.Eq(t, 0.5, c.NodePoolRebalanceUtilizationThreshold)
+				must.Eq(t, 3, c.NodePoolRebalanceMaxAllocs)
+			},
+		},
+		{
+			name: "invalid interval",
+			rebalanceConfig: &NodePoolRebalance{
+				Interval: "-1m",
+			},
+			expectedErr: "node_pool_rebalance.interval should be greater than 0s",
+		},
+		{
+			name: "invalid threshold",
+			rebalanceConfig: &NodePoolRebalance{
+				UtilizationThreshold: 1.5,
+			},
+			expectedErr: "node_pool_rebalance.utilization_threshold must be between 0 and 1",
+		},
+		{
+			name: "invalid max allocs",
+			rebalanceConfig: &NodePoolRebalance{
+				MaxAllocs: -1,
+			},
+			expectedErr: "node_pool_rebalance.max_allocs must be greater than 0",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := DevConfig(nil)
+			must.NoError(t, config.normalizeAddrs())
+			config.Server.NodePoolRebalance = tc.rebalanceConfig
+
+			serverConfig, err := convertServerConfig(config)
+			if tc.expectedErr != "" {
+				must.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			must.NoError(t, err)
+			tc.check(t, serverConfig)
+		})
+	}
+}
+
+func TestAgent_ServerConfig_EvalFairShare(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name            string
+		fairShareConfig *EvalFairShare
+		expectedErr     string
+		check           func(*testing.T, *nomad.Config)
+	}{
+		{
+			name:            "default",
+			fairShareConfig: nil,
+			check: func(t *testing.T, c *nomad.Config) {
+				must.False(t, c.EvalFairShareEnabled)
+				must.MapEmpty(t, c.EvalFairShareWeights)
+			},
+		},
+		{
+			name: "valid config",
+			fairShareConfig: &EvalFairShare{
+				Enabled:          pointer.Of(true),
+				NamespaceWeights: map[string]int{"prod": 3},
+			},
+			check: func(t *testing.T, c *nomad.Config) {
+				must.True(t, c.EvalFairShareEnabled)
+				must.Eq(t, map[string]int{"prod": 3}, c.EvalFairShareWeights)
+			},
+		},
+		{
+			name: "invalid weight",
+			fairShareConfig: &EvalFairShare{
+				NamespaceWeights: map[string]int{"prod": 0},
+			},
+			expectedErr: `eval_fair_share.namespace_weights for namespace "prod" must be greater than 0`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := DevConfig(nil)
+			must.NoError(t, config.normalizeAddrs())
+			config.Server.EvalFairShare = tc.fairShareConfig
+
+			serverConfig, err := convertServerConfig(config)
+			if tc.expectedErr != "" {
+				must.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			must.NoError(t, err)
+			tc.check(t, serverConfig)
+		})
+	}
+}
+
+func TestAgent_ServerConfig_ScorerPlugins(t *testing.T) {
+	ci.Parallel(t)
+
+	config := DevConfig(nil)
+	must.NoError(t, config.normalizeAddrs())
+	config.Server.ScorerPlugins = []string{"rack-power", "spot-risk", "rack-power"}
+
+	serverConfig, err := convertServerConfig(config)
+	must.NoError(t, err)
+	must.Eq(t, []string{"rack-power", "spot-risk"}, serverConfig.ScorerPlugins)
+}
+
 func TestAgent_ServerConfig_RaftMultiplier_Ok(t *testing.T) {
 	ci.Parallel(t)
 
@@ -156,9 +156,19 @@ func (s *HTTPServer) allocStop(allocID string, resp http.ResponseWriter, req *ht
 		}
 	}
 
+	checkpoint := false
+	if checkpointQS := req.URL.Query().Get("checkpoint"); checkpointQS != "" {
+		var err error
+		checkpoint, err = strconv.ParseBool(checkpointQS)
+		if err != nil {
+			return nil, fmt.Errorf("checkpoint value is not a boolean: %v", err)
+		}
+	}
+
 	sr := &structs.AllocStopRequest{
 		AllocID:         allocID,
 		NoShutdownDelay: noShutdownDelay,
+		Checkpoint:      checkpoint,
 	}
 	s.parseWriteRequest(req, &sr.WriteRequest)
 
@@ -466,6 +476,10 @@ func (s *HTTPServer) allocPauseSet(allocID string, resp http.ResponseWriter, req
 		args.ScheduleState = structs.TaskScheduleStateForceRun
 	case "scheduled":
 		args.ScheduleState = structs.TaskScheduleStateSchedResume
+	case "hibernate":
+		args.ScheduleState = structs.TaskScheduleStateHibernate
+	case "wake":
+		args.ScheduleState = structs.TaskScheduleStateWake
 	default:
 		return nil, CodedError(400, "Not a valid task schedule state")
 	}
@@ -290,6 +290,10 @@ type ClientConfig struct {
 	// DiskFreeMB is used to override any detected or default free disk space.
 	DiskFreeMB int `hcl:"disk_free_mb"`
 
+	// DiskIOPS is the disk IO operations per second capacity of the node
+	// that allocations can request.
+	DiskIOPS int `hcl:"disk_iops"`
+
 	// ReservableCores is used to override detected reservable cpu cores.
 	ReservableCores string `hcl:"reservable_cores"`
 
@@ -409,6 +413,9 @@ type ClientConfig struct {
 	// Users is used to configure parameters around operating system users.
 	Users *config.UsersConfig `hcl:"users"`
 
+	// DNS configures the DNS server for Nomad native services.
+	DNS *config.DNSConfig `hcl:"dns"`
+
 	// ExtraKeysHCL is used by hcl to surface unexpected keys
 	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
 }
@@ -433,6 +440,7 @@ func (c *ClientConfig) Copy() *ClientConfig {
 	nc.Artifact = c.Artifact.Copy()
 	nc.Drain = c.Drain.Copy()
 	nc.Users = c.Users.Copy()
+	nc.DNS = c.DNS.Copy()
 	nc.ExtraKeysHCL = slices.Clone(c.ExtraKeysHCL)
 	return &nc
 }
@@ -531,6 +539,11 @@ type ServerConfig struct {
 	// that the workers dequeue for processing.
 	EnabledSchedulers []string `hcl:"enabled_schedulers"`
 
+	// ScorerPlugins is the set of scorer plugins used by the schedulers of
+	// this server to rank nodes. The plugins are loaded from the plugin
+	// directory and configured with the plugin blocks of the agent.
+	ScorerPlugins []string `hcl:"scorer_plugins"`
+
 	// NodeGCThreshold controls how "old" a node must be to be collected by GC.
 	// Age is not the only requirement for a node to be GCed but the threshold
 	// can be used to filter by age.
@@ -667,6 +680,14 @@ type ServerConfig struct {
 	// detects potentially bad nodes.
 	PlanRejectionTracker *PlanRejectionTracker `hcl:"plan_rejection_tracker"`
 
+	// NodePoolRebalance configures the periodic migration of allocations off
+	// lightly utilized nodes to reduce fragmentation within node pools.
+	NodePoolRebalance *NodePoolRebalance `hcl:"node_pool_rebalance"`
+
+	// EvalFairShare configures weighted fair sharing of evaluation dequeues
+	// across namespaces.
+	EvalFairShare *EvalFairShare `hcl:"eval_fair_share"`
+
 	// EnableEventBroker configures whether this server's state store
 	// will generate events for its event stream.
 	EnableEventBroker *bool `hcl:"enable_event_broker"`
@@ -748,11 +769,14 @@ func (s *ServerConfig) Copy() *ServerConfig {
 	ns.RaftMultiplier = pointer.Copy(s.RaftMultiplier)
 	ns.NumSchedulers = pointer.Copy(s.NumSchedulers)
 	ns.EnabledSchedulers = slices.Clone(s.EnabledSchedulers)
+	ns.ScorerPlugins = slices.Clone(s.ScorerPlugins)
 	ns.StartJoin = slices.Clone(s.StartJoin)
 	ns.RetryJoin = slices.Clone(s.RetryJoin)
 	ns.ServerJoin = s.ServerJoin.Copy()
 	ns.DefaultSchedulerConfig = s.DefaultSchedulerConfig.Copy()
 	ns.PlanRejectionTracker = s.PlanRejectionTracker.Copy()
+	ns.NodePoolRebalance = s.NodePoolRebalance.Copy()
+	ns.EvalFairShare = s.EvalFairShare.Copy()
 	ns.EnableEventBroker = pointer.Copy(s.EnableEventBroker)
 	ns.EventBufferSize = pointer.Copy(s.EventBufferSize)
 	ns.JobMaxSourceSize = pointer.Copy(s.JobMaxSourceSize)
@@ -847,6 +871,111 @@ func (p *PlanRejectionTracker) Merge(b *PlanRejectionTracker) *PlanRejectionTrac
 	return &result
 }
 
+// NodePoolRebalance is used in servers to configure the rebalancing of
+// allocations within node pools.
+type NodePoolRebalance struct {
+	// Enabled controls if node pools are periodically rebalanced.
+	Enabled *bool `hcl:"enabled"`
+
+	// Interval is how often node pools are rebalanced.
+	Interval string `hcl:"interval"`
+
+	// UtilizationThreshold is the normalized bin packing score, between 0 and
+	// 1, below which a node is considered a candidate to be emptied.
+	UtilizationThreshold float64 `hcl:"utilization_threshold"`
+
+	// MaxAllocs is the maximum number of allocations migrated by a single
+	// rebalance.
+	MaxAllocs int `hcl:"max_allocs"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+func (r *NodePoolRebalance) Copy() *NodePoolRebalance {
+	if r == nil {
+		return nil
+	}
+
+	nr := *r
+	nr.Enabled = pointer.Copy(r.Enabled)
+	nr.ExtraKeysHCL = slices.Clone(r.ExtraKeysHCL)
+	return &nr
+}
+
+func (r *NodePoolRebalance) Merge(b *NodePoolRebalance) *NodePoolRebalance {
+	if r == nil {
+		return b.Copy()
+	}
+
+	result := r.Copy()
+
+	if b == nil {
+		return result
+	}
+
+	if b.Enabled != nil {
+		result.Enabled = pointer.Copy(b.Enabled)
+	}
+	if b.Interval != "" {
+		result.Interval = b.Interval
+	}
+	if b.UtilizationThreshold != 0 {
+		result.UtilizationThreshold = b.UtilizationThreshold
+	}
+	if b.MaxAllocs != 0 {
+		result.MaxAllocs = b.MaxAllocs
+	}
+	return result
+}
+
+// EvalFairShare is used in servers to configure fair sharing of the eval
+// broker between namespaces.
+type EvalFairShare struct {
+	// Enabled controls if evaluations are dequeued using weighted fair sharing
+	// across namespaces.
+	Enabled *bool `hcl:"enabled"`
+
+	// NamespaceWeights is the relative share of each namespace. Namespaces
+	// without a weight have a weight of 1.
+	NamespaceWeights map[string]int `hcl:"namespace_weights"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+func (e *EvalFairShare) Copy() *EvalFairShare {
+	if e == nil {
+		return nil
+	}
+
+	ne := *e
+	ne.Enabled = pointer.Copy(e.Enabled)
+	ne.NamespaceWeights = maps.Clone(e.NamespaceWeights)
+	ne.ExtraKeysHCL = slices.Clone(e.ExtraKeysHCL)
+	return &ne
+}
+
+func (e *EvalFairShare) Merge(b *EvalFairShare) *EvalFairShare {
+	if e == nil {
+		return b.Copy()
+	}
+
+	result := e.Copy()
+
+	if b == nil {
+		return result
+	}
+
+	if b.Enabled != nil {
+		result.Enabled = pointer.Copy(b.Enabled)
+	}
+	if len(b.NamespaceWeights) != 0 {
+		result.NamespaceWeights = maps.Clone(b.NamespaceWeights)
+	}
+	return result
+}
+
 // Search is used in servers to configure search API options.
 type Search struct {
 	// FuzzyEnabled toggles whether the FuzzySearch API is enabled. If not
@@ -2246,6 +2375,14 @@ func (s *ServerConfig) Merge(b *ServerConfig) *ServerConfig {
 		result.PlanRejectionTracker = result.PlanRejectionTracker.Merge(b.PlanRejectionTracker)
 	}
 
+	if b.NodePoolRebalance != nil {
+		result.NodePoolRebalance = result.NodePoolRebalance.Merge(b.NodePoolRebalance)
+	}
+
+	if b.EvalFairShare != nil {
+		result.EvalFairShare = result.EvalFairShare.Merge(b.EvalFairShare)
+	}
+
 	if b.DefaultSchedulerConfig != nil {
 		c := *b.DefaultSchedulerConfig
 		result.DefaultSchedulerConfig = &c
@@ -2297,6 +2434,9 @@ func (s *ServerConfig) Merge(b *ServerConfig) *ServerConfig {
 	// Add the schedulers
 	result.EnabledSchedulers = append(result.EnabledSchedulers, b.EnabledSchedulers...)
 
+	// Add the scorer plugins
+	result.ScorerPlugins = append(result.ScorerPlugins, b.ScorerPlugins...)
+
 	// Copy the start join addresses
 	result.StartJoin = make([]string, 0, len(s.StartJoin)+len(b.StartJoin))
 	result.StartJoin = append(result.StartJoin, s.StartJoin...)
@@ -2364,6 +2504,9 @@ func (a *ClientConfig) Merge(b *ClientConfig) *ClientConfig {
 	if b.DiskFreeMB != 0 {
 		result.DiskFreeMB = b.DiskFreeMB
 	}
+	if b.DiskIOPS != 0 {
+		result.DiskIOPS = b.DiskIOPS
+	}
 	if b.MaxKillTimeout != "" {
 		result.MaxKillTimeout = b.MaxKillTimeout
 	}
@@ -2498,6 +2641,7 @@ func (a *ClientConfig) Merge(b *ClientConfig) *ClientConfig {
 	result.Artifact = a.Artifact.Merge(b.Artifact)
 	result.Drain = a.Drain.Merge(b.Drain)
 	result.Users = a.Users.Merge(b.Users)
+	result.DNS = a.DNS.Merge(b.DNS)
 
 	return &result
 }
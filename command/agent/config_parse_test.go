@@ -106,6 +106,7 @@ var basicConfig = &Config{
 		RaftMultiplier:            pointer.Of(4),
 		NumSchedulers:             pointer.Of(2),
 		EnabledSchedulers:         []string{"test"},
+		ScorerPlugins:             []string{"rack-power"},
 		NodeGCThreshold:           "12h",
 		EvalGCThreshold:           "12h",
 		JobGCInterval:             "3m",
@@ -1148,6 +1149,7 @@ func TestConfig_Template(t *testing.T) {
 
 			must.Eq(t, []string{"plugin"}, cfg.Client.TemplateConfig.FunctionDenylist)
 			must.True(t, cfg.Client.TemplateConfig.DisableSandbox)
+			must.True(t, cfg.Client.TemplateConfig.NomadServicePassing)
 			must.Eq(t, pointer.Of(7600*time.Hour), cfg.Client.TemplateConfig.MaxStale)
 			must.Eq(t, pointer.Of(10*time.Minute), cfg.Client.TemplateConfig.BlockQueryWaitTime)
 
@@ -93,6 +93,9 @@ func (s *HTTPServer) EvalSpecificRequest(resp http.ResponseWriter, req *http.Req
 	case strings.HasSuffix(path, "/allocations"):
 		evalID := strings.TrimSuffix(path, "/allocations")
 		return s.evalAllocations(resp, req, evalID)
+	case strings.HasSuffix(path, "/trace"):
+		evalID := strings.TrimSuffix(path, "/trace")
+		return s.evalTrace(resp, req, evalID)
 	default:
 		return s.evalQuery(resp, req, path)
 	}
@@ -122,6 +125,30 @@ func (s *HTTPServer) evalAllocations(resp http.ResponseWriter, req *http.Request
 	return out.Allocations, nil
 }
 
+func (s *HTTPServer) evalTrace(resp http.ResponseWriter, req *http.Request, evalID string) (interface{}, error) {
+	if req.Method != http.MethodGet {
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+
+	args := structs.EvalTraceSpecificRequest{
+		EvalID: evalID,
+	}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.SingleEvalTraceResponse
+	if err := s.agent.RPC(structs.EvalTraceGetRPCMethod, &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	if out.Trace == nil {
+		return nil, CodedError(404, "eval trace not found")
+	}
+	return out.Trace, nil
+}
+
 func (s *HTTPServer) evalQuery(resp http.ResponseWriter, req *http.Request, evalID string) (interface{}, error) {
 	if req.Method != http.MethodGet {
 		return nil, CodedError(405, ErrInvalidMethod)
@@ -47,7 +47,12 @@ func (s *HTTPServer) HostVolumeSpecificRequest(resp http.ResponseWriter, req *ht
 	// POST /v1/volume/host/create
 	// PUT /v1/volume/host/register
 	// POST /v1/volume/host/register
+	// PUT /v1/volume/host/:id/snapshot
+	// POST /v1/volume/host/:id/snapshot
 	case http.MethodPut, http.MethodPost:
+		if len(tokens) == 2 && tokens[1] == "snapshot" {
+			return s.hostVolumeSnapshotCreate(tokens[0], resp, req)
+		}
 		switch tokens[0] {
 		case "create", "":
 			return s.hostVolumeCreate(resp, req)
@@ -58,11 +63,19 @@ func (s *HTTPServer) HostVolumeSpecificRequest(resp http.ResponseWriter, req *ht
 		}
 
 	// DELETE /v1/volume/host/:id
+	// DELETE /v1/volume/host/:id/snapshot/:snapshot_id
 	case http.MethodDelete:
+		if len(tokens) == 3 && tokens[1] == "snapshot" {
+			return s.hostVolumeSnapshotDelete(tokens[0], tokens[2], resp, req)
+		}
 		return s.hostVolumeDelete(tokens[0], resp, req)
 
 	// GET /v1/volume/host/:id
+	// GET /v1/volume/host/:id/snapshots
 	case http.MethodGet:
+		if len(tokens) == 2 && tokens[1] == "snapshots" {
+			return s.hostVolumeSnapshotList(tokens[0], resp, req)
+		}
 		return s.hostVolumeGet(tokens[0], resp, req)
 	}
 
@@ -141,3 +154,52 @@ func (s *HTTPServer) hostVolumeDelete(id string, resp http.ResponseWriter, req *
 
 	return out, nil
 }
+
+func (s *HTTPServer) hostVolumeSnapshotCreate(id string, resp http.ResponseWriter, req *http.Request) (any, error) {
+
+	args := structs.HostVolumeSnapshotCreateRequest{}
+	if err := decodeBody(req, &args); err != nil {
+		return err, CodedError(400, err.Error())
+	}
+	args.VolumeID = id
+	s.parseWriteRequest(req, &args.WriteRequest)
+
+	var out structs.HostVolumeSnapshotCreateResponse
+	if err := s.agent.RPC("HostVolume.CreateSnapshot", &args, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+func (s *HTTPServer) hostVolumeSnapshotList(id string, resp http.ResponseWriter, req *http.Request) (any, error) {
+	args := structs.HostVolumeSnapshotListRequest{
+		VolumeID: id,
+	}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.HostVolumeSnapshotListResponse
+	if err := s.agent.RPC("HostVolume.ListSnapshots", &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	return out.Snapshots, nil
+}
+
+func (s *HTTPServer) hostVolumeSnapshotDelete(id, snapID string, resp http.ResponseWriter, req *http.Request) (any, error) {
+	args := structs.HostVolumeSnapshotDeleteRequest{
+		VolumeID:   id,
+		SnapshotID: snapID,
+	}
+	s.parseWriteRequest(req, &args.WriteRequest)
+
+	var out structs.HostVolumeSnapshotDeleteResponse
+	if err := s.agent.RPC("HostVolume.DeleteSnapshot", &args, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -83,6 +83,43 @@ func TestHostVolumeEndpoint_CRUD(t *testing.T) {
 		vols := obj.([]*structs.HostVolumeStub)
 		must.Len(t, 1, vols)
 
+		// Snapshot the volume
+
+		buf = encodeReq(structs.HostVolumeSnapshotCreateRequest{Name: "nightly"})
+		req, err = http.NewRequest(http.MethodPut,
+			fmt.Sprintf("/v1/volume/host/%s/snapshot", volID), buf)
+		must.NoError(t, err)
+		obj, err = s.Server.HostVolumeSpecificRequest(respW, req)
+		must.NoError(t, err)
+		snapResp := obj.(*structs.HostVolumeSnapshotCreateResponse)
+		must.NotNil(t, snapResp.Snapshot)
+		must.Eq(t, "nightly", snapResp.Snapshot.Name)
+		must.Eq(t, volID, snapResp.Snapshot.VolumeID)
+
+		snapID := snapResp.Snapshot.ID
+
+		req, err = http.NewRequest(http.MethodGet,
+			fmt.Sprintf("/v1/volume/host/%s/snapshots", volID), nil)
+		must.NoError(t, err)
+		obj, err = s.Server.HostVolumeSpecificRequest(respW, req)
+		must.NoError(t, err)
+		snaps := obj.([]*structs.HostVolumeSnapshot)
+		must.Len(t, 1, snaps)
+		must.Eq(t, snapID, snaps[0].ID)
+
+		// The volume can't be deleted while it has snapshots
+
+		req, err = http.NewRequest(http.MethodDelete, fmt.Sprintf("/v1/volume/host/%s", volID), nil)
+		must.NoError(t, err)
+		_, err = s.Server.HostVolumeSpecificRequest(respW, req)
+		must.ErrorContains(t, err, "volume has 1 snapshot(s) that must be deleted first")
+
+		req, err = http.NewRequest(http.MethodDelete,
+			fmt.Sprintf("/v1/volume/host/%s/snapshot/%s", volID, snapID), nil)
+		must.NoError(t, err)
+		_, err = s.Server.HostVolumeSpecificRequest(respW, req)
+		must.NoError(t, err)
+
 		// Delete the volume
 
 		req, err = http.NewRequest(http.MethodDelete, fmt.Sprintf("/v1/volume/host/%s", volID), nil)
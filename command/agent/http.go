@@ -227,6 +227,15 @@ func NewHTTPServers(agent *Agent, config *Config) ([]*HTTPServer, error) {
 			Addr:     srv.Addr,
 			Handler:  newAuthMiddleware(srv, srv.mux),
 			ErrorLog: newHTTPServerLogger(srv.logger),
+
+			// The builtin listener is only dialed by templates, while the
+			// Task API serves its own listeners with this server.
+			ConnContext: func(ctx context.Context, c net.Conn) context.Context {
+				if c.LocalAddr().Network() == agent.builtinListener.Addr().Network() {
+					return context.WithValue(ctx, templateRequestKey{}, true)
+				}
+				return ctx
+			},
 		}
 
 		agent.taskAPIServer.SetServer(&httpServer)
@@ -243,6 +252,16 @@ func NewHTTPServers(agent *Agent, config *Config) ([]*HTTPServer, error) {
 	return srvs, nil
 }
 
+// templateRequestKey is the context key set on the requests made by templates
+// through the builtin listener.
+type templateRequestKey struct{}
+
+// isTemplateRequest returns true if the request was made by a template.
+func isTemplateRequest(req *http.Request) bool {
+	fromTemplate, _ := req.Context().Value(templateRequestKey{}).(bool)
+	return fromTemplate
+}
+
 // makeConnState returns a ConnState func for use in an http.Server. If
 // isTLS=true and handshakeTimeout>0 then the handshakeTimeout will be applied
 // as a connection deadline to new connections and removed when the connection
@@ -394,6 +413,9 @@ func (s *HTTPServer) registerHandlers(enableDebug bool) {
 	s.mux.HandleFunc("/v1/node/pools", s.wrap(s.NodePoolsRequest))
 	s.mux.HandleFunc("/v1/node/pool/", s.wrap(s.NodePoolSpecificRequest))
 
+	s.mux.HandleFunc("/v1/reservations", s.wrap(s.ReservationsRequest))
+	s.mux.HandleFunc("/v1/reservation/", s.wrap(s.ReservationSpecificRequest))
+
 	s.mux.HandleFunc("/v1/allocations", s.wrap(s.AllocsRequest))
 	s.mux.HandleFunc("/v1/allocation/", s.wrap(s.AllocSpecificRequest))
 
@@ -1150,6 +1150,7 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 		VaultNamespace: *job.VaultNamespace,
 		Constraints:    ApiConstraintsToStructs(job.Constraints),
 		Affinities:     ApiAffinitiesToStructs(job.Affinities),
+		Gang:           ApiJobGangToStructs(job.Gang),
 		UI:             ApiJobUIConfigToStructs(job.UI),
 		VersionTag:     ApiJobVersionTagToStructs(job.VersionTag),
 	}
@@ -1168,6 +1169,15 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 		}
 	}
 
+	if job.Reservation != nil {
+		j.Reservation = *job.Reservation
+	}
+
+	if job.PreemptionCost != nil {
+		j.PreemptionCost = *job.PreemptionCost
+	}
+	j.PreemptionBudget = ApiPreemptionBudgetToStructs(job.PreemptionBudget)
+
 	if len(job.Spreads) > 0 {
 		j.Spreads = []*structs.Spread{}
 		for _, apiSpread := range job.Spreads {
@@ -1252,6 +1262,10 @@ func ApiTgToStructsTG(job *structs.Job, taskGroup *api.TaskGroup, tg *structs.Ta
 		tg.ShutdownDelay = taskGroup.ShutdownDelay
 	}
 
+	if taskGroup.Checkpoint != nil {
+		tg.Checkpoint = *taskGroup.Checkpoint
+	}
+
 	if taskGroup.ReschedulePolicy != nil {
 		tg.ReschedulePolicy = &structs.ReschedulePolicy{
 			Attempts:      *taskGroup.ReschedulePolicy.Attempts,
@@ -1297,6 +1311,9 @@ func ApiTgToStructsTG(job *structs.Job, taskGroup *api.TaskGroup, tg *structs.Ta
 		SizeMB:  *taskGroup.EphemeralDisk.SizeMB,
 		Migrate: *taskGroup.EphemeralDisk.Migrate,
 	}
+	if taskGroup.EphemeralDisk.IOPS != nil {
+		tg.EphemeralDisk.IOPS = *taskGroup.EphemeralDisk.IOPS
+	}
 
 	if len(taskGroup.Spreads) > 0 {
 		tg.Spreads = []*structs.Spread{}
@@ -2232,6 +2249,31 @@ func ApiAffinitiesToStructs(in []*api.Affinity) []*structs.Affinity {
 	return out
 }
 
+func ApiJobGangToStructs(gang *api.JobGang) *structs.JobGang {
+	if gang == nil {
+		return nil
+	}
+
+	return &structs.JobGang{
+		Groups: slices.Clone(gang.Groups),
+	}
+}
+
+func ApiPreemptionBudgetToStructs(budget *api.PreemptionBudget) *structs.PreemptionBudget {
+	if budget == nil {
+		return nil
+	}
+
+	out := &structs.PreemptionBudget{}
+	if budget.MaxAllocs != nil {
+		out.MaxAllocs = *budget.MaxAllocs
+	}
+	if budget.Window != nil {
+		out.Window = *budget.Window
+	}
+	return out
+}
+
 func ApiJobUIConfigToStructs(jobUI *api.JobUIConfig) *structs.JobUIConfig {
 	if jobUI == nil {
 		return nil
@@ -2280,6 +2322,7 @@ func ApiAffinityToStructs(a1 *api.Affinity) *structs.Affinity {
 func ApiSpreadToStructs(a1 *api.Spread) *structs.Spread {
 	ret := &structs.Spread{}
 	ret.Attribute = a1.Attribute
+	ret.Hierarchy = slices.Clone(a1.Hierarchy)
 	ret.Weight = *a1.Weight
 	if a1.SpreadTarget != nil {
 		ret.SpreadTarget = make([]*structs.SpreadTarget, len(a1.SpreadTarget))
@@ -2650,6 +2650,10 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 		Meta: map[string]string{
 			"foo": "bar",
 		},
+		Gang: &api.JobGang{
+			Groups: []string{"group1"},
+		},
+		Reservation: pointer.Of("batch-window"),
 		Multiregion: &api.Multiregion{
 			Strategy: &api.MultiregionStrategy{
 				MaxParallel: pointer.Of(2),
@@ -2715,6 +2719,10 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 							},
 						},
 					},
+					{
+						Hierarchy: []string{"${meta.zone}", "${meta.rack}"},
+						Weight:    pointer.Of(int8(50)),
+					},
 				},
 				EphemeralDisk: &api.EphemeralDisk{
 					SizeMB:  pointer.Of(100),
@@ -3086,6 +3094,10 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 		Meta: map[string]string{
 			"foo": "bar",
 		},
+		Gang: &structs.JobGang{
+			Groups: []string{"group1"},
+		},
+		Reservation: "batch-window",
 		Multiregion: &structs.Multiregion{
 			Strategy: &structs.MultiregionStrategy{
 				MaxParallel: 2,
@@ -3137,6 +3149,10 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 							},
 						},
 					},
+					{
+						Hierarchy: []string{"${meta.zone}", "${meta.rack}"},
+						Weight:    50,
+					},
 				},
 				ReschedulePolicy: &structs.ReschedulePolicy{
 					Interval:      12 * time.Hour,
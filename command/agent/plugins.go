@@ -11,8 +11,13 @@ import (
 	"github.com/hashicorp/nomad/helper/pluginutils/singleton"
 )
 
-// setupPlugins is used to setup the plugin loaders.
+// setupPlugins is used to setup the plugin loaders. The plugin loaders are
+// shared by the server and client, so it is safe to call multiple times.
 func (a *Agent) setupPlugins() error {
+	if a.pluginLoader != nil {
+		return nil
+	}
+
 	// Get our internal plugins
 	internal, err := a.internalPluginConfigs()
 	if err != nil {
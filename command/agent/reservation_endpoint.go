@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+func (s *HTTPServer) ReservationsRequest(resp http.ResponseWriter, req *http.Request) (any, error) {
+	switch req.Method {
+	case http.MethodGet:
+		return s.reservationList(resp, req)
+	case http.MethodPut, http.MethodPost:
+		return s.reservationUpsert(resp, req, "")
+	default:
+		return nil, CodedError(http.StatusMethodNotAllowed, ErrInvalidMethod)
+	}
+}
+
+func (s *HTTPServer) ReservationSpecificRequest(resp http.ResponseWriter, req *http.Request) (any, error) {
+	name := strings.TrimPrefix(req.URL.Path, "/v1/reservation/")
+	if name == "" {
+		return nil, CodedError(http.StatusBadRequest, "missing reservation name")
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		return s.reservationQuery(resp, req, name)
+	case http.MethodPut, http.MethodPost:
+		return s.reservationUpsert(resp, req, name)
+	case http.MethodDelete:
+		return s.reservationDelete(resp, req, name)
+	default:
+		return nil, CodedError(http.StatusMethodNotAllowed, ErrInvalidMethod)
+	}
+}
+
+func (s *HTTPServer) reservationList(resp http.ResponseWriter, req *http.Request) (any, error) {
+	args := structs.ReservationListRequest{}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.ReservationListResponse
+	if err := s.agent.RPC(structs.ReservationListRPCMethod, &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	if out.Reservations == nil {
+		out.Reservations = make([]*structs.Reservation, 0)
+	}
+	return out.Reservations, nil
+}
+
+func (s *HTTPServer) reservationQuery(resp http.ResponseWriter, req *http.Request, name string) (any, error) {
+	args := structs.ReservationSpecificRequest{
+		Name: name,
+	}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.SingleReservationResponse
+	if err := s.agent.RPC(structs.ReservationGetRPCMethod, &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	if out.Reservation == nil {
+		return nil, CodedError(http.StatusNotFound, "reservation not found")
+	}
+
+	return out.Reservation, nil
+}
+
+func (s *HTTPServer) reservationUpsert(resp http.ResponseWriter, req *http.Request, name string) (any, error) {
+	var res structs.Reservation
+	if err := decodeBody(req, &res); err != nil {
+		return nil, CodedError(http.StatusBadRequest, err.Error())
+	}
+
+	if name != "" && res.Name != name {
+		return nil, CodedError(http.StatusBadRequest, "Reservation name does not match request path")
+	}
+
+	args := structs.ReservationUpsertRequest{
+		Reservations: []*structs.Reservation{&res},
+	}
+	s.parseWriteRequest(req, &args.WriteRequest)
+
+	var out structs.GenericResponse
+	if err := s.agent.RPC(structs.ReservationUpsertRPCMethod, &args, &out); err != nil {
+		return nil, err
+	}
+
+	setIndex(resp, out.Index)
+	return nil, nil
+}
+
+func (s *HTTPServer) reservationDelete(resp http.ResponseWriter, req *http.Request, name string) (any, error) {
+	args := structs.ReservationDeleteRequest{
+		Names: []string{name},
+	}
+	s.parseWriteRequest(req, &args.WriteRequest)
+
+	var out structs.GenericResponse
+	if err := s.agent.RPC(structs.ReservationDeleteRPCMethod, &args, &out); err != nil {
+		return nil, err
+	}
+
+	setIndex(resp, out.Index)
+	return nil, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestHTTP_Reservation_CRUD(t *testing.T) {
+	ci.Parallel(t)
+	httpTest(t, nil, func(s *TestAgent) {
+		res := mock.Reservation()
+		path := fmt.Sprintf("/v1/reservation/%s", res.Name)
+
+		// Create the reservation.
+		req, err := http.NewRequest(http.MethodPut, "/v1/reservations", encodeReq(res))
+		must.NoError(t, err)
+		respW := httptest.NewRecorder()
+		_, err = s.Server.ReservationsRequest(respW, req)
+		must.NoError(t, err)
+		must.NotEq(t, "", respW.Header().Get("X-Nomad-Index"))
+
+		// Read the reservation.
+		req, err = http.NewRequest(http.MethodGet, path, nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		obj, err := s.Server.ReservationSpecificRequest(respW, req)
+		must.NoError(t, err)
+		must.Eq(t, res.Name, obj.(*structs.Reservation).Name)
+
+		// List the reservations.
+		req, err = http.NewRequest(http.MethodGet, "/v1/reservations", nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		obj, err = s.Server.ReservationsRequest(respW, req)
+		must.NoError(t, err)
+		must.SliceLen(t, 1, obj.([]*structs.Reservation))
+
+		// The name in the body must match the path.
+		req, err = http.NewRequest(http.MethodPut, "/v1/reservation/other", encodeReq(res))
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		_, err = s.Server.ReservationSpecificRequest(respW, req)
+		must.ErrorContains(t, err, "does not match")
+
+		// Delete the reservation.
+		req, err = http.NewRequest(http.MethodDelete, path, nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		_, err = s.Server.ReservationSpecificRequest(respW, req)
+		must.NoError(t, err)
+
+		req, err = http.NewRequest(http.MethodGet, path, nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		_, err = s.Server.ReservationSpecificRequest(respW, req)
+		must.ErrorContains(t, err, "not found")
+	})
+}
@@ -101,6 +101,21 @@ func (s *HTTPServer) serviceGetRequest(
 		return nil, nil
 	}
 
+	passing, err := parseBool(req, "passing")
+	if err != nil {
+		return nil, CodedError(http.StatusBadRequest, err.Error())
+	}
+	if passing != nil {
+		args.Passing = *passing
+	} else if isTemplateRequest(req) {
+		// Templates can't set the passing parameter, so the client
+		// configuration decides for them.
+		if clientConfig := s.agent.GetConfig().Client; clientConfig != nil {
+			args.Passing = clientConfig.TemplateConfig != nil &&
+				clientConfig.TemplateConfig.NomadServicePassing
+		}
+	}
+
 	var reply structs.ServiceRegistrationByNameResponse
 	if err := s.agent.RPC(structs.ServiceRegistrationGetServiceRPCMethod, &args, &reply); err != nil {
 		return nil, err
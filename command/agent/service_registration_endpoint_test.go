@@ -4,6 +4,7 @@
 package agent
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -310,6 +311,60 @@ func TestHTTPServer_ServiceRegistrationRequest(t *testing.T) {
 				must.NotEq(t, services2[0], services2[1])
 			},
 		},
+		{
+			name: "get service passing",
+			testFn: func(s *TestAgent) {
+				testState := s.Agent.server.State()
+
+				passing := mock.ServiceRegistrations()[0]
+				passing.CheckStatus = structs.CheckSuccess
+				failing := passing.Copy()
+				failing.ID += "-failing"
+				failing.CheckStatus = structs.CheckFailure
+				must.NoError(t, testState.UpsertServiceRegistrations(
+					structs.MsgTypeTestSetup, 10, []*structs.ServiceRegistration{passing, failing}))
+
+				getFrom := func(fromTemplate bool, query string) []*structs.ServiceRegistration {
+					req, err := http.NewRequest(http.MethodGet, "/v1/service/"+passing.ServiceName+query, nil)
+					must.NoError(t, err)
+					if fromTemplate {
+						req = req.WithContext(context.WithValue(req.Context(), templateRequestKey{}, true))
+					}
+					obj, err := s.Server.ServiceRegistrationRequest(httptest.NewRecorder(), req)
+					must.NoError(t, err)
+					return obj.([]*structs.ServiceRegistration)
+				}
+				get := func(query string) []*structs.ServiceRegistration {
+					return getFrom(false, query)
+				}
+
+				// listing returns all services unless passing is set
+				must.Len(t, 2, get(""))
+				must.Len(t, 2, get("?passing=false"))
+				must.Len(t, 1, get("?passing=true"))
+
+				// choosing only chooses passing services if passing is set
+				must.Len(t, 2, get("?choose=2|abc123"))
+				chosen := get("?choose=2|abc123&passing=true")
+				must.Len(t, 1, chosen)
+				must.Eq(t, passing.ID, chosen[0].ID)
+
+				// templates only get passing services when the client is
+				// configured to, and only templates are affected
+				must.Len(t, 2, getFrom(true, ""))
+				s.Agent.GetConfig().Client.TemplateConfig.NomadServicePassing = true
+				must.Len(t, 1, getFrom(true, ""))
+				must.Len(t, 1, getFrom(true, "?choose=2|abc123"))
+				must.Len(t, 2, getFrom(true, "?passing=false"))
+				must.Len(t, 2, get(""))
+
+				// invalid passing values are rejected
+				req, err := http.NewRequest(http.MethodGet, "/v1/service/"+passing.ServiceName+"?passing=maybe", nil)
+				must.NoError(t, err)
+				_, err = s.Server.ServiceRegistrationRequest(httptest.NewRecorder(), req)
+				must.ErrorContains(t, err, `Failed to parse value of "passing"`)
+			},
+		},
 		{
 			name: "incorrect URI format",
 			testFn: func(s *TestAgent) {
@@ -116,6 +116,7 @@ server {
   raft_protocol                 = 3
   num_schedulers                = 2
   enabled_schedulers            = ["test"]
+  scorer_plugins                = ["rack-power"]
   node_gc_threshold             = "12h"
   job_gc_interval               = "3m"
   job_gc_threshold              = "12h"
@@ -3,9 +3,10 @@
 
 client {
   template {
-    function_denylist    = ["plugin"]
-    disable_file_sandbox = true
-    max_stale            = "7600h"
+    function_denylist     = ["plugin"]
+    disable_file_sandbox  = true
+    nomad_service_passing = true
+    max_stale             = "7600h"
 
     wait {
       min = "10s"
@@ -35,10 +35,12 @@ Pause Specific Options:
 
   -state=<state>
     Specify the schedule state to apply to a task. Must be one of pause, run,
-	or scheduled. When set to pause the task is halted. When set to run the task
-	is started regardless of the task schedule. When in scheduled state the task
-	respects the task schedule state in the task configuration. Defaults to
-	pause.
+	scheduled, hibernate, or wake. When set to pause the task is halted. When
+	set to run the task is started regardless of the task schedule. When in
+	scheduled state the task respects the task schedule state in the task
+	configuration. When set to hibernate the running task is frozen by its
+	driver, keeping its memory but releasing the CPU, until it is set to wake.
+	Defaults to pause.
 
   -status
     Get the current task schedule state status.
@@ -88,10 +90,10 @@ func (c *AllocPauseCommand) Run(args []string) int {
 	}
 
 	// Ensure the specified action is valid
-	actions := []string{"pause", "run", "scheduled"}
+	actions := []string{"pause", "run", "scheduled", "hibernate", "wake"}
 	if !slices.Contains(actions, action) {
-		c.Ui.Error(fmt.Sprintf("Pause action must be one of %q, %q, or %q but got %q",
-			"pause", "run", "scheduled", action,
+		c.Ui.Error(fmt.Sprintf("Pause action must be one of %q, %q, %q, %q, or %q but got %q",
+			"pause", "run", "scheduled", "hibernate", "wake", action,
 		))
 		return 1
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/nomad/api/contexts"
 	"github.com/posener/complete"
+	"github.com/ryanuber/columnize"
 )
 
 type AllocStatusCommand struct {
@@ -44,7 +45,8 @@ Alloc Status Options:
     Display short output. Shows only the most recent task event.
 
   -stats
-    Display detailed resource usage statistics.
+    Display detailed resource usage statistics, and the process tree of tasks
+    whose driver tracks it.
 
   -verbose
     Show full information.
@@ -672,7 +674,101 @@ func (c *AllocStatusCommand) outputTaskResources(alloc *api.Allocation, task str
 			c.Ui.Output("")
 			c.outputVerboseResourceUsage(task, ru.ResourceUsage)
 		}
+		if ru, ok := stats.Tasks[task]; ok && ru != nil && displayStats {
+			if processes := formatProcessTree(ru.Pids); len(processes) > 1 {
+				c.Ui.Output("")
+				c.Ui.Output("Processes")
+				c.Ui.Output(formatProcessList(processes))
+			}
+		}
+	}
+}
+
+// formatProcessTree returns the rows of a table listing the processes of a
+// task, with the command of each process indented under its parent. Processes
+// are only listed if the driver reported their parent and command.
+func formatProcessTree(pids map[string]*api.ResourceUsage) []string {
+	usage := make(map[int]*api.ResourceUsage, len(pids))
+	for spid, ru := range pids {
+		pid, err := strconv.Atoi(spid)
+		if err != nil || ru == nil || ru.Process == nil {
+			continue
+		}
+		usage[pid] = ru
+	}
+
+	var roots []int
+	children := make(map[int][]int)
+	for pid, ru := range usage {
+		ppid := ru.Process.PPid
+		if _, ok := usage[ppid]; ok && ppid != pid {
+			children[ppid] = append(children[ppid], pid)
+		} else {
+			roots = append(roots, pid)
+		}
 	}
+	sort.Ints(roots)
+	for _, c := range children {
+		sort.Ints(c)
+	}
+
+	rows := []string{"PID|CPU|Memory|Command"}
+	visited := make(map[int]bool, len(usage))
+	var walk func(pid int, indent, branch string)
+	walk = func(pid int, indent, branch string) {
+		if visited[pid] {
+			return
+		}
+		visited[pid] = true
+
+		ru := usage[pid]
+		cpu, mem := "", ""
+		if ru.CpuStats != nil {
+			cpu = fmt.Sprintf("%s%%", strconv.FormatFloat(ru.CpuStats.Percent, 'f', 2, 64))
+		}
+		if ru.MemoryStats != nil {
+			mem = humanize.IBytes(ru.MemoryStats.RSS)
+		}
+		rows = append(rows, fmt.Sprintf("%d|%s|%s|%s%s%s",
+			pid, cpu, mem, indent, branch, escapeColumnDelim(ru.Process.Command)))
+
+		switch branch {
+		case "├─ ":
+			indent += "│  "
+		case "└─ ":
+			indent += "   "
+		}
+		for i, child := range children[pid] {
+			if i == len(children[pid])-1 {
+				walk(child, indent, "└─ ")
+			} else {
+				walk(child, indent, "├─ ")
+			}
+		}
+	}
+	for _, pid := range roots {
+		walk(pid, "", "")
+	}
+	return rows
+}
+
+// escapedColumnDelim stands in for the column delimiter within a command line
+// until the table has been formatted.
+const escapedColumnDelim = "\x1f"
+
+// escapeColumnDelim escapes the columnize delimiter in a command line so
+// that it isn't split into extra columns.
+func escapeColumnDelim(s string) string {
+	s = strings.ReplaceAll(s, escapedColumnDelim, " ")
+	return strings.ReplaceAll(s, "|", escapedColumnDelim)
+}
+
+// formatProcessList formats the rows of formatProcessTree, keeping the
+// indentation of the commands
+func formatProcessList(in []string) string {
+	columnConf := columnize.DefaultConfig()
+	columnConf.NoTrim = true
+	return strings.ReplaceAll(columnize.Format(in, columnConf), escapedColumnDelim, "|")
 }
 
 // outputVerboseResourceUsage outputs the verbose resource usage for the passed
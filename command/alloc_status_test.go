@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/command/agent"
 	"github.com/hashicorp/nomad/helper/uuid"
@@ -484,3 +485,45 @@ func TestAllocStatusCommand_NSD_Checks(t *testing.T) {
 	must.RegexMatch(t, regexp.MustCompile(`Service\s+Task\s+Name\s+Mode\s+Status`), out)
 	must.RegexMatch(t, regexp.MustCompile(`service1\s+\(group\)\s+check1\s+healthiness\s+(pending|failure)`), out)
 }
+
+func TestAllocStatusCommand_formatProcessTree(t *testing.T) {
+	ci.Parallel(t)
+
+	usage := func(ppid int, command string) *api.ResourceUsage {
+		return &api.ResourceUsage{
+			CpuStats:    &api.CpuStats{Percent: 1.5},
+			MemoryStats: &api.MemoryStats{RSS: 1024 * 1024},
+			Process:     &api.ProcessInfo{PPid: ppid, Command: command},
+		}
+	}
+
+	pids := map[string]*api.ResourceUsage{
+		"100": usage(1, "/bin/sh run.sh"),
+		"101": usage(100, "worker 1"),
+		"102": usage(100, "worker 2"),
+		"103": usage(101, "helper"),
+		"200": usage(1, "sidecar"),
+		"201": usage(200, "sh -c ps aux | grep nomad"),
+		// processes without a parent or command are not listed
+		"300": {CpuStats: &api.CpuStats{}, MemoryStats: &api.MemoryStats{}},
+	}
+
+	must.Eq(t, []string{
+		"PID|CPU|Memory|Command",
+		"100|1.50%|1.0 MiB|/bin/sh run.sh",
+		"101|1.50%|1.0 MiB|├─ worker 1",
+		"103|1.50%|1.0 MiB|│  └─ helper",
+		"102|1.50%|1.0 MiB|└─ worker 2",
+		"200|1.50%|1.0 MiB|sidecar",
+		"201|1.50%|1.0 MiB|└─ sh -c ps aux \x1f grep nomad",
+	}, formatProcessTree(pids))
+
+	// pipes in command lines don't add columns
+	must.StrContains(t, formatProcessList(formatProcessTree(pids)),
+		"└─ sh -c ps aux | grep nomad")
+
+	// drivers that don't track processes report none
+	must.Eq(t, []string{"PID|CPU|Memory|Command"}, formatProcessTree(map[string]*api.ResourceUsage{
+		"300": {CpuStats: &api.CpuStats{}},
+	}))
+}
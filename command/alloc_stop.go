@@ -47,6 +47,12 @@ Stop Specific Options:
     this flag will result in failed network connections to the allocation
     being stopped.
 
+  -migrate
+    Checkpoint the allocation's tasks before stopping them and restore them
+    in the replacement allocation, along with the allocation's data. This
+    requires a task driver that supports checkpointing, such as exec. Tasks
+    that can't be checkpointed are stopped and started as usual.
+
   -verbose
     Show full information.
 `
@@ -56,13 +62,14 @@ Stop Specific Options:
 func (c *AllocStopCommand) Name() string { return "alloc stop" }
 
 func (c *AllocStopCommand) Run(args []string) int {
-	var detach, verbose, noShutdownDelay bool
+	var detach, verbose, noShutdownDelay, migrate bool
 
 	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
 	flags.Usage = func() { c.Ui.Output(c.Help()) }
 	flags.BoolVar(&detach, "detach", false, "")
 	flags.BoolVar(&verbose, "verbose", false, "")
 	flags.BoolVar(&noShutdownDelay, "no-shutdown-delay", false, "")
+	flags.BoolVar(&migrate, "migrate", false, "")
 
 	if err := flags.Parse(args); err != nil {
 		return 1
@@ -126,8 +133,14 @@ func (c *AllocStopCommand) Run(args []string) int {
 	}
 
 	var opts *api.QueryOptions
-	if noShutdownDelay {
-		opts = &api.QueryOptions{Params: map[string]string{"no_shutdown_delay": "true"}}
+	if noShutdownDelay || migrate {
+		opts = &api.QueryOptions{Params: map[string]string{}}
+		if noShutdownDelay {
+			opts.Params["no_shutdown_delay"] = "true"
+		}
+		if migrate {
+			opts.Params["checkpoint"] = "true"
+		}
 	}
 
 	resp, err := client.Allocations().Stop(alloc, opts)
@@ -882,6 +882,11 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"operator snapshot simulate": func() (cli.Command, error) {
+			return &OperatorSnapshotSimulateCommand{
+				Meta: meta,
+			}, nil
+		},
 
 		"plan": func() (cli.Command, error) {
 			return &JobPlanCommand{
@@ -899,6 +904,11 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"plugin test-driver": func() (cli.Command, error) {
+			return &PluginTestDriverCommand{
+				Meta: meta,
+			}, nil
+		},
 
 		"quota": func() (cli.Command, error) {
 			return &QuotaCommand{
@@ -973,6 +983,31 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"reservation": func() (cli.Command, error) {
+			return &ReservationCommand{
+				Meta: meta,
+			}, nil
+		},
+		"reservation apply": func() (cli.Command, error) {
+			return &ReservationApplyCommand{
+				Meta: meta,
+			}, nil
+		},
+		"reservation delete": func() (cli.Command, error) {
+			return &ReservationDeleteCommand{
+				Meta: meta,
+			}, nil
+		},
+		"reservation list": func() (cli.Command, error) {
+			return &ReservationListCommand{
+				Meta: meta,
+			}, nil
+		},
+		"reservation status": func() (cli.Command, error) {
+			return &ReservationStatusCommand{
+				Meta: meta,
+			}, nil
+		},
 
 		"run": func() (cli.Command, error) {
 			return &JobRunCommand{
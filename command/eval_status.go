@@ -38,6 +38,13 @@ Eval Status Options:
   -verbose
     Show full information.
 
+  -trace
+    Display the scheduler decision trace of the evaluation, listing why each
+    node was filtered, exhausted, scored or selected for every placement.
+    Traces are only recorded when enabled with the '-eval-trace' flag of the
+    'nomad operator scheduler set-config' command. When combined with -json or
+    -t, the trace is formatted instead of the evaluation.
+
   -json
     Output the evaluation in its JSON format.
 
@@ -61,6 +68,7 @@ func (c *EvalStatusCommand) AutocompleteFlags() complete.Flags {
 			"-json":    complete.PredictNothing,
 			"-monitor": complete.PredictNothing,
 			"-t":       complete.PredictAnything,
+			"-trace":   complete.PredictNothing,
 			"-verbose": complete.PredictNothing,
 			"-ui":      complete.PredictNothing,
 		})
@@ -88,13 +96,14 @@ func (c *EvalStatusCommand) AutocompleteArgs() complete.Predictor {
 func (c *EvalStatusCommand) Name() string { return "eval status" }
 
 func (c *EvalStatusCommand) Run(args []string) int {
-	var monitor, verbose, json, openURL bool
+	var monitor, verbose, json, openURL, trace bool
 	var tmpl string
 
 	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
 	flags.Usage = func() { c.Ui.Output(c.Help()) }
 	flags.BoolVar(&monitor, "monitor", false, "")
 	flags.BoolVar(&verbose, "verbose", false, "")
+	flags.BoolVar(&trace, "trace", false, "")
 	flags.BoolVar(&json, "json", false, "")
 	flags.StringVar(&tmpl, "t", "", "")
 	flags.BoolVar(&openURL, "ui", false, "")
@@ -166,9 +175,29 @@ func (c *EvalStatusCommand) Run(args []string) int {
 		return 1
 	}
 
+	// Lookup the decision trace if requested
+	var evalTrace *api.EvalTrace
+	if trace {
+		evalTrace, _, err = client.Evaluations().Trace(eval.ID, nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				c.Ui.Error(fmt.Sprintf("No trace found for evaluation %q; traces are only "+
+					"recorded when eval tracing is enabled in the scheduler configuration",
+					limit(eval.ID, length)))
+				return 1
+			}
+			c.Ui.Error(fmt.Sprintf("Error querying evaluation trace: %s", err))
+			return 1
+		}
+	}
+
 	// If output format is specified, format and output the data
 	if json || len(tmpl) > 0 {
-		out, err := Format(json, tmpl, eval)
+		var data any = eval
+		if evalTrace != nil {
+			data = evalTrace
+		}
+		out, err := Format(json, tmpl, data)
 		if err != nil {
 			c.Ui.Error(err.Error())
 			return 1
@@ -251,6 +280,14 @@ func (c *EvalStatusCommand) Run(args []string) int {
 		}
 	}
 
+	if evalTrace != nil {
+		c.Ui.Output(c.Colorize().Color("\n[bold]Scheduler Trace[reset]"))
+		c.Ui.Output(formatEvalTrace(evalTrace, length, verbose))
+		if evalTrace.Truncated {
+			c.Ui.Warn(fmt.Sprintf("\nTrace truncated after %d events", len(evalTrace.Events)))
+		}
+	}
+
 	hint, _ := c.Meta.showUIPath(UIHintContext{
 		Command: "eval status",
 		PathParams: map[string]string{
@@ -274,6 +311,45 @@ func sortedTaskGroupFromMetrics(groups map[string]*api.AllocationMetric) []strin
 	return tgs
 }
 
+// formatEvalTrace formats the events of an evaluation trace as a table. The
+// component scores of each node are only included in verbose mode.
+func formatEvalTrace(trace *api.EvalTrace, length int, verbose bool) string {
+	if len(trace.Events) == 0 {
+		return "No scheduler decisions recorded"
+	}
+
+	header := "Task Group|Alloc Name|Node ID|Decision|Reason|Score"
+	if verbose {
+		header += "|Scores"
+	}
+	out := make([]string, len(trace.Events)+1)
+	out[0] = header
+	for i, ev := range trace.Events {
+		var score string
+		switch ev.Decision {
+		case api.EvalTraceDecisionScored, api.EvalTraceDecisionSelected:
+			score = fmt.Sprintf("%.3g", ev.NormScore)
+		}
+		out[i+1] = fmt.Sprintf("%s|%s|%s|%s|%s|%s",
+			ev.TaskGroup, ev.AllocName, limit(ev.NodeID, length),
+			ev.Decision, ev.Reason, score)
+
+		if verbose {
+			names := make([]string, 0, len(ev.Scores))
+			for name := range ev.Scores {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			scores := make([]string, len(names))
+			for j, name := range names {
+				scores[j] = fmt.Sprintf("%s=%.3g", name, ev.Scores[name])
+			}
+			out[i+1] += "|" + strings.Join(scores, ", ")
+		}
+	}
+	return formatList(out)
+}
+
 func getTriggerDetails(eval *api.Evaluation) (noun, subject string) {
 	switch eval.TriggeredBy {
 	case "node-update":
@@ -103,7 +103,8 @@ Plan Options:
     Path to HCL2 file containing user variables.
 
   -verbose
-    Increase diff verbosity.
+    Increase diff verbosity, and list every allocation that would be preempted
+    along with the reason it would be preempted.
 `
 	return strings.TrimSpace(helpText)
 }
@@ -289,22 +290,17 @@ func (c *JobPlanCommand) outputPlannedJob(job *api.Job, resp *api.JobPlanRespons
 
 	// Print preemptions if there are any
 	if resp.Annotations != nil && len(resp.Annotations.PreemptedAllocs) > 0 {
-		c.addPreemptions(resp)
+		c.addPreemptions(resp, verbose)
 	}
 
 	return getExitCode(resp)
 }
 
 // addPreemptions shows details about preempted allocations
-func (c *JobPlanCommand) addPreemptions(resp *api.JobPlanResponse) {
+func (c *JobPlanCommand) addPreemptions(resp *api.JobPlanResponse, verbose bool) {
 	c.Ui.Output(c.Colorize().Color("[bold][yellow]Preemptions:\n[reset]"))
-	if len(resp.Annotations.PreemptedAllocs) < preemptionDisplayThreshold {
-		var allocs []string
-		allocs = append(allocs, "Alloc ID|Job ID|Task Group")
-		for _, alloc := range resp.Annotations.PreemptedAllocs {
-			allocs = append(allocs, fmt.Sprintf("%s|%s|%s", alloc.ID, alloc.JobID, alloc.TaskGroup))
-		}
-		c.Ui.Output(formatList(allocs))
+	if verbose || len(resp.Annotations.PreemptedAllocs) < preemptionDisplayThreshold {
+		c.Ui.Output(formatList(formatPreemptedAllocs(resp.Annotations)))
 		return
 	}
 	// Display in a summary format if the list is too large
@@ -350,6 +346,37 @@ func (c *JobPlanCommand) addPreemptions(resp *api.JobPlanResponse) {
 
 }
 
+// formatPreemptedAllocs lists the preempted allocations, along with the
+// reasons they are preempted when the server provides them.
+func formatPreemptedAllocs(annotations *api.PlanAnnotations) []string {
+	if len(annotations.PreemptionReasons) == 0 {
+		allocs := []string{"Alloc ID|Job ID|Task Group"}
+		for _, alloc := range annotations.PreemptedAllocs {
+			allocs = append(allocs, fmt.Sprintf("%s|%s|%s", alloc.ID, alloc.JobID, alloc.TaskGroup))
+		}
+		return allocs
+	}
+
+	allocs := []string{"Alloc ID|Job ID|Task Group|Node ID|Priority|Preemption Cost|Preempted For|Exhausted"}
+	for _, alloc := range annotations.PreemptedAllocs {
+		reason := annotations.PreemptionReasons[alloc.ID]
+		if reason == nil {
+			allocs = append(allocs, fmt.Sprintf("%s|%s|%s|%s|<none>|<none>|<none>|<none>",
+				alloc.ID, alloc.JobID, alloc.TaskGroup, alloc.NodeID))
+			continue
+		}
+
+		exhausted := "<none>"
+		if len(reason.Resources) > 0 {
+			exhausted = strings.Join(reason.Resources, ", ")
+		}
+		allocs = append(allocs, fmt.Sprintf("%s|%s|%s|%s|%d|%d|%s|%s",
+			alloc.ID, alloc.JobID, alloc.TaskGroup, reason.NodeID,
+			reason.JobPriority, reason.PreemptionCost, reason.PreemptedFor, exhausted))
+	}
+	return allocs
+}
+
 type namespaceIdPair struct {
 	id        string
 	namespace string
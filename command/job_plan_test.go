@@ -228,7 +228,7 @@ func TestPlanCommand_Preemptions(t *testing.T) {
 			},
 		},
 	}
-	cmd.addPreemptions(resp1)
+	cmd.addPreemptions(resp1, false)
 	out := ui.OutputWriter.String()
 	must.StrContains(t, out, "Alloc ID")
 	must.StrContains(t, out, "alloc1")
@@ -253,7 +253,7 @@ func TestPlanCommand_Preemptions(t *testing.T) {
 		},
 	}
 	ui.OutputWriter.Reset()
-	cmd.addPreemptions(resp2)
+	cmd.addPreemptions(resp2, false)
 	out = ui.OutputWriter.String()
 	must.StrContains(t, out, "Job ID")
 	must.StrContains(t, out, "Namespace")
@@ -284,13 +284,60 @@ func TestPlanCommand_Preemptions(t *testing.T) {
 		},
 	}
 	ui.OutputWriter.Reset()
-	cmd.addPreemptions(resp3)
+	cmd.addPreemptions(resp3, false)
 	out = ui.OutputWriter.String()
 	must.StrContains(t, out, "Job Type")
 	must.StrContains(t, out, "batch")
 	must.StrContains(t, out, "service")
 }
 
+func TestPlanCommand_PreemptionReasons(t *testing.T) {
+	ci.Parallel(t)
+	ui := cli.NewMockUi()
+	cmd := &JobPlanCommand{Meta: Meta{Ui: ui}}
+
+	var preemptedAllocs []*api.AllocationListStub
+	reasons := make(map[string]*api.PreemptionReason)
+	for i := 0; i < 12; i++ {
+		id := "alloc" + strconv.Itoa(i)
+		preemptedAllocs = append(preemptedAllocs, &api.AllocationListStub{
+			ID:        id,
+			JobID:     "batch-job",
+			TaskGroup: "worker",
+			JobType:   "batch",
+			Namespace: "test",
+		})
+		reasons[id] = &api.PreemptionReason{
+			PreemptedFor:   "web.api[0]",
+			NodeID:         "node1",
+			Resources:      []string{"memory"},
+			JobPriority:    20,
+			PreemptionCost: 5,
+		}
+	}
+	resp := &api.JobPlanResponse{
+		Annotations: &api.PlanAnnotations{
+			PreemptedAllocs:   preemptedAllocs,
+			PreemptionReasons: reasons,
+		},
+	}
+
+	// Summarized without verbose
+	cmd.addPreemptions(resp, false)
+	out := ui.OutputWriter.String()
+	must.StrContains(t, out, "Job ID")
+	must.StrNotContains(t, out, "Preempted For")
+
+	// Every alloc and its reason is listed with verbose
+	ui.OutputWriter.Reset()
+	cmd.addPreemptions(resp, true)
+	out = ui.OutputWriter.String()
+	must.StrContains(t, out, "Preempted For")
+	must.StrContains(t, out, "alloc11")
+	must.StrContains(t, out, "web.api[0]")
+	must.StrContains(t, out, "memory")
+}
+
 func TestPlanCommand_JSON(t *testing.T) {
 	ui := cli.NewMockUi()
 	cmd := &JobPlanCommand{
@@ -229,6 +229,7 @@ func parseNamespaceSpecImpl(result *api.Namespace, list *ast.ObjectList) error {
 	delete(m, "node_pool_config")
 	delete(m, "vault")
 	delete(m, "consul")
+	delete(m, "preemption_budget")
 
 	// Decode the rest
 	if err := mapstructure.WeakDecode(m, result); err != nil {
@@ -299,6 +300,22 @@ func parseNamespaceSpecImpl(result *api.Namespace, list *ast.ObjectList) error {
 		}
 	}
 
+	pbObj := list.Filter("preemption_budget")
+	if len(pbObj.Items) > 0 {
+		for _, o := range pbObj.Elem().Items {
+			ot, ok := o.Val.(*ast.ObjectType)
+			if !ok {
+				break
+			}
+			budget, err := parsePreemptionBudget(ot.List)
+			if err != nil {
+				return err
+			}
+			result.PreemptionBudget = budget
+			break
+		}
+	}
+
 	if metaO := list.Filter("meta"); len(metaO.Items) > 0 {
 		for _, o := range metaO.Elem().Items {
 			var m map[string]interface{}
@@ -313,3 +330,27 @@ func parseNamespaceSpecImpl(result *api.Namespace, list *ast.ObjectList) error {
 
 	return nil
 }
+
+// parsePreemptionBudget parses the preemption_budget block of a namespace
+// specification.
+func parsePreemptionBudget(list *ast.ObjectList) (*api.PreemptionBudget, error) {
+	var m map[string]interface{}
+	if err := hcl.DecodeObject(&m, list); err != nil {
+		return nil, err
+	}
+
+	var budget api.PreemptionBudget
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+		ErrorUnused:      true,
+		Result:           &budget,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := dec.Decode(m); err != nil {
+		return nil, fmt.Errorf("invalid preemption_budget: %v", err)
+	}
+	return &budget, nil
+}
@@ -6,10 +6,12 @@ package command
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/cli"
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/shoenig/test/must"
 )
 
@@ -155,6 +157,24 @@ node_pool_config {
 				},
 			},
 		},
+		{
+			name: "preemption budget",
+			input: `
+name = "budget"
+
+preemption_budget {
+  max_allocs = 5
+  window     = "1h"
+}
+`,
+			expected: &api.Namespace{
+				Name: "budget",
+				PreemptionBudget: &api.PreemptionBudget{
+					MaxAllocs: pointer.Of(5),
+					Window:    pointer.Of(time.Hour),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
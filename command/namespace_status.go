@@ -179,6 +179,16 @@ func (c *NamespaceStatusCommand) Run(args []string) int {
 		c.Ui.Output(formatKV(vConfigOut))
 	}
 
+	if budget := ns.PreemptionBudget; budget != nil {
+		c.Ui.Output(c.Colorize().Color("\n[bold]Preemption Budget[reset]"))
+		budget.Canonicalize()
+		budgetOut := []string{
+			fmt.Sprintf("Max Allocs|%d", *budget.MaxAllocs),
+			fmt.Sprintf("Window|%s", *budget.Window),
+		}
+		c.Ui.Output(formatKV(budgetOut))
+	}
+
 	if ns.ConsulConfiguration != nil {
 		c.Ui.Output(c.Colorize().Color("\n[bold]Consul Configuration[reset]"))
 		cConfig := ns.ConsulConfiguration
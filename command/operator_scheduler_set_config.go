@@ -27,6 +27,7 @@ type OperatorSchedulerSetConfig struct {
 	memoryOversubscription   flagHelper.BoolValue
 	rejectJobRegistration    flagHelper.BoolValue
 	pauseEvalBroker          flagHelper.BoolValue
+	evalTrace                flagHelper.BoolValue
 	preemptBatchScheduler    flagHelper.BoolValue
 	preemptServiceScheduler  flagHelper.BoolValue
 	preemptSysBatchScheduler flagHelper.BoolValue
@@ -44,6 +45,7 @@ func (o *OperatorSchedulerSetConfig) AutocompleteFlags() complete.Flags {
 			"-memory-oversubscription":    complete.PredictSet("true", "false"),
 			"-reject-job-registration":    complete.PredictSet("true", "false"),
 			"-pause-eval-broker":          complete.PredictSet("true", "false"),
+			"-eval-trace":                 complete.PredictSet("true", "false"),
 			"-preempt-batch-scheduler":    complete.PredictSet("true", "false"),
 			"-preempt-service-scheduler":  complete.PredictSet("true", "false"),
 			"-preempt-sysbatch-scheduler": complete.PredictSet("true", "false"),
@@ -68,6 +70,7 @@ func (o *OperatorSchedulerSetConfig) Run(args []string) int {
 	flags.Var(&o.memoryOversubscription, "memory-oversubscription", "")
 	flags.Var(&o.rejectJobRegistration, "reject-job-registration", "")
 	flags.Var(&o.pauseEvalBroker, "pause-eval-broker", "")
+	flags.Var(&o.evalTrace, "eval-trace", "")
 	flags.Var(&o.preemptBatchScheduler, "preempt-batch-scheduler", "")
 	flags.Var(&o.preemptServiceScheduler, "preempt-service-scheduler", "")
 	flags.Var(&o.preemptSysBatchScheduler, "preempt-sysbatch-scheduler", "")
@@ -130,6 +133,7 @@ func (o *OperatorSchedulerSetConfig) Run(args []string) int {
 	o.memoryOversubscription.Merge(&schedulerConfig.MemoryOversubscriptionEnabled)
 	o.rejectJobRegistration.Merge(&schedulerConfig.RejectJobRegistration)
 	o.pauseEvalBroker.Merge(&schedulerConfig.PauseEvalBroker)
+	o.evalTrace.Merge(&schedulerConfig.EvalTraceEnabled)
 	o.preemptBatchScheduler.Merge(&schedulerConfig.PreemptionConfig.BatchSchedulerEnabled)
 	o.preemptServiceScheduler.Merge(&schedulerConfig.PreemptionConfig.ServiceSchedulerEnabled)
 	o.preemptSysBatchScheduler.Merge(&schedulerConfig.PreemptionConfig.SysBatchSchedulerEnabled)
@@ -193,6 +197,11 @@ Scheduler Set Config Options:
     When set to true, the eval broker which usually runs on the leader will be
     disabled. This will prevent the scheduler workers from receiving new work.
 
+  -eval-trace=[true|false]
+    When true, the schedulers record every node filtering, exhaustion and
+    scoring decision made while processing an evaluation. The trace can be
+    read with 'nomad eval status -trace'.
+
   -preempt-batch-scheduler=[true|false]
     Specifies whether preemption for batch jobs is enabled. Note that if this
     is set to true, then batch jobs can preempt any other jobs.
@@ -32,6 +32,10 @@ Usage: nomad operator snapshot <subcommand> [options]
 
       $ nomad operator snapshot inspect backup.snap
 
+  Simulate scheduling a job against a snapshot:
+
+      $ nomad operator snapshot simulate backup.snap example.nomad.hcl
+
   Run a daemon process that locally saves a snapshot every hour (available only in
   Nomad Enterprise) :
 
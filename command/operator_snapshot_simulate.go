@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/helper/raftutil"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/scheduler"
+	"github.com/posener/complete"
+)
+
+type OperatorSnapshotSimulateCommand struct {
+	Meta
+	JobGetter
+}
+
+// SnapshotSimulateFormat is the structure emitted when the simulation
+// results are requested as JSON.
+type SnapshotSimulateFormat struct {
+	SnapshotIndex uint64
+	Plan          *api.JobPlanResponse
+	Placements    []*SimulatedPlacement
+}
+
+// SimulatedPlacement describes a single allocation the scheduler placed or
+// updated while processing the simulated evaluation.
+type SimulatedPlacement struct {
+	AllocID   string
+	Name      string
+	TaskGroup string
+	NodeID    string
+	NodeName  string
+	NodePool  string
+	Metrics   *api.AllocationMetric
+}
+
+func (c *OperatorSnapshotSimulateCommand) Help() string {
+	helpText := `
+Usage: nomad operator snapshot simulate [options] <snapshot> <jobfile>
+
+  Runs the scheduler against the state stored in a snapshot file to determine
+  the effects of submitting a job, without contacting a Nomad cluster. The
+  snapshot is loaded into an in-memory state store and the job is evaluated by
+  the same scheduler used by the servers. The results are similar to those of
+  "nomad job plan", with the addition of the node chosen for each placement.
+
+  Server-side admission controllers, such as Sentinel policies and implied
+  constraints, are not run. Results are therefore an approximation of what a
+  live cluster would decide and should be used for capacity planning only.
+
+  To simulate the job "example.nomad.hcl" against the file "backup.snap":
+
+    $ nomad operator snapshot simulate backup.snap example.nomad.hcl
+
+  Simulate will return one of the following exit codes:
+    * 0: No allocations created or destroyed.
+    * 1: Allocations created or destroyed.
+    * 255: Error determining plan results.
+
+Snapshot Simulate Options:
+
+  -diff
+    Determines whether the diff between the job in the snapshot and the
+    simulated job is shown. Defaults to true.
+
+  -json
+    Output the simulation results in JSON format.
+
+  -hcl2-strict
+    Whether an error should be produced from the HCL2 parser where a variable
+    has been supplied which is not defined within the root variables. Defaults
+    to true.
+
+  -var 'key=value'
+    Variable for template, can be used multiple times.
+
+  -var-file=path
+    Path to HCL2 file containing user variables.
+
+  -verbose
+    Increase diff verbosity and display the scoring metrics of each
+    placement.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *OperatorSnapshotSimulateCommand) AutocompleteFlags() complete.Flags {
+	return complete.Flags{
+		"-diff":        complete.PredictNothing,
+		"-json":        complete.PredictNothing,
+		"-hcl2-strict": complete.PredictNothing,
+		"-var":         complete.PredictAnything,
+		"-var-file":    complete.PredictFiles("*.var"),
+		"-verbose":     complete.PredictNothing,
+	}
+}
+
+func (c *OperatorSnapshotSimulateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictOr(
+		complete.PredictFiles("*.snap"),
+		complete.PredictFiles("*.nomad"),
+		complete.PredictFiles("*.hcl"),
+	)
+}
+
+func (c *OperatorSnapshotSimulateCommand) Synopsis() string {
+	return "Simulates scheduling a job against a Nomad snapshot file"
+}
+
+func (c *OperatorSnapshotSimulateCommand) Name() string { return "operator snapshot simulate" }
+
+func (c *OperatorSnapshotSimulateCommand) Run(args []string) int {
+	var diff, verbose, outputJSON bool
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&diff, "diff", true, "")
+	flags.BoolVar(&verbose, "verbose", false, "")
+	flags.BoolVar(&outputJSON, "json", false, "")
+	flags.BoolVar(&c.JobGetter.Strict, "hcl2-strict", true, "")
+	flags.Var(&c.JobGetter.Vars, "var", "")
+	flags.Var(&c.JobGetter.VarFiles, "var-file", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 255
+	}
+
+	args = flags.Args()
+	if len(args) != 2 {
+		c.Ui.Error("This command takes two arguments: <snapshot> <jobfile>")
+		c.Ui.Error(commandErrorText(c))
+		return 255
+	}
+
+	if err := c.JobGetter.Validate(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Invalid job options: %s", err))
+		return 255
+	}
+
+	_, apiJob, err := c.JobGetter.Get(args[1])
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting job struct: %s", err))
+		return 255
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error opening snapshot file: %s", err))
+		return 255
+	}
+	defer f.Close()
+
+	_, store, meta, err := raftutil.RestoreFromArchive(f, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to read archive file: %s", err))
+		return 255
+	}
+
+	job := agent.ApiJobToStructJob(apiJob)
+	job.Canonicalize()
+
+	result, err := simulateJob(store, job)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error during simulation: %s", err))
+		return 255
+	}
+
+	resp, err := toAPIPlanResponse(result.Response)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error formatting simulation results: %s", err))
+		return 255
+	}
+
+	placements, err := result.placements()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error formatting simulation results: %s", err))
+		return 255
+	}
+
+	if outputJSON {
+		out, err := Format(true, "", &SnapshotSimulateFormat{
+			SnapshotIndex: meta.Index,
+			Plan:          resp,
+			Placements:    placements,
+		})
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 255
+		}
+		c.Ui.Output(out)
+		return getExitCode(resp)
+	}
+
+	c.Ui.Output(c.Colorize().Color(fmt.Sprintf(
+		"[bold]Simulating against snapshot %q at index %d[reset]\n", meta.ID, meta.Index)))
+
+	planCmd := &JobPlanCommand{Meta: c.Meta}
+	exitCode := planCmd.outputPlannedJob(apiJob, resp, diff, verbose)
+
+	if len(placements) > 0 {
+		c.Ui.Output(c.Colorize().Color("[bold]Placements:[reset]"))
+		c.Ui.Output(formatSimulatedPlacements(placements))
+		if verbose {
+			for _, p := range placements {
+				if p.Metrics == nil {
+					continue
+				}
+				c.Ui.Output(fmt.Sprintf("\nAllocation %q on node %q:", p.Name, p.NodeName))
+				c.Ui.Output(formatAllocMetrics(p.Metrics, true, "  "))
+			}
+		}
+	}
+
+	return exitCode
+}
+
+// simulationResult holds the outcome of running the scheduler against a
+// restored snapshot.
+type simulationResult struct {
+	// Response mirrors the response of the Job.Plan RPC.
+	Response *structs.JobPlanResponse
+
+	// Plan is the plan submitted by the scheduler, if any.
+	Plan *structs.Plan
+
+	// State is the snapshot the scheduler ran against, used to resolve the
+	// nodes referenced by the plan.
+	State *state.StateSnapshot
+}
+
+// simulateJob runs the scheduler for the given job against the state store in
+// the same way the Job.Plan RPC does, but without any connection to a
+// cluster. The state store is not modified.
+func simulateJob(store *state.StateStore, job *structs.Job) (*simulationResult, error) {
+	if err := job.Validate(); err != nil {
+		return nil, fmt.Errorf("job validation failed: %w", err)
+	}
+
+	var warnings []error
+	if err := job.Warnings(); err != nil {
+		warnings = append(warnings, err)
+	}
+
+	snap, err := store.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	latestIndex, err := snap.LatestIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	existingJob, err := snap.JobByID(nil, job.Namespace, job.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobModifyIndex, updatedIndex uint64
+	if existingJob != nil {
+		jobModifyIndex = existingJob.JobModifyIndex
+
+		// Only insert the job if it has changed so that existing deployments
+		// are reused, as the Job.Plan RPC does.
+		if existingJob.SpecChanged(job) {
+			updatedIndex = latestIndex + 1
+			if err := snap.UpsertJob(structs.IgnoreUnknownTypeFlag, updatedIndex, nil, job); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		if err := snap.UpsertJob(structs.IgnoreUnknownTypeFlag, latestIndex+1, nil, job); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now().UnixNano()
+	eval := &structs.Evaluation{
+		ID:             uuid.Generate(),
+		Namespace:      job.Namespace,
+		Priority:       job.Priority,
+		Type:           job.Type,
+		TriggeredBy:    structs.EvalTriggerJobRegister,
+		JobID:          job.ID,
+		JobModifyIndex: updatedIndex,
+		Status:         structs.EvalStatusPending,
+		AnnotatePlan:   true,
+		CreateTime:     now,
+		ModifyTime:     now,
+	}
+	if err := snap.UpsertEvals(structs.IgnoreUnknownTypeFlag, latestIndex+2, []*structs.Evaluation{eval}); err != nil {
+		return nil, err
+	}
+
+	planner := &scheduler.Harness{
+		State: &snap.StateStore,
+	}
+	sched, err := scheduler.NewScheduler(eval.Type, hclog.NewNullLogger(), nil, snap, planner)
+	if err != nil {
+		return nil, err
+	}
+	if err := sched.Process(eval); err != nil {
+		return nil, err
+	}
+
+	if plans := len(planner.Plans); plans != 1 {
+		return nil, fmt.Errorf("scheduler resulted in an unexpected number of plans: %v", plans)
+	}
+	if len(planner.Evals) != 1 {
+		return nil, fmt.Errorf("scheduler resulted in an unexpected number of eval updates: %v", planner.Evals)
+	}
+	plan := planner.Plans[0]
+
+	jobDiff, err := existingJob.Diff(job, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job diff: %w", err)
+	}
+	if err := scheduler.Annotate(jobDiff, plan.Annotations); err != nil {
+		return nil, fmt.Errorf("failed to annotate job diff: %w", err)
+	}
+
+	resp := &structs.JobPlanResponse{
+		Annotations:    plan.Annotations,
+		FailedTGAllocs: planner.Evals[0].FailedTGAllocs,
+		JobModifyIndex: jobModifyIndex,
+		CreatedEvals:   planner.CreateEvals,
+		Diff:           jobDiff,
+		Warnings:       helper.MergeMultierrorWarnings(warnings...),
+	}
+
+	if job.IsPeriodic() && job.Periodic.Enabled {
+		resp.NextPeriodicLaunch, err = job.Periodic.Next(time.Now().In(job.Periodic.GetLocation()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse cron expression: %w", err)
+		}
+	}
+
+	return &simulationResult{
+		Response: resp,
+		Plan:     plan,
+		State:    snap,
+	}, nil
+}
+
+// placements returns the allocations placed or updated by the simulated plan,
+// sorted by allocation name.
+func (r *simulationResult) placements() ([]*SimulatedPlacement, error) {
+	var out []*SimulatedPlacement
+	for nodeID, allocs := range r.Plan.NodeAllocation {
+		node, err := r.State.NodeByID(nil, nodeID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, alloc := range allocs {
+			p := &SimulatedPlacement{
+				AllocID:   alloc.ID,
+				Name:      alloc.Name,
+				TaskGroup: alloc.TaskGroup,
+				NodeID:    nodeID,
+			}
+			if node != nil {
+				p.NodeName = node.Name
+				p.NodePool = node.NodePool
+			}
+			if alloc.Metrics != nil {
+				p.Metrics = new(api.AllocationMetric)
+				if err := convertViaJSON(alloc.Metrics, p.Metrics); err != nil {
+					return nil, err
+				}
+			}
+			out = append(out, p)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Name == out[j].Name {
+			return out[i].AllocID < out[j].AllocID
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out, nil
+}
+
+// formatSimulatedPlacements produces a table of the simulated placements.
+func formatSimulatedPlacements(placements []*SimulatedPlacement) string {
+	rows := make([]string, 0, len(placements)+1)
+	rows = append(rows, "Name|Task Group|Node ID|Node Name|Node Pool")
+	for _, p := range placements {
+		rows = append(rows, fmt.Sprintf("%s|%s|%s|%s|%s",
+			p.Name, p.TaskGroup, limit(p.NodeID, shortId), p.NodeName, p.NodePool))
+	}
+	return formatList(rows)
+}
+
+// toAPIPlanResponse converts the plan response to its API representation so
+// the formatting helpers shared with "nomad job plan" can be used.
+func toAPIPlanResponse(resp *structs.JobPlanResponse) (*api.JobPlanResponse, error) {
+	var out api.JobPlanResponse
+	if err := convertViaJSON(resp, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// convertViaJSON converts between server and API representations of the same
+// object by encoding it the way the HTTP API would.
+func convertViaJSON(in, out any) error {
+	buf, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf, out)
+}
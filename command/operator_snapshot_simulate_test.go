@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestOperatorSnapshotSimulate_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	snapPath := generateSnapshotFile(t, nil)
+
+	jobPath := filepath.Join(t.TempDir(), "example.nomad.hcl")
+	must.NoError(t, os.WriteFile(jobPath, []byte(`
+job "example" {
+  group "cache" {
+    task "redis" {
+      driver = "docker"
+      config {
+        image = "redis:7"
+      }
+    }
+  }
+}`), 0600))
+
+	ui := cli.NewMockUi()
+	cmd := &OperatorSnapshotSimulateCommand{Meta: Meta{Ui: ui}}
+
+	// The snapshot has no client nodes, so nothing can be placed.
+	code := cmd.Run([]string{snapPath, jobPath})
+	must.Eq(t, 1, code, must.Sprint(ui.ErrorWriter.String()))
+
+	out := ui.OutputWriter.String()
+	must.StrContains(t, out, "Simulating against snapshot")
+	must.StrContains(t, out, `+ Job: "example"`)
+	must.StrContains(t, out, "Failed to place all allocations")
+	must.StrNotContains(t, out, "Placements:")
+}
+
+func TestOperatorSnapshotSimulate_Fails(t *testing.T) {
+	ci.Parallel(t)
+
+	t.Run("wrong args", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := &OperatorSnapshotSimulateCommand{Meta: Meta{Ui: ui}}
+
+		code := cmd.Run([]string{"backup.snap"})
+		must.Eq(t, 255, code)
+		must.StrContains(t, ui.ErrorWriter.String(), "This command takes two arguments")
+	})
+
+	t.Run("missing snapshot", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		jobPath := filepath.Join(tmpDir, "example.nomad.hcl")
+		must.NoError(t, os.WriteFile(jobPath, []byte(`job "example" {}`), 0600))
+
+		ui := cli.NewMockUi()
+		cmd := &OperatorSnapshotSimulateCommand{Meta: Meta{Ui: ui}}
+
+		code := cmd.Run([]string{filepath.Join(tmpDir, "foo.snap"), jobPath})
+		must.Eq(t, 255, code)
+		must.StrContains(t, ui.ErrorWriter.String(), "Error opening snapshot file")
+	})
+}
+
+func TestOperatorSnapshotSimulate_simulateJob(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStore(t)
+
+	node := mock.Node()
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 100, node))
+
+	job := mock.Job()
+	job.TaskGroups[0].Count = 2
+
+	result, err := simulateJob(store, job)
+	must.NoError(t, err)
+	must.MapEmpty(t, result.Response.FailedTGAllocs)
+	must.Eq(t, structs.DiffTypeAdded, result.Response.Diff.Type)
+	must.Eq(t, 2, result.Response.Annotations.DesiredTGUpdates["web"].Place)
+
+	placements, err := result.placements()
+	must.NoError(t, err)
+	must.Len(t, 2, placements)
+	for _, p := range placements {
+		must.Eq(t, node.ID, p.NodeID)
+		must.Eq(t, node.Name, p.NodeName)
+		must.NotNil(t, p.Metrics)
+	}
+
+	// The simulation must not modify the original state store.
+	existing, err := store.JobByID(nil, job.Namespace, job.ID)
+	must.NoError(t, err)
+	must.Nil(t, existing)
+
+	allocs, err := store.AllocsByNode(nil, node.ID)
+	must.NoError(t, err)
+	must.SliceEmpty(t, allocs)
+}
@@ -11,9 +11,19 @@ type PluginCommand struct {
 
 func (c *PluginCommand) Help() string {
 	helpText := `
-Usage nomad plugin status [options] [plugin]
+Usage nomad plugin <subcommand> [options] [args]
 
     This command groups subcommands for interacting with plugins.
+
+    Display the status of the plugins registered in the cluster:
+
+        $ nomad plugin status
+
+    Run the conformance suite against a task driver plugin binary:
+
+        $ nomad plugin test-driver -config driver.hcl ./my-driver
+
+    Please see the individual subcommand help for detailed usage information.
 `
 	return helpText
 }
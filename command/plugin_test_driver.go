@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/posener/complete"
+
+	cconfig "github.com/hashicorp/nomad/client/config"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/hashicorp/nomad/plugins/drivers/conformance"
+)
+
+type PluginTestDriverCommand struct {
+	Meta
+}
+
+func (c *PluginTestDriverCommand) Help() string {
+	helpText := `
+Usage: nomad plugin test-driver [options] <driver-binary> [<args>...]
+
+  Run the task driver conformance suite against a driver plugin binary. The
+  driver is launched the same way the Nomad client launches external plugins,
+  and each scenario of the suite exercises its RPCs the way the client does:
+  starting, waiting for, stopping and destroying tasks, recovering running
+  tasks after the plugin restarts, and executing commands in, signaling,
+  collecting the stats of, updating the resources of and pausing tasks.
+
+  Scenarios for optional features are skipped if the driver doesn't advertise
+  the matching capability. Advertised capabilities whose RPCs aren't
+  implemented are reported as capability mismatches.
+
+  The command exits with a non-zero status if any scenario fails. It doesn't
+  need a running Nomad agent, but it may need to run as root to let drivers
+  that isolate tasks create their cgroups and chroots.
+
+Test Driver Options:
+
+  -config <path>
+    Path to an HCL file with the plugin configuration of the driver and the
+    tasks started by the scenarios. Without it, only the fingerprint of the
+    driver is checked. See the documentation of the command for its format.
+
+  -run <regexp>
+    Only run the scenarios whose name matches the regular expression. The
+    fingerprint scenario always runs. Available scenarios: ` + strings.Join(conformance.Scenarios(), ", ") + `.
+
+  -timeout <duration>
+    How long each scenario waits for the driver to reach an expected state.
+    Overrides the timeout of the config file. Defaults to 30s.
+
+  -verbose
+    Display the capabilities of the driver and log the plugin output at the
+    debug level.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *PluginTestDriverCommand) Synopsis() string {
+	return "Run the conformance suite against a task driver plugin"
+}
+
+func (c *PluginTestDriverCommand) AutocompleteFlags() complete.Flags {
+	return complete.Flags{
+		"-config":  complete.PredictFiles("*.hcl"),
+		"-run":     complete.PredictSet(conformance.Scenarios()...),
+		"-timeout": complete.PredictAnything,
+		"-verbose": complete.PredictNothing,
+	}
+}
+
+func (c *PluginTestDriverCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*")
+}
+
+func (c *PluginTestDriverCommand) Name() string { return "plugin test-driver" }
+
+func (c *PluginTestDriverCommand) Run(args []string) int {
+	var configPath, run string
+	var timeout time.Duration
+	var verbose bool
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetNone)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.StringVar(&configPath, "config", "", "")
+	flags.StringVar(&run, "run", "", "")
+	flags.DurationVar(&timeout, "timeout", 0, "")
+	flags.BoolVar(&verbose, "verbose", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) < 1 {
+		c.Ui.Error("This command takes at least one argument: <driver-binary>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	cfg := &conformance.Config{}
+	if configPath != "" {
+		var err error
+		cfg, err = conformance.ParseConfigFile(configPath)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error loading config: %s", err))
+			return 1
+		}
+	}
+
+	if run != "" {
+		filter, err := regexp.Compile(run)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Invalid -run expression: %s", err))
+			return 1
+		}
+		cfg.Filter = filter
+	}
+	if timeout != 0 {
+		cfg.Timeout = timeout
+	}
+
+	level := hclog.Error
+	if verbose {
+		level = hclog.Debug
+	}
+	cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:   "test-driver",
+		Level:  level,
+		Output: os.Stderr,
+	})
+
+	cfg.Launch = conformance.BinaryLauncher(args[0], args[1:]...)
+	cfg.Chroot = cconfig.DefaultChrootEnv
+
+	report := conformance.Run(cfg)
+
+	if verbose && report.Capabilities != nil {
+		caps := report.Capabilities
+		mounts := "all"
+		if caps.MountConfigs == drivers.MountConfigSupportNone {
+			mounts = "none"
+		}
+		c.Ui.Output(c.Colorize().Color(fmt.Sprintf("[bold]Driver %q capabilities[reset]", report.Driver)))
+		c.Ui.Output(formatKV([]string{
+			fmt.Sprintf("Send Signals|%t", caps.SendSignals),
+			fmt.Sprintf("Exec|%t", caps.Exec),
+			fmt.Sprintf("FS Isolation|%s", caps.FSIsolation),
+			fmt.Sprintf("Mount Configs|%s", mounts),
+			fmt.Sprintf("Update Resources|%t", caps.UpdateResources),
+			fmt.Sprintf("Pause|%t", caps.Pause),
+		}))
+		c.Ui.Output("")
+	}
+
+	c.Ui.Output(c.Colorize().Color("[bold]Scenarios[reset]"))
+	c.Ui.Output(formatReport(report))
+
+	if report.Failed() {
+		c.Ui.Error("\nDriver failed the conformance suite")
+		return 1
+	}
+	return 0
+}
+
+// formatReport returns a table of the results of a conformance run.
+func formatReport(report *conformance.Report) string {
+	var passed, failed, skipped int
+	rows := make([]string, 0, len(report.Results)+1)
+	rows = append(rows, "Scenario|Result|Duration|Message")
+	for _, res := range report.Results {
+		switch res.Status {
+		case conformance.StatusPass:
+			passed++
+		case conformance.StatusFail:
+			failed++
+		case conformance.StatusSkip:
+			skipped++
+		}
+
+		msg := res.Message
+		if msg == "" {
+			msg = "<none>"
+		}
+		rows = append(rows, fmt.Sprintf("%s|%s|%s|%s",
+			res.Name, res.Status, res.Duration.Round(time.Millisecond), msg))
+	}
+
+	return fmt.Sprintf("%s\n\n%d passed, %d failed, %d skipped",
+		formatList(rows), passed, failed, skipped)
+}
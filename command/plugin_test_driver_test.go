@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/plugins/drivers/conformance"
+	"github.com/shoenig/test/must"
+)
+
+func TestPluginTestDriverCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &PluginTestDriverCommand{}
+}
+
+func TestPluginTestDriverCommand_Fails(t *testing.T) {
+	ci.Parallel(t)
+	ui := cli.NewMockUi()
+	cmd := &PluginTestDriverCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on misuse
+	code := cmd.Run([]string{})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), commandErrorText(cmd))
+	ui.ErrorWriter.Reset()
+
+	// Fails on an invalid config file
+	path := filepath.Join(t.TempDir(), "driver.hcl")
+	must.NoError(t, os.WriteFile(path, []byte(`tasks {}`), 0o644))
+	code = cmd.Run([]string{"-config", path, "/bin/true"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "invalid key: tasks")
+	ui.ErrorWriter.Reset()
+
+	// Fails on an invalid filter
+	code = cmd.Run([]string{"-run", "(", "/bin/true"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "Invalid -run expression")
+	ui.ErrorWriter.Reset()
+
+	// Fails if the driver can't be launched
+	code = cmd.Run([]string{filepath.Join(t.TempDir(), "missing-driver")})
+	must.One(t, code)
+	must.StrContains(t, ui.OutputWriter.String(), "launch")
+	must.StrContains(t, ui.ErrorWriter.String(), "Driver failed the conformance suite")
+}
+
+func TestPluginTestDriverCommand_formatReport(t *testing.T) {
+	ci.Parallel(t)
+
+	out := formatReport(&conformance.Report{
+		Results: []*conformance.Result{
+			{Name: "fingerprint", Status: conformance.StatusPass, Duration: 12 * time.Millisecond},
+			{Name: "recover", Status: conformance.StatusFail, Message: "failed to recover task"},
+			{Name: "pause", Status: conformance.StatusSkip, Message: "driver doesn't advertise the Pause capability"},
+		},
+	})
+
+	must.StrContains(t, out, "fingerprint  pass    12ms      <none>")
+	must.StrContains(t, out, "recover      fail    0s        failed to recover task")
+	must.StrContains(t, out, "1 passed, 1 failed, 1 skipped")
+}
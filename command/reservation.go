@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+)
+
+type ReservationCommand struct {
+	Meta
+}
+
+func (c *ReservationCommand) Name() string {
+	return "reservation"
+}
+
+func (c *ReservationCommand) Synopsis() string {
+	return "Interact with reservations"
+}
+
+func (c *ReservationCommand) Help() string {
+	helpText := `
+Usage: nomad reservation <subcommand> [options] [args]
+
+  This command groups subcommands for interacting with reservations.
+  Reservations hold CPU, memory, and devices on a set of nodes for a window of
+  time. While a reservation is active, the reserved capacity is only available
+  to jobs that reference the reservation.
+
+  Create or update a reservation:
+
+    $ nomad reservation apply <path>
+
+  List all reservations:
+
+    $ nomad reservation list
+
+  Fetch the status of an existing reservation:
+
+    $ nomad reservation status <name>
+
+  Delete a reservation:
+
+    $ nomad reservation delete <name>
+
+  Please refer to individual subcommand help for detailed usage information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ReservationCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+// reservationStatus returns whether the reservation is pending, active, or
+// expired at the given time.
+func reservationStatus(res *api.Reservation, now time.Time) string {
+	switch {
+	case now.Before(res.StartTime):
+		return "pending"
+	case now.Before(res.EndTime):
+		return "active"
+	default:
+		return "expired"
+	}
+}
+
+func formatReservationList(reservations []*api.Reservation, showNamespace bool) string {
+	now := time.Now()
+
+	out := make([]string, len(reservations)+1)
+	if showNamespace {
+		out[0] = "Name|Namespace|Node Pool|Status|Start|End"
+	} else {
+		out[0] = "Name|Node Pool|Status|Start|End"
+	}
+	for i, res := range reservations {
+		row := []string{res.Name}
+		if showNamespace {
+			row = append(row, res.Namespace)
+		}
+		row = append(row,
+			res.NodePool,
+			reservationStatus(res, now),
+			formatTime(res.StartTime),
+			formatTime(res.EndTime),
+		)
+		out[i+1] = strings.Join(row, "|")
+	}
+	return formatList(out)
+}
+
+// reservationByPrefix returns a reservation that matches the given prefix or
+// a list of all matches if an exact match is not found.
+func reservationByPrefix(client *api.Client, prefix string) (*api.Reservation, []*api.Reservation, error) {
+	reservations, _, err := client.Reservations().PrefixList(prefix, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch len(reservations) {
+	case 0:
+		return nil, nil, fmt.Errorf("No reservation with prefix %q found", prefix)
+	case 1:
+		return reservations[0], nil, nil
+	default:
+		for _, res := range reservations {
+			if res.Name == prefix {
+				return res, nil, nil
+			}
+		}
+		return nil, reservations, nil
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/mitchellh/mapstructure"
+	"github.com/posener/complete"
+)
+
+type ReservationApplyCommand struct {
+	Meta
+}
+
+func (c *ReservationApplyCommand) Name() string {
+	return "reservation apply"
+}
+
+func (c *ReservationApplyCommand) Synopsis() string {
+	return "Create or update a reservation"
+}
+
+func (c *ReservationApplyCommand) Help() string {
+	helpText := `
+Usage: nomad reservation apply [options] <input>
+
+  Apply is used to create or update a reservation. The specification file is
+  read from stdin by specifying "-", otherwise a path to the file is expected.
+
+  If ACLs are enabled, this command requires a token with the 'operator:write'
+  capability.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Apply Options:
+
+  -json
+    Parse the input as a JSON reservation specification.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ReservationApplyCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+		})
+}
+
+func (c *ReservationApplyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictOr(
+		complete.PredictFiles("*.hcl"),
+		complete.PredictFiles("*.json"),
+	)
+}
+
+func (c *ReservationApplyCommand) Run(args []string) int {
+	var jsonInput bool
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&jsonInput, "json", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we only have one argument.
+	args = flags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("This command takes one argument: <input>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Read input content.
+	path := args[0]
+	var content []byte
+	var err error
+	switch path {
+	case "-":
+		content, err = io.ReadAll(os.Stdin)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to read stdin: %v", err))
+			return 1
+		}
+	default:
+		content, err = os.ReadFile(path)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to read file %q: %v", path, err))
+			return 1
+		}
+	}
+
+	// Parse input.
+	var res *api.Reservation
+	if jsonInput {
+		err = json.Unmarshal(content, &res)
+	} else {
+		res, err = parseReservationSpec(content)
+	}
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to parse input content: %v", err))
+		return 1
+	}
+
+	// Make API request.
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	_, err = client.Reservations().Register(res, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error applying reservation: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully applied reservation %q!", res.Name))
+	return 0
+}
+
+// parseReservationSpec parses the HCL reservation specification into an API
+// reservation. The start and end times are RFC 3339 strings.
+func parseReservationSpec(content []byte) (*api.Reservation, error) {
+	root, err := hcl.ParseBytes(content)
+	if err != nil {
+		return nil, err
+	}
+
+	list, ok := root.Node.(*ast.ObjectList)
+	if !ok {
+		return nil, errors.New("error parsing: root should be an object")
+	}
+
+	matches := list.Filter("reservation")
+	if len(matches.Items) == 0 {
+		return nil, errors.New("missing reservation block")
+	}
+	if len(matches.Items) > 1 {
+		return nil, errors.New("only one reservation block is allowed")
+	}
+	item := matches.Items[0]
+	if len(item.Keys) != 1 {
+		return nil, errors.New("reservation block must have a name label")
+	}
+
+	valid := []string{
+		"description",
+		"namespace",
+		"node_pool",
+		"node_class",
+		"start",
+		"end",
+		"constraint",
+		"resources",
+	}
+	if err := helper.CheckHCLKeys(item.Val, valid); err != nil {
+		return nil, err
+	}
+
+	ot, ok := item.Val.(*ast.ObjectType)
+	if !ok {
+		return nil, errors.New("reservation should be an object")
+	}
+
+	var m map[string]any
+	if err := hcl.DecodeObject(&m, ot); err != nil {
+		return nil, err
+	}
+	delete(m, "constraint")
+	delete(m, "resources")
+
+	var block struct {
+		Description string `mapstructure:"description"`
+		Namespace   string `mapstructure:"namespace"`
+		NodePool    string `mapstructure:"node_pool"`
+		NodeClass   string `mapstructure:"node_class"`
+		Start       string `mapstructure:"start"`
+		End         string `mapstructure:"end"`
+	}
+	if err := mapstructure.WeakDecode(m, &block); err != nil {
+		return nil, err
+	}
+
+	res := &api.Reservation{
+		Name:        item.Keys[0].Token.Value().(string),
+		Namespace:   block.Namespace,
+		Description: block.Description,
+		NodePool:    block.NodePool,
+		NodeClass:   block.NodeClass,
+	}
+
+	if res.StartTime, err = time.Parse(time.RFC3339, block.Start); err != nil {
+		return nil, fmt.Errorf("invalid start time: %w", err)
+	}
+	if res.EndTime, err = time.Parse(time.RFC3339, block.End); err != nil {
+		return nil, fmt.Errorf("invalid end time: %w", err)
+	}
+
+	if o := ot.List.Filter("constraint"); len(o.Items) > 0 {
+		if err := parseConstraints(&res.Constraints, o); err != nil {
+			return nil, fmt.Errorf("invalid constraint: %w", err)
+		}
+	}
+	if o := ot.List.Filter("resources"); len(o.Items) > 0 {
+		if res.Resources, err = parseReservationResources(o); err != nil {
+			return nil, fmt.Errorf("invalid resources: %w", err)
+		}
+	}
+
+	return res, nil
+}
+
+func parseReservationResources(list *ast.ObjectList) (*api.ReservationResources, error) {
+	if len(list.Items) > 1 {
+		return nil, errors.New("only one resources block is allowed")
+	}
+	item := list.Items[0]
+
+	valid := []string{"cpu", "memory", "device"}
+	if err := helper.CheckHCLKeys(item.Val, valid); err != nil {
+		return nil, err
+	}
+
+	ot, ok := item.Val.(*ast.ObjectType)
+	if !ok {
+		return nil, errors.New("resources should be an object")
+	}
+
+	var m map[string]any
+	if err := hcl.DecodeObject(&m, ot); err != nil {
+		return nil, err
+	}
+	delete(m, "device")
+
+	var block struct {
+		CPU      int `mapstructure:"cpu"`
+		MemoryMB int `mapstructure:"memory"`
+	}
+	if err := mapstructure.WeakDecode(m, &block); err != nil {
+		return nil, err
+	}
+	resources := &api.ReservationResources{
+		CPU:      block.CPU,
+		MemoryMB: block.MemoryMB,
+	}
+
+	for _, o := range ot.List.Filter("device").Items {
+		if len(o.Keys) != 1 {
+			return nil, errors.New("device block must have a name label")
+		}
+		if err := helper.CheckHCLKeys(o.Val, []string{"count", "constraint"}); err != nil {
+			return nil, err
+		}
+
+		dot, ok := o.Val.(*ast.ObjectType)
+		if !ok {
+			return nil, errors.New("device should be an object")
+		}
+
+		var dm map[string]any
+		if err := hcl.DecodeObject(&dm, dot); err != nil {
+			return nil, err
+		}
+		delete(dm, "constraint")
+
+		device := &api.RequestedDevice{Name: o.Keys[0].Token.Value().(string)}
+		if err := mapstructure.WeakDecode(dm, device); err != nil {
+			return nil, err
+		}
+		if c := dot.List.Filter("constraint"); len(c.Items) > 0 {
+			if err := parseConstraints(&device.Constraints, c); err != nil {
+				return nil, fmt.Errorf("invalid device constraint: %w", err)
+			}
+		}
+		resources.Devices = append(resources.Devices, device)
+	}
+
+	return resources, nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestReservationApplyCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &ReservationApplyCommand{}
+}
+
+func TestReservationApplyCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start test server.
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	waitForNodes(t, client)
+
+	start := time.Now().UTC().Truncate(time.Second)
+	end := start.Add(time.Hour)
+
+	spec := `
+reservation "batch-window" {
+  description = "Nightly batch window"
+  node_class  = "batch"
+  start       = "` + start.Format(time.RFC3339) + `"
+  end         = "` + end.Format(time.RFC3339) + `"
+
+  constraint {
+    attribute = "${attr.kernel.name}"
+    value     = "linux"
+  }
+
+  resources {
+    cpu    = 2000
+    memory = 4096
+  }
+}
+`
+	path := filepath.Join(t.TempDir(), "reservation.nomad.hcl")
+	must.NoError(t, os.WriteFile(path, []byte(spec), 0o644))
+
+	// Initialize UI and command.
+	ui := cli.NewMockUi()
+	cmd := &ReservationApplyCommand{Meta: Meta{Ui: ui}}
+
+	// Create reservation.
+	code := cmd.Run([]string{"-address", url, path})
+	must.Eq(t, 0, code)
+	must.StrContains(t, ui.OutputWriter.String(), `Successfully applied reservation "batch-window"!`)
+
+	// Verify reservation was created.
+	got, _, err := client.Reservations().Info("batch-window", nil)
+	must.NoError(t, err)
+	must.Eq(t, "Nightly batch window", got.Description)
+	must.Eq(t, "default", got.NodePool)
+	must.Eq(t, "batch", got.NodeClass)
+	must.Len(t, 1, got.Constraints)
+	must.Eq(t, &api.ReservationResources{CPU: 2000, MemoryMB: 4096}, got.Resources)
+	must.True(t, start.Equal(got.StartTime))
+	must.True(t, end.Equal(got.EndTime))
+}
+
+func TestReservationApplyCommand_Run_fail(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start test server.
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	waitForNodes(t, client)
+
+	testCases := []struct {
+		name        string
+		spec        string
+		expectedErr string
+	}{
+		{
+			name:        "missing block",
+			spec:        ``,
+			expectedErr: "missing reservation block",
+		},
+		{
+			name: "invalid time",
+			spec: `
+reservation "invalid" {
+  start = "tomorrow"
+  end   = "2030-01-01T00:00:00Z"
+  resources {
+    cpu = 100
+  }
+}`,
+			expectedErr: "invalid start time",
+		},
+		{
+			name: "invalid window",
+			spec: `
+reservation "invalid" {
+  start = "2030-01-02T00:00:00Z"
+  end   = "2030-01-01T00:00:00Z"
+  resources {
+    cpu = 100
+  }
+}`,
+			expectedErr: "end time must be after start time",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "reservation.nomad.hcl")
+			must.NoError(t, os.WriteFile(path, []byte(tc.spec), 0o644))
+
+			// Initialize UI and command.
+			ui := cli.NewMockUi()
+			cmd := &ReservationApplyCommand{Meta: Meta{Ui: ui}}
+
+			// Run command.
+			code := cmd.Run([]string{"-address", url, path})
+			must.Eq(t, 1, code)
+			must.StrContains(t, ui.ErrorWriter.String(), tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/posener/complete"
+)
+
+type ReservationDeleteCommand struct {
+	Meta
+}
+
+func (c *ReservationDeleteCommand) Name() string {
+	return "reservation delete"
+}
+
+func (c *ReservationDeleteCommand) Synopsis() string {
+	return "Delete a reservation"
+}
+
+func (c *ReservationDeleteCommand) Help() string {
+	helpText := `
+Usage: nomad reservation delete [options] <name>
+
+  Delete is used to remove a reservation. The capacity held by the reservation
+  becomes available to every job immediately.
+
+  If ACLs are enabled, this command requires a token with the 'operator:write'
+  capability.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault)
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ReservationDeleteCommand) AutocompleteFlags() complete.Flags {
+	return c.Meta.AutocompleteFlags(FlagSetClient)
+}
+
+func (c *ReservationDeleteCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ReservationDeleteCommand) Run(args []string) int {
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we only have one argument.
+	args = flags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("This command takes one argument: <name>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+	name := args[0]
+
+	// Make API request.
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	_, err = client.Reservations().Delete(name, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error deleting reservation: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully deleted reservation %q!", name))
+	return 0
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestReservationDeleteCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &ReservationDeleteCommand{}
+}
+
+func TestReservationDeleteCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start test server.
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	waitForNodes(t, client)
+
+	// Register test reservation.
+	now := time.Now().UTC().Truncate(time.Second)
+	res := &api.Reservation{
+		Name:      "dev-1",
+		StartTime: now,
+		EndTime:   now.Add(time.Hour),
+		Resources: &api.ReservationResources{CPU: 100},
+	}
+	_, err := client.Reservations().Register(res, nil)
+	must.NoError(t, err)
+
+	// Initialize UI and command.
+	ui := cli.NewMockUi()
+	cmd := &ReservationDeleteCommand{Meta: Meta{Ui: ui}}
+
+	// Delete test reservation.
+	code := cmd.Run([]string{"-address", url, res.Name})
+	must.Eq(t, 0, code)
+	must.StrContains(t, ui.OutputWriter.String(), "Successfully deleted")
+
+	// Verify reservation was deleted.
+	got, _, err := client.Reservations().Info(res.Name, nil)
+	must.ErrorContains(t, err, "404")
+	must.Nil(t, got)
+
+	// Deleting a missing reservation fails.
+	ui = cli.NewMockUi()
+	cmd = &ReservationDeleteCommand{Meta: Meta{Ui: ui}}
+	code = cmd.Run([]string{"-address", url, res.Name})
+	must.Eq(t, 1, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "not found")
+}
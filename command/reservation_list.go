@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type ReservationListCommand struct {
+	Meta
+}
+
+func (c *ReservationListCommand) Name() string {
+	return "reservation list"
+}
+
+func (c *ReservationListCommand) Synopsis() string {
+	return "List reservations"
+}
+
+func (c *ReservationListCommand) Help() string {
+	helpText := `
+Usage: nomad reservation list [options]
+
+  List is used to list existing reservations.
+
+  If ACLs are enabled, this command requires a token with the 'read-job'
+  capability for the namespaces of the reservations.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+List Options:
+
+  -filter
+    Specifies an expression used to filter results.
+
+  -json
+    Output the reservations in JSON format.
+
+  -page-token
+    Where to start pagination.
+
+  -per-page
+    How many results to show per page. If not specified, or set to 0, all
+    results are returned.
+
+  -t
+    Format and display the reservations using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ReservationListCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-filter":     complete.PredictAnything,
+			"-json":       complete.PredictNothing,
+			"-page-token": complete.PredictAnything,
+			"-per-page":   complete.PredictAnything,
+			"-t":          complete.PredictAnything,
+		})
+}
+
+func (c *ReservationListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ReservationListCommand) Run(args []string) int {
+	var json bool
+	var perPage int
+	var tmpl, pageToken, filter string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.StringVar(&filter, "filter", "", "")
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&pageToken, "page-token", "", "")
+	flags.IntVar(&perPage, "per-page", 0, "")
+	flags.StringVar(&tmpl, "t", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we don't have any arguments.
+	if len(flags.Args()) != 0 {
+		c.Ui.Error("This command takes no arguments")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Make list request.
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	opts := &api.QueryOptions{
+		Filter:    filter,
+		PerPage:   int32(perPage),
+		NextToken: pageToken,
+	}
+	reservations, qm, err := client.Reservations().List(opts)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying reservations: %s", err))
+		return 1
+	}
+
+	// Format output if requested.
+	if json || tmpl != "" {
+		out, err := Format(json, tmpl, reservations)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error formatting output: %s", err))
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
+	if len(reservations) == 0 {
+		c.Ui.Output("No reservations found")
+		return 0
+	}
+
+	c.Ui.Output(formatReservationList(reservations, c.allNamespaces()))
+
+	if qm.NextToken != "" {
+		c.Ui.Output(fmt.Sprintf(`
+Results have been paginated. To get the next page run:
+
+%s -page-token %s`, argsWithoutPageToken(os.Args), qm.NextToken))
+	}
+
+	return 0
+}
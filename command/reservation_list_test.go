@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestReservationListCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &ReservationListCommand{}
+}
+
+func TestReservationListCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start test server.
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	waitForNodes(t, client)
+
+	// Initialize UI and command.
+	ui := cli.NewMockUi()
+	cmd := &ReservationListCommand{Meta: Meta{Ui: ui}}
+
+	code := cmd.Run([]string{"-address", url})
+	must.Eq(t, 0, code)
+	must.StrContains(t, ui.OutputWriter.String(), "No reservations found")
+
+	// Register test reservations.
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, res := range []*api.Reservation{
+		{
+			Name:      "active",
+			StartTime: now.Add(-time.Minute),
+			EndTime:   now.Add(time.Hour),
+			Resources: &api.ReservationResources{CPU: 100},
+		},
+		{
+			Name:      "pending",
+			StartTime: now.Add(time.Hour),
+			EndTime:   now.Add(2 * time.Hour),
+			Resources: &api.ReservationResources{MemoryMB: 100},
+		},
+	} {
+		_, err := client.Reservations().Register(res, nil)
+		must.NoError(t, err)
+	}
+
+	ui.OutputWriter.Reset()
+	code = cmd.Run([]string{"-address", url})
+	must.Eq(t, 0, code)
+	out := ui.OutputWriter.String()
+	must.RegexMatch(t, regexp.MustCompile(`active\s+default\s+active`), out)
+	must.RegexMatch(t, regexp.MustCompile(`pending\s+default\s+pending`), out)
+
+	// Test JSON output.
+	ui.OutputWriter.Reset()
+	code = cmd.Run([]string{"-address", url, "-json"})
+	must.Eq(t, 0, code)
+
+	var got []*api.Reservation
+	must.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &got))
+	must.Len(t, 2, got)
+
+	// Test filter.
+	ui.OutputWriter.Reset()
+	code = cmd.Run([]string{"-address", url, "-json", "-filter", `Name == "pending"`})
+	must.Eq(t, 0, code)
+
+	got = nil
+	must.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &got))
+	must.Len(t, 1, got)
+	must.Eq(t, "pending", got[0].Name)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/posener/complete"
+)
+
+type ReservationStatusCommand struct {
+	Meta
+}
+
+func (c *ReservationStatusCommand) Name() string {
+	return "reservation status"
+}
+
+func (c *ReservationStatusCommand) Synopsis() string {
+	return "Display status information about a reservation"
+}
+
+func (c *ReservationStatusCommand) Help() string {
+	helpText := `
+Usage: nomad reservation status [options] <name>
+
+  Status is used to fetch information about an existing reservation.
+
+  If ACLs are enabled, this command requires a token with the 'read-job'
+  capability for the reservation's namespace.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Status Options:
+
+  -json
+    Output the reservation in its JSON format.
+
+  -t
+    Format and display the reservation using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ReservationStatusCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+			"-t":    complete.PredictAnything,
+		})
+}
+
+func (c *ReservationStatusCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ReservationStatusCommand) Run(args []string) int {
+	var json bool
+	var tmpl string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we only have one argument.
+	args = flags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("This command takes one argument: <name>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Lookup reservation by prefix.
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	res, possible, err := reservationByPrefix(client, args[0])
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error retrieving reservation: %s", err))
+		return 1
+	}
+	if len(possible) != 0 {
+		c.Ui.Error(fmt.Sprintf("Prefix matched multiple reservations\n\n%s",
+			formatReservationList(possible, c.allNamespaces())))
+		return 1
+	}
+
+	// Format output if requested.
+	if json || tmpl != "" {
+		out, err := Format(json, tmpl, res)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
+	// Print reservation information.
+	basic := []string{
+		fmt.Sprintf("Name|%s", res.Name),
+		fmt.Sprintf("Namespace|%s", res.Namespace),
+		fmt.Sprintf("Description|%s", res.Description),
+		fmt.Sprintf("Node Pool|%s", res.NodePool),
+		fmt.Sprintf("Node Class|%s", res.NodeClass),
+		fmt.Sprintf("Status|%s", reservationStatus(res, time.Now())),
+		fmt.Sprintf("Start|%s", formatTime(res.StartTime)),
+		fmt.Sprintf("End|%s", formatTime(res.EndTime)),
+	}
+	c.Ui.Output(formatKV(basic))
+
+	c.Ui.Output(c.Colorize().Color("\n[bold]Reserved Resources Per Node[reset]"))
+	if r := res.Resources; r != nil {
+		resources := []string{
+			fmt.Sprintf("CPU|%d MHz", r.CPU),
+			fmt.Sprintf("Memory|%d MiB", r.MemoryMB),
+		}
+		for _, d := range r.Devices {
+			var count uint64
+			if d.Count != nil {
+				count = *d.Count
+			}
+			resources = append(resources, fmt.Sprintf("Device %s|%d", d.Name, count))
+		}
+		c.Ui.Output(formatKV(resources))
+	}
+
+	if len(res.Constraints) > 0 {
+		c.Ui.Output(c.Colorize().Color("\n[bold]Constraints[reset]"))
+		constraints := make([]string, len(res.Constraints)+1)
+		constraints[0] = "Attribute|Operator|Value"
+		for i, con := range res.Constraints {
+			constraints[i+1] = fmt.Sprintf("%s|%s|%s", con.LTarget, con.Operand, con.RTarget)
+		}
+		c.Ui.Output(formatList(constraints))
+	}
+
+	return 0
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestReservationStatusCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &ReservationStatusCommand{}
+}
+
+func TestReservationStatusCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start test server.
+	srv, client, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	waitForNodes(t, client)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	res := &api.Reservation{
+		Name:        "maintenance-window",
+		Description: "Capacity for the maintenance window",
+		StartTime:   now.Add(-time.Minute),
+		EndTime:     now.Add(time.Hour),
+		Resources:   &api.ReservationResources{CPU: 500, MemoryMB: 256},
+		Constraints: []*api.Constraint{
+			{LTarget: "${attr.kernel.name}", RTarget: "linux", Operand: "="},
+		},
+	}
+	_, err := client.Reservations().Register(res, nil)
+	must.NoError(t, err)
+
+	// Initialize UI and command.
+	ui := cli.NewMockUi()
+	cmd := &ReservationStatusCommand{Meta: Meta{Ui: ui}}
+
+	// Read reservation using a prefix.
+	code := cmd.Run([]string{"-address", url, "maint"})
+	must.Eq(t, 0, code)
+	out := ui.OutputWriter.String()
+	must.StrContains(t, out, "maintenance-window")
+	must.StrContains(t, out, "Capacity for the maintenance window")
+	must.RegexMatch(t, regexp.MustCompile(`Status\s+= active`), out)
+	must.RegexMatch(t, regexp.MustCompile(`CPU\s+= 500`), out)
+	must.StrContains(t, out, "${attr.kernel.name}")
+
+	// Read non-existent reservation.
+	ui = cli.NewMockUi()
+	cmd = &ReservationStatusCommand{Meta: Meta{Ui: ui}}
+	code = cmd.Run([]string{"-address", url, "invalid"})
+	must.Eq(t, 1, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "No reservation with prefix")
+}
@@ -241,6 +241,7 @@ func (s *ServiceInfoCommand) formatVerboseOutput(jobIDs []string, jobServices ma
 				fmt.Sprintf("Node ID|%s", service.NodeID),
 				fmt.Sprintf("Datacenter|%s", service.Datacenter),
 				fmt.Sprintf("Address|%v", fmt.Sprintf("%s:%v", service.Address, service.Port)),
+				fmt.Sprintf("Check Status|%s", service.CheckStatus),
 				fmt.Sprintf("Tags|[%s]\n", strings.Join(service.Tags, ",")),
 			}
 			s.Ui.Output(formatKV(out))
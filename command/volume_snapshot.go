@@ -4,9 +4,14 @@
 package command
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/dustin/go-humanize"
 	"github.com/hashicorp/cli"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/helper"
 )
 
 type VolumeSnapshotCommand struct {
@@ -27,22 +32,72 @@ func (f *VolumeSnapshotCommand) Help() string {
 	helpText := `
 Usage: nomad volume snapshot <subcommand> [options] [args]
 
-  This command groups subcommands for interacting with CSI volume snapshots.
+  This command groups subcommands for interacting with CSI and dynamic host
+  volume snapshots.
 
   Create a snapshot of an external storage volume:
 
-      $ nomad volume snapshot create <volume id>
+      $ nomad volume snapshot create <volume id> <snapshot name>
 
   Display a list of CSI volume snapshots along with their
   source volume ID as known to the external storage provider.
 
       $ nomad volume snapshot list -plugin <plugin id>
 
+  Display a list of the snapshots of a dynamic host volume:
+
+      $ nomad volume snapshot list -type host -volume <volume id>
+
   Delete a snapshot of an external storage volume:
 
-      $ nomad volume snapshot delete <snapshot id>
+      $ nomad volume snapshot delete <plugin id> <snapshot id>
+
+  Delete a snapshot of a dynamic host volume:
+
+      $ nomad volume snapshot delete -type host <volume id> <snapshot id>
 
   Please see the individual subcommand help for detailed usage information.
 `
 	return strings.TrimSpace(helpText)
 }
+
+// hostVolumeSnapshotTarget resolves a host volume ID or prefix for the
+// snapshot commands, and returns the volume ID and namespace.
+func hostVolumeSnapshotTarget(client *api.Client, volID, ns string) (string, string, error) {
+	if helper.IsUUID(volID) {
+		return volID, ns, nil
+	}
+	stub, possible, err := getHostVolumeByPrefix(client, volID, ns)
+	if err != nil {
+		return "", "", fmt.Errorf("could not find existing host volume: %w", err)
+	}
+	if len(possible) > 0 {
+		out, err := formatHostVolumes(possible, formatOpts{short: true})
+		if err != nil {
+			return "", "", fmt.Errorf("error formatting: %w", err)
+		}
+		return "", "", fmt.Errorf("prefix matched multiple volumes\n\n%s", out)
+	}
+	return stub.ID, stub.Namespace, nil
+}
+
+func hostFormatSnapshots(snapshots []*api.HostVolumeSnapshot, verbose bool) string {
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].CreateTime < snapshots[j].CreateTime
+	})
+	rows := []string{"Snapshot ID|Name|Volume ID|Size|Create Time"}
+	length := 8
+	if verbose {
+		length = 36
+	}
+	for _, v := range snapshots {
+		rows = append(rows, fmt.Sprintf("%s|%s|%s|%s|%s",
+			limit(v.ID, length),
+			v.Name,
+			limit(v.VolumeID, length),
+			humanize.IBytes(uint64(v.SizeBytes)),
+			formatUnixNanoTime(v.CreateTime),
+		))
+	}
+	return formatList(rows)
+}
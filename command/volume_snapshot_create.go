@@ -28,10 +28,12 @@ Usage: nomad volume snapshot create <volume id> <snapshot_name>
 	volume must still be registered with Nomad in order to be snapshotted.
 
   Snapshot name will be passed to the CSI plugin to be used as the ID of the
-  resulting snapshot.
+  resulting snapshot. Snapshots of dynamic host volumes are given an ID by
+  Nomad, and are taken by the plugin that created the volume.
 
   When ACLs are enabled, this command requires a token with the
-  'csi-write-volume' capability for the volume's namespace.
+  'csi-write-volume' capability for the volume's namespace, or the
+  'host-volume-create' capability for dynamic host volumes.
 
 General Options:
 
@@ -45,7 +47,11 @@ Snapshot Create Options:
 
   -secret
     Secrets to pass to the plugin to create snapshot. Accepts multiple
-    flags in the form -secret key=value
+    flags in the form -secret key=value. Only available for CSI volumes.
+
+  -type <type>
+    Type of volume to snapshot. Must be one of "csi" or "host". Defaults to
+    "csi".
 
   -verbose
     Display full information for the resulting snapshot.
@@ -56,7 +62,9 @@ Snapshot Create Options:
 
 func (c *VolumeSnapshotCreateCommand) AutocompleteFlags() complete.Flags {
 	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
-		complete.Flags{})
+		complete.Flags{
+			"-type": complete.PredictSet("csi", "host"),
+		})
 }
 
 func (c *VolumeSnapshotCreateCommand) AutocompleteArgs() complete.Predictor {
@@ -86,9 +94,11 @@ func (c *VolumeSnapshotCreateCommand) Run(args []string) int {
 	flags.Usage = func() { c.Ui.Output(c.Help()) }
 
 	var verbose bool
+	var typeArg string
 	var parametersArgs flaghelper.StringFlag
 	var secretsArgs flaghelper.StringFlag
 	flags.BoolVar(&verbose, "verbose", false, "")
+	flags.StringVar(&typeArg, "type", "csi", "type of volume (csi or host)")
 	flags.Var(&parametersArgs, "parameter", "parameters for snapshot, ex. -parameter key=value")
 	flags.Var(&secretsArgs, "secret", "secrets for snapshot, ex. -secret key=value")
 
@@ -114,6 +124,26 @@ func (c *VolumeSnapshotCreateCommand) Run(args []string) int {
 		return 1
 	}
 
+	params := map[string]string{}
+	for _, kv := range parametersArgs {
+		if key, value, found := strings.Cut(kv, "="); found {
+			params[key] = value
+		}
+	}
+
+	switch typeArg {
+	case "csi":
+	case "host":
+		if len(secretsArgs) > 0 {
+			c.Ui.Error("The -secret flag is only available for CSI volumes")
+			return 1
+		}
+		return c.snapshotHostVolume(client, volID, snapshotName, params, verbose)
+	default:
+		c.Ui.Error(fmt.Sprintf("No such volume type %q", typeArg))
+		return 1
+	}
+
 	secrets := api.CSISecrets{}
 	for _, kv := range secretsArgs {
 		if key, value, found := strings.Cut(kv, "="); found {
@@ -124,13 +154,6 @@ func (c *VolumeSnapshotCreateCommand) Run(args []string) int {
 		}
 	}
 
-	params := map[string]string{}
-	for _, kv := range parametersArgs {
-		if key, value, found := strings.Cut(kv, "="); found {
-			params[key] = value
-		}
-	}
-
 	snaps, _, err := client.CSIVolumes().CreateSnapshot(&api.CSISnapshot{
 		SourceVolumeID: volID,
 		Name:           snapshotName,
@@ -145,3 +168,26 @@ func (c *VolumeSnapshotCreateCommand) Run(args []string) int {
 	c.Ui.Output(csiFormatSnapshots(snaps.Snapshots, verbose))
 	return 0
 }
+
+func (c *VolumeSnapshotCreateCommand) snapshotHostVolume(client *api.Client,
+	volID, snapshotName string, params map[string]string, verbose bool) int {
+
+	volID, ns, err := hostVolumeSnapshotTarget(client, volID, c.namespace)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	resp, _, err := client.HostVolumes().CreateSnapshot(&api.HostVolumeSnapshotCreateRequest{
+		VolumeID:   volID,
+		Name:       snapshotName,
+		Parameters: params,
+	}, &api.WriteOptions{Namespace: ns})
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error snapshotting volume: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(hostFormatSnapshots([]*api.HostVolumeSnapshot{resp.Snapshot}, verbose))
+	return 0
+}
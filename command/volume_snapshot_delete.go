@@ -20,11 +20,14 @@ type VolumeSnapshotDeleteCommand struct {
 func (c *VolumeSnapshotDeleteCommand) Help() string {
 	helpText := `
 Usage: nomad volume snapshot delete [options] <plugin id> <snapshot id>
+       nomad volume snapshot delete -type host [options] <volume id> <snapshot id>
 
-  Delete a snapshot from an external storage provider.
+  Delete a snapshot from an external storage provider, or a snapshot of a
+  dynamic host volume.
 
   When ACLs are enabled, this command requires a token with the
-  'csi-write-volume' and 'plugin:read' capabilities.
+  'csi-write-volume' and 'plugin:read' capabilities, or the
+  'host-volume-delete' capability for the host volume's namespace.
 
 General Options:
 
@@ -34,8 +37,11 @@ Snapshot Options:
 
   -secret
     Secrets to pass to the plugin to delete the snapshot. Accepts multiple
-    flags in the form -secret key=value
+    flags in the form -secret key=value. Only available for CSI volumes.
 
+  -type <type>
+    Type of volume the snapshot was taken from. Must be one of "csi" or
+    "host". Defaults to "csi".
 `
 	return strings.TrimSpace(helpText)
 }
@@ -44,6 +50,7 @@ func (c *VolumeSnapshotDeleteCommand) AutocompleteFlags() complete.Flags {
 	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
 		complete.Flags{
 			"-secret": complete.PredictNothing,
+			"-type":   complete.PredictSet("csi", "host"),
 		})
 }
 
@@ -70,9 +77,11 @@ func (c *VolumeSnapshotDeleteCommand) Name() string { return "volume snapshot de
 
 func (c *VolumeSnapshotDeleteCommand) Run(args []string) int {
 	var secretsArgs flaghelper.StringFlag
+	var typeArg string
 	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
 	flags.Usage = func() { c.Ui.Output(c.Help()) }
 	flags.Var(&secretsArgs, "secret", "secrets for snapshot, ex. -secret key=value")
+	flags.StringVar(&typeArg, "type", "csi", "type of volume (csi or host)")
 
 	if err := flags.Parse(args); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error parsing arguments %s", err))
@@ -95,6 +104,20 @@ func (c *VolumeSnapshotDeleteCommand) Run(args []string) int {
 		return 1
 	}
 
+	switch typeArg {
+	case "csi":
+	case "host":
+		if len(secretsArgs) > 0 {
+			c.Ui.Error("The -secret flag is only available for CSI volumes")
+			return 1
+		}
+		// the first argument is the volume ID for host volumes
+		return c.deleteHostVolumeSnapshot(client, args[0], snapID)
+	default:
+		c.Ui.Error(fmt.Sprintf("No such volume type %q", typeArg))
+		return 1
+	}
+
 	secrets := api.CSISecrets{}
 	for _, kv := range secretsArgs {
 		if key, value, found := strings.Cut(kv, "="); found {
@@ -117,3 +140,23 @@ func (c *VolumeSnapshotDeleteCommand) Run(args []string) int {
 
 	return 0
 }
+
+func (c *VolumeSnapshotDeleteCommand) deleteHostVolumeSnapshot(client *api.Client, volID, snapID string) int {
+	volID, ns, err := hostVolumeSnapshotTarget(client, volID, c.namespace)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	_, err = client.HostVolumes().DeleteSnapshot(&api.HostVolumeSnapshotDeleteRequest{
+		VolumeID:   volID,
+		SnapshotID: snapID,
+	}, &api.WriteOptions{Namespace: ns})
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error deleting snapshot: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully deleted snapshot %q of volume %q!", snapID, volID))
+	return 0
+}
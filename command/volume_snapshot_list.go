@@ -25,13 +25,16 @@ type VolumeSnapshotListCommand struct {
 func (c *VolumeSnapshotListCommand) Help() string {
 	helpText := `
 Usage: nomad volume snapshot list [-plugin plugin_id]
+       nomad volume snapshot list -type host -volume volume_id
 
   Display a list of CSI volume snapshots for a plugin along
   with their source volume ID as known to the external
-  storage provider.
+  storage provider, or a list of the snapshots of a dynamic
+  host volume.
 
   When ACLs are enabled, this command requires a token with the
-  'csi-list-volumes' capability for the plugin's namespace.
+  'csi-list-volumes' capability for the plugin's namespace, or the
+  'host-volume-read' capability for the host volume's namespace.
 
 General Options:
 
@@ -52,6 +55,14 @@ List Options:
     Secrets to pass to the plugin to list snapshots. Accepts multiple
     flags in the form -secret key=value
 
+  -type <type>
+    Type of volume to list snapshots for. Must be one of "csi" or "host".
+    Defaults to "csi".
+
+  -volume
+    Display the snapshots of a particular dynamic host volume. This
+    parameter is required for host volumes, and is only available for them.
+
   -verbose
     Display full information for snapshots.
 `
@@ -65,7 +76,10 @@ func (c *VolumeSnapshotListCommand) Synopsis() string {
 
 func (c *VolumeSnapshotListCommand) AutocompleteFlags() complete.Flags {
 	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
-		complete.Flags{})
+		complete.Flags{
+			"-type":   complete.PredictSet("csi", "host"),
+			"-volume": complete.PredictAnything,
+		})
 }
 
 func (c *VolumeSnapshotListCommand) AutocompleteArgs() complete.Predictor {
@@ -87,6 +101,8 @@ func (c *VolumeSnapshotListCommand) Name() string { return "volume snapshot list
 
 func (c *VolumeSnapshotListCommand) Run(args []string) int {
 	var pluginID string
+	var typeArg string
+	var volID string
 	var verbose bool
 	var secretsArgs flaghelper.StringFlag
 	var perPage int
@@ -95,6 +111,8 @@ func (c *VolumeSnapshotListCommand) Run(args []string) int {
 	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
 	flags.Usage = func() { c.Ui.Output(c.Help()) }
 	flags.StringVar(&pluginID, "plugin", "", "")
+	flags.StringVar(&typeArg, "type", "csi", "type of volume (csi or host)")
+	flags.StringVar(&volID, "volume", "", "")
 	flags.BoolVar(&verbose, "verbose", false, "")
 	flags.Var(&secretsArgs, "secret", "secrets for snapshot, ex. -secret key=value")
 	flags.IntVar(&perPage, "per-page", 30, "")
@@ -119,6 +137,23 @@ func (c *VolumeSnapshotListCommand) Run(args []string) int {
 		return 1
 	}
 
+	switch typeArg {
+	case "csi":
+		if volID != "" {
+			c.Ui.Error("The -volume flag is only available for host volumes")
+			return 1
+		}
+	case "host":
+		if volID == "" {
+			c.Ui.Error("The -volume flag is required for host volumes")
+			return 1
+		}
+		return c.listHostVolumeSnapshots(client, volID, verbose)
+	default:
+		c.Ui.Error(fmt.Sprintf("No such volume type %q", typeArg))
+		return 1
+	}
+
 	plugs, _, err := client.CSIPlugins().List(&api.QueryOptions{Prefix: pluginID})
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Error querying CSI plugins: %s", err))
@@ -184,6 +219,27 @@ Results have been paginated. To get the next page run:
 	return 0
 }
 
+func (c *VolumeSnapshotListCommand) listHostVolumeSnapshots(client *api.Client, volID string, verbose bool) int {
+	volID, ns, err := hostVolumeSnapshotTarget(client, volID, c.namespace)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	snaps, _, err := client.HostVolumes().ListSnapshots(volID, &api.QueryOptions{Namespace: ns})
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying snapshots of host volume %q: %s", volID, err))
+		return 1
+	}
+	if len(snaps) == 0 {
+		c.Ui.Output("No snapshots found")
+		return 0
+	}
+
+	c.Ui.Output(hostFormatSnapshots(snaps, verbose))
+	return 0
+}
+
 func csiFormatSnapshots(snapshots []*api.CSISnapshot, verbose bool) string {
 	rows := []string{"Snapshot ID|Volume ID|Size|Create Time|Ready?"}
 	length := 12
@@ -131,6 +131,12 @@ func formatHostVolume(vol *api.HostVolume, opts formatOpts) (string, error) {
 		fmt.Sprintf("State|%s", vol.State),
 		fmt.Sprintf("Host Path|%s", vol.HostPath),
 	}
+	if vol.SourceVolumeID != "" {
+		output = append(output, fmt.Sprintf("Source Volume ID|%s", vol.SourceVolumeID))
+	}
+	if vol.SnapshotID != "" {
+		output = append(output, fmt.Sprintf("Snapshot ID|%s", vol.SnapshotID))
+	}
 
 	// Exit early
 	if opts.short {
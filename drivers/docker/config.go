@@ -310,6 +310,16 @@ var (
 		// task containers.  If true, nomad doesn't start docker_logger/logmon processes
 		"disable_log_collection": hclspec.NewAttr("disable_log_collection", "bool", false),
 
+		// image_prefetch lists images the driver pulls in the background when it
+		// starts so that tasks using them don't wait on the pull
+		"image_prefetch": hclspec.NewBlock("image_prefetch", false, hclspec.NewObject(map[string]*hclspec.Spec{
+			"images": hclspec.NewAttr("images", "list(string)", false),
+			"pull_timeout": hclspec.NewDefault(
+				hclspec.NewAttr("pull_timeout", "string", false),
+				hclspec.NewLiteral(`"30m"`),
+			),
+		})),
+
 		// windows_allow_insecure_container_admin indicates that on windows,
 		// docker checks the task.user field or, if unset, the container image
 		// manifest after pulling the container, to see if it's running as
@@ -446,6 +456,7 @@ var (
 		},
 		MustInitiateNetwork: true,
 		MountConfigs:        drivers.MountConfigSupportAll,
+		Pause:               true,
 	}
 )
 
@@ -662,26 +673,27 @@ type ContainerGCConfig struct {
 }
 
 type DriverConfig struct {
-	Endpoint                           string        `codec:"endpoint"`
-	Auth                               AuthConfig    `codec:"auth"`
-	TLS                                TLSConfig     `codec:"tls"`
-	GC                                 GCConfig      `codec:"gc"`
-	Volumes                            VolumeConfig  `codec:"volumes"`
-	AllowPrivileged                    bool          `codec:"allow_privileged"`
-	AllowCaps                          []string      `codec:"allow_caps"`
-	GPURuntimeName                     string        `codec:"nvidia_runtime"`
-	InfraImage                         string        `codec:"infra_image"`
-	InfraImagePullTimeout              string        `codec:"infra_image_pull_timeout"`
-	infraImagePullTimeoutDuration      time.Duration `codec:"-"`
-	ContainerExistsAttempts            uint64        `codec:"container_exists_attempts"`
-	DisableLogCollection               bool          `codec:"disable_log_collection"`
-	PullActivityTimeout                string        `codec:"pull_activity_timeout"`
-	PidsLimit                          int64         `codec:"pids_limit"`
-	pullActivityTimeoutDuration        time.Duration `codec:"-"`
-	OOMScoreAdj                        int           `codec:"oom_score_adj"`
-	WindowsAllowInsecureContainerAdmin bool          `codec:"windows_allow_insecure_container_admin"`
-	ExtraLabels                        []string      `codec:"extra_labels"`
-	Logging                            LoggingConfig `codec:"logging"`
+	Endpoint                           string              `codec:"endpoint"`
+	Auth                               AuthConfig          `codec:"auth"`
+	TLS                                TLSConfig           `codec:"tls"`
+	GC                                 GCConfig            `codec:"gc"`
+	Volumes                            VolumeConfig        `codec:"volumes"`
+	AllowPrivileged                    bool                `codec:"allow_privileged"`
+	AllowCaps                          []string            `codec:"allow_caps"`
+	GPURuntimeName                     string              `codec:"nvidia_runtime"`
+	InfraImage                         string              `codec:"infra_image"`
+	InfraImagePullTimeout              string              `codec:"infra_image_pull_timeout"`
+	infraImagePullTimeoutDuration      time.Duration       `codec:"-"`
+	ContainerExistsAttempts            uint64              `codec:"container_exists_attempts"`
+	DisableLogCollection               bool                `codec:"disable_log_collection"`
+	PullActivityTimeout                string              `codec:"pull_activity_timeout"`
+	PidsLimit                          int64               `codec:"pids_limit"`
+	pullActivityTimeoutDuration        time.Duration       `codec:"-"`
+	OOMScoreAdj                        int                 `codec:"oom_score_adj"`
+	WindowsAllowInsecureContainerAdmin bool                `codec:"windows_allow_insecure_container_admin"`
+	ExtraLabels                        []string            `codec:"extra_labels"`
+	Logging                            LoggingConfig       `codec:"logging"`
+	ImagePrefetch                      ImagePrefetchConfig `codec:"image_prefetch"`
 
 	AllowRuntimesList []string            `codec:"allow_runtimes"`
 	allowRuntimes     map[string]struct{} `codec:"-"`
@@ -711,6 +723,15 @@ type GCConfig struct {
 	DanglingContainers ContainerGCConfig `codec:"dangling_containers"`
 }
 
+// ImagePrefetchConfig lists the images the driver pulls ahead of any task
+// that uses them, so that tasks on freshly started nodes don't wait on large
+// image pulls.
+type ImagePrefetchConfig struct {
+	Images              []string      `codec:"images"`
+	PullTimeout         string        `codec:"pull_timeout"`
+	pullTimeoutDuration time.Duration `codec:"-"`
+}
+
 type VolumeConfig struct {
 	Enabled      bool   `codec:"enabled"`
 	SelinuxLabel string `codec:"selinuxlabel"`
@@ -731,6 +752,7 @@ func (d *Driver) ConfigSchema() (*hclspec.Spec, error) {
 
 const danglingContainersCreationGraceMinimum = 1 * time.Minute
 const pullActivityTimeoutMinimum = 1 * time.Minute
+const defaultImagePrefetchPullTimeout = 30 * time.Minute
 
 func (d *Driver) SetConfig(c *base.Config) error {
 	var config DriverConfig
@@ -790,6 +812,15 @@ func (d *Driver) SetConfig(c *base.Config) error {
 		d.config.infraImagePullTimeoutDuration = dur
 	}
 
+	d.config.ImagePrefetch.pullTimeoutDuration = defaultImagePrefetchPullTimeout
+	if d.config.ImagePrefetch.PullTimeout != "" {
+		dur, err := time.ParseDuration(d.config.ImagePrefetch.PullTimeout)
+		if err != nil {
+			return fmt.Errorf("failed to parse 'pull_timeout' duration: %v", err)
+		}
+		d.config.ImagePrefetch.pullTimeoutDuration = dur
+	}
+
 	d.config.allowRuntimes = make(map[string]struct{}, len(d.config.AllowRuntimesList))
 	for _, r := range d.config.AllowRuntimesList {
 		d.config.allowRuntimes[r] = struct{}{}
@@ -817,6 +848,10 @@ func (d *Driver) SetConfig(c *base.Config) error {
 
 	go d.recoverPauseContainers(d.ctx)
 
+	if len(d.config.ImagePrefetch.Images) > 0 {
+		go d.prefetchImages(d.ctx)
+	}
+
 	return nil
 }
 
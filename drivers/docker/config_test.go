@@ -647,6 +647,7 @@ func TestConfig_Capabilities(t *testing.T) {
 				MustInitiateNetwork:  true,
 				MountConfigs:         0,
 				DisableLogCollection: false,
+				Pause:                true,
 			},
 		},
 		{
@@ -660,6 +661,7 @@ func TestConfig_Capabilities(t *testing.T) {
 				MustInitiateNetwork:  true,
 				MountConfigs:         0,
 				DisableLogCollection: true,
+				Pause:                true,
 			},
 		},
 		{
@@ -673,6 +675,7 @@ func TestConfig_Capabilities(t *testing.T) {
 				MustInitiateNetwork:  true,
 				MountConfigs:         0,
 				DisableLogCollection: false,
+				Pause:                true,
 			},
 		},
 	}
@@ -835,6 +838,55 @@ func TestConfig_DriverConfig_PullActivityTimeout(t *testing.T) {
 	}
 }
 
+func TestConfig_DriverConfig_ImagePrefetch(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name     string
+		config   string
+		expected ImagePrefetchConfig
+	}{
+		{
+			name:     "default",
+			config:   `{}`,
+			expected: ImagePrefetchConfig{},
+		},
+		{
+			name: "images only",
+			config: `{
+				image_prefetch {
+					images = ["redis:7", "example.com/app:1.2"]
+				}
+			}`,
+			expected: ImagePrefetchConfig{
+				Images:      []string{"redis:7", "example.com/app:1.2"},
+				PullTimeout: "30m",
+			},
+		},
+		{
+			name: "set explicitly",
+			config: `{
+				image_prefetch {
+					images       = ["redis:7"]
+					pull_timeout = "1h"
+				}
+			}`,
+			expected: ImagePrefetchConfig{
+				Images:      []string{"redis:7"},
+				PullTimeout: "1h",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var tc DriverConfig
+			hclutils.NewConfigParser(configSpec).ParseHCL(t, "config "+c.config, &tc)
+			must.Eq(t, c.expected, tc.ImagePrefetch)
+		})
+	}
+}
+
 func TestConfig_DriverConfig_AllowRuntimes(t *testing.T) {
 	ci.Parallel(t)
 
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"sort"
 	"sync"
 	"time"
 
@@ -26,6 +27,10 @@ var (
 	imageNotFoundMatcher = regexp.MustCompile(`Error: image .+ not found`)
 )
 
+// prefetchCallerID is the reference holder for images pulled by the
+// image_prefetch configuration.
+const prefetchCallerID = "nomad-image-prefetch"
+
 // pullFuture is a sharable future for retrieving a pulled images ID and user,
 // and any error that may have occurred during the pull.
 type pullFuture struct {
@@ -128,6 +133,10 @@ type dockerCoordinator struct {
 
 	// deleteFuture is indexed by image ID and has a cancelable delete future
 	deleteFuture map[string]context.CancelFunc
+
+	// prefetched is the set of image names that were prefetched and are
+	// present on the node
+	prefetched map[string]struct{}
 }
 
 // newDockerCoordinator returns a new Docker coordinator
@@ -142,6 +151,7 @@ func newDockerCoordinator(config *dockerCoordinatorConfig) *dockerCoordinator {
 		pullLoggers:             make(map[string][]LogEventFn),
 		imageRefCount:           make(map[string]map[string]struct{}),
 		deleteFuture:            make(map[string]context.CancelFunc),
+		prefetched:              make(map[string]struct{}),
 	}
 }
 
@@ -244,6 +254,47 @@ func (d *dockerCoordinator) pullImageImpl(imageID string, authOptions *registry.
 	return dockerImage.ID, imageUser, err
 }
 
+// PrefetchImage pulls the given image if it is not already present on the
+// node. Prefetched images hold a reference that is never released, so they
+// are not removed by image garbage collection.
+func (d *dockerCoordinator) PrefetchImage(image string, authOptions *registry.AuthConfig,
+	pullTimeout, pullActivityTimeout time.Duration) error {
+
+	if dockerImage, _, _ := d.client.ImageInspectWithRaw(d.ctx, image); dockerImage.ID != "" {
+		d.logger.Debug("prefetch image already present", "image_name", image, "image_id", dockerImage.ID)
+		d.imageLock.Lock()
+		defer d.imageLock.Unlock()
+		if d.cleanup {
+			d.incrementImageReferenceImpl(dockerImage.ID, image, prefetchCallerID)
+		}
+		d.prefetched[image] = struct{}{}
+		return nil
+	}
+
+	_, _, err := d.PullImage(image, authOptions, prefetchCallerID, noopLogEventFn, pullTimeout, pullActivityTimeout)
+	if err != nil {
+		return err
+	}
+
+	d.imageLock.Lock()
+	defer d.imageLock.Unlock()
+	d.prefetched[image] = struct{}{}
+	return nil
+}
+
+// PrefetchedImages returns the sorted names of the prefetched images present
+// on the node.
+func (d *dockerCoordinator) PrefetchedImages() []string {
+	d.imageLock.Lock()
+	defer d.imageLock.Unlock()
+	images := make([]string, 0, len(d.prefetched))
+	for image := range d.prefetched {
+		images = append(images, image)
+	}
+	sort.Strings(images)
+	return images
+}
+
 // IncrementImageReference is used to increment an image reference count
 func (d *dockerCoordinator) IncrementImageReference(imageID, imageName, callerID string) {
 	d.imageLock.Lock()
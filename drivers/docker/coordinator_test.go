@@ -435,3 +435,40 @@ func TestDockerCoordinator_PullImage_Timeouts(t *testing.T) {
 		})
 	}
 }
+
+func TestDockerCoordinator_PrefetchImage(t *testing.T) {
+	ci.Parallel(t)
+
+	mapping := map[string]string{"present:v1": "present"}
+	mock := newMockImageClient(mapping, 1*time.Millisecond)
+	config := &dockerCoordinatorConfig{
+		ctx:         context.Background(),
+		logger:      testlog.HCLogger(t),
+		cleanup:     true,
+		client:      mock,
+		removeDelay: 1 * time.Millisecond,
+	}
+	coordinator := newDockerCoordinator(config)
+
+	// images already on the node are not pulled again
+	must.NoError(t, coordinator.PrefetchImage("present:v1", nil, time.Minute, time.Minute))
+	must.NoError(t, coordinator.PrefetchImage("missing:v1", nil, time.Minute, time.Minute))
+
+	mock.lock.Lock()
+	must.Eq(t, 0, mock.pulled["present:v1"])
+	must.Eq(t, 1, mock.pulled["missing:v1"])
+	mock.lock.Unlock()
+
+	must.Eq(t, []string{"missing:v1", "present:v1"}, coordinator.PrefetchedImages())
+
+	// a task using the prefetched image doesn't release the prefetch
+	// reference when it stops, so the image is not garbage collected
+	callerID := uuid.Generate()
+	coordinator.IncrementImageReference("present", "present:v1", callerID)
+	coordinator.RemoveImage("present", callerID)
+	time.Sleep(10 * time.Millisecond)
+
+	mock.lock.Lock()
+	defer mock.lock.Unlock()
+	must.Eq(t, 0, mock.removed["present"])
+}
@@ -164,6 +164,10 @@ type Driver struct {
 	// gpuRuntime indicates nvidia-docker runtime availability
 	gpuRuntime bool
 
+	// engine describes the container engine as of the last fingerprint
+	engine     engineFeatures
+	engineLock sync.RWMutex
+
 	// compute contains information about the available cpu compute
 	compute cpustats.Compute
 
@@ -343,6 +347,12 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 
 	driverConfig.Image = strings.TrimPrefix(driverConfig.Image, "https://")
 
+	// reject options the engine can't honor before pulling the image, rather
+	// than surfacing an engine error when the container is created
+	if err := d.engineFeatures().validate(&driverConfig); err != nil {
+		return nil, nil, err
+	}
+
 	handle := drivers.NewTaskHandle(taskHandleVersion)
 	handle.Config = cfg
 
@@ -1764,6 +1774,26 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 	return h.dockerClient.ContainerKill(d.ctx, h.containerID, signal)
 }
 
+// PauseTask pauses the task's container, freezing its processes.
+func (d *Driver) PauseTask(taskID string) error {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return h.dockerClient.ContainerPause(d.ctx, h.containerID)
+}
+
+// ResumeTask unpauses the task's container.
+func (d *Driver) ResumeTask(taskID string) error {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return h.dockerClient.ContainerUnpause(d.ctx, h.containerID)
+}
+
 func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	h, ok := d.tasks.Get(taskID)
 	if !ok {
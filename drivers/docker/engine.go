@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package docker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/system"
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// podmanComponentName is the name of the server component reported by the
+// Podman compatibility API.
+const podmanComponentName = "Podman Engine"
+
+// engineFeatures describes the container engine the driver is connected to.
+// Rootless Docker and the Podman compatibility API accept the same API calls
+// as Docker but can't honor every task configuration, so tasks are validated
+// against the engine before any image is pulled or container created.
+type engineFeatures struct {
+	// rootless is true when the engine runs in a user namespace as an
+	// unprivileged user
+	rootless bool
+
+	// podman is true when the engine is the Podman compatibility API
+	podman bool
+}
+
+// detectEngineFeatures inspects the version and info responses of the engine.
+func detectEngineFeatures(version types.Version, info system.Info) engineFeatures {
+	var f engineFeatures
+	for _, opt := range info.SecurityOptions {
+		if strings.Contains(opt, "name=rootless") {
+			f.rootless = true
+		}
+	}
+	for _, component := range version.Components {
+		if component.Name == podmanComponentName {
+			f.podman = true
+		}
+	}
+	return f
+}
+
+// String returns a human readable name for the engine, for use in errors.
+func (f engineFeatures) String() string {
+	name := "Docker"
+	if f.podman {
+		name = "Podman"
+	}
+	if f.rootless {
+		name = "rootless " + name
+	}
+	return name
+}
+
+// cpuset returns whether the driver can manage the cpuset cgroup of
+// containers. The cgroups of a rootless engine live under the user's slice
+// rather than where the driver expects them.
+func (f engineFeatures) cpuset() bool {
+	return !f.rootless
+}
+
+// privileged returns whether privileged containers get access to the host.
+// Privileged containers of a rootless engine are confined to its user
+// namespace and can't access host devices.
+func (f engineFeatures) privileged() bool {
+	return !f.rootless
+}
+
+// oomScoreAdj returns whether the OOM score of containers can be set. A
+// rootless engine can't set an OOM score lower than its own, which is often
+// raised by the user's service manager.
+func (f engineFeatures) oomScoreAdj() bool {
+	return !f.rootless
+}
+
+// hostNetwork returns whether the "host" network mode shares the network of
+// the host. For a rootless engine it only shares the network namespace of the
+// engine itself.
+func (f engineFeatures) hostNetwork() bool {
+	return !f.rootless
+}
+
+// validate returns an error describing each option of the task configuration
+// that the engine can't honor.
+func (f engineFeatures) validate(driverConfig *TaskConfig) error {
+	var mErr *multierror.Error
+	if driverConfig.Privileged && !f.privileged() {
+		mErr = multierror.Append(mErr, fmt.Errorf("privileged containers cannot access the host"))
+	}
+	if driverConfig.OOMScoreAdj != 0 && !f.oomScoreAdj() {
+		mErr = multierror.Append(mErr, fmt.Errorf("oom_score_adj is not supported"))
+	}
+	if driverConfig.NetworkMode == "host" && !f.hostNetwork() {
+		mErr = multierror.Append(mErr, fmt.Errorf(`network_mode "host" does not use the host network`))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("task configuration is not supported by the %s engine: %w", f, err)
+	}
+	return nil
+}
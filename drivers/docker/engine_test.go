@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/system"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestEngine_detectEngineFeatures(t *testing.T) {
+	ci.Parallel(t)
+
+	podman := types.Version{Components: []types.ComponentVersion{
+		{Name: "Podman Engine", Version: "5.2.0"},
+	}}
+	docker := types.Version{Components: []types.ComponentVersion{
+		{Name: "Engine", Version: "28.0.1"},
+	}}
+	rootless := system.Info{SecurityOptions: []string{"name=seccomp,profile=builtin", "name=rootless", "name=cgroupns"}}
+	rootful := system.Info{SecurityOptions: []string{"name=seccomp,profile=builtin", "name=cgroupns"}}
+
+	cases := []struct {
+		name     string
+		version  types.Version
+		info     system.Info
+		expected engineFeatures
+		str      string
+	}{
+		{"docker", docker, rootful, engineFeatures{}, "Docker"},
+		{"rootless docker", docker, rootless, engineFeatures{rootless: true}, "rootless Docker"},
+		{"podman", podman, rootful, engineFeatures{podman: true}, "Podman"},
+		{"rootless podman", podman, rootless, engineFeatures{rootless: true, podman: true}, "rootless Podman"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := detectEngineFeatures(tc.version, tc.info)
+			must.Eq(t, tc.expected, f)
+			must.Eq(t, tc.str, f.String())
+		})
+	}
+}
+
+func TestEngine_validate(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name      string
+		engine    engineFeatures
+		config    TaskConfig
+		expectErr []string
+	}{
+		{
+			name:   "rootful allows everything",
+			engine: engineFeatures{podman: true},
+			config: TaskConfig{Privileged: true, OOMScoreAdj: 500, NetworkMode: "host"},
+		},
+		{
+			name:   "rootless default config",
+			engine: engineFeatures{rootless: true},
+			config: TaskConfig{NetworkMode: "bridge"},
+		},
+		{
+			name:   "rootless rejects host features",
+			engine: engineFeatures{rootless: true},
+			config: TaskConfig{Privileged: true, OOMScoreAdj: 500, NetworkMode: "host"},
+			expectErr: []string{
+				"not supported by the rootless Docker engine",
+				"privileged containers",
+				"oom_score_adj",
+				`network_mode "host"`,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.engine.validate(&tc.config)
+			if len(tc.expectErr) == 0 {
+				must.NoError(t, err)
+				return
+			}
+			for _, expect := range tc.expectErr {
+				must.ErrorContains(t, err, expect)
+			}
+		})
+	}
+}
@@ -63,6 +63,18 @@ func (d *Driver) fingerprintSuccessful() bool {
 	return d.fingerprintSuccess == nil || *d.fingerprintSuccess
 }
 
+func (d *Driver) engineFeatures() engineFeatures {
+	d.engineLock.RLock()
+	defer d.engineLock.RUnlock()
+	return d.engine
+}
+
+func (d *Driver) setEngineFeatures(f engineFeatures) {
+	d.engineLock.Lock()
+	defer d.engineLock.Unlock()
+	d.engine = f
+}
+
 func (d *Driver) handleFingerprint(ctx context.Context, ch chan *drivers.Fingerprint) {
 	defer close(ch)
 
@@ -142,6 +154,13 @@ func (d *Driver) buildFingerprint() *drivers.Fingerprint {
 		fp.Attributes["driver.docker.volumes.enabled"] = pstructs.NewBoolAttribute(true)
 	}
 
+	if d.coordinator != nil {
+		if images := d.coordinator.PrefetchedImages(); len(images) > 0 {
+			fp.Attributes["driver.docker.images.prefetched"] = pstructs.NewStringAttribute(
+				strings.Join(images, ","))
+		}
+	}
+
 	if nets, err := dockerClient.NetworkList(d.ctx, network.ListOptions{}); err != nil {
 		d.logger.Warn("error discovering bridge IP", "error", err)
 	} else {
@@ -184,6 +203,28 @@ func (d *Driver) buildFingerprint() *drivers.Fingerprint {
 			strings.Join(runtimeNames, ","))
 		fp.Attributes["driver.docker.os_type"] = pstructs.NewStringAttribute(dockerInfo.OSType)
 
+		engine := detectEngineFeatures(env, dockerInfo)
+		d.setEngineFeatures(engine)
+		if engine.rootless {
+			fp.Attributes["driver.docker.rootless"] = pstructs.NewBoolAttribute(true)
+		}
+		if engine.podman {
+			fp.Attributes["driver.docker.podman"] = pstructs.NewBoolAttribute(true)
+		}
+		if !engine.cpuset() {
+			d.config.disableCpusetManagement = true
+			fp.Attributes["driver.docker.cpuset_management.disabled"] = pstructs.NewBoolAttribute(true)
+		}
+		if !engine.privileged() {
+			delete(fp.Attributes, "driver.docker.privileged.enabled")
+		}
+		if !engine.oomScoreAdj() {
+			fp.Attributes["driver.docker.oom_score_adj.disabled"] = pstructs.NewBoolAttribute(true)
+		}
+		if !engine.hostNetwork() {
+			fp.Attributes["driver.docker.host_network.disabled"] = pstructs.NewBoolAttribute(true)
+		}
+
 		// If this situations arises, we are running in Windows 10 with Linux Containers enabled via VM
 		if runtime.GOOS == "windows" && dockerInfo.OSType == "linux" {
 			if d.fingerprintSuccessful() {
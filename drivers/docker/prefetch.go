@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package docker
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/nomad/helper"
+)
+
+const (
+	// prefetchRetryBase and prefetchRetryLimit bound the backoff between
+	// attempts to prefetch images that failed to pull
+	prefetchRetryBase  = 30 * time.Second
+	prefetchRetryLimit = 10 * time.Minute
+)
+
+// prefetchImages pulls the images listed in the image_prefetch block in the
+// background. Images are pulled one at a time to avoid saturating the node's
+// network while tasks are starting, and failed pulls are retried with backoff
+// until the driver shuts down.
+func (d *Driver) prefetchImages(ctx context.Context) {
+	pending := d.config.ImagePrefetch.Images
+
+	var attempt uint64
+	for {
+		var failed []string
+		for _, image := range pending {
+			if ctx.Err() != nil {
+				return
+			}
+			if err := d.prefetchImage(image); err != nil {
+				d.logger.Warn("failed to prefetch image", "image", image, "error", err)
+				failed = append(failed, image)
+			}
+		}
+		if len(failed) == 0 {
+			return
+		}
+
+		pending = failed
+		attempt++
+		timer, stop := helper.NewSafeTimer(helper.Backoff(prefetchRetryBase, prefetchRetryLimit, attempt))
+		select {
+		case <-ctx.Done():
+			stop()
+			return
+		case <-timer.C:
+			stop()
+		}
+	}
+}
+
+// prefetchImage pulls a single image using the registry credentials from the
+// plugin configuration.
+func (d *Driver) prefetchImage(image string) error {
+	repo, _, err := parseDockerImage(image)
+	if err != nil {
+		return err
+	}
+
+	authOptions, err := d.resolveRegistryAuthentication(&TaskConfig{}, repo)
+	if err != nil {
+		return err
+	}
+
+	d.logger.Debug("prefetching image", "image", image)
+	return d.coordinator.PrefetchImage(image, authOptions,
+		d.config.ImagePrefetch.pullTimeoutDuration, d.config.pullActivityTimeoutDuration)
+}
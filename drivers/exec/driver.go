@@ -5,6 +5,7 @@ package exec
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/nomad/drivers/shared/capabilities"
 	"github.com/hashicorp/nomad/drivers/shared/eventer"
 	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/drivers/shared/ociimage"
 	"github.com/hashicorp/nomad/drivers/shared/resolvconf"
 	"github.com/hashicorp/nomad/drivers/shared/validators"
 	"github.com/hashicorp/nomad/helper/pluginutils/loader"
@@ -41,6 +43,14 @@ const (
 	// taskHandleVersion is the version of task handle which this driver sets
 	// and understands how to decode driver state
 	taskHandleVersion = 1
+
+	// imagePullTimeout is the maximum time allowed to pull a task's image
+	imagePullTimeout = 10 * time.Minute
+
+	// imageMarkerFile is the file in the task's private directory recording
+	// the digest of the image unpacked into the task directory, so the image
+	// is not unpacked again when the task restarts.
+	imageMarkerFile = ".image"
 )
 
 var (
@@ -84,20 +94,31 @@ var (
 			hclspec.NewAttr("allow_caps", "list(string)", false),
 			hclspec.NewLiteral(capabilities.HCLSpecLiteral),
 		),
-		"denied_host_uids": hclspec.NewAttr("denied_host_uids", "string", false),
-		"denied_host_gids": hclspec.NewAttr("denied_host_gids", "string", false),
+		"denied_host_uids":  hclspec.NewAttr("denied_host_uids", "string", false),
+		"denied_host_gids":  hclspec.NewAttr("denied_host_gids", "string", false),
+		"image_cache_dir":   hclspec.NewAttr("image_cache_dir", "string", false),
+		"image_layouts_dir": hclspec.NewAttr("image_layouts_dir", "string", false),
 	})
 
 	// taskConfigSpec is the hcl specification for the driver config section of
 	// a task within a job. It is returned in the TaskConfigSchema RPC
 	taskConfigSpec = hclspec.NewObject(map[string]*hclspec.Spec{
-		"command":  hclspec.NewAttr("command", "string", true),
+		"command":  hclspec.NewAttr("command", "string", false),
 		"args":     hclspec.NewAttr("args", "list(string)", false),
 		"pid_mode": hclspec.NewAttr("pid_mode", "string", false),
 		"ipc_mode": hclspec.NewAttr("ipc_mode", "string", false),
 		"cap_add":  hclspec.NewAttr("cap_add", "list(string)", false),
 		"cap_drop": hclspec.NewAttr("cap_drop", "list(string)", false),
 		"work_dir": hclspec.NewAttr("work_dir", "string", false),
+		"image":    hclspec.NewAttr("image", "string", false),
+		"auth": hclspec.NewBlock("auth", false, hclspec.NewObject(map[string]*hclspec.Spec{
+			"username": hclspec.NewAttr("username", "string", false),
+			"password": hclspec.NewAttr("password", "string", false),
+		})),
+		"seccomp_profile": hclspec.NewAttr("seccomp_profile", "string", false),
+		"seccomp_allow":   hclspec.NewAttr("seccomp_allow", "list(string)", false),
+		"landlock":        hclspec.NewAttr("landlock", "bool", false),
+		"landlock_paths":  hclspec.NewAttr("landlock_paths", "list(string)", false),
 	})
 
 	// driverCapabilities represents the RPC response for what features are
@@ -110,7 +131,11 @@ var (
 			drivers.NetIsolationModeHost,
 			drivers.NetIsolationModeGroup,
 		},
-		MountConfigs: drivers.MountConfigSupportAll,
+		MountConfigs:    drivers.MountConfigSupportAll,
+		Checkpoint:      true,
+		UpdateResources: true,
+		Pause:           true,
+		ProvidesRootFS:  true,
 	}
 )
 
@@ -146,6 +171,9 @@ type Driver struct {
 	compute cpustats.Compute
 
 	userIDValidator UserIDValidator
+
+	// images pulls and caches the OCI images tasks are run from
+	images *ociimage.Store
 }
 
 // Config is the driver configuration set by the SetConfig RPC call
@@ -168,6 +196,15 @@ type Config struct {
 
 	DeniedHostUids string `codec:"denied_host_uids"`
 	DeniedHostGids string `codec:"denied_host_gids"`
+
+	// ImageCacheDir is the directory the layers of task images are cached
+	// in. Defaults to a directory under the client's data directory.
+	ImageCacheDir string `codec:"image_cache_dir"`
+
+	// ImageLayoutsDir is the directory holding OCI image layouts that tasks
+	// may reference with the oci-layout:// scheme. Local layouts are disabled
+	// if unset.
+	ImageLayoutsDir string `codec:"image_layouts_dir"`
 }
 
 func (c *Config) validate() error {
@@ -215,6 +252,50 @@ type TaskConfig struct {
 
 	// WorkDir is the working directory inside the chroot
 	WorkDir string `codec:"work_dir"`
+
+	// Image is an OCI image to unpack into the chroot instead of the
+	// client's chroot_env. Command, Args and WorkDir default to the image's
+	// configuration when unset.
+	Image string `codec:"image"`
+
+	// Auth is the credentials used to pull Image from its registry
+	Auth ImageAuth `codec:"auth"`
+
+	// SeccompProfile is the seccomp profile applied to the task, either
+	// empty or "default" to deny every syscall not on a list of common
+	// syscalls.
+	SeccompProfile string `codec:"seccomp_profile"`
+
+	// SeccompAllow is the list of syscalls permitted in addition to the
+	// seccomp profile.
+	SeccompAllow []string `codec:"seccomp_allow"`
+
+	// Landlock restricts the filesystem access of the task with the Landlock
+	// LSM.
+	Landlock bool `codec:"landlock"`
+
+	// LandlockPaths are extra paths a landlocked task can access.
+	LandlockPaths []string `codec:"landlock_paths"`
+}
+
+// sandbox returns the sandbox configuration of the task, or nil if the task
+// is not sandboxed.
+func (tc *TaskConfig) sandbox() *executor.SandboxConfig {
+	if tc.SeccompProfile == "" && len(tc.SeccompAllow) == 0 && !tc.Landlock && len(tc.LandlockPaths) == 0 {
+		return nil
+	}
+	return &executor.SandboxConfig{
+		SeccompProfile: tc.SeccompProfile,
+		SeccompAllow:   tc.SeccompAllow,
+		Landlock:       tc.Landlock,
+		LandlockPaths:  tc.LandlockPaths,
+	}
+}
+
+// ImageAuth is the registry credentials for a task image
+type ImageAuth struct {
+	Username string `codec:"username"`
+	Password string `codec:"password"`
 }
 
 func (tc *TaskConfig) validate() error {
@@ -245,6 +326,10 @@ func (tc *TaskConfig) validate() error {
 		return fmt.Errorf("work_dir must be absolute but got relative path %q", tc.WorkDir)
 	}
 
+	if err := tc.sandbox().Validate(); err != nil {
+		return fmt.Errorf("invalid sandbox: %w", err)
+	}
+
 	return nil
 }
 
@@ -332,6 +417,14 @@ func (d *Driver) SetConfig(cfg *base.Config) error {
 		d.nomadConfig = cfg.AgentConfig.Driver
 		d.compute = cfg.AgentConfig.Compute()
 	}
+
+	// Cache images under the client's data directory by default, which
+	// unlike a temporary directory is not writable by other users
+	imageCacheDir := config.ImageCacheDir
+	if imageCacheDir == "" && d.nomadConfig != nil && d.nomadConfig.DataDir != "" {
+		imageCacheDir = filepath.Join(d.nomadConfig.DataDir, "exec", "images")
+	}
+	d.images = ociimage.NewStore(imageCacheDir, config.ImageLayoutsDir, d.logger)
 	return nil
 }
 
@@ -456,7 +549,72 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 	return nil
 }
 
-func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle, network *drivers.DriverNetwork, err error) {
+func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drivers.DriverNetwork, error) {
+	return d.startTask(cfg, "")
+}
+
+// RestoreTask starts the task from a checkpoint image taken by
+// CheckpointTask, possibly on another node.
+func (d *Driver) RestoreTask(cfg *drivers.TaskConfig, imagePath string) (*drivers.TaskHandle, *drivers.DriverNetwork, error) {
+	return d.startTask(cfg, imagePath)
+}
+
+// CheckpointTask dumps the state of the task's container to imagePath.
+func (d *Driver) CheckpointTask(taskID, imagePath string, leaveRunning bool) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Checkpoint(imagePath, leaveRunning)
+}
+
+// UpdateTaskResources applies new CPU and memory limits to the cgroup of a
+// running task.
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return executor.UpdateResources(handle.taskConfig.AllocID, handle.taskConfig.Name, resources)
+}
+
+// PauseTask freezes the task's container.
+func (d *Driver) PauseTask(taskID string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Pause()
+}
+
+// ResumeTask thaws the task's container.
+func (d *Driver) ResumeTask(taskID string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Resume()
+}
+
+// TaskProvidesRootFS returns true if the task is run from an image, whose
+// root filesystem is unpacked into the task directory instead of the client's
+// chroot_env.
+func (d *Driver) TaskProvidesRootFS(cfg *drivers.TaskConfig) (bool, error) {
+	var driverConfig TaskConfig
+	if err := cfg.DecodeDriverConfig(&driverConfig); err != nil {
+		return false, fmt.Errorf("failed to decode driver config: %v", err)
+	}
+
+	return driverConfig.Image != "", nil
+}
+
+// startTask launches the task, restoring it from the checkpoint image at
+// restoreImagePath if set.
+func (d *Driver) startTask(cfg *drivers.TaskConfig, restoreImagePath string) (handle *drivers.TaskHandle, network *drivers.DriverNetwork, err error) {
 	if _, ok := d.tasks.Get(cfg.ID); ok {
 		return nil, nil, fmt.Errorf("task with ID %q already started", cfg.ID)
 	}
@@ -480,7 +638,27 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle,
 		return nil, nil, fmt.Errorf("failed host user validation: %v", err)
 	}
 
-	d.logger.Info("starting task", "driver_cfg", hclog.Fmt("%+v", driverConfig))
+	command, args, env, workDir := driverConfig.Command, driverConfig.Args, cfg.EnvList(), driverConfig.WorkDir
+	if driverConfig.Image != "" {
+		img, err := d.prepareImage(cfg, &driverConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		command, args = imageCommand(img.Config, command, args)
+		env = imageEnv(img.Config.Env, env)
+		if workDir == "" {
+			workDir = img.Config.WorkingDir
+		}
+	}
+	if command == "" {
+		return nil, nil, errors.New("command must be set unless the task image defines one")
+	}
+
+	logConfig := driverConfig
+	if logConfig.Auth.Password != "" {
+		logConfig.Auth.Password = "<redacted>"
+	}
+	d.logger.Info("starting task", "driver_cfg", hclog.Fmt("%+v", logConfig))
 	handle = drivers.NewTaskHandle(taskHandleVersion)
 	handle.Config = cfg
 
@@ -523,15 +701,15 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle,
 	}()
 
 	execCmd := &executor.ExecCommand{
-		Cmd:              driverConfig.Command,
-		Args:             driverConfig.Args,
-		Env:              cfg.EnvList(),
+		Cmd:              command,
+		Args:             args,
+		Env:              env,
 		User:             user,
 		ResourceLimits:   true,
 		NoPivotRoot:      d.config.NoPivotRoot,
 		Resources:        cfg.Resources,
 		TaskDir:          cfg.TaskDir().Dir,
-		WorkDir:          driverConfig.WorkDir,
+		WorkDir:          workDir,
 		StdoutPath:       cfg.StdoutPath,
 		StderrPath:       cfg.StderrPath,
 		Mounts:           cfg.Mounts,
@@ -540,6 +718,8 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (handle *drivers.TaskHandle,
 		ModePID:          executor.IsolationMode(d.config.DefaultModePID, driverConfig.ModePID),
 		ModeIPC:          executor.IsolationMode(d.config.DefaultModeIPC, driverConfig.ModeIPC),
 		Capabilities:     caps,
+		RestoreImagePath: restoreImagePath,
+		Sandbox:          driverConfig.sandbox(),
 	}
 
 	ps, err := exec.Launch(execCmd)
@@ -716,6 +896,8 @@ func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*
 }
 
 var _ drivers.ExecTaskStreamingRawDriver = (*Driver)(nil)
+var _ drivers.DriverCheckpointer = (*Driver)(nil)
+var _ drivers.DriverTaskResourceUpdater = (*Driver)(nil)
 
 func (d *Driver) ExecTaskStreamingRaw(ctx context.Context,
 	taskID string,
@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/nomad/plugins/drivers"
 	dtestutil "github.com/hashicorp/nomad/plugins/drivers/testutils"
 	"github.com/hashicorp/nomad/testutil"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/shoenig/test/must"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/sys/unix"
@@ -1137,3 +1138,146 @@ func TestDriver_TaskConfig_validate(t *testing.T) {
 		}
 	})
 }
+
+func TestConfig_ParseAllHCL_Image(t *testing.T) {
+	ci.Parallel(t)
+
+	cfgStr := `
+config {
+  image = "registry.example.com/app:1.0"
+  args  = ["--verbose"]
+
+  auth {
+    username = "user"
+    password = "pass"
+  }
+}`
+
+	expected := &TaskConfig{
+		Image: "registry.example.com/app:1.0",
+		Args:  []string{"--verbose"},
+		Auth: ImageAuth{
+			Username: "user",
+			Password: "pass",
+		},
+	}
+
+	var tc *TaskConfig
+	hclutils.NewConfigParser(taskConfigSpec).ParseHCL(t, cfgStr, &tc)
+	must.Eq(t, expected, tc)
+}
+
+func TestExecDriver_TaskProvidesRootFS(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := newExecDriverTest(t, ctx)
+	harness := dtestutil.NewDriverHarness(t, d)
+
+	caps, err := harness.Capabilities()
+	must.NoError(t, err)
+	must.True(t, caps.ProvidesRootFS)
+
+	provider, ok := harness.DriverPlugin.(drivers.DriverRootFSProvider)
+	must.True(t, ok)
+
+	for _, tc := range []struct {
+		name     string
+		config   TaskConfig
+		expected bool
+	}{
+		{
+			name:     "command",
+			config:   TaskConfig{Command: "/bin/sleep"},
+			expected: false,
+		},
+		{
+			name:     "image",
+			config:   TaskConfig{Image: "registry.example.com/app:1.0"},
+			expected: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			task := &drivers.TaskConfig{
+				ID:   uuid.Generate(),
+				Name: "test",
+			}
+			must.NoError(t, task.EncodeConcreteDriverConfig(&tc.config))
+
+			provides, err := provider.TaskProvidesRootFS(task)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, provides)
+		})
+	}
+}
+
+func TestDriver_imageCommand(t *testing.T) {
+	ci.Parallel(t)
+
+	conf := ocispec.ImageConfig{
+		Entrypoint: []string{"/bin/app", "serve"},
+		Cmd:        []string{"--port", "80"},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		conf    ocispec.ImageConfig
+		command string
+		args    []string
+		expCmd  string
+		expArgs []string
+	}{
+		{
+			name:    "task command",
+			conf:    conf,
+			command: "/bin/sh",
+			args:    []string{"-c", "true"},
+			expCmd:  "/bin/sh",
+			expArgs: []string{"-c", "true"},
+		},
+		{
+			name:    "entrypoint and cmd",
+			conf:    conf,
+			expCmd:  "/bin/app",
+			expArgs: []string{"serve", "--port", "80"},
+		},
+		{
+			name:    "entrypoint and task args",
+			conf:    conf,
+			args:    []string{"--port", "8080"},
+			expCmd:  "/bin/app",
+			expArgs: []string{"serve", "--port", "8080"},
+		},
+		{
+			name:    "cmd only",
+			conf:    ocispec.ImageConfig{Cmd: []string{"/bin/sh"}},
+			expCmd:  "/bin/sh",
+			expArgs: []string{},
+		},
+		{
+			name:   "no command",
+			expCmd: "",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd, args := imageCommand(tc.conf, tc.command, tc.args)
+			must.Eq(t, tc.expCmd, cmd)
+			must.Eq(t, tc.expArgs, args)
+		})
+	}
+
+	// the image config must not be modified
+	must.Eq(t, []string{"/bin/app", "serve"}, conf.Entrypoint)
+}
+
+func TestDriver_imageEnv(t *testing.T) {
+	ci.Parallel(t)
+
+	env := imageEnv(
+		[]string{"PATH=/usr/local/bin:/usr/bin", "LANG=C.UTF-8"},
+		[]string{"LANG=en_US.UTF-8", "NOMAD_TASK_NAME=web"},
+	)
+	must.Eq(t, []string{"PATH=/usr/local/bin:/usr/bin", "LANG=en_US.UTF-8", "NOMAD_TASK_NAME=web"}, env)
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package exec
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/hashicorp/nomad/drivers/shared/ociimage"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// imageProtectedDirs are the directories of the task directory managed by
+// Nomad, which are never written to when unpacking an image.
+var imageProtectedDirs = []string{
+	allocdir.SharedAllocName,
+	allocdir.TaskLocal,
+	allocdir.TaskSecrets,
+	allocdir.TaskPrivate,
+	allocdir.TaskCheckpoint,
+}
+
+// prepareImage pulls the task's image and unpacks it into the task
+// directory, unless it was already unpacked by a previous run of the task.
+func (d *Driver) prepareImage(cfg *drivers.TaskConfig, driverConfig *TaskConfig) (*ociimage.Image, error) {
+	d.eventer.EmitEvent(&drivers.TaskEvent{
+		TaskID:    cfg.ID,
+		AllocID:   cfg.AllocID,
+		TaskName:  cfg.Name,
+		Timestamp: time.Now(),
+		Message:   "Downloading image",
+		Annotations: map[string]string{
+			"image": driverConfig.Image,
+		},
+	})
+
+	var auth *ociimage.Auth
+	if driverConfig.Auth.Username != "" {
+		auth = &ociimage.Auth{
+			Username: driverConfig.Auth.Username,
+			Password: driverConfig.Auth.Password,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(d.ctx, imagePullTimeout)
+	defer cancel()
+
+	img, err := d.images.Pull(ctx, driverConfig.Image, auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull image %q: %w", driverConfig.Image, err)
+	}
+
+	taskDir := cfg.TaskDir().Dir
+	marker := filepath.Join(taskDir, allocdir.TaskPrivate, imageMarkerFile)
+	if digest, err := os.ReadFile(marker); err == nil && string(digest) == img.Digest {
+		return img, nil
+	}
+
+	d.logger.Debug("unpacking image", "image", img.Reference, "digest", img.Digest, "task_dir", taskDir)
+	if err := ociimage.Unpack(img, taskDir, imageProtectedDirs); err != nil {
+		return nil, fmt.Errorf("failed to unpack image %q: %w", driverConfig.Image, err)
+	}
+	if err := os.WriteFile(marker, []byte(img.Digest), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to record unpacked image: %w", err)
+	}
+	return img, nil
+}
+
+// imageCommand returns the command and arguments to run for a task using an
+// image. A command set on the task is run as is, otherwise the image's
+// entrypoint is run with the task's args, or the image's cmd if the task has
+// no args.
+func imageCommand(conf ocispec.ImageConfig, command string, args []string) (string, []string) {
+	if command != "" {
+		return command, args
+	}
+
+	argv := conf.Entrypoint
+	if len(args) > 0 {
+		argv = append(argv[:len(argv):len(argv)], args...)
+	} else {
+		argv = append(argv[:len(argv):len(argv)], conf.Cmd...)
+	}
+	if len(argv) == 0 {
+		return "", nil
+	}
+	return argv[0], argv[1:]
+}
+
+// imageEnv returns the task environment with the variables defined by the
+// image added, with the task's variables taking precedence.
+func imageEnv(image, task []string) []string {
+	keys := make(map[string]struct{}, len(task))
+	for _, kv := range task {
+		k, _, _ := strings.Cut(kv, "=")
+		keys[k] = struct{}{}
+	}
+
+	env := make([]string, 0, len(image)+len(task))
+	for _, kv := range image {
+		k, _, _ := strings.Cut(kv, "=")
+		if _, ok := keys[k]; !ok {
+			env = append(env, kv)
+		}
+	}
+	return append(env, task...)
+}
@@ -111,10 +111,12 @@ var (
 			drivers.NetIsolationModeHost,
 			drivers.NetIsolationModeGroup,
 		},
-		MountConfigs: drivers.MountConfigSupportNone,
+		MountConfigs:    drivers.MountConfigSupportNone,
+		UpdateResources: runtime.GOOS == "linux",
 	}
 
-	_ drivers.DriverPlugin = (*Driver)(nil)
+	_ drivers.DriverPlugin              = (*Driver)(nil)
+	_ drivers.DriverTaskResourceUpdater = (*Driver)(nil)
 )
 
 func init() {
@@ -681,6 +683,17 @@ func (d *Driver) TaskEvents(ctx context.Context) (<-chan *drivers.TaskEvent, err
 	return d.eventer.TaskEvents(ctx)
 }
 
+// UpdateTaskResources applies new CPU and memory limits to the cgroup of a
+// running task.
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return executor.UpdateResources(handle.taskConfig.AllocID, handle.taskConfig.Name, resources)
+}
+
 func (d *Driver) SignalTask(taskID string, signal string) error {
 	handle, ok := d.tasks.Get(taskID)
 	if !ok {
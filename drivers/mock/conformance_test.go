@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package mock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/drivers/mock"
+	"github.com/hashicorp/nomad/plugins/drivers/conformance"
+	dtestutil "github.com/hashicorp/nomad/plugins/drivers/testutils"
+)
+
+// The conformance suite imports the client, which imports this package
+// through the plugin catalog, so it's tested from an external package.
+func TestMockDriver_Conformance(t *testing.T) {
+	ci.Parallel(t)
+
+	dtestutil.RunConformance(t, &conformance.Config{
+		Launch: dtestutil.InProcessLauncher(t, mock.NewMockDriver),
+		Task: &conformance.TaskSpec{
+			Config: map[string]interface{}{
+				"run_for": "60s",
+				"exec_command": []map[string]interface{}{{
+					"run_for":       "1ms",
+					"stdout_string": "hello from exec",
+				}},
+			},
+		},
+		ExitTask: &conformance.TaskSpec{
+			Config: map[string]interface{}{
+				"run_for":   "10ms",
+				"exit_code": 3,
+			},
+		},
+		ExitCode: 3,
+		Exec: &conformance.ExecSpec{
+			Command: []string{"echo"},
+			Output:  "hello from exec",
+		},
+		Timeout: 10 * time.Second,
+	})
+}
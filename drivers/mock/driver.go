@@ -84,12 +84,15 @@ var (
 		"driver_ip":               hclspec.NewAttr("driver_ip", "string", false),
 		"driver_advertise":        hclspec.NewAttr("driver_advertise", "bool", false),
 		"driver_port_map":         hclspec.NewAttr("driver_port_map", "string", false),
+		"provides_rootfs":         hclspec.NewAttr("provides_rootfs", "bool", false),
 
 		"run_for":                hclspec.NewAttr("run_for", "string", false),
 		"exit_code":              hclspec.NewAttr("exit_code", "number", false),
 		"exit_signal":            hclspec.NewAttr("exit_signal", "number", false),
 		"exit_err_msg":           hclspec.NewAttr("exit_err_msg", "string", false),
 		"signal_error":           hclspec.NewAttr("signal_error", "string", false),
+		"update_resources_error": hclspec.NewAttr("update_resources_error", "string", false),
+		"pause_error":            hclspec.NewAttr("pause_error", "string", false),
 		"stdout_string":          hclspec.NewAttr("stdout_string", "string", false),
 		"stdout_repeat":          hclspec.NewAttr("stdout_repeat", "number", false),
 		"stdout_repeat_duration": hclspec.NewAttr("stdout_repeat_duration", "string", false),
@@ -153,10 +156,13 @@ func NewMockDriver(ctx context.Context, logger hclog.Logger) drivers.DriverPlugi
 	logger = logger.Named(pluginName)
 
 	capabilities := &drivers.Capabilities{
-		SendSignals:  true,
-		Exec:         true,
-		FSIsolation:  drivers.FSIsolationNone,
-		MountConfigs: drivers.MountConfigSupportNone,
+		SendSignals:     true,
+		Exec:            true,
+		FSIsolation:     drivers.FSIsolationNone,
+		MountConfigs:    drivers.MountConfigSupportNone,
+		UpdateResources: true,
+		Pause:           true,
+		ProvidesRootFS:  true,
 	}
 
 	return &Driver{
@@ -205,6 +211,14 @@ type Command struct {
 	// SignalErr is the error message that the task returns if signalled
 	SignalErr string `codec:"signal_error"`
 
+	// UpdateResourcesErr is the error message that the task returns if its
+	// resources are updated
+	UpdateResourcesErr string `codec:"update_resources_error"`
+
+	// PauseErr is the error message that the task returns if it is paused
+	// or resumed
+	PauseErr string `codec:"pause_error"`
+
 	// StdoutString is the string that should be sent to stdout
 	StdoutString string `codec:"stdout_string"`
 
@@ -263,6 +277,10 @@ type TaskConfig struct {
 	// DriverPortMap will parse a label:number pair and return it in
 	// DriverNetwork.PortMap from Start().
 	DriverPortMap string `codec:"driver_port_map"`
+
+	// ProvidesRootFS is returned by TaskProvidesRootFS, so that the client
+	// does not build a chroot for the task.
+	ProvidesRootFS bool `codec:"provides_rootfs"`
 }
 
 type MockTaskState struct {
@@ -592,15 +610,17 @@ func (d *Driver) InspectTask(taskID string) (*drivers.TaskStatus, error) {
 
 func (d *Driver) TaskStats(ctx context.Context, taskID string, interval time.Duration) (<-chan *drivers.TaskResourceUsage, error) {
 	ch := make(chan *drivers.TaskResourceUsage)
-	go d.handleStats(ctx, ch)
+	go d.handleStats(ctx, ch, interval)
 	return ch, nil
 }
 
-func (d *Driver) handleStats(ctx context.Context, ch chan<- *drivers.TaskResourceUsage) {
+func (d *Driver) handleStats(ctx context.Context, ch chan<- *drivers.TaskResourceUsage, interval time.Duration) {
 	timer := time.NewTimer(0)
 	for {
 		select {
 		case <-timer.C:
+			timer.Reset(interval)
+
 			// Generate random value for the memory usage
 			s := &drivers.TaskResourceUsage{
 				ResourceUsage: &drivers.ResourceUsage{
@@ -608,6 +628,7 @@ func (d *Driver) handleStats(ctx context.Context, ch chan<- *drivers.TaskResourc
 						RSS:      rand.Uint64(),
 						Measured: []string{"RSS"},
 					},
+					CpuStats: &drivers.CpuStats{},
 				},
 				Timestamp: time.Now().UTC().UnixNano(),
 			}
@@ -640,6 +661,93 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 	return errors.New(h.command.SignalErr)
 }
 
+// UpdateTaskResources records the new resources of the task so that tests can
+// inspect them with GetTaskResources.
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	if h.command.UpdateResourcesErr != "" {
+		return errors.New(h.command.UpdateResourcesErr)
+	}
+
+	h.stateLock.Lock()
+	defer h.stateLock.Unlock()
+	h.resources = resources
+	return nil
+}
+
+// GetTaskResources returns the resources last applied by UpdateTaskResources,
+// or nil if the task's resources were never updated.
+func (d *Driver) GetTaskResources(taskID string) *drivers.Resources {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return nil
+	}
+
+	h.stateLock.RLock()
+	defer h.stateLock.RUnlock()
+	return h.resources
+}
+
+var _ drivers.DriverTaskResourceUpdater = (*Driver)(nil)
+
+// PauseTask records the task as paused so that tests can inspect it with
+// IsTaskPaused.
+func (d *Driver) PauseTask(taskID string) error {
+	return d.setTaskPaused(taskID, true)
+}
+
+// ResumeTask records the task as no longer paused.
+func (d *Driver) ResumeTask(taskID string) error {
+	return d.setTaskPaused(taskID, false)
+}
+
+func (d *Driver) setTaskPaused(taskID string, paused bool) error {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	if h.command.PauseErr != "" {
+		return errors.New(h.command.PauseErr)
+	}
+
+	h.stateLock.Lock()
+	defer h.stateLock.Unlock()
+	h.paused = paused
+	return nil
+}
+
+// IsTaskPaused returns whether the task was paused by PauseTask and not
+// resumed since.
+func (d *Driver) IsTaskPaused(taskID string) bool {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return false
+	}
+
+	h.stateLock.RLock()
+	defer h.stateLock.RUnlock()
+	return h.paused
+}
+
+var _ drivers.DriverPauser = (*Driver)(nil)
+
+// TaskProvidesRootFS returns the provides_rootfs option of the task.
+func (d *Driver) TaskProvidesRootFS(cfg *drivers.TaskConfig) (bool, error) {
+	var driverConfig TaskConfig
+	if err := cfg.DecodeDriverConfig(&driverConfig); err != nil {
+		return false, err
+	}
+
+	return driverConfig.ProvidesRootFS, nil
+}
+
+var _ drivers.DriverRootFSProvider = (*Driver)(nil)
+
 func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	h, ok := d.tasks.Get(taskID)
 	if !ok {
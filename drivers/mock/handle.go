@@ -25,10 +25,16 @@ type taskHandle struct {
 	command     Command
 	execCommand *Command
 
-	// stateLock guards the procState field
+	// stateLock guards the procState, resources and paused fields
 	stateLock sync.RWMutex
 	procState drivers.TaskState
 
+	// resources are the resources last applied by UpdateTaskResources
+	resources *drivers.Resources
+
+	// paused is set while the task is paused by PauseTask
+	paused bool
+
 	startedAt   time.Time
 	completedAt time.Time
 	exitResult  *drivers.ExitResult
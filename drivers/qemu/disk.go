@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package qemu
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/nomad/helper/users"
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+const (
+	// overlayDirName is the directory within the task's local directory that
+	// holds the copy-on-write overlays of the image and disks. Keeping them in
+	// the local directory lets them migrate with the ephemeral disk.
+	overlayDirName = "overlays"
+
+	// cloudInitDirName is the directory within the task's local directory
+	// that holds the cloud-init NoCloud seed presented to the guest
+	cloudInitDirName = "cidata"
+
+	// cloudInitLabel is the volume label cloud-init looks for to find the
+	// NoCloud seed
+	cloudInitLabel = "cidata"
+)
+
+// hardcoded list of disk formats the driver can attach and create
+var allowedDiskFormats = []string{"raw", "qcow2"}
+
+// DiskConfig is an additional virtio disk attached to the virtual machine
+type DiskConfig struct {
+	// Source is the path of the disk image. Relative paths are relative to
+	// the task directory, and absolute paths must be within the destination
+	// of a volume_mount or one of the allowed image paths.
+	Source string `codec:"source"`
+
+	// Format is the format of the disk image, either raw or qcow2
+	Format string `codec:"format"`
+
+	// Size creates the disk with the given size if it doesn't exist yet
+	Size string `codec:"size"`
+
+	// ReadOnly attaches the disk read-only
+	ReadOnly bool `codec:"read_only"`
+
+	// Overlay attaches a copy-on-write overlay of the disk, so writes from
+	// the guest never modify the source
+	Overlay bool `codec:"overlay"`
+}
+
+// CloudInitConfig configures the cloud-init NoCloud seed presented to the
+// guest. Each file is a path relative to the task directory, typically the
+// destination of a template.
+type CloudInitConfig struct {
+	UserData      string `codec:"user_data"`
+	MetaData      string `codec:"meta_data"`
+	NetworkConfig string `codec:"network_config"`
+	VendorData    string `codec:"vendor_data"`
+}
+
+func (d *DiskConfig) validate() error {
+	var mErr multierror.Error
+	if d.Source == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("disk source must be set"))
+	}
+	if !isAllowedDiskFormat(d.Format) {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("unsupported disk format %q", d.Format))
+	}
+	if d.Size != "" {
+		if _, err := humanize.ParseBytes(d.Size); err != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid disk size %q: %v", d.Size, err))
+		}
+	}
+	if d.ReadOnly && d.Overlay {
+		mErr.Errors = append(mErr.Errors, errors.New("disk overlay can't be used with read_only"))
+	}
+	return mErr.ErrorOrNil()
+}
+
+func isAllowedDiskFormat(format string) bool {
+	for _, f := range allowedDiskFormats {
+		if format == f {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveDiskSource returns the host path of a disk source, and whether the
+// disk must be attached read-only because it's on a read-only volume.
+func resolveDiskSource(allowedPaths []string, allocDir, taskDir string, mounts []*drivers.MountConfig, source string) (string, bool, error) {
+	if !filepath.IsAbs(source) {
+		path := filepath.Join(taskDir, source)
+		if !isAllowedImagePath(nil, allocDir, path) {
+			return "", false, fmt.Errorf("disk source %q is outside the allocation directory", source)
+		}
+		return path, false, nil
+	}
+
+	source = filepath.Clean(source)
+	if m, rel := mountForSource(mounts, source); m != nil {
+		return filepath.Join(m.HostPath, rel), m.Readonly, nil
+	}
+
+	if !isAllowedImagePath(allowedPaths, allocDir, source) {
+		return "", false, fmt.Errorf("disk source %q is not in a volume mount or the allowed paths", source)
+	}
+	return source, false, nil
+}
+
+// qemuOptEscape escapes a value of a QEMU option list, in which commas
+// separate options
+func qemuOptEscape(s string) string {
+	return strings.ReplaceAll(s, ",", ",,")
+}
+
+// imageFormat returns the format of the image at path
+func imageFormat(qemuImg, path string) (string, error) {
+	out, err := exec.Command(qemuImg, "info", "--force-share", "--output=json", path).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect image %q: %v", path, commandError(err))
+	}
+
+	var info struct {
+		Format string `json:"format"`
+	}
+	if err := json.Unmarshal(out, &info); err != nil {
+		return "", fmt.Errorf("failed to parse image info of %q: %v", path, err)
+	}
+	return info.Format, nil
+}
+
+// createDisk creates an empty disk image at path unless it already exists
+func createDisk(logger hclog.Logger, qemuImg, path, format, size, user string) error {
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	bytes, err := humanize.ParseBytes(size)
+	if err != nil {
+		return fmt.Errorf("invalid disk size %q: %v", size, err)
+	}
+
+	logger.Debug("creating disk", "path", path, "format", format, "size", bytes)
+	cmd := exec.Command(qemuImg, "create", "-f", format, path, strconv.FormatUint(bytes, 10))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to create disk %q: %v: %s", path, err, strings.TrimSpace(string(out)))
+	}
+	chownFor(logger, path, user)
+	return nil
+}
+
+// createOverlay creates a qcow2 overlay at path backed by the image at
+// backing, unless it already exists. The overlay of a restarted task is kept
+// so the guest's writes survive the restart.
+func createOverlay(logger hclog.Logger, qemuImg, path, backing, backingFormat, user string) error {
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	logger.Debug("creating overlay", "path", path, "backing", backing)
+	cmd := exec.Command(qemuImg, "create", "-f", "qcow2", "-F", backingFormat, "-b", backing, path)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to create overlay of %q: %v: %s", backing, err, strings.TrimSpace(string(out)))
+	}
+	chownFor(logger, path, user)
+	return nil
+}
+
+// writeCloudInitSeed writes the cloud-init NoCloud seed of the task into dir,
+// replacing any previous seed so the guest sees re-rendered templates.
+func writeCloudInitSeed(ci *CloudInitConfig, cfg *drivers.TaskConfig, taskDir, dir string) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	files := []struct {
+		name   string
+		source string
+	}{
+		{"user-data", ci.UserData},
+		{"meta-data", ci.MetaData},
+		{"network-config", ci.NetworkConfig},
+		{"vendor-data", ci.VendorData},
+	}
+
+	for _, f := range files {
+		var contents []byte
+		switch {
+		case f.source != "":
+			path := filepath.Join(taskDir, f.source)
+			if filepath.IsAbs(f.source) || !isAllowedImagePath(nil, taskDir, path) {
+				return fmt.Errorf("cloud_init %s %q must be relative to the task directory", strings.ReplaceAll(f.name, "-", "_"), f.source)
+			}
+			b, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("failed to read cloud_init %s: %v", strings.ReplaceAll(f.name, "-", "_"), err)
+			}
+			contents = b
+		case f.name == "meta-data":
+			// NoCloud requires meta-data, so default to an instance ID that
+			// is stable across restarts of the task
+			contents = []byte(fmt.Sprintf("instance-id: %s-%s\n", cfg.AllocID, cfg.Name))
+		case f.name == "user-data":
+			// and user-data, even if empty
+			contents = []byte{}
+		default:
+			continue
+		}
+
+		if err := users.WriteFileFor(filepath.Join(dir, f.name), contents, cfg.User); err != nil {
+			return fmt.Errorf("failed to write cloud-init seed: %v", err)
+		}
+	}
+	return nil
+}
+
+// chownFor makes path owned by the user QEMU runs as, so it can write to
+// images created by the driver. Failing to do so isn't fatal, since QEMU may
+// run as the same user as the driver.
+func chownFor(logger hclog.Logger, path, user string) {
+	if user == "" {
+		return
+	}
+	uid, gid, _, err := users.LookupUnix(user)
+	if err == nil {
+		err = os.Chown(path, uid, gid)
+	}
+	if err != nil {
+		logger.Debug("failed to change owner of image", "path", path, "user", user, "error", err)
+	}
+}
+
+// commandError includes the stderr of a failed command in its error
+func commandError(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return err
+}
+
+// mountForSource returns the volume mount an absolute disk source is in, and
+// the path of the source relative to the mount.
+func mountForSource(mounts []*drivers.MountConfig, source string) (*drivers.MountConfig, string) {
+	for _, m := range mounts {
+		rel, err := filepath.Rel(filepath.Clean(m.TaskPath), filepath.Clean(source))
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		return m, rel
+	}
+	return nil, ""
+}
+
+// validateMounts ensures every volume mount holds the source of a disk. The
+// guest has no access to volume mounts, so a mount that isn't used by a disk
+// would silently be missing from the VM.
+func validateMounts(mounts []*drivers.MountConfig, disks []*DiskConfig) error {
+	used := make(map[*drivers.MountConfig]struct{}, len(mounts))
+	for _, disk := range disks {
+		if !filepath.IsAbs(disk.Source) {
+			continue
+		}
+		if m, _ := mountForSource(mounts, disk.Source); m != nil {
+			used[m] = struct{}{}
+		}
+	}
+	for _, m := range mounts {
+		if _, ok := used[m]; !ok {
+			return fmt.Errorf("volume mount %q is not used by a disk: the qemu driver only uses volume mounts to locate disk images", m.TaskPath)
+		}
+	}
+	return nil
+}
+
+// diskArgs prepares the additional disks of the task, creating disks and
+// overlays as needed, and returns the arguments attaching them to the VM.
+func (d *Driver) diskArgs(cfg *drivers.TaskConfig, disks []*DiskConfig, qemuImg string) ([]string, error) {
+	taskDir := cfg.TaskDir()
+
+	if err := validateMounts(cfg.Mounts, disks); err != nil {
+		return nil, err
+	}
+
+	var args []string
+	for i, disk := range disks {
+		path, readOnly, err := resolveDiskSource(d.config.ImagePaths, cfg.AllocDir, taskDir.Dir, cfg.Mounts, disk.Source)
+		if err != nil {
+			return nil, err
+		}
+		readOnly = readOnly || disk.ReadOnly
+
+		if disk.Size != "" {
+			if readOnly {
+				if _, err := os.Stat(path); err != nil {
+					return nil, fmt.Errorf("read-only disk %q can't be created: %v", disk.Source, err)
+				}
+			}
+			if err := createDisk(d.logger, qemuImg, path, disk.Format, disk.Size, cfg.User); err != nil {
+				return nil, err
+			}
+		}
+
+		format := disk.Format
+		if disk.Overlay {
+			overlay := filepath.Join(taskDir.LocalDir, overlayDirName, fmt.Sprintf("disk%d.qcow2", i))
+			if err := createOverlay(d.logger, qemuImg, overlay, path, format, cfg.User); err != nil {
+				return nil, err
+			}
+			path, format = overlay, "qcow2"
+		}
+
+		drive := fmt.Sprintf("file=%s,format=%s,if=virtio", qemuOptEscape(path), format)
+		if readOnly {
+			drive += ",readonly=on"
+		}
+		args = append(args, "-drive", drive)
+	}
+	return args, nil
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package qemu
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/shoenig/test/must"
+)
+
+func TestDiskConfig_validate(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name      string
+		disk      *DiskConfig
+		expectErr string
+	}{
+		{
+			name: "valid",
+			disk: &DiskConfig{Source: "/data/disk.qcow2", Format: "qcow2", Size: "10GiB", Overlay: true},
+		},
+		{
+			name:      "no source",
+			disk:      &DiskConfig{Format: "raw"},
+			expectErr: "disk source must be set",
+		},
+		{
+			name:      "bad format",
+			disk:      &DiskConfig{Source: "disk.vmdk", Format: "vmdk"},
+			expectErr: `unsupported disk format "vmdk"`,
+		},
+		{
+			name:      "bad size",
+			disk:      &DiskConfig{Source: "disk.img", Format: "raw", Size: "lots"},
+			expectErr: `invalid disk size "lots"`,
+		},
+		{
+			name:      "read-only overlay",
+			disk:      &DiskConfig{Source: "disk.img", Format: "raw", ReadOnly: true, Overlay: true},
+			expectErr: "disk overlay can't be used with read_only",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.disk.validate()
+			if tc.expectErr == "" {
+				must.NoError(t, err)
+			} else {
+				must.ErrorContains(t, err, tc.expectErr)
+			}
+		})
+	}
+}
+
+func TestResolveDiskSource(t *testing.T) {
+	ci.Parallel(t)
+
+	allocDir := "/var/nomad/alloc/123"
+	taskDir := "/var/nomad/alloc/123/vm"
+	mounts := []*drivers.MountConfig{
+		{TaskPath: "/data", HostPath: "/srv/volumes/data"},
+		{TaskPath: "/images", HostPath: "/srv/volumes/images", Readonly: true},
+	}
+
+	cases := []struct {
+		source       string
+		expectPath   string
+		expectRO     bool
+		expectErrStr string
+	}{
+		{source: "local/disk.img", expectPath: "/var/nomad/alloc/123/vm/local/disk.img"},
+		{source: "../alloc/data/disk.img", expectPath: "/var/nomad/alloc/123/alloc/data/disk.img"},
+		{source: "../../other/disk.img", expectErrStr: "outside the allocation directory"},
+		{source: "/data/disk.qcow2", expectPath: "/srv/volumes/data/disk.qcow2"},
+		{source: "/data/sub/../disk.qcow2", expectPath: "/srv/volumes/data/disk.qcow2"},
+		{source: "/images/base.img", expectPath: "/srv/volumes/images/base.img", expectRO: true},
+		{source: "/opt/qemu/disk.img", expectPath: "/opt/qemu/disk.img"},
+		{source: "/database/disk.img", expectErrStr: "not in a volume mount or the allowed paths"},
+		{source: "/data/../etc/shadow", expectErrStr: "not in a volume mount or the allowed paths"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.source, func(t *testing.T) {
+			path, ro, err := resolveDiskSource([]string{"/opt/qemu"}, allocDir, taskDir, mounts, tc.source)
+			if tc.expectErrStr != "" {
+				must.ErrorContains(t, err, tc.expectErrStr)
+				return
+			}
+			must.NoError(t, err)
+			must.Eq(t, tc.expectPath, path)
+			must.Eq(t, tc.expectRO, ro)
+		})
+	}
+}
+
+func TestValidateMounts(t *testing.T) {
+	ci.Parallel(t)
+
+	mounts := []*drivers.MountConfig{
+		{TaskPath: "/data", HostPath: "/srv/volumes/data"},
+		{TaskPath: "/images", HostPath: "/srv/volumes/images", Readonly: true},
+	}
+
+	// every mount holds a disk
+	must.NoError(t, validateMounts(mounts, []*DiskConfig{
+		{Source: "/data/disk.qcow2"},
+		{Source: "/images/sub/base.img"},
+		{Source: "local/scratch.img"},
+	}))
+
+	// a mount without a disk is rejected
+	must.ErrorContains(t, validateMounts(mounts, []*DiskConfig{
+		{Source: "/data/disk.qcow2"},
+	}), `volume mount "/images" is not used by a disk`)
+
+	// mounts are rejected when the task has no disks
+	must.ErrorContains(t, validateMounts(mounts, nil), `volume mount "/data" is not used by a disk`)
+	must.NoError(t, validateMounts(nil, nil))
+}
+
+func TestWriteCloudInitSeed(t *testing.T) {
+	ci.Parallel(t)
+
+	taskDir := t.TempDir()
+	must.NoError(t, os.MkdirAll(filepath.Join(taskDir, "local"), 0o755))
+	must.NoError(t, os.WriteFile(filepath.Join(taskDir, "local", "user-data"), []byte("#cloud-config\n"), 0o644))
+
+	cfg := &drivers.TaskConfig{AllocID: "abc", Name: "vm"}
+	seedDir := filepath.Join(taskDir, "local", cloudInitDirName)
+
+	// a stale file from a previous start is removed
+	must.NoError(t, os.MkdirAll(seedDir, 0o755))
+	must.NoError(t, os.WriteFile(filepath.Join(seedDir, "network-config"), []byte("stale"), 0o644))
+
+	cloudInit := &CloudInitConfig{UserData: "local/user-data"}
+	must.NoError(t, writeCloudInitSeed(cloudInit, cfg, taskDir, seedDir))
+
+	b, err := os.ReadFile(filepath.Join(seedDir, "user-data"))
+	must.NoError(t, err)
+	must.Eq(t, "#cloud-config\n", string(b))
+
+	b, err = os.ReadFile(filepath.Join(seedDir, "meta-data"))
+	must.NoError(t, err)
+	must.Eq(t, "instance-id: abc-vm\n", string(b))
+
+	_, err = os.Stat(filepath.Join(seedDir, "network-config"))
+	must.True(t, os.IsNotExist(err))
+
+	// sources must be within the task directory
+	cloudInit = &CloudInitConfig{UserData: "../../etc/passwd"}
+	must.ErrorContains(t, writeCloudInitSeed(cloudInit, cfg, taskDir, seedDir),
+		"must be relative to the task directory")
+
+	cloudInit = &CloudInitConfig{MetaData: "local/missing"}
+	must.ErrorContains(t, writeCloudInitSeed(cloudInit, cfg, taskDir, seedDir),
+		"failed to read cloud_init meta_data")
+}
+
+func TestCreateOverlay(t *testing.T) {
+	ci.Parallel(t)
+
+	qemuImg, err := exec.LookPath("qemu-img")
+	if err != nil {
+		t.Skip("qemu-img not found")
+	}
+
+	logger := testlog.HCLogger(t)
+	dir := t.TempDir()
+	base := filepath.Join(dir, "base.img")
+	overlay := filepath.Join(dir, "overlays", "image.qcow2")
+
+	must.NoError(t, createDisk(logger, qemuImg, base, "raw", "1MiB", ""))
+	format, err := imageFormat(qemuImg, base)
+	must.NoError(t, err)
+	must.Eq(t, "raw", format)
+
+	must.NoError(t, createOverlay(logger, qemuImg, overlay, base, format, ""))
+	format, err = imageFormat(qemuImg, overlay)
+	must.NoError(t, err)
+	must.Eq(t, "qcow2", format)
+
+	// an existing overlay is kept
+	stat, err := os.Stat(overlay)
+	must.NoError(t, err)
+	must.NoError(t, createOverlay(logger, qemuImg, overlay, base, format, ""))
+	stat2, err := os.Stat(overlay)
+	must.NoError(t, err)
+	must.Eq(t, stat.ModTime(), stat2.ModTime())
+}
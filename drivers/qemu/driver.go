@@ -96,6 +96,23 @@ var (
 		"guest_agent":       hclspec.NewAttr("guest_agent", "bool", false),
 		"args":              hclspec.NewAttr("args", "list(string)", false),
 		"port_map":          hclspec.NewAttr("port_map", "list(map(number))", false),
+		"image_overlay":     hclspec.NewAttr("image_overlay", "bool", false),
+		"disk": hclspec.NewBlockList("disk", hclspec.NewObject(map[string]*hclspec.Spec{
+			"source": hclspec.NewAttr("source", "string", true),
+			"format": hclspec.NewDefault(
+				hclspec.NewAttr("format", "string", false),
+				hclspec.NewLiteral(`"raw"`),
+			),
+			"size":      hclspec.NewAttr("size", "string", false),
+			"read_only": hclspec.NewAttr("read_only", "bool", false),
+			"overlay":   hclspec.NewAttr("overlay", "bool", false),
+		})),
+		"cloud_init": hclspec.NewBlock("cloud_init", false, hclspec.NewObject(map[string]*hclspec.Spec{
+			"user_data":      hclspec.NewAttr("user_data", "string", false),
+			"meta_data":      hclspec.NewAttr("meta_data", "string", false),
+			"network_config": hclspec.NewAttr("network_config", "string", false),
+			"vendor_data":    hclspec.NewAttr("vendor_data", "string", false),
+		})),
 	})
 
 	// capabilities is returned by the Capabilities RPC and indicates what
@@ -108,7 +125,8 @@ var (
 			drivers.NetIsolationModeHost,
 			drivers.NetIsolationModeGroup,
 		},
-		MountConfigs: drivers.MountConfigSupportNone,
+		// Mounts are only used to locate the disks attached to the VM
+		MountConfigs: drivers.MountConfigSupportAll,
 	}
 
 	_ drivers.DriverPlugin = (*Driver)(nil)
@@ -123,6 +141,9 @@ type TaskConfig struct {
 	GracefulShutdown bool               `codec:"graceful_shutdown"`
 	DriveInterface   string             `codec:"drive_interface"` // Use interface for image
 	GuestAgent       bool               `codec:"guest_agent"`
+	ImageOverlay     bool               `codec:"image_overlay"` // Boot from a copy-on-write overlay of the image
+	Disks            []*DiskConfig      `codec:"disk"`
+	CloudInit        *CloudInitConfig   `codec:"cloud_init"`
 }
 
 // TaskState is the state which is encoded in the handle returned in StartTask.
@@ -302,26 +323,33 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 		return fmt.Errorf("failed to reattach to executor: %v", err)
 	}
 
-	// Try to restore monitor socket path.
+	// Try to restore QMP socket path, or the monitor socket path of tasks
+	// started before the QMP socket was used.
 	taskDir := filepath.Join(handle.Config.AllocDir, handle.Config.Name)
-	possiblePaths := []string{
-		filepath.Join(taskDir, qemuMonitorSocketName),
-		// Support restoring tasks that used the old socket name.
-		filepath.Join(taskDir, "qemu-monitor.sock"),
-	}
-
-	var monitorPath string
-	for _, path := range possiblePaths {
-		if _, err := os.Stat(path); err == nil {
-			monitorPath = path
-			d.logger.Debug("found existing monitor socket", "monitor", monitorPath)
-			break
+	var qmpPath, monitorPath string
+	if path := filepath.Join(taskDir, qmpSocketName); fileExists(path) {
+		qmpPath = path
+		d.logger.Debug("found existing QMP socket", "qmp_path", qmpPath)
+	} else {
+		possiblePaths := []string{
+			filepath.Join(taskDir, qemuMonitorSocketName),
+			// Support restoring tasks that used the old socket name.
+			filepath.Join(taskDir, "qemu-monitor.sock"),
+		}
+
+		for _, path := range possiblePaths {
+			if fileExists(path) {
+				monitorPath = path
+				d.logger.Debug("found existing monitor socket", "monitor", monitorPath)
+				break
+			}
 		}
 	}
 
 	h := &taskHandle{
 		exec:         execImpl,
 		pid:          taskState.Pid,
+		qmpPath:      qmpPath,
 		monitorPath:  monitorPath,
 		pluginClient: pluginClient,
 		taskConfig:   taskState.TaskConfig,
@@ -329,6 +357,7 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 		startedAt:    taskState.StartedAt,
 		exitResult:   &drivers.ExitResult{},
 		logger:       d.logger,
+		doneCh:       make(chan struct{}),
 	}
 
 	d.tasks.Set(taskState.TaskConfig.ID, h)
@@ -453,15 +482,73 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		return nil, nil, fmt.Errorf("Unsupported drive_interface")
 	}
 
+	for i, disk := range driverConfig.Disks {
+		if err := disk.validate(); err != nil {
+			return nil, nil, fmt.Errorf("invalid disk %d: %v", i, err)
+		}
+	}
+
+	taskDir := filepath.Join(cfg.AllocDir, cfg.Name)
+
+	// Overlays and new disks are created with qemu-img
+	var qemuImg string
+	needsQemuImg := driverConfig.ImageOverlay
+	for _, disk := range driverConfig.Disks {
+		needsQemuImg = needsQemuImg || disk.Overlay || disk.Size != ""
+	}
+	if needsQemuImg {
+		qemuImg, err = GetAbsolutePath("qemu-img")
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Boot from a copy-on-write overlay of the image if configured, so the
+	// image is never modified by the VM
+	drive := "file=" + vmPath + ",if=" + driveInterface
+	if driverConfig.ImageOverlay {
+		backing := vmPath
+		if !filepath.IsAbs(backing) {
+			backing = filepath.Join(taskDir, backing)
+		}
+		format, err := imageFormat(qemuImg, backing)
+		if err != nil {
+			return nil, nil, err
+		}
+		overlay := filepath.Join(cfg.TaskDir().LocalDir, overlayDirName, "image.qcow2")
+		if err := createOverlay(d.logger, qemuImg, overlay, backing, format, cfg.User); err != nil {
+			return nil, nil, err
+		}
+		drive = "file=" + qemuOptEscape(overlay) + ",format=qcow2,if=" + driveInterface
+	}
+
 	args := []string{
 		absPath,
 		"-machine", "type=pc,accel=" + accelerator,
 		"-name", vmID,
 		"-m", mem,
-		"-drive", "file=" + vmPath + ",if=" + driveInterface,
+		"-drive", drive,
 		"-nographic",
 	}
 
+	diskArgs, err := d.diskArgs(cfg, driverConfig.Disks, qemuImg)
+	if err != nil {
+		return nil, nil, err
+	}
+	args = append(args, diskArgs...)
+
+	// Present the cloud-init NoCloud seed to the guest as a FAT drive
+	// labeled for cloud-init to find
+	if driverConfig.CloudInit != nil {
+		seedDir := filepath.Join(cfg.TaskDir().LocalDir, cloudInitDirName)
+		if err := writeCloudInitSeed(driverConfig.CloudInit, cfg, taskDir, seedDir); err != nil {
+			return nil, nil, err
+		}
+		args = append(args, "-drive", fmt.Sprintf(
+			"if=virtio,format=raw,readonly=on,file.driver=vvfat,file.dir=%s,file.label=%s",
+			qemuOptEscape(seedDir), cloudInitLabel))
+	}
+
 	var netdevArgs []string
 	if cfg.DNS != nil {
 		if len(cfg.DNS.Servers) > 0 {
@@ -473,21 +560,19 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		}
 	}
 
-	taskDir := filepath.Join(cfg.AllocDir, cfg.Name)
-
-	var monitorPath string
+	var qmpPath string
 	if driverConfig.GracefulShutdown {
 		if runtime.GOOS == "windows" {
 			return nil, nil, errors.New("QEMU graceful shutdown is unsupported on the Windows platform")
 		}
-		// This socket will be used to manage the virtual machine (for example,
-		// to perform graceful shutdowns)
-		monitorPath = filepath.Join(taskDir, qemuMonitorSocketName)
-		if err := validateSocketPath(monitorPath); err != nil {
+		// This QMP socket will be used to manage the virtual machine (for
+		// example, to perform graceful shutdowns)
+		qmpPath = filepath.Join(taskDir, qmpSocketName)
+		if err := validateSocketPath(qmpPath); err != nil {
 			return nil, nil, err
 		}
-		d.logger.Debug("got monitor path", "monitorPath", monitorPath)
-		args = append(args, "-monitor", fmt.Sprintf("unix:%s,server,nowait", monitorPath))
+		d.logger.Debug("got QMP path", "qmp_path", qmpPath)
+		args = append(args, "-qmp", fmt.Sprintf("unix:%s,server,nowait", qmpPath))
 	}
 
 	if driverConfig.GuestAgent {
@@ -597,12 +682,13 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	h := &taskHandle{
 		exec:         execImpl,
 		pid:          ps.Pid,
-		monitorPath:  monitorPath,
+		qmpPath:      qmpPath,
 		pluginClient: pluginClient,
 		taskConfig:   cfg,
 		procState:    drivers.TaskStateRunning,
 		startedAt:    time.Now().Round(time.Millisecond),
 		logger:       d.logger,
+		doneCh:       make(chan struct{}),
 	}
 
 	qemuDriverState := TaskState{
@@ -650,17 +736,30 @@ func (d *Driver) StopTask(taskID string, timeout time.Duration, signal string) e
 	}
 
 	// Attempt a graceful shutdown only if it was configured in the job
-	if handle.monitorPath != "" {
-		if err := sendQemuShutdown(d.logger, handle.monitorPath, handle.pid); err != nil {
-			d.logger.Debug("error sending graceful shutdown ", "pid", handle.pid, "error", err)
-		}
+	var err error
+	switch {
+	case handle.qmpPath != "":
+		err = sendQMPShutdown(d.logger, handle.qmpPath, handle.pid)
+	case handle.monitorPath != "":
+		err = sendQemuShutdown(d.logger, handle.monitorPath, handle.pid)
+	default:
+		err = errors.New("monitor socket is empty")
+	}
+
+	if err != nil {
+		d.logger.Debug("error sending graceful shutdown, forcing shutdown", "pid", handle.pid, "error", err)
 	} else {
-		d.logger.Debug("monitor socket is empty, forcing shutdown")
+		// Give the guest until the kill timeout to power off, since QEMU
+		// exits on a signal without shutting down the guest
+		select {
+		case <-handle.doneCh:
+			return nil
+		case <-time.After(timeout):
+			d.logger.Debug("VM did not power off within the timeout, forcing shutdown", "pid", handle.pid)
+			timeout = 0
+		}
 	}
 
-	// TODO(preetha) we are calling shutdown on the executor here
-	// after attempting a graceful qemu shutdown, qemu process may
-	// not be around when we call exec.shutdown
 	if err := handle.exec.Shutdown(signal, timeout); err != nil {
 		if handle.pluginClient.Exited() {
 			return nil
@@ -758,6 +857,11 @@ func (d *Driver) handleWait(ctx context.Context, handle *taskHandle, ch chan *dr
 	}
 }
 
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
 // validateSocketPath provides best effort validation of socket paths since
 // some rules may be platform-dependant.
 func validateSocketPath(path string) error {
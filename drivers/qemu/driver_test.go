@@ -270,6 +270,20 @@ config {
     https = 443
   }
   graceful_shutdown = true
+  image_overlay = true
+  disk {
+    source = "/data/disk.qcow2"
+    format = "qcow2"
+    size = "10GiB"
+  }
+  disk {
+    source = "local/seed.img"
+    read_only = true
+  }
+  cloud_init {
+    user_data = "local/user-data"
+    network_config = "local/network-config"
+  }
 }`
 
 	expected := &TaskConfig{
@@ -282,6 +296,15 @@ config {
 			"https": 443,
 		},
 		GracefulShutdown: true,
+		ImageOverlay:     true,
+		Disks: []*DiskConfig{
+			{Source: "/data/disk.qcow2", Format: "qcow2", Size: "10GiB"},
+			{Source: "local/seed.img", Format: "raw", ReadOnly: true},
+		},
+		CloudInit: &CloudInitConfig{
+			UserData:      "local/user-data",
+			NetworkConfig: "local/network-config",
+		},
 	}
 
 	var tc *TaskConfig
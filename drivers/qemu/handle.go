@@ -20,8 +20,12 @@ type taskHandle struct {
 	pid          int
 	pluginClient *plugin.Client
 	logger       hclog.Logger
+	qmpPath      string
 	monitorPath  string
 
+	// doneCh is closed when the VM process exits
+	doneCh chan struct{}
+
 	// stateLock syncs access to all fields below
 	stateLock sync.RWMutex
 
@@ -63,6 +67,7 @@ func (h *taskHandle) run() {
 	h.stateLock.Unlock()
 
 	ps, err := h.exec.Wait(context.Background())
+	defer close(h.doneCh)
 
 	h.stateLock.Lock()
 	defer h.stateLock.Unlock()
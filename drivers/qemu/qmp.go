@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package qemu
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// Socket file for the QEMU Machine Protocol, used to manage the virtual
+	// machine. Use a short file name since socket paths have a maximum length.
+	qmpSocketName = "qmp.sock"
+
+	// qmpTimeout is how long a QMP exchange with QEMU may take
+	qmpTimeout = 5 * time.Second
+)
+
+// qmpRequest is a command sent to QEMU over QMP
+type qmpRequest struct {
+	Execute string `json:"execute"`
+}
+
+// qmpResponse is a message received from QEMU over QMP. Asynchronous events
+// can be interleaved with command responses and are ignored.
+type qmpResponse struct {
+	Greeting json.RawMessage `json:"QMP"`
+	Return   json.RawMessage `json:"return"`
+	Error    *qmpError       `json:"error"`
+	Event    string          `json:"event"`
+}
+
+type qmpError struct {
+	Class string `json:"class"`
+	Desc  string `json:"desc"`
+}
+
+func (e *qmpError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Class, e.Desc)
+}
+
+// qmpClient is a minimal client for the QEMU Machine Protocol
+type qmpClient struct {
+	conn net.Conn
+	dec  *json.Decoder
+	enc  *json.Encoder
+}
+
+// dialQMP connects to the QMP socket at path and negotiates capabilities, so
+// the returned client is ready to execute commands.
+func dialQMP(path string) (*qmpClient, error) {
+	conn, err := net.DialTimeout("unix", path, qmpTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(qmpTimeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	c := &qmpClient{
+		conn: conn,
+		dec:  json.NewDecoder(conn),
+		enc:  json.NewEncoder(conn),
+	}
+
+	var greeting qmpResponse
+	if err := c.dec.Decode(&greeting); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to read QMP greeting: %w", err)
+	}
+	if greeting.Greeting == nil {
+		conn.Close()
+		return nil, errors.New("unexpected QMP greeting")
+	}
+
+	if err := c.execute("qmp_capabilities"); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to negotiate QMP capabilities: %w", err)
+	}
+	return c, nil
+}
+
+// execute runs the command and waits for its response
+func (c *qmpClient) execute(command string) error {
+	if err := c.enc.Encode(&qmpRequest{Execute: command}); err != nil {
+		return err
+	}
+
+	for {
+		var resp qmpResponse
+		if err := c.dec.Decode(&resp); err != nil {
+			return err
+		}
+		switch {
+		case resp.Event != "":
+			continue
+		case resp.Error != nil:
+			return resp.Error
+		case resp.Return != nil:
+			return nil
+		}
+	}
+}
+
+func (c *qmpClient) Close() error {
+	return c.conn.Close()
+}
+
+// sendQMPShutdown attempts to issue an ACPI power-off command via the QMP
+// socket
+func sendQMPShutdown(logger hclog.Logger, qmpPath string, userPid int) error {
+	if qmpPath == "" {
+		return errors.New("qmpPath not set")
+	}
+
+	client, err := dialQMP(qmpPath)
+	if err != nil {
+		logger.Warn("could not connect to qemu QMP socket", "pid", userPid, "qmp_path", qmpPath, "error", err)
+		return err
+	}
+	defer client.Close()
+
+	logger.Debug("sending graceful shutdown command to qemu QMP socket", "qmp_path", qmpPath, "pid", userPid)
+	if err := client.execute("system_powerdown"); err != nil {
+		logger.Warn("failed to send shutdown command", "qmp_path", qmpPath, "pid", userPid, "error", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package qemu
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/shoenig/test/must"
+)
+
+// fakeQMP serves a single QMP connection on path and sends the commands it
+// receives on the returned channel
+func fakeQMP(t *testing.T, path string) <-chan string {
+	ln, err := net.Listen("unix", path)
+	must.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	commands := make(chan string, 10)
+	go func() {
+		defer close(commands)
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		conn.Write([]byte(`{"QMP": {"version": {}, "capabilities": []}}` + "\n"))
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			var req qmpRequest
+			if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+				return
+			}
+			commands <- req.Execute
+			switch req.Execute {
+			case "qmp_capabilities", "system_powerdown":
+				// events may precede the response
+				conn.Write([]byte(`{"event": "POWERDOWN", "timestamp": {}}` + "\n"))
+				conn.Write([]byte(`{"return": {}}` + "\n"))
+			default:
+				conn.Write([]byte(`{"error": {"class": "CommandNotFound", "desc": "unknown"}}` + "\n"))
+			}
+		}
+	}()
+	return commands
+}
+
+func TestSendQMPShutdown(t *testing.T) {
+	ci.Parallel(t)
+
+	path := filepath.Join(t.TempDir(), qmpSocketName)
+	commands := fakeQMP(t, path)
+
+	must.NoError(t, sendQMPShutdown(testlog.HCLogger(t), path, 1))
+	must.Eq(t, "qmp_capabilities", <-commands)
+	must.Eq(t, "system_powerdown", <-commands)
+}
+
+func TestQMPClient_error(t *testing.T) {
+	ci.Parallel(t)
+
+	path := filepath.Join(t.TempDir(), qmpSocketName)
+	fakeQMP(t, path)
+
+	client, err := dialQMP(path)
+	must.NoError(t, err)
+	defer client.Close()
+
+	must.EqError(t, client.execute("bogus"), "CommandNotFound: unknown")
+}
+
+func TestSendQMPShutdown_noSocket(t *testing.T) {
+	ci.Parallel(t)
+
+	path := filepath.Join(t.TempDir(), qmpSocketName)
+	must.Error(t, sendQMPShutdown(testlog.HCLogger(t), path, 1))
+}
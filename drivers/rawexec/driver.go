@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"time"
 
@@ -95,6 +96,10 @@ var (
 		"cgroup_v1_override": hclspec.NewAttr("cgroup_v1_override", "list(map(string))", false),
 		"oom_score_adj":      hclspec.NewAttr("oom_score_adj", "number", false),
 		"work_dir":           hclspec.NewAttr("work_dir", "string", false),
+		"seccomp_profile":    hclspec.NewAttr("seccomp_profile", "string", false),
+		"seccomp_allow":      hclspec.NewAttr("seccomp_allow", "list(string)", false),
+		"landlock":           hclspec.NewAttr("landlock", "bool", false),
+		"landlock_paths":     hclspec.NewAttr("landlock_paths", "list(string)", false),
 	})
 
 	// capabilities is returned by the Capabilities RPC and indicates what
@@ -107,7 +112,9 @@ var (
 			drivers.NetIsolationModeHost,
 			drivers.NetIsolationModeGroup,
 		},
-		MountConfigs: drivers.MountConfigSupportNone,
+		MountConfigs:    drivers.MountConfigSupportNone,
+		UpdateResources: runtime.GOOS == "linux",
+		Pause:           runtime.GOOS == "linux",
 	}
 )
 
@@ -176,6 +183,36 @@ type TaskConfig struct {
 
 	// WorkDir sets the working directory of the task
 	WorkDir string `codec:"work_dir"`
+
+	// SeccompProfile is the seccomp profile applied to the task, either
+	// empty or "default" to deny every syscall not on a list of common
+	// syscalls.
+	SeccompProfile string `codec:"seccomp_profile"`
+
+	// SeccompAllow is the list of syscalls permitted in addition to the
+	// seccomp profile.
+	SeccompAllow []string `codec:"seccomp_allow"`
+
+	// Landlock restricts the filesystem access of the task with the Landlock
+	// LSM.
+	Landlock bool `codec:"landlock"`
+
+	// LandlockPaths are extra paths a landlocked task can access.
+	LandlockPaths []string `codec:"landlock_paths"`
+}
+
+// sandbox returns the sandbox configuration of the task, or nil if the task
+// is not sandboxed.
+func (t *TaskConfig) sandbox() *executor.SandboxConfig {
+	if t.SeccompProfile == "" && len(t.SeccompAllow) == 0 && !t.Landlock && len(t.LandlockPaths) == 0 {
+		return nil
+	}
+	return &executor.SandboxConfig{
+		SeccompProfile: t.SeccompProfile,
+		SeccompAllow:   t.SeccompAllow,
+		Landlock:       t.Landlock,
+		LandlockPaths:  t.LandlockPaths,
+	}
 }
 
 func (t *TaskConfig) validate() error {
@@ -191,6 +228,9 @@ func (t *TaskConfig) validate() error {
 	if t.WorkDir != "" && !filepath.IsAbs(t.WorkDir) {
 		return errors.New("work_dir must be an absolute path")
 	}
+	if err := t.sandbox().Validate(); err != nil {
+		return fmt.Errorf("invalid sandbox: %w", err)
+	}
 	return nil
 }
 
@@ -413,6 +453,7 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		OverrideCgroupV2: driverConfig.OverrideCgroupV2,
 		OverrideCgroupV1: driverConfig.OverrideCgroupV1,
 		OOMScoreAdj:      int32(driverConfig.OOMScoreAdj),
+		Sandbox:          driverConfig.sandbox(),
 	}
 
 	ps, err := exec.Launch(execCmd)
@@ -554,6 +595,58 @@ func (d *Driver) TaskEvents(ctx context.Context) (<-chan *drivers.TaskEvent, err
 	return d.eventer.TaskEvents(ctx)
 }
 
+// UpdateTaskResources applies new CPU and memory limits to the cgroup of a
+// running task. Tasks placed in custom cgroups are not managed by Nomad and
+// so cannot be updated.
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	handle, err := d.cgroupTaskHandle(taskID)
+	if err != nil {
+		return err
+	}
+
+	return executor.UpdateResources(handle.taskConfig.AllocID, handle.taskConfig.Name, resources)
+}
+
+// PauseTask freezes the cgroup of a running task. Tasks placed in custom
+// cgroups are not managed by Nomad and so cannot be paused.
+func (d *Driver) PauseTask(taskID string) error {
+	handle, err := d.cgroupTaskHandle(taskID)
+	if err != nil {
+		return err
+	}
+
+	return handle.exec.Pause()
+}
+
+// ResumeTask thaws the cgroup of a task frozen by PauseTask.
+func (d *Driver) ResumeTask(taskID string) error {
+	handle, err := d.cgroupTaskHandle(taskID)
+	if err != nil {
+		return err
+	}
+
+	return handle.exec.Resume()
+}
+
+// cgroupTaskHandle returns the handle of a task running in the cgroup Nomad
+// created for it.
+func (d *Driver) cgroupTaskHandle(taskID string) (*taskHandle, error) {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return nil, drivers.ErrTaskNotFound
+	}
+
+	var driverConfig TaskConfig
+	if err := handle.taskConfig.DecodeDriverConfig(&driverConfig); err != nil {
+		return nil, fmt.Errorf("failed to decode driver config: %v", err)
+	}
+	if len(driverConfig.OverrideCgroupV1) > 0 || driverConfig.OverrideCgroupV2 != "" {
+		return nil, errors.New("tasks using a custom cgroup are not managed by Nomad")
+	}
+
+	return handle, nil
+}
+
 func (d *Driver) SignalTask(taskID string, signal string) error {
 	handle, ok := d.tasks.Get(taskID)
 	if !ok {
@@ -593,6 +686,7 @@ func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*
 }
 
 var _ drivers.ExecTaskStreamingRawDriver = (*Driver)(nil)
+var _ drivers.DriverTaskResourceUpdater = (*Driver)(nil)
 
 func (d *Driver) ExecTaskStreamingRaw(ctx context.Context,
 	taskID string,
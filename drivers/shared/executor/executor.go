@@ -90,6 +90,18 @@ type Executor interface {
 
 	ExecStreaming(ctx context.Context, cmd []string, tty bool,
 		stream drivers.ExecTaskStream) error
+
+	// Checkpoint dumps the state of the user process to the given image
+	// directory so it can be restored by a later Launch. If leaveRunning is
+	// false the process is stopped once the checkpoint is taken.
+	Checkpoint(imagePath string, leaveRunning bool) error
+
+	// Pause freezes the user process and its children, which keep their
+	// memory but no longer use the CPU, until Resume is called.
+	Pause() error
+
+	// Resume thaws the user process frozen by Pause.
+	Resume() error
 }
 
 // ExecCommand holds the user command, args, and other isolation related
@@ -171,6 +183,14 @@ type ExecCommand struct {
 	// OOMScoreAdj allows setting oom_score_adj (likelihood of process being
 	// OOM killed) on Linux systems
 	OOMScoreAdj int32
+
+	// RestoreImagePath is the directory of a checkpoint image. If set, the
+	// process is restored from the image instead of being started.
+	RestoreImagePath string
+
+	// Sandbox restricts the syscalls and filesystem access of the task
+	// process. If nil the task is not sandboxed.
+	Sandbox *SandboxConfig
 }
 
 func (c *ExecCommand) getCgroupOr(controller, fallback string) string {
@@ -414,10 +434,25 @@ func (e *UniversalExecutor) Launch(command *ExecCommand) (*ProcessState, error)
 	}
 
 	path := absPath
+	args := append([]string{path}, command.Args...)
+
+	// Run the command through the sandbox subcommand of this binary, which
+	// restricts itself before executing the command
+	if command.Sandbox.Enabled() {
+		bin, err := os.Executable()
+		if err != nil {
+			return nil, fmt.Errorf("failed to find executor binary: %w", err)
+		}
+		allocDir := filepath.Join(filepath.Dir(command.TaskDir), allocdir.SharedAllocName)
+		path, args, err = sandboxWrap(command.Sandbox, bin, []string{command.TaskDir, allocDir}, absPath, command.Args)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Set the commands arguments
 	e.childCmd.Path = path
-	e.childCmd.Args = append([]string{e.childCmd.Path}, command.Args...)
+	e.childCmd.Args = args
 	e.childCmd.Env = e.command.Env
 
 	// Start the process
@@ -699,6 +734,34 @@ func (e *UniversalExecutor) Signal(s os.Signal) error {
 	return nil
 }
 
+// Checkpoint is not supported by the universal executor, as it doesn't run
+// the task in a container CRIU can dump.
+func (e *UniversalExecutor) Checkpoint(imagePath string, leaveRunning bool) error {
+	return fmt.Errorf("checkpoint is not supported by this executor")
+}
+
+// Pause freezes the cgroup of the user process
+func (e *UniversalExecutor) Pause() error {
+	cgroup := e.command.StatsCgroup()
+	if cgroup == "" {
+		return fmt.Errorf("pausing a task requires it to run in a cgroup")
+	}
+
+	e.logger.Debug("freezing task cgroup", "cgroup", cgroup)
+	return cgroupslib.Freeze(cgroup)
+}
+
+// Resume thaws the cgroup of the user process
+func (e *UniversalExecutor) Resume() error {
+	cgroup := e.command.StatsCgroup()
+	if cgroup == "" {
+		return fmt.Errorf("resuming a task requires it to run in a cgroup")
+	}
+
+	e.logger.Debug("thawing task cgroup", "cgroup", cgroup)
+	return cgroupslib.Thaw(cgroup)
+}
+
 func (e *UniversalExecutor) Stats(ctx context.Context, interval time.Duration) (<-chan *cstructs.TaskResourceUsage, error) {
 	ch := make(chan *cstructs.TaskResourceUsage)
 	go e.handleStats(ch, ctx, interval)
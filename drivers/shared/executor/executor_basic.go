@@ -6,6 +6,7 @@
 package executor
 
 import (
+	"errors"
 	"os/exec"
 
 	"github.com/hashicorp/go-hclog"
@@ -44,3 +45,7 @@ func (e *UniversalExecutor) ListProcesses() set.Collection[int] {
 func (e *UniversalExecutor) setSubCmdCgroup(*exec.Cmd, string) (func(), error) {
 	return func() {}, nil
 }
+
+func UpdateResources(string, string, *drivers.Resources) error {
+	return errors.New("updating the resources of a running task is not supported on this platform")
+}
@@ -205,6 +205,19 @@ func (l *LibcontainerExecutor) Launch(command *ExecCommand) (*ProcessState, erro
 	}
 
 	combined := append([]string{taskPath}, command.Args...)
+	if command.Sandbox.Enabled() {
+		taskDirs := []string{
+			allocdir.SharedAllocContainerPath,
+			"/" + allocdir.TaskLocal,
+			"/" + allocdir.TaskSecrets,
+			"/" + allocdir.TaskPrivate,
+			"/tmp",
+		}
+		_, combined, err = sandboxWrap(command.Sandbox, sandboxChrootBin, taskDirs, taskPath, command.Args)
+		if err != nil {
+			return nil, err
+		}
+	}
 	stdout, err := command.Stdout()
 	if err != nil {
 		return nil, err
@@ -236,8 +249,14 @@ func (l *LibcontainerExecutor) Launch(command *ExecCommand) (*ProcessState, erro
 	l.userCpuStats = cpustats.New(l.compute)
 	l.systemCpuStats = cpustats.New(l.compute)
 
-	// Starts the task
-	if err := container.Run(process); err != nil {
+	// Starts the task, or restores it from a checkpoint image
+	if command.RestoreImagePath != "" {
+		l.logger.Debug("restoring from checkpoint", "image", command.RestoreImagePath)
+		if err := container.Restore(process, criuOpts(command.RestoreImagePath, false)); err != nil {
+			container.Destroy()
+			return nil, fmt.Errorf("failed to restore container(%s): %v", l.id, err)
+		}
+	} else if err := container.Run(process); err != nil {
 		container.Destroy()
 		return nil, err
 	}
@@ -494,6 +513,51 @@ func (l *LibcontainerExecutor) Signal(s os.Signal) error {
 	return l.userProc.Signal(s)
 }
 
+// Checkpoint dumps the state of the container to imagePath with CRIU
+func (l *LibcontainerExecutor) Checkpoint(imagePath string, leaveRunning bool) error {
+	if l.container == nil {
+		return fmt.Errorf("container not yet launched")
+	}
+	if err := os.MkdirAll(imagePath, 0o700); err != nil {
+		return fmt.Errorf("failed to create checkpoint dir: %v", err)
+	}
+
+	l.logger.Debug("checkpointing container", "image", imagePath, "leave_running", leaveRunning)
+	return l.container.Checkpoint(criuOpts(imagePath, leaveRunning))
+}
+
+// Pause freezes the container
+func (l *LibcontainerExecutor) Pause() error {
+	if l.container == nil {
+		return fmt.Errorf("container not yet launched")
+	}
+
+	l.logger.Debug("pausing container")
+	return l.container.Pause()
+}
+
+// Resume thaws the container frozen by Pause
+func (l *LibcontainerExecutor) Resume() error {
+	if l.container == nil {
+		return fmt.Errorf("container not yet launched")
+	}
+
+	l.logger.Debug("resuming container")
+	return l.container.Resume()
+}
+
+// criuOpts returns the CRIU options used to checkpoint and restore tasks.
+// Established TCP connections are not preserved since the task is usually
+// restored on another node.
+func criuOpts(imagePath string, leaveRunning bool) *libcontainer.CriuOpts {
+	return &libcontainer.CriuOpts{
+		ImagesDirectory:         imagePath,
+		LeaveRunning:            leaveRunning,
+		ExternalUnixConnections: true,
+		FileLocks:               true,
+	}
+}
+
 // Exec starts an additional process inside the container
 func (l *LibcontainerExecutor) Exec(deadline time.Time, cmd string, args []string) ([]byte, int, error) {
 	combined := append([]string{cmd}, args...)
@@ -734,6 +798,20 @@ func configureIsolation(cfg *runc.Config, command *ExecCommand) error {
 		cfg.Mounts = append(cfg.Mounts, cmdMounts(command.Mounts)...)
 	}
 
+	// the sandbox subcommand of the executor binary runs in the chroot
+	if command.Sandbox.Enabled() {
+		bin, err := os.Executable()
+		if err != nil {
+			return fmt.Errorf("failed to find executor binary: %w", err)
+		}
+		cfg.Mounts = append(cfg.Mounts, &runc.Mount{
+			Source:      bin,
+			Destination: sandboxChrootBin,
+			Device:      "bind",
+			Flags:       unix.MS_BIND | unix.MS_RDONLY,
+		})
+	}
+
 	return nil
 }
 
@@ -6,6 +6,7 @@
 package executor
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -332,7 +333,13 @@ func mbToBytes(n int64) int64 {
 
 // computeMemory returns the hard and soft memory limits for the task
 func (*UniversalExecutor) computeMemory(command *ExecCommand) (int64, int64) {
-	mem := command.Resources.NomadResources.Memory
+	return memoryLimits(command.Resources)
+}
+
+// memoryLimits returns the hard and soft memory limits in bytes for the
+// given task resources
+func memoryLimits(resources *drivers.Resources) (int64, int64) {
+	mem := resources.NomadResources.Memory
 	memHard, memSoft := mem.MemoryMaxMB, mem.MemoryMB
 
 	switch memHard {
@@ -351,6 +358,25 @@ func (*UniversalExecutor) computeMemory(command *ExecCommand) (int64, int64) {
 	}
 }
 
+// UpdateResources applies new CPU and memory limits to the cgroup of a running
+// task. It is used by drivers whose tasks run in the cgroups created by the
+// executor, which are named after the allocation ID and task name.
+func UpdateResources(allocID, task string, resources *drivers.Resources) error {
+	if resources == nil || resources.LinuxResources == nil || resources.NomadResources == nil {
+		return errors.New("task has no cgroup resources to update")
+	}
+
+	memHard, memSoft := memoryLimits(resources)
+	cpuShares := min(max(resources.LinuxResources.CPUShares, MinCPUShares), MaxCPUShares)
+	cores := len(resources.NomadResources.Cpu.ReservedCores) > 0
+
+	return cgroupslib.UpdateLimits(allocID, task, cores, &cgroupslib.Limits{
+		CPUShares:      uint64(cpuShares),
+		MemoryMaxBytes: memHard,
+		MemoryLowBytes: memSoft,
+	})
+}
+
 // withNetworkIsolation calls the passed function the network namespace `spec`
 func withNetworkIsolation(f func() error, spec *drivers.NetworkIsolationSpec) error {
 	if spec != nil && spec.Path != "" {
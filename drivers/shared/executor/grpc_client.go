@@ -56,6 +56,8 @@ func (c *grpcExecutorClient) Launch(cmd *ExecCommand) (*ProcessState, error) {
 		CgroupV1Override: cmd.OverrideCgroupV1,
 		OomScoreAdj:      cmd.OOMScoreAdj,
 		WorkDir:          cmd.WorkDir,
+		RestoreImagePath: cmd.RestoreImagePath,
+		Sandbox:          sandboxConfigToProto(cmd.Sandbox),
 	}
 	resp, err := c.client.Launch(ctx, req)
 	if err != nil {
@@ -165,6 +167,37 @@ func (c *grpcExecutorClient) handleStats(ctx context.Context, stream proto.Execu
 	}
 }
 
+func (c *grpcExecutorClient) Checkpoint(imagePath string, leaveRunning bool) error {
+	ctx := context.Background()
+	req := &proto.CheckpointRequest{
+		ImagePath:    imagePath,
+		LeaveRunning: leaveRunning,
+	}
+	if _, err := c.client.Checkpoint(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *grpcExecutorClient) Pause() error {
+	ctx := context.Background()
+	if _, err := c.client.Pause(ctx, &proto.PauseRequest{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *grpcExecutorClient) Resume() error {
+	ctx := context.Background()
+	if _, err := c.client.Resume(ctx, &proto.ResumeRequest{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c *grpcExecutorClient) Signal(s os.Signal) error {
 	ctx := context.Background()
 	sig, ok := s.(syscall.Signal)
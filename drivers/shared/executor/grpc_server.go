@@ -45,6 +45,8 @@ func (s *grpcExecutorServer) Launch(ctx context.Context, req *proto.LaunchReques
 		OverrideCgroupV1: req.CgroupV1Override,
 		OOMScoreAdj:      req.OomScoreAdj,
 		WorkDir:          req.WorkDir,
+		RestoreImagePath: req.RestoreImagePath,
+		Sandbox:          sandboxConfigFromProto(req.Sandbox),
 	})
 
 	if err != nil {
@@ -151,6 +153,27 @@ func (s *grpcExecutorServer) Signal(ctx context.Context, req *proto.SignalReques
 	return &proto.SignalResponse{}, nil
 }
 
+func (s *grpcExecutorServer) Checkpoint(ctx context.Context, req *proto.CheckpointRequest) (*proto.CheckpointResponse, error) {
+	if err := s.impl.Checkpoint(req.ImagePath, req.LeaveRunning); err != nil {
+		return nil, err
+	}
+	return &proto.CheckpointResponse{}, nil
+}
+
+func (s *grpcExecutorServer) Pause(context.Context, *proto.PauseRequest) (*proto.PauseResponse, error) {
+	if err := s.impl.Pause(); err != nil {
+		return nil, err
+	}
+	return &proto.PauseResponse{}, nil
+}
+
+func (s *grpcExecutorServer) Resume(context.Context, *proto.ResumeRequest) (*proto.ResumeResponse, error) {
+	if err := s.impl.Resume(); err != nil {
+		return nil, err
+	}
+	return &proto.ResumeResponse{}, nil
+}
+
 func (s *grpcExecutorServer) Exec(ctx context.Context, req *proto.ExecRequest) (*proto.ExecResponse, error) {
 	deadline, err := ptypes.Timestamp(req.Deadline)
 	if err != nil {
@@ -123,10 +123,29 @@ func (lps *linuxProcStats) StatProcesses() ProcUsages {
 			return cs
 		}
 
+		getProcess := func() *drivers.ProcessInfo {
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer cancel()
+			ppid, err := p.PpidWithContext(ctx)
+			if err != nil {
+				return nil
+			}
+			command, err := p.CmdlineWithContext(ctx)
+			if err != nil || command == "" {
+				// kernel threads and zombies have no command line
+				command, _ = p.NameWithContext(ctx)
+			}
+			return &drivers.ProcessInfo{
+				PPid:    int(ppid),
+				Command: command,
+			}
+		}
+
 		spid := strconv.Itoa(pid)
 		result[spid] = &drivers.ResourceUsage{
 			MemoryStats: getMemory(),
 			CpuStats:    getCPU(),
+			Process:     getProcess(),
 		}
 	}
 
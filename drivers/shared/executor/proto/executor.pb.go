@@ -50,6 +50,8 @@ type LaunchRequest struct {
 	CgroupV1Override     map[string]string            `protobuf:"bytes,21,rep,name=cgroup_v1_override,json=cgroupV1Override,proto3" json:"cgroup_v1_override,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	OomScoreAdj          int32                        `protobuf:"varint,22,opt,name=oom_score_adj,json=oomScoreAdj,proto3" json:"oom_score_adj,omitempty"`
 	WorkDir              string                       `protobuf:"bytes,23,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
+	RestoreImagePath     string                       `protobuf:"bytes,24,opt,name=restore_image_path,json=restoreImagePath,proto3" json:"restore_image_path,omitempty"`
+	Sandbox              *SandboxConfig               `protobuf:"bytes,25,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -242,6 +244,20 @@ func (m *LaunchRequest) GetWorkDir() string {
 	return ""
 }
 
+func (m *LaunchRequest) GetRestoreImagePath() string {
+	if m != nil {
+		return m.RestoreImagePath
+	}
+	return ""
+}
+
+func (m *LaunchRequest) GetSandbox() *SandboxConfig {
+	if m != nil {
+		return m.Sandbox
+	}
+	return nil
+}
+
 type LaunchResponse struct {
 	Process              *ProcessState `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -819,6 +835,208 @@ func (m *ExecResponse) GetExitCode() int32 {
 	return 0
 }
 
+type CheckpointRequest struct {
+	ImagePath            string   `protobuf:"bytes,1,opt,name=image_path,json=imagePath,proto3" json:"image_path,omitempty"`
+	LeaveRunning         bool     `protobuf:"varint,2,opt,name=leave_running,json=leaveRunning,proto3" json:"leave_running,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointRequest) Reset()         { *m = CheckpointRequest{} }
+func (m *CheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckpointRequest) ProtoMessage()    {}
+func (*CheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{16}
+}
+
+func (m *CheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointRequest.Unmarshal(m, b)
+}
+func (m *CheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointRequest.Merge(m, src)
+}
+func (m *CheckpointRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckpointRequest.Size(m)
+}
+func (m *CheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointRequest proto.InternalMessageInfo
+
+func (m *CheckpointRequest) GetImagePath() string {
+	if m != nil {
+		return m.ImagePath
+	}
+	return ""
+}
+
+func (m *CheckpointRequest) GetLeaveRunning() bool {
+	if m != nil {
+		return m.LeaveRunning
+	}
+	return false
+}
+
+type CheckpointResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointResponse) Reset()         { *m = CheckpointResponse{} }
+func (m *CheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointResponse) ProtoMessage()    {}
+func (*CheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{17}
+}
+
+func (m *CheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointResponse.Unmarshal(m, b)
+}
+func (m *CheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointResponse.Merge(m, src)
+}
+func (m *CheckpointResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckpointResponse.Size(m)
+}
+func (m *CheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointResponse proto.InternalMessageInfo
+
+type PauseRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseRequest) Reset()         { *m = PauseRequest{} }
+func (m *PauseRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseRequest) ProtoMessage()    {}
+func (*PauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{18}
+}
+
+func (m *PauseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseRequest.Unmarshal(m, b)
+}
+func (m *PauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseRequest.Merge(m, src)
+}
+func (m *PauseRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseRequest.Size(m)
+}
+func (m *PauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseRequest proto.InternalMessageInfo
+
+type PauseResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseResponse) Reset()         { *m = PauseResponse{} }
+func (m *PauseResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseResponse) ProtoMessage()    {}
+func (*PauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{19}
+}
+
+func (m *PauseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseResponse.Unmarshal(m, b)
+}
+func (m *PauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseResponse.Marshal(b, m, deterministic)
+}
+func (m *PauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseResponse.Merge(m, src)
+}
+func (m *PauseResponse) XXX_Size() int {
+	return xxx_messageInfo_PauseResponse.Size(m)
+}
+func (m *PauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseResponse proto.InternalMessageInfo
+
+type ResumeRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeRequest) Reset()         { *m = ResumeRequest{} }
+func (m *ResumeRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeRequest) ProtoMessage()    {}
+func (*ResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{20}
+}
+
+func (m *ResumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeRequest.Unmarshal(m, b)
+}
+func (m *ResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeRequest.Merge(m, src)
+}
+func (m *ResumeRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeRequest.Size(m)
+}
+func (m *ResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeRequest proto.InternalMessageInfo
+
+type ResumeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeResponse) Reset()         { *m = ResumeResponse{} }
+func (m *ResumeResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeResponse) ProtoMessage()    {}
+func (*ResumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{21}
+}
+
+func (m *ResumeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeResponse.Unmarshal(m, b)
+}
+func (m *ResumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeResponse.Marshal(b, m, deterministic)
+}
+func (m *ResumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeResponse.Merge(m, src)
+}
+func (m *ResumeResponse) XXX_Size() int {
+	return xxx_messageInfo_ResumeResponse.Size(m)
+}
+func (m *ResumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeResponse proto.InternalMessageInfo
+
 type ProcessState struct {
 	Pid                  int32                `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
 	ExitCode             int32                `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
@@ -834,7 +1052,7 @@ func (m *ProcessState) Reset()         { *m = ProcessState{} }
 func (m *ProcessState) String() string { return proto.CompactTextString(m) }
 func (*ProcessState) ProtoMessage()    {}
 func (*ProcessState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_66b85426380683f3, []int{16}
+	return fileDescriptor_66b85426380683f3, []int{22}
 }
 
 func (m *ProcessState) XXX_Unmarshal(b []byte) error {
@@ -890,6 +1108,69 @@ func (m *ProcessState) GetOomKilled() bool {
 	return false
 }
 
+type SandboxConfig struct {
+	SeccompProfile       string   `protobuf:"bytes,1,opt,name=seccomp_profile,json=seccompProfile,proto3" json:"seccomp_profile,omitempty"`
+	SeccompAllow         []string `protobuf:"bytes,2,rep,name=seccomp_allow,json=seccompAllow,proto3" json:"seccomp_allow,omitempty"`
+	Landlock             bool     `protobuf:"varint,3,opt,name=landlock,proto3" json:"landlock,omitempty"`
+	LandlockPaths        []string `protobuf:"bytes,4,rep,name=landlock_paths,json=landlockPaths,proto3" json:"landlock_paths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SandboxConfig) Reset()         { *m = SandboxConfig{} }
+func (m *SandboxConfig) String() string { return proto.CompactTextString(m) }
+func (*SandboxConfig) ProtoMessage()    {}
+func (*SandboxConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{23}
+}
+
+func (m *SandboxConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SandboxConfig.Unmarshal(m, b)
+}
+func (m *SandboxConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SandboxConfig.Marshal(b, m, deterministic)
+}
+func (m *SandboxConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SandboxConfig.Merge(m, src)
+}
+func (m *SandboxConfig) XXX_Size() int {
+	return xxx_messageInfo_SandboxConfig.Size(m)
+}
+func (m *SandboxConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SandboxConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SandboxConfig proto.InternalMessageInfo
+
+func (m *SandboxConfig) GetSeccompProfile() string {
+	if m != nil {
+		return m.SeccompProfile
+	}
+	return ""
+}
+
+func (m *SandboxConfig) GetSeccompAllow() []string {
+	if m != nil {
+		return m.SeccompAllow
+	}
+	return nil
+}
+
+func (m *SandboxConfig) GetLandlock() bool {
+	if m != nil {
+		return m.Landlock
+	}
+	return false
+}
+
+func (m *SandboxConfig) GetLandlockPaths() []string {
+	if m != nil {
+		return m.LandlockPaths
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LaunchRequest)(nil), "hashicorp.nomad.plugins.executor.proto.LaunchRequest")
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.executor.proto.LaunchRequest.CgroupV1OverrideEntry")
@@ -908,7 +1189,14 @@ func init() {
 	proto.RegisterType((*SignalResponse)(nil), "hashicorp.nomad.plugins.executor.proto.SignalResponse")
 	proto.RegisterType((*ExecRequest)(nil), "hashicorp.nomad.plugins.executor.proto.ExecRequest")
 	proto.RegisterType((*ExecResponse)(nil), "hashicorp.nomad.plugins.executor.proto.ExecResponse")
+	proto.RegisterType((*CheckpointRequest)(nil), "hashicorp.nomad.plugins.executor.proto.CheckpointRequest")
+	proto.RegisterType((*CheckpointResponse)(nil), "hashicorp.nomad.plugins.executor.proto.CheckpointResponse")
+	proto.RegisterType((*PauseRequest)(nil), "hashicorp.nomad.plugins.executor.proto.PauseRequest")
+	proto.RegisterType((*PauseResponse)(nil), "hashicorp.nomad.plugins.executor.proto.PauseResponse")
+	proto.RegisterType((*ResumeRequest)(nil), "hashicorp.nomad.plugins.executor.proto.ResumeRequest")
+	proto.RegisterType((*ResumeResponse)(nil), "hashicorp.nomad.plugins.executor.proto.ResumeResponse")
 	proto.RegisterType((*ProcessState)(nil), "hashicorp.nomad.plugins.executor.proto.ProcessState")
+	proto.RegisterType((*SandboxConfig)(nil), "hashicorp.nomad.plugins.executor.proto.SandboxConfig")
 }
 
 func init() {
@@ -916,83 +1204,98 @@ func init() {
 }
 
 var fileDescriptor_66b85426380683f3 = []byte{
-	// 1205 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xfb, 0x6e, 0x1b, 0xc5,
-	0x17, 0xee, 0xc6, 0x71, 0x6c, 0x1f, 0xdb, 0x89, 0x3b, 0xbf, 0x36, 0xdd, 0xfa, 0x27, 0xd4, 0xb0,
-	0x48, 0xd4, 0x82, 0xb2, 0x69, 0xd3, 0xab, 0x40, 0xa2, 0xd0, 0xa4, 0xa0, 0xaa, 0x17, 0xa2, 0x4d,
-	0x69, 0x25, 0xfe, 0x60, 0x99, 0xee, 0x4e, 0xed, 0xa9, 0xd7, 0x3b, 0xcb, 0xcc, 0xac, 0x9b, 0x48,
-	0x48, 0xbc, 0x04, 0x48, 0x3c, 0x00, 0xaf, 0xc7, 0x3b, 0xa0, 0xb9, 0x6d, 0xec, 0xb4, 0x54, 0x9b,
-	0x22, 0xfe, 0xca, 0x9e, 0xcf, 0xdf, 0xb9, 0xcc, 0x39, 0x73, 0xbe, 0x09, 0x5c, 0x49, 0x39, 0x9d,
-	0x13, 0x2e, 0xb6, 0xc5, 0x04, 0x73, 0x92, 0x6e, 0x93, 0x43, 0x92, 0x94, 0x92, 0xf1, 0xed, 0x82,
-	0x33, 0xc9, 0x2a, 0x33, 0xd4, 0x26, 0xfa, 0x78, 0x82, 0xc5, 0x84, 0x26, 0x8c, 0x17, 0x61, 0xce,
-	0x66, 0x38, 0x0d, 0x8b, 0xac, 0x1c, 0xd3, 0x5c, 0x84, 0xcb, 0xbc, 0xe1, 0xa5, 0x31, 0x63, 0xe3,
-	0x8c, 0x98, 0x20, 0x2f, 0xca, 0x97, 0xdb, 0x92, 0xce, 0x88, 0x90, 0x78, 0x56, 0x58, 0x42, 0x60,
-	0x1d, 0xb7, 0x5d, 0x7a, 0x93, 0xce, 0x58, 0x86, 0x13, 0xfc, 0xd5, 0x86, 0xfe, 0x23, 0x5c, 0xe6,
-	0xc9, 0x24, 0x22, 0x3f, 0x97, 0x44, 0x48, 0x34, 0x80, 0x46, 0x32, 0x4b, 0x7d, 0x6f, 0xcb, 0x1b,
-	0x75, 0x22, 0xf5, 0x89, 0x10, 0xac, 0x62, 0x3e, 0x16, 0xfe, 0xca, 0x56, 0x63, 0xd4, 0x89, 0xf4,
-	0x37, 0x7a, 0x02, 0x1d, 0x4e, 0x04, 0x2b, 0x79, 0x42, 0x84, 0xdf, 0xd8, 0xf2, 0x46, 0xdd, 0x9d,
-	0xab, 0xe1, 0x3f, 0x15, 0x6e, 0xf3, 0x9b, 0x94, 0x61, 0xe4, 0xfc, 0xa2, 0xe3, 0x10, 0xe8, 0x12,
-	0x74, 0x85, 0x4c, 0x59, 0x29, 0xe3, 0x02, 0xcb, 0x89, 0xbf, 0xaa, 0xb3, 0x83, 0x81, 0xf6, 0xb1,
-	0x9c, 0x58, 0x02, 0xe1, 0xdc, 0x10, 0x9a, 0x15, 0x81, 0x70, 0xae, 0x09, 0x03, 0x68, 0x90, 0x7c,
-	0xee, 0xaf, 0xe9, 0x22, 0xd5, 0xa7, 0xaa, 0xbb, 0x14, 0x84, 0xfb, 0x2d, 0xcd, 0xd5, 0xdf, 0xe8,
-	0x22, 0xb4, 0x25, 0x16, 0xd3, 0x38, 0xa5, 0xdc, 0x6f, 0x6b, 0xbc, 0xa5, 0xec, 0x3d, 0xca, 0xd1,
-	0x65, 0xd8, 0x70, 0xf5, 0xc4, 0x19, 0x9d, 0x51, 0x29, 0xfc, 0xce, 0x96, 0x37, 0x6a, 0x47, 0xeb,
-	0x0e, 0x7e, 0xa4, 0x51, 0x74, 0x03, 0xce, 0xbd, 0xc0, 0x82, 0x26, 0x71, 0xc1, 0x59, 0x42, 0x84,
-	0x88, 0x93, 0x31, 0x67, 0x65, 0xe1, 0x83, 0x62, 0xdf, 0x5b, 0xf1, 0xbd, 0x08, 0xe9, 0xdf, 0xf7,
-	0xcd, 0xcf, 0xbb, 0xfa, 0x57, 0xb4, 0x07, 0x6b, 0x33, 0x56, 0xe6, 0x52, 0xf8, 0xdd, 0xad, 0xc6,
-	0xa8, 0xbb, 0x73, 0xa5, 0x66, 0xbb, 0x1e, 0x2b, 0xa7, 0xc8, 0xfa, 0xa2, 0x6f, 0xa1, 0x95, 0x92,
-	0x39, 0x55, 0x5d, 0xef, 0xe9, 0x30, 0x9f, 0xd5, 0x0c, 0xb3, 0xa7, 0xbd, 0x22, 0xe7, 0x8d, 0x26,
-	0x70, 0x36, 0x27, 0xf2, 0x35, 0xe3, 0xd3, 0x98, 0x0a, 0x96, 0x61, 0x49, 0x59, 0xee, 0xf7, 0xf5,
-	0x20, 0xbf, 0xa8, 0x19, 0xf2, 0x89, 0xf1, 0x7f, 0xe0, 0xdc, 0x0f, 0x0a, 0x92, 0x44, 0x83, 0xfc,
-	0x04, 0x8a, 0x02, 0xe8, 0xe7, 0x2c, 0x2e, 0xe8, 0x9c, 0xc9, 0x98, 0x33, 0x26, 0xfd, 0x75, 0xdd,
-	0xd5, 0x6e, 0xce, 0xf6, 0x15, 0x16, 0x31, 0x26, 0xd1, 0x08, 0x06, 0x29, 0x79, 0x89, 0xcb, 0x4c,
-	0xc6, 0x05, 0x4d, 0xe3, 0x19, 0x4b, 0x89, 0xbf, 0xa1, 0xc7, 0xb3, 0x6e, 0xf1, 0x7d, 0x9a, 0x3e,
-	0x66, 0x29, 0x59, 0x64, 0xd2, 0x22, 0x31, 0xcc, 0xc1, 0x12, 0xf3, 0x41, 0x91, 0x68, 0xe6, 0x47,
-	0xd0, 0x4f, 0x8a, 0x52, 0x10, 0xe9, 0xe6, 0x73, 0x56, 0xd3, 0x7a, 0x06, 0xb4, 0x53, 0xf9, 0x00,
-	0x00, 0x67, 0x19, 0x7b, 0x1d, 0x27, 0xb8, 0x10, 0x3e, 0xd2, 0x97, 0xa7, 0xa3, 0x91, 0x5d, 0x5c,
-	0x08, 0x14, 0x40, 0x2f, 0xc1, 0x05, 0x7e, 0x41, 0x33, 0x2a, 0x29, 0x11, 0xfe, 0xff, 0x34, 0x61,
-	0x09, 0x43, 0x57, 0x00, 0x99, 0x04, 0xf1, 0x7c, 0x27, 0x66, 0x73, 0xc2, 0x39, 0x4d, 0x89, 0x7f,
-	0x4e, 0x27, 0x1b, 0x98, 0x5f, 0x9e, 0xed, 0x7c, 0x67, 0x71, 0x74, 0x74, 0xcc, 0xbe, 0x76, 0xcc,
-	0x3e, 0xaf, 0x67, 0xf9, 0x30, 0xac, 0xb7, 0xfa, 0xe1, 0xd2, 0xc6, 0x86, 0xe6, 0x28, 0xcf, 0xae,
-	0xb9, 0x1c, 0xf7, 0x73, 0xc9, 0x8f, 0xaa, 0xd4, 0x15, 0xac, 0x06, 0xc1, 0xd8, 0x2c, 0x16, 0x09,
-	0xe3, 0x24, 0xc6, 0xe9, 0x2b, 0x7f, 0x73, 0xcb, 0x1b, 0x35, 0xa3, 0x2e, 0x63, 0xb3, 0x03, 0x85,
-	0x7d, 0x9d, 0xbe, 0x52, 0xfb, 0xa1, 0xef, 0x84, 0xda, 0x8f, 0x0b, 0x66, 0x3f, 0x94, 0xbd, 0x47,
-	0xf9, 0x70, 0x17, 0xce, 0xbf, 0x35, 0x93, 0xda, 0xbc, 0x29, 0x39, 0x72, 0x8a, 0x31, 0x25, 0x47,
-	0xe8, 0x1c, 0x34, 0xe7, 0x38, 0x2b, 0x89, 0xbf, 0xa2, 0x31, 0x63, 0x7c, 0xbe, 0x72, 0xc7, 0x0b,
-	0x7e, 0x82, 0x75, 0x57, 0xbc, 0x28, 0x58, 0x2e, 0x08, 0x7a, 0x02, 0x2d, 0xbb, 0x47, 0x3a, 0x42,
-	0x77, 0xe7, 0x46, 0xdd, 0x2e, 0xd8, 0xfd, 0x3a, 0x90, 0x58, 0x92, 0xc8, 0x05, 0x09, 0xfa, 0xd0,
-	0x7d, 0x8e, 0xa9, 0xb4, 0xcd, 0x09, 0x7e, 0x84, 0x9e, 0x31, 0xff, 0xa3, 0x74, 0x8f, 0x60, 0xe3,
-	0x60, 0x52, 0xca, 0x94, 0xbd, 0xce, 0x9d, 0x82, 0x6e, 0xc2, 0x9a, 0xa0, 0xe3, 0x1c, 0x67, 0xb6,
-	0x25, 0xd6, 0x42, 0x1f, 0x42, 0x6f, 0xcc, 0x71, 0x42, 0xe2, 0x82, 0x70, 0xca, 0x52, 0xdd, 0x9c,
-	0x46, 0xd4, 0xd5, 0xd8, 0xbe, 0x86, 0x02, 0x04, 0x83, 0xe3, 0x68, 0xa6, 0xe2, 0x60, 0x02, 0x9b,
-	0xdf, 0x17, 0xa9, 0x4a, 0x5a, 0x09, 0xa7, 0x4d, 0xb4, 0x24, 0xc2, 0xde, 0xbf, 0x16, 0xe1, 0xe0,
-	0x22, 0x5c, 0x78, 0x23, 0x93, 0x2d, 0x62, 0x00, 0xeb, 0xcf, 0x08, 0x17, 0x94, 0xb9, 0x53, 0x06,
-	0x9f, 0xc2, 0x46, 0x85, 0xd8, 0xde, 0xfa, 0xd0, 0x9a, 0x1b, 0xc8, 0x9e, 0xdc, 0x99, 0xc1, 0x27,
-	0xd0, 0x53, 0x7d, 0xab, 0x2a, 0x1f, 0x42, 0x9b, 0xe6, 0x92, 0xf0, 0xb9, 0x6d, 0x52, 0x23, 0xaa,
-	0xec, 0xe0, 0x39, 0xf4, 0x2d, 0xd7, 0x86, 0xfd, 0x06, 0x9a, 0x42, 0x01, 0xa7, 0x3c, 0xe2, 0x53,
-	0x2c, 0xa6, 0x26, 0x90, 0x71, 0x0f, 0x2e, 0x43, 0xff, 0x40, 0x4f, 0xe2, 0xed, 0x83, 0x6a, 0xba,
-	0x41, 0xa9, 0xc3, 0x3a, 0xa2, 0x3d, 0xfe, 0x14, 0xba, 0xf7, 0x0f, 0x49, 0xe2, 0x1c, 0x6f, 0x41,
-	0x3b, 0x25, 0x38, 0xcd, 0x68, 0x4e, 0x6c, 0x51, 0xc3, 0xd0, 0xbc, 0xc6, 0xa1, 0x7b, 0x8d, 0xc3,
-	0xa7, 0xee, 0x35, 0x8e, 0x2a, 0xae, 0x7b, 0x5b, 0x57, 0xde, 0x7c, 0x5b, 0x1b, 0xc7, 0x6f, 0x6b,
-	0xb0, 0x0b, 0x3d, 0x93, 0xcc, 0x9e, 0x7f, 0x13, 0xd6, 0x58, 0x29, 0x8b, 0x52, 0xea, 0x5c, 0xbd,
-	0xc8, 0x5a, 0xe8, 0xff, 0xd0, 0x21, 0x87, 0x54, 0xc6, 0x89, 0xd2, 0xc0, 0x15, 0x7d, 0x82, 0xb6,
-	0x02, 0x76, 0x59, 0x4a, 0x82, 0x3f, 0x3d, 0xe8, 0x2d, 0xde, 0x58, 0x95, 0xbb, 0xa0, 0xa9, 0x3d,
-	0xa9, 0xfa, 0x7c, 0xa7, 0xff, 0x42, 0x6f, 0x1a, 0x8b, 0xbd, 0x41, 0x21, 0xac, 0xaa, 0xff, 0x33,
-	0xf4, 0x0b, 0xfd, 0xee, 0x63, 0x6b, 0x9e, 0x12, 0x58, 0x25, 0x3a, 0x53, 0x9a, 0x65, 0x24, 0xd5,
-	0xcf, 0x76, 0x3b, 0xea, 0x30, 0x36, 0x7b, 0xa8, 0x81, 0x9d, 0xdf, 0x3b, 0xd0, 0xbe, 0x6f, 0xf7,
-	0x0c, 0x1d, 0xc1, 0x9a, 0x11, 0x07, 0x74, 0xf3, 0xbd, 0x94, 0x70, 0x78, 0xeb, 0xb4, 0x6e, 0x76,
-	0xbc, 0x67, 0x90, 0x80, 0x55, 0x25, 0x13, 0xe8, 0x7a, 0xdd, 0x08, 0x0b, 0x1a, 0x33, 0xbc, 0x71,
-	0x3a, 0xa7, 0x2a, 0xe9, 0xaf, 0xd0, 0x76, 0xdb, 0x8e, 0x6e, 0xd7, 0x8d, 0x71, 0x42, 0x6d, 0x86,
-	0x77, 0x4e, 0xef, 0x58, 0x15, 0xf0, 0x9b, 0x07, 0x1b, 0x27, 0x36, 0x1e, 0x7d, 0x59, 0x37, 0xde,
-	0xdb, 0x45, 0x69, 0x78, 0xf7, 0xbd, 0xfd, 0xab, 0xb2, 0x7e, 0x81, 0x96, 0x95, 0x16, 0x54, 0x7b,
-	0xa2, 0xcb, 0xea, 0x34, 0xbc, 0x7d, 0x6a, 0xbf, 0x2a, 0xfb, 0x21, 0x34, 0xb5, 0x6c, 0xa0, 0xda,
-	0x63, 0x5d, 0x94, 0xb6, 0xe1, 0xcd, 0x53, 0x7a, 0xb9, 0xbc, 0x57, 0x3d, 0x75, 0xff, 0x8d, 0xee,
-	0xd4, 0xbf, 0xff, 0x4b, 0x82, 0x56, 0xff, 0xfe, 0x9f, 0x90, 0x37, 0x7d, 0xff, 0xd5, 0x1a, 0xd6,
-	0xbf, 0xff, 0x0b, 0x72, 0x58, 0xff, 0xfe, 0x2f, 0xca, 0x5a, 0x70, 0x06, 0xfd, 0xe1, 0x41, 0x5f,
-	0x41, 0x07, 0x92, 0x13, 0x3c, 0xa3, 0xf9, 0x18, 0xdd, 0xad, 0xa9, 0xed, 0xca, 0xcb, 0xe8, 0xbb,
-	0xf5, 0x74, 0xa5, 0x7c, 0xf5, 0xfe, 0x01, 0x5c, 0x59, 0x23, 0xef, 0xaa, 0x77, 0xaf, 0xf5, 0x43,
-	0xd3, 0x48, 0xda, 0x9a, 0xfe, 0x73, 0xfd, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xc0, 0xa1,
-	0x7e, 0xc4, 0x0d, 0x00, 0x00,
+	// 1451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0xdb, 0x46,
+	0x16, 0x5f, 0x5a, 0x96, 0x25, 0x3d, 0x7d, 0x58, 0x99, 0x75, 0x1c, 0x46, 0x8b, 0x45, 0xbc, 0x0c,
+	0x76, 0x23, 0x6c, 0x5d, 0x39, 0x71, 0x6c, 0x27, 0x4d, 0x81, 0xa6, 0x89, 0x93, 0x16, 0x41, 0xbe,
+	0x0c, 0x3a, 0x4d, 0x80, 0x1e, 0xca, 0xd2, 0xe4, 0x58, 0x9a, 0x88, 0xe2, 0xb0, 0x33, 0x43, 0xc5,
+	0x06, 0x0a, 0xf4, 0xd4, 0x7b, 0x0f, 0x3d, 0xf4, 0xd8, 0x43, 0xff, 0xbc, 0xfe, 0x11, 0xc5, 0x7c,
+	0xd1, 0x52, 0x92, 0xb6, 0x94, 0x8b, 0x9e, 0xc8, 0xf7, 0x9b, 0xf7, 0xfd, 0xe6, 0xbd, 0x37, 0xb0,
+	0x19, 0x33, 0x32, 0xc5, 0x8c, 0x6f, 0xf1, 0x51, 0xc8, 0x70, 0xbc, 0x85, 0x4f, 0x70, 0x94, 0x0b,
+	0xca, 0xb6, 0x32, 0x46, 0x05, 0x2d, 0xc8, 0x81, 0x22, 0xd1, 0xff, 0x46, 0x21, 0x1f, 0x91, 0x88,
+	0xb2, 0x6c, 0x90, 0xd2, 0x49, 0x18, 0x0f, 0xb2, 0x24, 0x1f, 0x92, 0x94, 0x0f, 0xe6, 0xf9, 0x7a,
+	0x57, 0x86, 0x94, 0x0e, 0x13, 0xac, 0x95, 0x1c, 0xe5, 0xc7, 0x5b, 0x82, 0x4c, 0x30, 0x17, 0xe1,
+	0x24, 0x33, 0x0c, 0x9e, 0x11, 0xdc, 0xb2, 0xe6, 0xb5, 0x39, 0x4d, 0x69, 0x1e, 0xef, 0xd7, 0x06,
+	0xb4, 0x9f, 0x84, 0x79, 0x1a, 0x8d, 0x7c, 0xfc, 0x4d, 0x8e, 0xb9, 0x40, 0x5d, 0xa8, 0x44, 0x93,
+	0xd8, 0x75, 0x36, 0x9c, 0x7e, 0xc3, 0x97, 0xbf, 0x08, 0xc1, 0x72, 0xc8, 0x86, 0xdc, 0x5d, 0xda,
+	0xa8, 0xf4, 0x1b, 0xbe, 0xfa, 0x47, 0xcf, 0xa0, 0xc1, 0x30, 0xa7, 0x39, 0x8b, 0x30, 0x77, 0x2b,
+	0x1b, 0x4e, 0xbf, 0xb9, 0x7d, 0x7d, 0xf0, 0x7b, 0x8e, 0x1b, 0xfb, 0xda, 0xe4, 0xc0, 0xb7, 0x72,
+	0xfe, 0x99, 0x0a, 0x74, 0x05, 0x9a, 0x5c, 0xc4, 0x34, 0x17, 0x41, 0x16, 0x8a, 0x91, 0xbb, 0xac,
+	0xac, 0x83, 0x86, 0x0e, 0x42, 0x31, 0x32, 0x0c, 0x98, 0x31, 0xcd, 0x50, 0x2d, 0x18, 0x30, 0x63,
+	0x8a, 0xa1, 0x0b, 0x15, 0x9c, 0x4e, 0xdd, 0x15, 0xe5, 0xa4, 0xfc, 0x95, 0x7e, 0xe7, 0x1c, 0x33,
+	0xb7, 0xa6, 0x78, 0xd5, 0x3f, 0xba, 0x0c, 0x75, 0x11, 0xf2, 0x71, 0x10, 0x13, 0xe6, 0xd6, 0x15,
+	0x5e, 0x93, 0xf4, 0x03, 0xc2, 0xd0, 0x35, 0x58, 0xb5, 0xfe, 0x04, 0x09, 0x99, 0x10, 0xc1, 0xdd,
+	0xc6, 0x86, 0xd3, 0xaf, 0xfb, 0x1d, 0x0b, 0x3f, 0x51, 0x28, 0xda, 0x81, 0xb5, 0xa3, 0x90, 0x93,
+	0x28, 0xc8, 0x18, 0x8d, 0x30, 0xe7, 0x41, 0x34, 0x64, 0x34, 0xcf, 0x5c, 0x90, 0xdc, 0xf7, 0x97,
+	0x5c, 0xc7, 0x47, 0xea, 0xfc, 0x40, 0x1f, 0xef, 0xab, 0x53, 0xf4, 0x00, 0x56, 0x26, 0x34, 0x4f,
+	0x05, 0x77, 0x9b, 0x1b, 0x95, 0x7e, 0x73, 0x7b, 0xb3, 0x64, 0xba, 0x9e, 0x4a, 0x21, 0xdf, 0xc8,
+	0xa2, 0xcf, 0xa1, 0x16, 0xe3, 0x29, 0x91, 0x59, 0x6f, 0x29, 0x35, 0x1f, 0x96, 0x54, 0xf3, 0x40,
+	0x49, 0xf9, 0x56, 0x1a, 0x8d, 0xe0, 0x42, 0x8a, 0xc5, 0x1b, 0xca, 0xc6, 0x01, 0xe1, 0x34, 0x09,
+	0x05, 0xa1, 0xa9, 0xdb, 0x56, 0x85, 0xfc, 0xb8, 0xa4, 0xca, 0x67, 0x5a, 0xfe, 0x91, 0x15, 0x3f,
+	0xcc, 0x70, 0xe4, 0x77, 0xd3, 0xb7, 0x50, 0xe4, 0x41, 0x3b, 0xa5, 0x41, 0x46, 0xa6, 0x54, 0x04,
+	0x8c, 0x52, 0xe1, 0x76, 0x54, 0x56, 0x9b, 0x29, 0x3d, 0x90, 0x98, 0x4f, 0xa9, 0x40, 0x7d, 0xe8,
+	0xc6, 0xf8, 0x38, 0xcc, 0x13, 0x11, 0x64, 0x24, 0x0e, 0x26, 0x34, 0xc6, 0xee, 0xaa, 0x2a, 0x4f,
+	0xc7, 0xe0, 0x07, 0x24, 0x7e, 0x4a, 0x63, 0x3c, 0xcb, 0x49, 0xb2, 0x48, 0x73, 0x76, 0xe7, 0x38,
+	0x1f, 0x65, 0x91, 0xe2, 0xbc, 0x0a, 0xed, 0x28, 0xcb, 0x39, 0x16, 0xb6, 0x3e, 0x17, 0x14, 0x5b,
+	0x4b, 0x83, 0xa6, 0x2a, 0xff, 0x06, 0x08, 0x93, 0x84, 0xbe, 0x09, 0xa2, 0x30, 0xe3, 0x2e, 0x52,
+	0x97, 0xa7, 0xa1, 0x90, 0xfd, 0x30, 0xe3, 0xc8, 0x83, 0x56, 0x14, 0x66, 0xe1, 0x11, 0x49, 0x88,
+	0x20, 0x98, 0xbb, 0xff, 0x54, 0x0c, 0x73, 0x18, 0xda, 0x04, 0xa4, 0x0d, 0x04, 0xd3, 0xed, 0x80,
+	0x4e, 0x31, 0x63, 0x24, 0xc6, 0xee, 0x9a, 0x32, 0xd6, 0xd5, 0x27, 0x2f, 0xb7, 0x9f, 0x1b, 0x1c,
+	0x9d, 0x9e, 0x71, 0xdf, 0x38, 0xe3, 0xbe, 0xa8, 0x6a, 0xf9, 0x78, 0x50, 0xae, 0xf5, 0x07, 0x73,
+	0x1d, 0x3b, 0xd0, 0xa1, 0xbc, 0xbc, 0x61, 0x6d, 0x3c, 0x4c, 0x05, 0x3b, 0x2d, 0x4c, 0x17, 0xb0,
+	0x2c, 0x04, 0xa5, 0x93, 0x80, 0x47, 0x94, 0xe1, 0x20, 0x8c, 0x5f, 0xbb, 0xeb, 0x1b, 0x4e, 0xbf,
+	0xea, 0x37, 0x29, 0x9d, 0x1c, 0x4a, 0xec, 0x5e, 0xfc, 0x5a, 0xf6, 0x87, 0xba, 0x13, 0xb2, 0x3f,
+	0x2e, 0xe9, 0xfe, 0x90, 0xb4, 0xec, 0x8f, 0x4d, 0x40, 0x0c, 0x73, 0x21, 0x85, 0xc9, 0x24, 0x1c,
+	0x62, 0xdd, 0x88, 0xae, 0x8e, 0xd3, 0x9c, 0x3c, 0x92, 0x07, 0xaa, 0x1d, 0x9f, 0x43, 0x8d, 0x87,
+	0x69, 0x7c, 0x44, 0x4f, 0xdc, 0xcb, 0xea, 0x56, 0xed, 0x96, 0x0d, 0xee, 0x50, 0x8b, 0xed, 0xd3,
+	0xf4, 0x98, 0x0c, 0x7d, 0xab, 0xa5, 0xb7, 0x0f, 0x17, 0xdf, 0x1b, 0xa8, 0x6c, 0xfc, 0x31, 0x3e,
+	0xb5, 0x03, 0x6b, 0x8c, 0x4f, 0xd1, 0x1a, 0x54, 0xa7, 0x61, 0x92, 0x63, 0x77, 0x49, 0x61, 0x9a,
+	0xb8, 0xb3, 0x74, 0xdb, 0xf1, 0xbe, 0x86, 0x8e, 0xcd, 0x1d, 0xcf, 0x68, 0xca, 0x31, 0x7a, 0x06,
+	0x35, 0xd3, 0xc6, 0x4a, 0x43, 0x73, 0x7b, 0xa7, 0xac, 0x9f, 0xa6, 0xbd, 0x0f, 0x45, 0x28, 0xb0,
+	0x6f, 0x95, 0x78, 0x6d, 0x68, 0xbe, 0x0a, 0x89, 0x30, 0xb5, 0xf1, 0xbe, 0x82, 0x96, 0x26, 0xff,
+	0x26, 0x73, 0x4f, 0x60, 0xf5, 0x70, 0x94, 0x8b, 0x98, 0xbe, 0x49, 0xed, 0x00, 0x5f, 0x87, 0x15,
+	0x4e, 0x86, 0x69, 0x98, 0x98, 0x94, 0x18, 0x0a, 0xfd, 0x07, 0x5a, 0x43, 0x16, 0x46, 0x38, 0xc8,
+	0x30, 0x23, 0x34, 0x56, 0xc9, 0xa9, 0xf8, 0x4d, 0x85, 0x1d, 0x28, 0xc8, 0x43, 0xd0, 0x3d, 0xd3,
+	0xa6, 0x3d, 0xf6, 0x46, 0xb0, 0xfe, 0x45, 0x16, 0x4b, 0xa3, 0xc5, 0xdc, 0x36, 0x86, 0xe6, 0x76,
+	0x80, 0xf3, 0x97, 0x77, 0x80, 0x77, 0x19, 0x2e, 0xbd, 0x63, 0xc9, 0x38, 0xd1, 0x85, 0xce, 0x4b,
+	0xcc, 0x38, 0xa1, 0x36, 0x4a, 0xef, 0x03, 0x58, 0x2d, 0x10, 0x93, 0x5b, 0x17, 0x6a, 0x53, 0x0d,
+	0x99, 0xc8, 0x2d, 0xe9, 0xfd, 0x1f, 0x5a, 0x32, 0x6f, 0x85, 0xe7, 0x3d, 0xa8, 0x93, 0x54, 0x60,
+	0x36, 0x35, 0x49, 0xaa, 0xf8, 0x05, 0xed, 0xbd, 0x82, 0xb6, 0xe1, 0x35, 0x6a, 0x3f, 0x83, 0x2a,
+	0x97, 0xc0, 0x82, 0x21, 0xbe, 0x08, 0xf9, 0x58, 0x2b, 0xd2, 0xe2, 0xde, 0x35, 0x68, 0x1f, 0xaa,
+	0x4a, 0xbc, 0xbf, 0x50, 0x55, 0x5b, 0x28, 0x19, 0xac, 0x65, 0x34, 0xe1, 0x8f, 0xa1, 0xf9, 0xf0,
+	0x04, 0x47, 0x56, 0x70, 0x0f, 0xea, 0x31, 0x0e, 0xe3, 0x84, 0xa4, 0xd8, 0x38, 0xd5, 0x1b, 0xe8,
+	0xc7, 0xc0, 0xc0, 0x3e, 0x06, 0x06, 0x2f, 0xec, 0x63, 0xc0, 0x2f, 0x78, 0xed, 0x6a, 0x5f, 0x7a,
+	0x77, 0xb5, 0x57, 0xce, 0x56, 0xbb, 0xb7, 0x0f, 0x2d, 0x6d, 0xcc, 0xc4, 0xbf, 0x0e, 0x2b, 0x34,
+	0x17, 0x59, 0x2e, 0x94, 0xad, 0x96, 0x6f, 0x28, 0xf4, 0x2f, 0x68, 0xe0, 0x13, 0x22, 0x82, 0x48,
+	0x8e, 0xe0, 0x25, 0x15, 0x41, 0x5d, 0x02, 0xfb, 0x34, 0xc6, 0xde, 0x2b, 0xb8, 0xb0, 0x3f, 0xc2,
+	0xd1, 0x38, 0xa3, 0x24, 0xb5, 0xcd, 0x20, 0x87, 0xed, 0xcc, 0xe4, 0xd0, 0x35, 0x6a, 0x90, 0x62,
+	0x64, 0x5c, 0x85, 0x76, 0x82, 0xc3, 0x29, 0x0e, 0x58, 0x9e, 0xa6, 0x24, 0x1d, 0x2a, 0xa5, 0x75,
+	0xbf, 0xa5, 0x40, 0x5f, 0x63, 0xde, 0x1a, 0xa0, 0x59, 0xc5, 0x26, 0x41, 0x1d, 0x68, 0x1d, 0x84,
+	0x39, 0xc7, 0xf6, 0x76, 0xac, 0x42, 0xdb, 0xd0, 0x86, 0x61, 0x15, 0xda, 0x3e, 0xe6, 0xf9, 0xa4,
+	0xe0, 0xe8, 0x42, 0xc7, 0x02, 0x86, 0xe5, 0x17, 0x07, 0x5a, 0xb3, 0x4d, 0x26, 0xd3, 0x95, 0x91,
+	0xd8, 0x14, 0x47, 0xfe, 0xfe, 0x61, 0xc8, 0x33, 0xe5, 0xac, 0xcc, 0x96, 0x13, 0x0d, 0x60, 0x59,
+	0xbe, 0xcc, 0xdc, 0xe5, 0x3f, 0xad, 0x94, 0xe2, 0x93, 0x59, 0x92, 0x63, 0x7a, 0x4c, 0x92, 0x04,
+	0xc7, 0xea, 0xa1, 0x53, 0xf7, 0x1b, 0x94, 0x4e, 0x1e, 0x2b, 0xc0, 0xfb, 0xd9, 0x81, 0xf6, 0xdc,
+	0x88, 0x94, 0x0f, 0x17, 0x8e, 0xa3, 0x88, 0x4e, 0x32, 0xf9, 0x22, 0x39, 0x26, 0x09, 0x36, 0xb9,
+	0xed, 0x18, 0xf8, 0x40, 0xa3, 0x32, 0xc1, 0x96, 0x51, 0xad, 0x38, 0xf3, 0xa2, 0x6b, 0x19, 0xf0,
+	0x9e, 0xc4, 0x64, 0x6f, 0x24, 0x61, 0x1a, 0x27, 0x34, 0x1a, 0xab, 0x40, 0xea, 0x7e, 0x41, 0xa3,
+	0xff, 0x42, 0xc7, 0xfe, 0xab, 0x1a, 0x72, 0x77, 0x59, 0x69, 0x68, 0x5b, 0x54, 0xd6, 0x91, 0x6f,
+	0xff, 0xd0, 0x82, 0xfa, 0x43, 0x33, 0xbd, 0xd0, 0x29, 0xac, 0xe8, 0x91, 0x8b, 0x76, 0xcf, 0xb5,
+	0xde, 0x7a, 0x7b, 0x8b, 0x8a, 0x99, 0x7a, 0xfe, 0x03, 0x71, 0x58, 0x96, 0xc3, 0x17, 0xdd, 0x2c,
+	0xab, 0x61, 0x66, 0x72, 0xf7, 0x76, 0x16, 0x13, 0x2a, 0x8c, 0x7e, 0x07, 0x75, 0x3b, 0x43, 0xd1,
+	0xad, 0xd2, 0x3b, 0x6f, 0x7e, 0x86, 0xf7, 0x6e, 0x2f, 0x2e, 0x58, 0x38, 0xf0, 0xa3, 0x03, 0xab,
+	0x6f, 0xcd, 0x51, 0xf4, 0x49, 0x59, 0x7d, 0xef, 0x1f, 0xf5, 0xbd, 0xbb, 0xe7, 0x96, 0x2f, 0xdc,
+	0xfa, 0x16, 0x6a, 0x66, 0x60, 0xa3, 0xd2, 0x15, 0x9d, 0x9f, 0xf9, 0xbd, 0x5b, 0x0b, 0xcb, 0x15,
+	0xd6, 0x4f, 0xa0, 0xaa, 0x86, 0x31, 0x2a, 0x5d, 0xd6, 0xd9, 0x85, 0xd1, 0xdb, 0x5d, 0x50, 0xca,
+	0xda, 0xbd, 0xee, 0xc8, 0xfb, 0xaf, 0xa7, 0x79, 0xf9, 0xfb, 0x3f, 0xb7, 0x26, 0x7a, 0x7b, 0x8b,
+	0x8a, 0xcd, 0xde, 0x7f, 0xd9, 0x86, 0xe5, 0xef, 0xff, 0xcc, 0x92, 0xe9, 0xed, 0x2c, 0x26, 0x54,
+	0x18, 0xfd, 0xc9, 0x81, 0xb6, 0x84, 0x0e, 0x05, 0xc3, 0xe1, 0x84, 0xa4, 0x43, 0x74, 0xb7, 0xe4,
+	0xc6, 0x94, 0x52, 0x7a, 0x6b, 0x1a, 0x49, 0xeb, 0xca, 0xa7, 0xe7, 0x57, 0x60, 0xdd, 0xea, 0x3b,
+	0xd7, 0x1d, 0xf4, 0xbd, 0x03, 0x70, 0xb6, 0x3c, 0xd0, 0x47, 0x65, 0x23, 0x7c, 0x67, 0x93, 0xf5,
+	0xee, 0x9c, 0x47, 0xb4, 0x48, 0xd1, 0x14, 0xaa, 0x6a, 0x3b, 0x95, 0xbf, 0x8c, 0xb3, 0xcb, 0xad,
+	0xb7, 0xbb, 0xa0, 0x54, 0x61, 0xf7, 0x14, 0x56, 0xf4, 0xce, 0x2b, 0x7f, 0x15, 0xe7, 0x96, 0x66,
+	0x6f, 0x6f, 0x51, 0x31, 0x6b, 0xfa, 0x7e, 0xed, 0xcb, 0xaa, 0x5e, 0x78, 0x2b, 0xea, 0x73, 0xf3,
+	0xb7, 0x01, 0x00, 0xbc, 0xfb, 0x18, 0x5d, 0x14, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1017,6 +1320,9 @@ type ExecutorClient interface {
 	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error)
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ExecStreaming(ctx context.Context, opts ...grpc.CallOption) (Executor_ExecStreamingClient, error)
+	Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error)
+	Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseResponse, error)
+	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error)
 }
 
 type executorClient struct {
@@ -1153,6 +1459,33 @@ func (x *executorExecStreamingClient) Recv() (*proto1.ExecTaskStreamingResponse,
 	return m, nil
 }
 
+func (c *executorClient) Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error) {
+	out := new(CheckpointResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.executor.proto.Executor/Checkpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseResponse, error) {
+	out := new(PauseResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.executor.proto.Executor/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error) {
+	out := new(ResumeResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.executor.proto.Executor/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExecutorServer is the server API for Executor service.
 type ExecutorServer interface {
 	Launch(context.Context, *LaunchRequest) (*LaunchResponse, error)
@@ -1165,6 +1498,9 @@ type ExecutorServer interface {
 	Exec(context.Context, *ExecRequest) (*ExecResponse, error)
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ExecStreaming(Executor_ExecStreamingServer) error
+	Checkpoint(context.Context, *CheckpointRequest) (*CheckpointResponse, error)
+	Pause(context.Context, *PauseRequest) (*PauseResponse, error)
+	Resume(context.Context, *ResumeRequest) (*ResumeResponse, error)
 }
 
 // UnimplementedExecutorServer can be embedded to have forward compatible implementations.
@@ -1198,6 +1534,15 @@ func (*UnimplementedExecutorServer) Exec(ctx context.Context, req *ExecRequest)
 func (*UnimplementedExecutorServer) ExecStreaming(srv Executor_ExecStreamingServer) error {
 	return status.Errorf(codes.Unimplemented, "method ExecStreaming not implemented")
 }
+func (*UnimplementedExecutorServer) Checkpoint(ctx context.Context, req *CheckpointRequest) (*CheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+func (*UnimplementedExecutorServer) Pause(ctx context.Context, req *PauseRequest) (*PauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedExecutorServer) Resume(ctx context.Context, req *ResumeRequest) (*ResumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
 
 func RegisterExecutorServer(s *grpc.Server, srv ExecutorServer) {
 	s.RegisterService(&_Executor_serviceDesc, srv)
@@ -1376,6 +1721,60 @@ func (x *executorExecStreamingServer) Recv() (*proto1.ExecTaskStreamingRequest,
 	return m, nil
 }
 
+func _Executor_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.executor.proto.Executor/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Checkpoint(ctx, req.(*CheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.executor.proto.Executor/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Pause(ctx, req.(*PauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.executor.proto.Executor/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Resume(ctx, req.(*ResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Executor_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hashicorp.nomad.plugins.executor.proto.Executor",
 	HandlerType: (*ExecutorServer)(nil),
@@ -1408,6 +1807,18 @@ var _Executor_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _Executor_Exec_Handler,
 		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _Executor_Checkpoint_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Executor_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _Executor_Resume_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
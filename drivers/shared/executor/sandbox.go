@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package executor
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/nomad/drivers/shared/executor/proto"
+	"github.com/shoenig/go-landlock"
+)
+
+const (
+	// SeccompProfileDefault is the seccomp profile that permits a list of
+	// common syscalls and denies every other syscall.
+	SeccompProfileDefault = "default"
+
+	// sandboxCommand is the executor subcommand that applies the sandbox to
+	// itself before executing the task command.
+	sandboxCommand = "executor-sandbox"
+)
+
+// SandboxConfig restricts what the task process can do. The sandbox is
+// applied by a short-lived process that the executor starts in place of the
+// task command, which restricts itself and then executes the task command,
+// because the restrictions can't be applied between fork and exec in Go.
+type SandboxConfig struct {
+	// SeccompProfile is the seccomp profile applied to the task. It is either
+	// empty for no seccomp filtering or SeccompProfileDefault.
+	SeccompProfile string
+
+	// SeccompAllow is the list of syscalls permitted in addition to the
+	// syscalls of the seccomp profile.
+	SeccompAllow []string
+
+	// Landlock restricts the filesystem access of the task to the task and
+	// alloc directories, the system binaries and libraries, and the
+	// LandlockPaths.
+	Landlock bool
+
+	// LandlockPaths are extra paths the task can access, in the
+	// "type:mode:path" format of the artifact filesystem_isolation_extra_paths,
+	// for example "d:r:/etc/ssl" or "f:rw:/var/run/app.sock".
+	LandlockPaths []string
+}
+
+// Enabled returns whether the sandbox restricts the task at all.
+func (c *SandboxConfig) Enabled() bool {
+	return c != nil && (c.SeccompProfile != "" || c.Landlock)
+}
+
+// Validate returns an error if the sandbox can't be applied as configured.
+func (c *SandboxConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.Enabled() {
+		if err := sandboxSupported(c); err != nil {
+			return err
+		}
+	}
+
+	var mErr *multierror.Error
+
+	switch c.SeccompProfile {
+	case "":
+		if len(c.SeccompAllow) > 0 {
+			mErr = multierror.Append(mErr, fmt.Errorf("seccomp_allow requires a seccomp_profile"))
+		}
+	case SeccompProfileDefault:
+		if err := validateSeccompAllow(c.SeccompAllow); err != nil {
+			mErr = multierror.Append(mErr, err)
+		}
+	default:
+		mErr = multierror.Append(mErr, fmt.Errorf("unknown seccomp_profile %q", c.SeccompProfile))
+	}
+
+	if !c.Landlock && len(c.LandlockPaths) > 0 {
+		mErr = multierror.Append(mErr, fmt.Errorf("landlock_paths requires landlock to be enabled"))
+	}
+	for _, p := range c.LandlockPaths {
+		if _, err := landlock.ParsePath(p); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("invalid landlock path %q: %w", p, err))
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// sandboxSpec is passed to the sandbox subcommand. It includes the paths of
+// the task and alloc directories as the task sees them, which depend on
+// whether the task runs in a chroot.
+type sandboxSpec struct {
+	Config   *SandboxConfig
+	TaskDirs []string
+}
+
+func sandboxConfigToProto(c *SandboxConfig) *proto.SandboxConfig {
+	if c == nil {
+		return nil
+	}
+	return &proto.SandboxConfig{
+		SeccompProfile: c.SeccompProfile,
+		SeccompAllow:   slices.Clone(c.SeccompAllow),
+		Landlock:       c.Landlock,
+		LandlockPaths:  slices.Clone(c.LandlockPaths),
+	}
+}
+
+func sandboxConfigFromProto(pb *proto.SandboxConfig) *SandboxConfig {
+	if pb == nil {
+		return nil
+	}
+	return &SandboxConfig{
+		SeccompProfile: pb.SeccompProfile,
+		SeccompAllow:   slices.Clone(pb.SeccompAllow),
+		Landlock:       pb.Landlock,
+		LandlockPaths:  slices.Clone(pb.LandlockPaths),
+	}
+}
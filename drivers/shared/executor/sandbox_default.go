@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+
+package executor
+
+import "errors"
+
+var errSandboxNotSupported = errors.New("task sandboxing is only supported on Linux")
+
+func sandboxSupported(*SandboxConfig) error {
+	return errSandboxNotSupported
+}
+
+func validateSeccompAllow([]string) error {
+	return errSandboxNotSupported
+}
+
+func sandboxWrap(*SandboxConfig, string, []string, string, []string) (string, []string, error) {
+	return "", nil, errSandboxNotSupported
+}
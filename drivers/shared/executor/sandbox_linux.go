@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package executor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/shoenig/go-landlock"
+	"golang.org/x/sys/unix"
+)
+
+// sandboxChrootBin is where the executor binary is mounted in the chroot of
+// tasks that are sandboxed, so the sandbox subcommand can run in the chroot.
+const sandboxChrootBin = "/.nomad-sandbox"
+
+func sandboxSupported(c *SandboxConfig) error {
+	if c.Landlock && !landlock.Available() {
+		return errors.New("landlock is not available on this kernel")
+	}
+	return nil
+}
+
+func validateSeccompAllow(allow []string) error {
+	_, err := seccompFilter(allow)
+	return err
+}
+
+// sandboxWrap returns the path and args that run the task command through the
+// sandbox subcommand of bin. The taskDirs are the task and alloc directories
+// the task can write to when landlock is enabled.
+func sandboxWrap(c *SandboxConfig, bin string, taskDirs []string, path string, args []string) (string, []string, error) {
+	spec, err := json.Marshal(&sandboxSpec{Config: c, TaskDirs: taskDirs})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode sandbox: %w", err)
+	}
+	wrapped := append([]string{bin, sandboxCommand, string(spec), path}, args...)
+	return bin, wrapped, nil
+}
+
+// runSandbox applies the sandbox to the current process and executes the
+// task command in its place. It only returns if the sandbox can't be applied
+// or the command can't be executed.
+func runSandbox(spec *sandboxSpec, path string, args []string) error {
+	// landlock and seccomp are applied to every thread, but keep execve on
+	// the thread that applied them
+	runtime.LockOSThread()
+
+	if spec.Config.Landlock {
+		paths, err := landlockPaths(spec.TaskDirs, spec.Config.LandlockPaths)
+		if err != nil {
+			return err
+		}
+		if err := landlock.New(paths...).Lock(landlock.Mandatory); err != nil {
+			return err
+		}
+	}
+
+	if spec.Config.SeccompProfile != "" {
+		filter, err := seccompFilter(spec.Config.SeccompAllow)
+		if err != nil {
+			return err
+		}
+		if err := installSeccompFilter(filter); err != nil {
+			return err
+		}
+	}
+
+	return unix.Exec(path, append([]string{path}, args...), os.Environ())
+}
+
+// landlockPaths returns the paths a landlocked task can access: the system
+// binaries and libraries, name resolution and certificates, the task
+// directories, and any extra paths from the task configuration.
+func landlockPaths(taskDirs, extra []string) ([]*landlock.Path, error) {
+	paths := []*landlock.Path{
+		landlock.Shared(),
+		landlock.DNS(),
+		landlock.Certs(),
+	}
+
+	system := []struct {
+		path string
+		mode string
+		dir  bool
+	}{
+		{"/bin", "rx", true},
+		{"/sbin", "rx", true},
+		{"/usr/bin", "rx", true},
+		{"/usr/sbin", "rx", true},
+		{"/usr/local/bin", "rx", true},
+		{"/usr/share", "r", true},
+		{"/dev/zero", "r", false},
+		{"/dev/full", "rw", false},
+		{"/dev/random", "r", false},
+		{"/dev/urandom", "r", false},
+		{"/proc", "r", true},
+		{"/etc/passwd", "r", false},
+		{"/etc/group", "r", false},
+	}
+	for _, p := range system {
+		if _, err := os.Stat(p.path); err != nil {
+			continue
+		}
+		if p.dir {
+			paths = append(paths, landlock.Dir(p.path, p.mode))
+		} else {
+			paths = append(paths, landlock.File(p.path, p.mode))
+		}
+	}
+
+	for _, dir := range taskDirs {
+		if _, err := os.Stat(dir); err != nil {
+			continue
+		}
+		paths = append(paths, landlock.Dir(dir, "rwcx"))
+	}
+
+	for _, p := range extra {
+		path, err := landlock.ParsePath(p)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package executor
+
+import (
+	"encoding/binary"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/go-landlock"
+	"github.com/shoenig/test/must"
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+)
+
+func TestSandboxConfig_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name      string
+		config    *SandboxConfig
+		expectErr string
+	}{
+		{
+			name:   "nil",
+			config: nil,
+		},
+		{
+			name:   "default profile",
+			config: &SandboxConfig{SeccompProfile: "default", SeccompAllow: []string{"ptrace"}},
+		},
+		{
+			name:      "unknown profile",
+			config:    &SandboxConfig{SeccompProfile: "strict"},
+			expectErr: `unknown seccomp_profile "strict"`,
+		},
+		{
+			name:      "unknown syscall",
+			config:    &SandboxConfig{SeccompProfile: "default", SeccompAllow: []string{"not_a_syscall"}},
+			expectErr: `unknown syscall "not_a_syscall"`,
+		},
+		{
+			name:      "allow without profile",
+			config:    &SandboxConfig{SeccompAllow: []string{"ptrace"}},
+			expectErr: "seccomp_allow requires a seccomp_profile",
+		},
+		{
+			name:      "paths without landlock",
+			config:    &SandboxConfig{LandlockPaths: []string{"d:r:/etc"}},
+			expectErr: "landlock_paths requires landlock",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.expectErr == "" {
+				must.NoError(t, err)
+			} else {
+				must.ErrorContains(t, err, tc.expectErr)
+			}
+		})
+	}
+}
+
+func TestSandbox_seccompFilter(t *testing.T) {
+	ci.Parallel(t)
+
+	if seccompAuditArch == 0 {
+		t.Skip("seccomp filters are not built for this architecture")
+	}
+
+	base, err := seccompFilter(nil)
+	must.NoError(t, err)
+
+	// one more syscall adds a comparison and a return
+	extra, err := seccompFilter([]string{"ptrace"})
+	must.NoError(t, err)
+	must.Eq(t, len(base)+2, len(extra))
+
+	// already permitted syscalls don't grow the filter
+	dup, err := seccompFilter([]string{"read"})
+	must.NoError(t, err)
+	must.Eq(t, len(base), len(dup))
+}
+
+func TestSandbox_seccompFilter_Rules(t *testing.T) {
+	ci.Parallel(t)
+
+	if seccompAuditArch == 0 {
+		t.Skip("seccomp filters are not built for this architecture")
+	}
+
+	// run evaluates the filter against a syscall with the given first
+	// argument and returns the seccomp action
+	run := func(t *testing.T, filter []unix.SockFilter, name string, arg0 uint64) uint32 {
+		t.Helper()
+		raw := make([]bpf.RawInstruction, len(filter))
+		for i, f := range filter {
+			raw[i] = bpf.RawInstruction{Op: f.Code, Jt: f.Jt, Jf: f.Jf, K: f.K}
+		}
+		insns, ok := bpf.Disassemble(raw)
+		must.True(t, ok)
+		vm, err := bpf.NewVM(insns)
+		must.NoError(t, err)
+
+		// struct seccomp_data on a little endian architecture. The VM loads
+		// words in network byte order where the kernel uses the native one,
+		// so each word is written big endian.
+		data := make([]byte, 64)
+		binary.BigEndian.PutUint32(data[0:], seccompSyscalls[name])
+		binary.BigEndian.PutUint32(data[4:], seccompAuditArch)
+		binary.BigEndian.PutUint32(data[16:], uint32(arg0))
+		binary.BigEndian.PutUint32(data[20:], uint32(arg0>>32))
+		action, err := vm.Run(data)
+		must.NoError(t, err)
+		return uint32(action)
+	}
+
+	const (
+		allow  = unix.SECCOMP_RET_ALLOW
+		eperm  = unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM)
+		enosys = unix.SECCOMP_RET_ERRNO | uint32(unix.ENOSYS)
+	)
+
+	filter, err := seccompFilter(nil)
+	must.NoError(t, err)
+
+	must.Eq(t, allow, run(t, filter, "read", 0))
+	must.Eq(t, eperm, run(t, filter, "io_uring_setup", 0))
+	must.Eq(t, allow, run(t, filter, "clone", unix.CLONE_VM|unix.CLONE_THREAD|uint64(unix.SIGCHLD)))
+	must.Eq(t, eperm, run(t, filter, "clone", unix.CLONE_NEWUSER|uint64(unix.SIGCHLD)))
+	must.Eq(t, eperm, run(t, filter, "clone", unix.CLONE_NEWNET))
+	must.Eq(t, enosys, run(t, filter, "clone3", 0))
+
+	// explicitly allowed syscalls are not filtered on their arguments
+	filter, err = seccompFilter([]string{"clone", "clone3"})
+	must.NoError(t, err)
+	must.Eq(t, allow, run(t, filter, "clone", unix.CLONE_NEWUSER))
+	must.Eq(t, allow, run(t, filter, "clone3", 0))
+}
+
+// runSandboxed runs the shell script through the sandbox subcommand of the
+// test binary and returns its combined output.
+func runSandboxed(t *testing.T, config *SandboxConfig, taskDirs []string, script string) (string, error) {
+	t.Helper()
+	bin, err := os.Executable()
+	must.NoError(t, err)
+	path, args, err := sandboxWrap(config, bin, taskDirs, "/bin/sh", []string{"-c", script})
+	must.NoError(t, err)
+	out, err := exec.Command(path, args[1:]...).CombinedOutput()
+	return string(out), err
+}
+
+func TestSandbox_Seccomp(t *testing.T) {
+	ci.Parallel(t)
+
+	if seccompAuditArch == 0 {
+		t.Skip("seccomp filters are not built for this architecture")
+	}
+
+	config := &SandboxConfig{SeccompProfile: SeccompProfileDefault}
+
+	out, err := runSandboxed(t, config, nil, "echo hello")
+	must.NoError(t, err, must.Sprint(out))
+	must.StrContains(t, out, "hello")
+
+	// processes and threads can be created without namespace flags
+	out, err = runSandboxed(t, config, nil, "/bin/true && echo forked")
+	must.NoError(t, err, must.Sprint(out))
+	must.StrContains(t, out, "forked")
+
+	// unshare is not part of the default profile
+	out, err = runSandboxed(t, config, nil, "unshare --user true")
+	must.Error(t, err)
+	must.StrContains(t, out, "Operation not permitted")
+}
+
+func TestSandbox_Landlock(t *testing.T) {
+	ci.Parallel(t)
+
+	if !landlock.Available() {
+		t.Skip("landlock is not available")
+	}
+
+	taskDir := t.TempDir()
+	otherDir := t.TempDir()
+	must.NoError(t, os.WriteFile(filepath.Join(otherDir, "secret"), []byte("s3cr3t"), 0o644))
+
+	config := &SandboxConfig{Landlock: true}
+
+	out, err := runSandboxed(t, config, []string{taskDir}, "echo ok > "+filepath.Join(taskDir, "out"))
+	must.NoError(t, err, must.Sprint(out))
+
+	_, err = runSandboxed(t, config, []string{taskDir}, "cat "+filepath.Join(otherDir, "secret"))
+	must.Error(t, err)
+
+	// extra paths grant access outside the task directories
+	config.LandlockPaths = []string{"d:r:" + otherDir}
+	out, err = runSandboxed(t, config, []string{taskDir}, "cat "+filepath.Join(otherDir, "secret"))
+	must.NoError(t, err, must.Sprint(out))
+	must.StrContains(t, out, "s3cr3t")
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// seccompDefaultAllow is the list of syscalls permitted by the "default"
+// seccomp profile. Every other syscall fails with EPERM. The list follows the
+// syscalls Docker permits to unprivileged containers, without those that
+// manipulate namespaces, mounts, kernel modules, keyrings, other processes'
+// memory, or the system clock. io_uring is left out because its operations
+// bypass the filter. clone and clone3 are handled by seccompFilter rather than
+// listed here. Names that don't exist on the architecture are ignored.
+var seccompDefaultAllow = []string{
+	"accept", "accept4", "access", "alarm", "arch_prctl", "bind", "brk",
+	"capget", "capset", "chdir", "chmod", "chown", "clock_getres",
+	"clock_gettime", "clock_nanosleep", "close",
+	"close_range", "connect", "copy_file_range", "creat", "dup", "dup2",
+	"dup3", "epoll_create", "epoll_create1", "epoll_ctl", "epoll_pwait",
+	"epoll_pwait2", "epoll_wait", "eventfd", "eventfd2", "execve",
+	"execveat", "exit", "exit_group", "faccessat", "faccessat2",
+	"fadvise64", "fallocate", "fanotify_mark", "fchdir", "fchmod",
+	"fchmodat", "fchown", "fchownat", "fcntl", "fdatasync", "fgetxattr",
+	"flistxattr", "flock", "fork", "fremovexattr", "fsetxattr", "fstat",
+	"fstatfs", "fsync", "ftruncate", "futex", "futex_waitv", "futimesat",
+	"get_robust_list", "getcpu", "getcwd", "getdents", "getdents64",
+	"getegid", "geteuid", "getgid", "getgroups", "getitimer",
+	"getpeername", "getpgid", "getpgrp", "getpid", "getppid",
+	"getpriority", "getrandom", "getresgid", "getresuid", "getrlimit",
+	"getrusage", "getsid", "getsockname", "getsockopt", "gettid",
+	"gettimeofday", "getuid", "getxattr", "inotify_add_watch",
+	"inotify_init", "inotify_init1", "inotify_rm_watch", "io_cancel",
+	"io_destroy", "io_getevents", "io_pgetevents", "io_setup", "io_submit",
+	"ioctl",
+	"ioprio_get", "ioprio_set", "kill", "lchown", "lgetxattr", "link",
+	"linkat", "listen", "listxattr", "llistxattr", "lremovexattr", "lseek",
+	"lsetxattr", "lstat", "madvise", "membarrier", "memfd_create",
+	"mincore", "mkdir", "mkdirat", "mknod", "mknodat", "mlock", "mlock2",
+	"mlockall", "mmap", "mprotect", "mq_getsetattr", "mq_notify",
+	"mq_open", "mq_timedreceive", "mq_timedsend", "mq_unlink", "mremap",
+	"msgctl", "msgget", "msgrcv", "msgsnd", "msync", "munlock",
+	"munlockall", "munmap", "nanosleep", "newfstatat", "open", "openat",
+	"openat2", "pause", "pidfd_open", "pidfd_send_signal", "pipe", "pipe2",
+	"poll", "ppoll", "prctl", "pread64", "preadv", "preadv2", "prlimit64",
+	"pselect6", "pwrite64", "pwritev", "pwritev2", "read", "readahead",
+	"readlink", "readlinkat", "readv", "recvfrom", "recvmmsg", "recvmsg",
+	"remap_file_pages", "removexattr", "rename", "renameat", "renameat2",
+	"restart_syscall", "rmdir", "rseq", "rt_sigaction", "rt_sigpending",
+	"rt_sigprocmask", "rt_sigqueueinfo", "rt_sigreturn", "rt_sigsuspend",
+	"rt_sigtimedwait", "rt_tgsigqueueinfo", "sched_get_priority_max",
+	"sched_get_priority_min", "sched_getaffinity", "sched_getattr",
+	"sched_getparam", "sched_getscheduler", "sched_rr_get_interval",
+	"sched_setaffinity", "sched_setattr", "sched_setparam",
+	"sched_setscheduler", "sched_yield", "select", "semctl", "semget",
+	"semop", "semtimedop", "sendfile", "sendmmsg", "sendmsg", "sendto",
+	"set_robust_list", "set_tid_address", "setfsgid", "setfsuid",
+	"setgid", "setgroups", "setitimer", "setpgid", "setpriority",
+	"setregid", "setresgid", "setresuid", "setreuid", "setrlimit",
+	"setsid", "setsockopt", "setuid", "setxattr", "shmat", "shmctl",
+	"shmdt", "shmget", "shutdown", "sigaltstack", "signalfd", "signalfd4",
+	"socket", "socketpair", "splice", "stat", "statfs", "statx",
+	"symlink", "symlinkat", "sync", "sync_file_range", "syncfs",
+	"sysinfo", "tee", "tgkill", "time", "timer_create", "timer_delete",
+	"timer_getoverrun", "timer_gettime", "timer_settime",
+	"timerfd_create", "timerfd_gettime", "timerfd_settime", "times",
+	"tkill", "truncate", "umask", "uname", "unlink", "unlinkat", "utime",
+	"utimensat", "utimes", "vfork", "wait4", "waitid", "write", "writev",
+}
+
+// seccompCloneNamespaceFlags are the clone flags that create namespaces, which
+// the default profile denies like it denies unshare.
+const seccompCloneNamespaceFlags = unix.CLONE_NEWNS | unix.CLONE_NEWUTS |
+	unix.CLONE_NEWIPC | unix.CLONE_NEWUSER | unix.CLONE_NEWPID |
+	unix.CLONE_NEWNET | unix.CLONE_NEWCGROUP
+
+// seccompFilter builds a BPF program that permits the default syscalls and
+// the syscalls in allow, and fails every other syscall with EPERM. Unless
+// allowed explicitly, clone is permitted only without namespace flags, and
+// clone3 fails with ENOSYS since its flags can't be inspected, so that libc
+// falls back to clone. Syscalls made through a different architecture's ABI
+// kill the process.
+func seccompFilter(allow []string) ([]unix.SockFilter, error) {
+	if seccompAuditArch == 0 {
+		return nil, errors.New("seccomp profiles are not supported on this architecture")
+	}
+
+	allowed := make(map[uint32]struct{}, len(seccompDefaultAllow)+len(allow))
+	for _, name := range seccompDefaultAllow {
+		if nr, ok := seccompSyscalls[name]; ok {
+			allowed[nr] = struct{}{}
+		}
+	}
+	for _, name := range allow {
+		nr, ok := seccompSyscalls[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown syscall %q", name)
+		}
+		allowed[nr] = struct{}{}
+	}
+
+	const (
+		// offsets of the fields of struct seccomp_data. The flags are the
+		// low 32 bits of the first argument, as both supported
+		// architectures are little endian.
+		offsetNr   = 0
+		offsetArch = 4
+		offsetArg0 = 16
+
+		ldAbs = unix.BPF_LD | unix.BPF_W | unix.BPF_ABS
+		jeq   = unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K
+		jset  = unix.BPF_JMP | unix.BPF_JSET | unix.BPF_K
+		ret   = unix.BPF_RET | unix.BPF_K
+	)
+
+	filter := []unix.SockFilter{
+		{Code: ldAbs, K: offsetArch},
+		{Code: jeq, Jt: 1, Jf: 0, K: seccompAuditArch},
+		{Code: ret, K: unix.SECCOMP_RET_KILL_PROCESS},
+		{Code: ldAbs, K: offsetNr},
+	}
+
+	// each allowed syscall returns immediately, so the program never needs
+	// jumps longer than the 8 bits BPF allows
+	for nr := range allowed {
+		filter = append(filter,
+			unix.SockFilter{Code: jeq, Jt: 0, Jf: 1, K: nr},
+			unix.SockFilter{Code: ret, K: unix.SECCOMP_RET_ALLOW},
+		)
+	}
+
+	if nr, ok := seccompSyscalls["clone"]; ok {
+		if _, ok := allowed[nr]; !ok {
+			// the flags are loaded over the syscall number, so other
+			// syscalls jump past this rule
+			filter = append(filter,
+				unix.SockFilter{Code: jeq, Jt: 0, Jf: 4, K: nr},
+				unix.SockFilter{Code: ldAbs, K: offsetArg0},
+				unix.SockFilter{Code: jset, Jt: 1, Jf: 0, K: seccompCloneNamespaceFlags},
+				unix.SockFilter{Code: ret, K: unix.SECCOMP_RET_ALLOW},
+				unix.SockFilter{Code: ret, K: unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM)},
+			)
+		}
+	}
+	if nr, ok := seccompSyscalls["clone3"]; ok {
+		if _, ok := allowed[nr]; !ok {
+			filter = append(filter,
+				unix.SockFilter{Code: jeq, Jt: 0, Jf: 1, K: nr},
+				unix.SockFilter{Code: ret, K: unix.SECCOMP_RET_ERRNO | uint32(unix.ENOSYS)},
+			)
+		}
+	}
+
+	filter = append(filter, unix.SockFilter{
+		Code: ret, K: unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM),
+	})
+	return filter, nil
+}
+
+// installSeccompFilter applies the filter to every thread of the process. The
+// filter is inherited by child processes and is kept across execve.
+func installSeccompFilter(filter []unix.SockFilter) error {
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return fmt.Errorf("failed to set no_new_privs: %w", err)
+	}
+
+	prog := unix.SockFprog{
+		Len:    uint16(len(filter)),
+		Filter: &filter[0],
+	}
+	_, _, errno := unix.Syscall(unix.SYS_SECCOMP,
+		unix.SECCOMP_SET_MODE_FILTER,
+		unix.SECCOMP_FILTER_FLAG_TSYNC,
+		uintptr(unsafe.Pointer(&prog)))
+	if errno != 0 {
+		return fmt.Errorf("failed to install seccomp filter: %w", errno)
+	}
+	return nil
+}
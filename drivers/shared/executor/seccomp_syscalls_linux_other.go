@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux && !amd64 && !arm64
+
+package executor
+
+// seccompAuditArch is zero on architectures seccomp filters are not built for.
+const seccompAuditArch = 0
+
+// seccompSyscalls is empty on architectures seccomp filters are not built for.
+var seccompSyscalls = map[string]uint32{}
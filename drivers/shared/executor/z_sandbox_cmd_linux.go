@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Install the sandbox subcommand, which the executor runs in place of a
+// sandboxed task command. It must apply the sandbox and exec the task command
+// before any other initialization, like the executor subcommand.
+func init() {
+	if len(os.Args) > 1 && os.Args[1] == sandboxCommand {
+		if len(os.Args) < 4 {
+			fmt.Fprintln(os.Stderr, "sandbox configuration and command not provided")
+			os.Exit(1)
+		}
+
+		var spec sandboxSpec
+		if err := json.Unmarshal([]byte(os.Args[2]), &spec); err != nil || spec.Config == nil {
+			fmt.Fprintln(os.Stderr, "invalid sandbox configuration")
+			os.Exit(1)
+		}
+
+		err := runSandbox(&spec, os.Args[3], os.Args[4:])
+		fmt.Fprintf(os.Stderr, "failed to start sandboxed task: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ociimage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// pullLayout resolves a reference of the form name[:tag] against the OCI
+// image layout at <layoutsDir>/name. Blobs are used in place rather than
+// copied into the store.
+func (s *Store) pullLayout(ref string) (*Image, error) {
+	if s.layoutsDir == "" {
+		return nil, errors.New("local OCI image layouts are not enabled on this client")
+	}
+
+	name, tag, _ := strings.Cut(ref, ":")
+	if name == "" || !filepath.IsLocal(name) {
+		return nil, fmt.Errorf("invalid OCI image layout name %q", name)
+	}
+	layout := &ociLayout{dir: filepath.Join(s.layoutsDir, name)}
+
+	buf, err := readFileLimited(filepath.Join(layout.dir, ocispec.ImageIndexFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCI image layout %q: %w", name, err)
+	}
+	index, err := decodeManifest(buf, ocispec.MediaTypeImageIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	desc, err := selectTag(index.Manifests, tag)
+	if err != nil {
+		return nil, fmt.Errorf("OCI image layout %q: %w", name, err)
+	}
+
+	m, err := layout.manifest(desc.Digest.String())
+	if err != nil {
+		return nil, err
+	}
+	dgst := desc.Digest.String()
+	if m.isIndex() {
+		desc, err := selectPlatform(m.Manifests, runtime.GOOS, runtime.GOARCH)
+		if err != nil {
+			return nil, err
+		}
+		if m, err = layout.manifest(desc.Digest.String()); err != nil {
+			return nil, err
+		}
+		if m.isIndex() {
+			return nil, errors.New("nested image indexes are not supported")
+		}
+		dgst = desc.Digest.String()
+	}
+
+	img := &Image{
+		Reference: LayoutScheme + ref,
+		Digest:    dgst,
+	}
+
+	configPath, err := layout.blobPath(m.Config.Digest.String())
+	if err != nil {
+		return nil, err
+	}
+	if buf, err = readFileLimited(configPath); err != nil {
+		return nil, fmt.Errorf("failed to read image config: %w", err)
+	}
+	if img.Config, err = decodeConfig(buf); err != nil {
+		return nil, err
+	}
+
+	for _, desc := range m.Layers {
+		if _, err := layerCompression(desc.MediaType); err != nil {
+			return nil, err
+		}
+		path, err := layout.blobPath(desc.Digest.String())
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(path); err != nil {
+			return nil, fmt.Errorf("missing layer %s: %w", desc.Digest, err)
+		}
+		img.Layers = append(img.Layers, Layer{
+			Digest:    desc.Digest.String(),
+			MediaType: desc.MediaType,
+			Path:      path,
+		})
+	}
+
+	return img, nil
+}
+
+// selectTag returns the manifest in the layout index with the given tag. If
+// tag is empty the index must contain exactly one manifest.
+func selectTag(manifests []ocispec.Descriptor, tag string) (ocispec.Descriptor, error) {
+	if tag == "" {
+		if len(manifests) != 1 {
+			return ocispec.Descriptor{}, fmt.Errorf("layout has %d images, a tag must be specified", len(manifests))
+		}
+		return manifests[0], nil
+	}
+	for _, desc := range manifests {
+		if desc.Annotations[ocispec.AnnotationRefName] == tag {
+			return desc, nil
+		}
+	}
+	return ocispec.Descriptor{}, fmt.Errorf("tag %q not found", tag)
+}
+
+// ociLayout is a directory laid out according to the OCI image layout spec.
+type ociLayout struct {
+	dir string
+}
+
+func (l *ociLayout) blobPath(dgst string) (string, error) {
+	hash, err := sha256Hex(dgst)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(l.dir, ocispec.ImageBlobsDir, "sha256", hash), nil
+}
+
+// manifest reads and verifies the manifest or index with the given digest.
+func (l *ociLayout) manifest(dgst string) (*manifestOrIndex, error) {
+	path, err := l.blobPath(dgst)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := readFileLimited(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	if err := verifyDigest(dgst, buf); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	return decodeManifest(buf, "")
+}
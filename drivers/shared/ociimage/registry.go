@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ociimage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+
+	"github.com/distribution/reference"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+func (s *Store) pullRegistry(ctx context.Context, ref string, auth *Auth) (*Image, error) {
+	if s.dir == "" {
+		return nil, errors.New("image cache directory is not configured")
+	}
+
+	named, err := reference.ParseDockerRef(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image reference %q: %w", ref, err)
+	}
+	r, err := name.ParseReference(named.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid image reference %q: %w", ref, err)
+	}
+
+	authenticator := authn.Anonymous
+	if auth != nil {
+		authenticator = &authn.Basic{
+			Username: auth.Username,
+			Password: auth.Password,
+		}
+	}
+
+	s.logger.Debug("pulling image", "image", named.String())
+	remoteImg, err := remote.Image(r,
+		remote.WithContext(ctx),
+		remote.WithAuth(authenticator),
+		remote.WithTransport(s.transport),
+		remote.WithPlatform(v1.Platform{OS: runtime.GOOS, Architecture: runtime.GOARCH}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch image manifest: %w", err)
+	}
+
+	dgst, err := remoteImg.Digest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute image digest: %w", err)
+	}
+	img := &Image{
+		Reference: named.String(),
+		Digest:    dgst.String(),
+	}
+
+	buf, err := remoteImg.RawConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch image config: %w", err)
+	}
+	if img.Config, err = decodeConfig(buf); err != nil {
+		return nil, err
+	}
+
+	layers, err := remoteImg.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image layers: %w", err)
+	}
+	for _, layer := range layers {
+		mediaType, err := layer.MediaType()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read layer media type: %w", err)
+		}
+		if _, err := layerCompression(string(mediaType)); err != nil {
+			return nil, err
+		}
+		layerDigest, err := layer.Digest()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read layer digest: %w", err)
+		}
+
+		path, err := s.blob(layerDigest.String(), layer.Compressed)
+		if err != nil {
+			return nil, err
+		}
+		img.Layers = append(img.Layers, Layer{
+			Digest:    layerDigest.String(),
+			MediaType: string(mediaType),
+			Path:      path,
+		})
+	}
+
+	return img, nil
+}
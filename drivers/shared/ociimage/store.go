@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ociimage fetches OCI images from registries or local OCI image
+// layouts and unpacks them into a root filesystem. It is used by task drivers
+// that isolate tasks with a chroot rather than a container runtime.
+package ociimage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-hclog"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// LayoutScheme is the prefix of image references that are resolved
+	// against the local OCI image layouts directory rather than a registry.
+	LayoutScheme = "oci-layout://"
+
+	// maxManifestSize is the largest manifest, index or image config that
+	// will be read into memory.
+	maxManifestSize = 4 << 20
+
+	// Docker schema 2 media types, which are structurally compatible with
+	// their OCI counterparts and still served by most registries.
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+)
+
+// Auth is the credentials used to authenticate with a registry.
+type Auth struct {
+	Username string
+	Password string
+}
+
+// Image is an image that has been fetched into the store.
+type Image struct {
+	// Reference is the image reference the image was pulled by.
+	Reference string
+
+	// Digest is the digest of the image manifest.
+	Digest string
+
+	// Config is the runtime configuration of the image.
+	Config ocispec.ImageConfig
+
+	// Layers are the layers of the image, from the lowest to the highest.
+	Layers []Layer
+}
+
+// Layer is a single filesystem layer of an image.
+type Layer struct {
+	// Digest is the digest of the compressed layer blob.
+	Digest string
+
+	// MediaType is the media type of the layer blob.
+	MediaType string
+
+	// Path is the path to the layer blob on disk.
+	Path string
+}
+
+// Store fetches images and caches their blobs by digest.
+type Store struct {
+	// dir is the directory blobs pulled from registries are cached in.
+	dir string
+
+	// layoutsDir is the directory local OCI image layouts are resolved
+	// against. Local layouts are disabled if empty.
+	layoutsDir string
+
+	transport http.RoundTripper
+	logger    hclog.Logger
+}
+
+// NewStore returns a Store that caches blobs under dir and resolves
+// references using the oci-layout:// scheme against layoutsDir. Pulling from
+// registries is disabled if dir is empty.
+func NewStore(dir, layoutsDir string, logger hclog.Logger) *Store {
+	return &Store{
+		dir:        dir,
+		layoutsDir: layoutsDir,
+		transport:  cleanhttp.DefaultPooledTransport(),
+		logger:     logger.Named("ociimage"),
+	}
+}
+
+// Pull fetches the image with the given reference and returns it once all
+// of its blobs are available on disk. The auth may be nil for anonymous
+// access.
+func (s *Store) Pull(ctx context.Context, ref string, auth *Auth) (*Image, error) {
+	if name, ok := strings.CutPrefix(ref, LayoutScheme); ok {
+		return s.pullLayout(name)
+	}
+	return s.pullRegistry(ctx, ref, auth)
+}
+
+// blobPath returns the path in the cache of the blob with the given digest.
+func (s *Store) blobPath(dgst string) (string, error) {
+	hash, err := sha256Hex(dgst)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, ocispec.ImageBlobsDir, "sha256", hash), nil
+}
+
+// blob ensures the blob with the given digest is in the cache and returns
+// its path. A cached blob is hashed again before it is used, so that a blob
+// modified on disk is fetched again with open rather than unpacked.
+func (s *Store) blob(dgst string, open func() (io.ReadCloser, error)) (string, error) {
+	path, err := s.blobPath(dgst)
+	if err != nil {
+		return "", err
+	}
+	if err := verifyFile(dgst, path); err == nil {
+		return path, nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		s.logger.Warn("discarding invalid cached blob", "digest", dgst, "error", err)
+		if err := os.Remove(path); err != nil {
+			return "", fmt.Errorf("failed to remove invalid blob %s: %w", dgst, err)
+		}
+	}
+
+	r, err := open()
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch blob %s: %w", dgst, err)
+	}
+	defer r.Close()
+	return s.writeBlob(dgst, r)
+}
+
+// writeBlob copies r into the cache under the given digest, verifying the
+// content matches the digest before making it visible.
+func (s *Store) writeBlob(dgst string, r io.Reader) (string, error) {
+	path, err := s.blobPath(dgst)
+	if err != nil {
+		return "", err
+	}
+
+	// the cache is only readable by the client, and its permissions are
+	// reset in case it was created by an operator
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return "", fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	if err := os.Chmod(s.dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to set image cache permissions: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".download-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create blob file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	h := sha256.New()
+	_, err = io.Copy(io.MultiWriter(tmp, h), r)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to write blob %s: %w", dgst, err)
+	}
+
+	if actual := "sha256:" + hex.EncodeToString(h.Sum(nil)); actual != dgst {
+		return "", fmt.Errorf("blob digest mismatch: expected %s, got %s", dgst, actual)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to store blob %s: %w", dgst, err)
+	}
+	return path, nil
+}
+
+// sha256Hex returns the hex encoded hash of a sha256 digest, rejecting any
+// other algorithm or a malformed digest.
+func sha256Hex(dgst string) (string, error) {
+	hash, ok := strings.CutPrefix(dgst, "sha256:")
+	if !ok {
+		return "", fmt.Errorf("unsupported digest %q: only sha256 is supported", dgst)
+	}
+	if _, err := hex.DecodeString(hash); err != nil || len(hash) != sha256.Size*2 {
+		return "", fmt.Errorf("invalid digest %q", dgst)
+	}
+	return hash, nil
+}
+
+// verifyDigest checks that buf hashes to dgst.
+func verifyDigest(dgst string, buf []byte) error {
+	if _, err := sha256Hex(dgst); err != nil {
+		return err
+	}
+	if actual := digestOf(buf); actual != dgst {
+		return fmt.Errorf("digest mismatch: expected %s, got %s", dgst, actual)
+	}
+	return nil
+}
+
+// verifyFile checks that the file at path hashes to dgst.
+func verifyFile(dgst, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	if actual := "sha256:" + hex.EncodeToString(h.Sum(nil)); actual != dgst {
+		return fmt.Errorf("digest mismatch: expected %s, got %s", dgst, actual)
+	}
+	return nil
+}
+
+// digestOf returns the sha256 digest of buf.
+func digestOf(buf []byte) string {
+	sum := sha256.Sum256(buf)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// manifestOrIndex is the union of an image manifest and an image index, used
+// to decode a manifest before its media type is known.
+type manifestOrIndex struct {
+	MediaType string               `json:"mediaType"`
+	Config    ocispec.Descriptor   `json:"config"`
+	Layers    []ocispec.Descriptor `json:"layers"`
+	Manifests []ocispec.Descriptor `json:"manifests"`
+}
+
+// decodeManifest decodes buf as a manifest or index. The media type is
+// taken from the document if present, otherwise from contentType.
+func decodeManifest(buf []byte, contentType string) (*manifestOrIndex, error) {
+	var m manifestOrIndex
+	if err := json.Unmarshal(buf, &m); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	if m.MediaType == "" {
+		m.MediaType = contentType
+	}
+	if m.MediaType == "" && len(m.Manifests) > 0 {
+		m.MediaType = ocispec.MediaTypeImageIndex
+	}
+	return &m, nil
+}
+
+func (m *manifestOrIndex) isIndex() bool {
+	switch m.MediaType {
+	case ocispec.MediaTypeImageIndex, mediaTypeDockerManifestList:
+		return true
+	}
+	return false
+}
+
+// selectPlatform returns the manifest in an index matching the platform the
+// client is running on.
+func selectPlatform(manifests []ocispec.Descriptor, os, arch string) (ocispec.Descriptor, error) {
+	for _, desc := range manifests {
+		if desc.Platform == nil {
+			continue
+		}
+		if desc.Platform.OS == os && desc.Platform.Architecture == arch {
+			return desc, nil
+		}
+	}
+	return ocispec.Descriptor{}, fmt.Errorf("no image found for platform %s/%s", os, arch)
+}
+
+// decodeConfig decodes an image config blob.
+func decodeConfig(buf []byte) (ocispec.ImageConfig, error) {
+	var img ocispec.Image
+	if err := json.Unmarshal(buf, &img); err != nil {
+		return ocispec.ImageConfig{}, fmt.Errorf("failed to decode image config: %w", err)
+	}
+	return img.Config, nil
+}
+
+// readLimited reads all of r, failing if it is larger than maxManifestSize.
+func readLimited(r io.Reader) ([]byte, error) {
+	buf, err := io.ReadAll(io.LimitReader(r, maxManifestSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) > maxManifestSize {
+		return nil, errors.New("document exceeds maximum manifest size")
+	}
+	return buf, nil
+}
+
+// readFileLimited reads a small JSON document from disk.
+func readFileLimited(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readLimited(f)
+}
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ociimage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/shoenig/test/must"
+)
+
+// testBlobs is the content of a single platform image.
+type testBlobs struct {
+	index    []byte
+	manifest []byte
+	config   []byte
+	layer    []byte
+}
+
+func (b *testBlobs) all() map[string][]byte {
+	return map[string][]byte{
+		digestOf(b.index):    b.index,
+		digestOf(b.manifest): b.manifest,
+		digestOf(b.config):   b.config,
+		digestOf(b.layer):    b.layer,
+	}
+}
+
+func descriptor(t *testing.T, mediaType string, buf []byte) ocispec.Descriptor {
+	t.Helper()
+
+	var desc ocispec.Descriptor
+	must.NoError(t, json.Unmarshal([]byte(fmt.Sprintf(
+		`{"mediaType":%q,"digest":%q,"size":%d}`, mediaType, digestOf(buf), len(buf))), &desc))
+	return desc
+}
+
+func newTestBlobs(t *testing.T) *testBlobs {
+	t.Helper()
+
+	b := &testBlobs{
+		layer: testLayer(t, testEntry{Name: "bin/app", Content: "app"}),
+	}
+
+	var err error
+	b.config, err = json.Marshal(ocispec.Image{
+		Platform: ocispec.Platform{OS: runtime.GOOS, Architecture: runtime.GOARCH},
+		Config: ocispec.ImageConfig{
+			Entrypoint: []string{"/bin/app"},
+			Env:        []string{"PATH=/bin"},
+		},
+	})
+	must.NoError(t, err)
+
+	b.manifest, err = json.Marshal(ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    descriptor(t, ocispec.MediaTypeImageConfig, b.config),
+		Layers:    []ocispec.Descriptor{descriptor(t, ocispec.MediaTypeImageLayerGzip, b.layer)},
+	})
+	must.NoError(t, err)
+
+	manifestDesc := descriptor(t, ocispec.MediaTypeImageManifest, b.manifest)
+	manifestDesc.Platform = &ocispec.Platform{OS: runtime.GOOS, Architecture: runtime.GOARCH}
+	other := descriptor(t, ocispec.MediaTypeImageManifest, []byte("other"))
+	other.Platform = &ocispec.Platform{OS: "plan9", Architecture: "mips"}
+
+	b.index, err = json.Marshal(ocispec.Index{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ocispec.MediaTypeImageIndex,
+		Manifests: []ocispec.Descriptor{other, manifestDesc},
+	})
+	must.NoError(t, err)
+	return b
+}
+
+func TestStore_PullLayout(t *testing.T) {
+	ci.Parallel(t)
+
+	layouts := t.TempDir()
+	b := newTestBlobs(t)
+
+	// write an OCI image layout whose top level index points to the
+	// multi-platform index
+	dir := filepath.Join(layouts, "app")
+	blobs := filepath.Join(dir, ocispec.ImageBlobsDir, "sha256")
+	must.NoError(t, os.MkdirAll(blobs, 0o755))
+	for dgst, buf := range b.all() {
+		must.NoError(t, os.WriteFile(filepath.Join(blobs, strings.TrimPrefix(dgst, "sha256:")), buf, 0o644))
+	}
+	indexDesc := descriptor(t, ocispec.MediaTypeImageIndex, b.index)
+	indexDesc.Annotations = map[string]string{ocispec.AnnotationRefName: "v1"}
+	top, err := json.Marshal(ocispec.Index{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		Manifests: []ocispec.Descriptor{indexDesc},
+	})
+	must.NoError(t, err)
+	must.NoError(t, os.WriteFile(filepath.Join(dir, ocispec.ImageIndexFile), top, 0o644))
+
+	store := NewStore(t.TempDir(), layouts, testlog.HCLogger(t))
+
+	t.Run("tag", func(t *testing.T) {
+		img, err := store.Pull(context.Background(), "oci-layout://app:v1", nil)
+		must.NoError(t, err)
+		must.Eq(t, digestOf(b.manifest), img.Digest)
+		must.Eq(t, []string{"/bin/app"}, img.Config.Entrypoint)
+		must.Len(t, 1, img.Layers)
+		must.Eq(t, filepath.Join(blobs, strings.TrimPrefix(digestOf(b.layer), "sha256:")), img.Layers[0].Path)
+	})
+
+	t.Run("single image without tag", func(t *testing.T) {
+		img, err := store.Pull(context.Background(), "oci-layout://app", nil)
+		must.NoError(t, err)
+		must.Eq(t, digestOf(b.manifest), img.Digest)
+	})
+
+	t.Run("missing tag", func(t *testing.T) {
+		_, err := store.Pull(context.Background(), "oci-layout://app:v2", nil)
+		must.ErrorContains(t, err, `tag "v2" not found`)
+	})
+
+	t.Run("escape", func(t *testing.T) {
+		_, err := store.Pull(context.Background(), "oci-layout://../app", nil)
+		must.ErrorContains(t, err, "invalid OCI image layout name")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		store := NewStore(t.TempDir(), "", testlog.HCLogger(t))
+		_, err := store.Pull(context.Background(), "oci-layout://app:v1", nil)
+		must.ErrorContains(t, err, "not enabled")
+	})
+}
+
+func TestStore_PullRegistry(t *testing.T) {
+	ci.Parallel(t)
+
+	b := newTestBlobs(t)
+	blobs := b.all()
+	var layerRequests atomic.Int32
+
+	mux := http.NewServeMux()
+	ts := httptest.NewTLSServer(mux)
+	defer ts.Close()
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "user" || pass != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("scope") != "repository:team/app:pull" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, `{"token":"secret"}`)
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(
+				`Bearer realm="%s/token",service="test",scope="repository:team/app:pull"`, ts.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		kind, ref, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v2/team/app/"), "/")
+		switch {
+		case r.URL.Path == "/v2/":
+			w.WriteHeader(http.StatusOK)
+		case kind == "manifests" && ref == "v1":
+			w.Header().Set("Content-Type", ocispec.MediaTypeImageIndex)
+			w.Write(b.index)
+		case kind == "manifests" && ref == digestOf(b.manifest):
+			w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+			w.Write(b.manifest)
+		case kind == "blobs" && blobs[ref] != nil:
+			if ref == digestOf(b.layer) {
+				layerRequests.Add(1)
+			}
+			w.Write(blobs[ref])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	cacheDir := filepath.Join(t.TempDir(), "images")
+	store := NewStore(cacheDir, "", testlog.HCLogger(t))
+	store.transport = ts.Client().Transport
+	ref := strings.TrimPrefix(ts.URL, "https://") + "/team/app:v1"
+	auth := &Auth{Username: "user", Password: "pass"}
+
+	img, err := store.Pull(context.Background(), ref, auth)
+	must.NoError(t, err)
+	must.Eq(t, digestOf(b.manifest), img.Digest)
+	must.Eq(t, []string{"PATH=/bin"}, img.Config.Env)
+	must.Len(t, 1, img.Layers)
+	must.FileExists(t, img.Layers[0].Path)
+	must.Eq(t, 1, layerRequests.Load())
+
+	info, err := os.Stat(cacheDir)
+	must.NoError(t, err)
+	must.Eq(t, os.FileMode(0o700), info.Mode().Perm())
+
+	// layers are served from the cache on the next pull
+	_, err = store.Pull(context.Background(), ref, auth)
+	must.NoError(t, err)
+	must.Eq(t, 1, layerRequests.Load())
+
+	// a modified cached layer is fetched again
+	must.NoError(t, os.WriteFile(img.Layers[0].Path, []byte("tampered"), 0o600))
+	img, err = store.Pull(context.Background(), ref, auth)
+	must.NoError(t, err)
+	must.Eq(t, 2, layerRequests.Load())
+	must.Eq(t, string(b.layer), readFile(t, img.Layers[0].Path))
+
+	// missing credentials are rejected by the token server
+	store = NewStore(t.TempDir(), "", testlog.HCLogger(t))
+	store.transport = ts.Client().Transport
+	_, err = store.Pull(context.Background(), ref, nil)
+	must.ErrorContains(t, err, "failed to fetch image manifest")
+
+	// pulling from registries requires a cache directory
+	store = NewStore("", "", testlog.HCLogger(t))
+	_, err = store.Pull(context.Background(), ref, auth)
+	must.ErrorContains(t, err, "not configured")
+}
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ociimage
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/hashicorp/go-set/v3"
+)
+
+const (
+	// whiteoutPrefix marks a file in a lower layer as deleted.
+	whiteoutPrefix = ".wh."
+
+	// whiteoutOpaque marks a directory as replacing the contents of the same
+	// directory in lower layers.
+	whiteoutOpaque = ".wh..wh..opq"
+)
+
+type compression int
+
+const (
+	compressionNone compression = iota
+	compressionGzip
+)
+
+// layerCompression returns the compression used by a layer media type, or
+// an error if the media type is not supported.
+func layerCompression(mediaType string) (compression, error) {
+	switch {
+	case strings.HasSuffix(mediaType, "+gzip"), strings.HasSuffix(mediaType, ".tar.gzip"):
+		return compressionGzip, nil
+	case strings.HasSuffix(mediaType, ".tar"):
+		return compressionNone, nil
+	default:
+		return 0, fmt.Errorf("unsupported layer media type %q", mediaType)
+	}
+}
+
+// Unpack extracts the layers of img into dir. Top level entries of dir named
+// in protect are never modified. Files that already existed in dir before
+// unpacking are left in place, so that content written by the client such as
+// templates and artifacts takes precedence over the image.
+func Unpack(img *Image, dir string, protect []string) error {
+	u := &unpacker{
+		root:    dir,
+		protect: set.From(protect),
+		created: set.New[string](0),
+		chown:   os.Geteuid() == 0,
+	}
+	for _, layer := range img.Layers {
+		if err := u.apply(layer); err != nil {
+			return fmt.Errorf("failed to unpack layer %s: %w", layer.Digest, err)
+		}
+	}
+	return nil
+}
+
+type unpacker struct {
+	root    string
+	protect *set.Set[string]
+
+	// created is the set of paths, relative to root, that were written by
+	// this unpacker and so may be replaced or deleted by higher layers.
+	created *set.Set[string]
+
+	// chown is whether file ownership from the image is applied, which
+	// requires running as root.
+	chown bool
+}
+
+// apply extracts a single layer, first deleting the paths whited out by the
+// layer from lower layers.
+func (u *unpacker) apply(layer Layer) error {
+	var opaque, whiteouts []string
+	err := u.walk(layer, func(name string, hdr *tar.Header, _ io.Reader) error {
+		dir, base := path.Split(name)
+		switch {
+		case base == whiteoutOpaque:
+			opaque = append(opaque, path.Clean(dir))
+		case strings.HasPrefix(base, whiteoutPrefix):
+			whiteouts = append(whiteouts, path.Join(dir, strings.TrimPrefix(base, whiteoutPrefix)))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range opaque {
+		if err := u.clearDir(dir); err != nil {
+			return err
+		}
+	}
+	for _, name := range whiteouts {
+		if err := u.remove(name); err != nil {
+			return err
+		}
+	}
+
+	return u.walk(layer, func(name string, hdr *tar.Header, r io.Reader) error {
+		if strings.HasPrefix(path.Base(name), whiteoutPrefix) {
+			return nil
+		}
+		return u.extract(name, hdr, r)
+	})
+}
+
+// walk calls fn for each entry of the layer that is not the root directory
+// and is not protected.
+func (u *unpacker) walk(layer Layer, fn func(string, *tar.Header, io.Reader) error) error {
+	comp, err := layerCompression(layer.MediaType)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(layer.Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if comp == compressionGzip {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := path.Clean("/" + hdr.Name)[1:]
+		if name == "" || u.protected(name) {
+			continue
+		}
+		if err := fn(name, hdr, tr); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+}
+
+func (u *unpacker) protected(name string) bool {
+	top, _, _ := strings.Cut(name, "/")
+	return u.protect.Contains(top)
+}
+
+// resolve returns the host path of name, resolving any symlinks in its
+// parent directories within the root. The final element is not resolved so
+// that symlinks themselves can be replaced.
+func (u *unpacker) resolve(name string) (string, error) {
+	parent, err := securejoin.SecureJoin(u.root, path.Dir(name))
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(parent, path.Base(name)), nil
+}
+
+// remove deletes name if it was created by a lower layer.
+func (u *unpacker) remove(name string) error {
+	if !u.created.Contains(name) {
+		return nil
+	}
+	target, err := u.resolve(name)
+	if err != nil {
+		return err
+	}
+	u.created.Remove(name)
+	return os.RemoveAll(target)
+}
+
+// clearDir deletes the entries of dir that were created by lower layers.
+func (u *unpacker) clearDir(dir string) error {
+	target, err := securejoin.SecureJoin(u.root, dir)
+	if err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(target)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := u.remove(path.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extract writes a single entry to disk.
+func (u *unpacker) extract(name string, hdr *tar.Header, r io.Reader) error {
+	target, err := u.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	if fi, err := os.Lstat(target); err == nil {
+		switch {
+		case !u.created.Contains(name):
+			// never replace content that was in place before unpacking
+			return nil
+		case fi.IsDir() && hdr.Typeflag == tar.TypeDir:
+			return u.setAttrs(target, hdr)
+		default:
+			if err := os.RemoveAll(target); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if err := os.Mkdir(target, 0o700); err != nil {
+			return err
+		}
+	case tar.TypeReg:
+		f, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(f, r)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+	case tar.TypeSymlink:
+		if err := os.Symlink(hdr.Linkname, target); err != nil {
+			return err
+		}
+	case tar.TypeLink:
+		src, err := u.resolve(path.Clean("/" + hdr.Linkname)[1:])
+		if err != nil {
+			return err
+		}
+		if err := os.Link(src, target); err != nil {
+			return err
+		}
+	default:
+		// Device nodes and fifos are skipped as the task's /dev is provided
+		// by the executor.
+		return nil
+	}
+
+	u.created.Insert(name)
+	return u.setAttrs(target, hdr)
+}
+
+// setAttrs applies the ownership, mode and modification time of the entry.
+func (u *unpacker) setAttrs(target string, hdr *tar.Header) error {
+	if u.chown {
+		if err := os.Lchown(target, hdr.Uid, hdr.Gid); err != nil {
+			return err
+		}
+	}
+
+	switch hdr.Typeflag {
+	case tar.TypeSymlink:
+		return nil
+	case tar.TypeLink:
+		// the mode and time are shared with the link target
+		return nil
+	}
+
+	if err := os.Chmod(target, hdr.FileInfo().Mode()); err != nil {
+		return err
+	}
+	return os.Chtimes(target, hdr.AccessTime, hdr.ModTime)
+}
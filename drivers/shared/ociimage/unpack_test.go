@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ociimage
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/shoenig/test/must"
+)
+
+// testEntry is a single entry of a test layer. Entries with a Link are
+// symlinks, entries with a trailing slash are directories.
+type testEntry struct {
+	Name    string
+	Content string
+	Link    string
+}
+
+// testLayer returns a gzip compressed layer containing the entries.
+func testLayer(t *testing.T, entries ...testEntry) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.Name, Mode: 0o644, Size: int64(len(e.Content))}
+		switch {
+		case e.Link != "":
+			hdr.Typeflag, hdr.Linkname, hdr.Size = tar.TypeSymlink, e.Link, 0
+		case e.Name[len(e.Name)-1] == '/':
+			hdr.Typeflag, hdr.Mode = tar.TypeDir, 0o755
+		default:
+			hdr.Typeflag = tar.TypeReg
+		}
+		must.NoError(t, tw.WriteHeader(hdr))
+		_, err := tw.Write([]byte(e.Content))
+		must.NoError(t, err)
+	}
+	must.NoError(t, tw.Close())
+	must.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// testImage writes the layers to disk and returns an image using them.
+func testImage(t *testing.T, layers ...[]byte) *Image {
+	t.Helper()
+
+	img := &Image{}
+	dir := t.TempDir()
+	for _, layer := range layers {
+		dgst := digestOf(layer)
+		path := filepath.Join(dir, dgst[len("sha256:"):])
+		must.NoError(t, os.WriteFile(path, layer, 0o644))
+		img.Layers = append(img.Layers, Layer{
+			Digest:    dgst,
+			MediaType: ocispec.MediaTypeImageLayerGzip,
+			Path:      path,
+		})
+	}
+	return img
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	buf, err := os.ReadFile(path)
+	must.NoError(t, err)
+	return string(buf)
+}
+
+func TestUnpack_Layers(t *testing.T) {
+	ci.Parallel(t)
+
+	img := testImage(t,
+		testLayer(t,
+			testEntry{Name: "bin/"},
+			testEntry{Name: "bin/app", Content: "v1"},
+			testEntry{Name: "etc/config", Content: "base"},
+			testEntry{Name: "etc/remove-me", Content: "x"},
+			testEntry{Name: "var/cache/a", Content: "a"},
+			testEntry{Name: "sbin", Link: "bin"},
+		),
+		testLayer(t,
+			testEntry{Name: "bin/app", Content: "v2"},
+			testEntry{Name: "etc/.wh.remove-me"},
+			testEntry{Name: "var/cache/.wh..wh..opq"},
+			testEntry{Name: "var/cache/b", Content: "b"},
+		),
+	)
+
+	dir := t.TempDir()
+	must.NoError(t, Unpack(img, dir, nil))
+
+	must.Eq(t, "v2", readFile(t, filepath.Join(dir, "bin/app")))
+	must.Eq(t, "v2", readFile(t, filepath.Join(dir, "sbin/app")))
+	must.Eq(t, "base", readFile(t, filepath.Join(dir, "etc/config")))
+	must.Eq(t, "b", readFile(t, filepath.Join(dir, "var/cache/b")))
+	must.FileNotExists(t, filepath.Join(dir, "etc/remove-me"))
+	must.FileNotExists(t, filepath.Join(dir, "var/cache/a"))
+
+	fi, err := os.Stat(filepath.Join(dir, "bin"))
+	must.NoError(t, err)
+	must.Eq(t, os.FileMode(0o755), fi.Mode().Perm())
+}
+
+func TestUnpack_PreservesExisting(t *testing.T) {
+	ci.Parallel(t)
+
+	dir := t.TempDir()
+	must.NoError(t, os.MkdirAll(filepath.Join(dir, "etc"), 0o755))
+	must.NoError(t, os.WriteFile(filepath.Join(dir, "etc/config"), []byte("template"), 0o644))
+	must.NoError(t, os.MkdirAll(filepath.Join(dir, "secrets"), 0o755))
+
+	img := testImage(t,
+		testLayer(t,
+			testEntry{Name: "etc/config", Content: "image"},
+			testEntry{Name: "secrets/token", Content: "image"},
+		),
+		testLayer(t,
+			testEntry{Name: "etc/.wh.config"},
+		),
+	)
+	must.NoError(t, Unpack(img, dir, []string{"secrets"}))
+
+	must.Eq(t, "template", readFile(t, filepath.Join(dir, "etc/config")))
+	must.FileNotExists(t, filepath.Join(dir, "secrets/token"))
+}
+
+func TestUnpack_SymlinkEscape(t *testing.T) {
+	ci.Parallel(t)
+
+	outside := t.TempDir()
+	img := testImage(t,
+		testLayer(t,
+			testEntry{Name: "escape", Link: outside},
+			testEntry{Name: "relative", Link: "../../../../../../" + outside},
+		),
+		testLayer(t,
+			testEntry{Name: "escape/file", Content: "x"},
+			testEntry{Name: "relative/file", Content: "x"},
+		),
+	)
+
+	dir := t.TempDir()
+	must.NoError(t, Unpack(img, dir, nil))
+
+	must.FileNotExists(t, filepath.Join(outside, "file"))
+	must.Eq(t, "x", readFile(t, filepath.Join(dir, outside, "file")))
+}
+
+func TestUnpack_UnsupportedMediaType(t *testing.T) {
+	ci.Parallel(t)
+
+	img := testImage(t, testLayer(t, testEntry{Name: "a", Content: "a"}))
+	img.Layers[0].MediaType = ocispec.MediaTypeImageLayerZstd
+
+	err := Unpack(img, t.TempDir(), nil)
+	must.ErrorContains(t, err, "unsupported layer media type")
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/nomad/plugins/base"
 	"github.com/hashicorp/nomad/plugins/device"
 	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/hashicorp/nomad/plugins/scorer"
 )
 
 var (
@@ -15,5 +16,6 @@ var (
 	AgentSupportedApiVersions = map[string][]string{
 		base.PluginTypeDevice: {device.ApiVersion010},
 		base.PluginTypeDriver: {drivers.ApiVersion010},
+		base.PluginTypeScorer: {scorer.ApiVersion010},
 	}
 )
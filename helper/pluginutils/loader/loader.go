@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/nomad/plugins/base"
 	"github.com/hashicorp/nomad/plugins/device"
 	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/hashicorp/nomad/plugins/scorer"
 	"github.com/hashicorp/nomad/plugins/shared/hclspec"
 )
 
@@ -275,6 +276,8 @@ func getPluginMap(pluginType string, logger log.Logger) map[string]plugin.Plugin
 		pmap[base.PluginTypeDevice] = &device.PluginDevice{}
 	case base.PluginTypeDriver:
 		pmap[base.PluginTypeDriver] = drivers.NewDriverPlugin(nil, logger)
+	case base.PluginTypeScorer:
+		pmap[base.PluginTypeScorer] = &scorer.PluginScorer{}
 	}
 
 	return pmap
@@ -71,4 +71,7 @@ var msgTypeNames = map[structs.MessageType]string{
 	structs.HostVolumeRegisterRequestType:                "HostVolumeRegisterRequestType",
 	structs.HostVolumeDeleteRequestType:                  "HostVolumeDeleteRequestType",
 	structs.TaskGroupHostVolumeClaimDeleteRequestType:    "TaskGroupHostVolumeClaimDeleteRequestType",
+	structs.ReservationUpsertRequestType:                 "ReservationUpsertRequestType",
+	structs.ReservationDeleteRequestType:                 "ReservationDeleteRequestType",
+	structs.EvalTraceUpsertRequestType:                   "EvalTraceUpsertRequestType",
 }
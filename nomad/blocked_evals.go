@@ -4,6 +4,7 @@
 package nomad
 
 import (
+	"maps"
 	"sync"
 	"time"
 
@@ -692,6 +693,7 @@ func (b *BlockedEvals) Flush() {
 	b.stats.TotalEscaped = 0
 	b.stats.TotalBlocked = 0
 	b.stats.TotalQuotaLimit = 0
+	b.stats.TotalBlockedByNamespace = make(map[string]int)
 	b.stats.BlockedResources = NewBlockedResourcesStats()
 	b.captured = make(map[string]wrappedEval)
 	b.escaped = make(map[string]wrappedEval)
@@ -716,6 +718,7 @@ func (b *BlockedEvals) Stats() *BlockedStats {
 	stats.TotalEscaped = b.stats.TotalEscaped
 	stats.TotalBlocked = b.stats.TotalBlocked
 	stats.TotalQuotaLimit = b.stats.TotalQuotaLimit
+	stats.TotalBlockedByNamespace = maps.Clone(b.stats.TotalBlockedByNamespace)
 	stats.BlockedResources = b.stats.BlockedResources.Copy()
 
 	return stats
@@ -736,6 +739,11 @@ func (b *BlockedEvals) EmitStats(period time.Duration, stopCh <-chan struct{}) {
 			metrics.SetGauge([]string{"nomad", "blocked_evals", "total_blocked"}, float32(stats.TotalBlocked))
 			metrics.SetGauge([]string{"nomad", "blocked_evals", "total_escaped"}, float32(stats.TotalEscaped))
 
+			for ns, n := range stats.TotalBlockedByNamespace {
+				metrics.SetGaugeWithLabels([]string{"nomad", "blocked_evals", "namespace", "total_blocked"},
+					float32(n), []metrics.Label{{Name: "namespace", Value: ns}})
+			}
+
 			for k, v := range stats.BlockedResources.ByJob {
 				labels := []metrics.Label{
 					{Name: "namespace", Value: k.Namespace},
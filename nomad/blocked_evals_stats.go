@@ -22,6 +22,10 @@ type BlockedStats struct {
 	// to the quota limit being reached.
 	TotalQuotaLimit int
 
+	// TotalBlockedByNamespace is the number of blocked evaluations in each
+	// namespace.
+	TotalBlockedByNamespace map[string]int
+
 	// BlockedResources stores the amount of resources requested by blocked
 	// evaluations.
 	BlockedResources *BlockedResourcesStats
@@ -36,7 +40,8 @@ type classInDC struct {
 // NewBlockedStats returns a new BlockedStats.
 func NewBlockedStats() *BlockedStats {
 	return &BlockedStats{
-		BlockedResources: NewBlockedResourcesStats(),
+		TotalBlockedByNamespace: make(map[string]int),
+		BlockedResources:        NewBlockedResourcesStats(),
 	}
 }
 
@@ -44,6 +49,7 @@ func NewBlockedStats() *BlockedStats {
 // evaluation being blocked.
 func (b *BlockedStats) Block(eval *structs.Evaluation) {
 	b.TotalBlocked++
+	b.TotalBlockedByNamespace[eval.Namespace]++
 	resourceStats := generateResourceStats(eval)
 	b.BlockedResources = b.BlockedResources.Add(resourceStats)
 }
@@ -52,6 +58,7 @@ func (b *BlockedStats) Block(eval *structs.Evaluation) {
 // evaluation being unblocked.
 func (b *BlockedStats) Unblock(eval *structs.Evaluation) {
 	b.TotalBlocked--
+	b.TotalBlockedByNamespace[eval.Namespace]--
 	resourceStats := generateResourceStats(eval)
 	b.BlockedResources = b.BlockedResources.Subtract(resourceStats)
 }
@@ -62,6 +69,12 @@ func (b *BlockedStats) prune(cutoff time.Time) {
 		return s.Timestamp.Before(cutoff) && s.IsZero()
 	}
 
+	for ns, n := range b.TotalBlockedByNamespace {
+		if n == 0 {
+			delete(b.TotalBlockedByNamespace, ns)
+		}
+	}
+
 	for k, v := range b.BlockedResources.ByJob {
 		if shouldPrune(v) {
 			delete(b.BlockedResources.ByJob, k)
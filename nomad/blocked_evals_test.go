@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
 	"github.com/shoenig/test/must"
+	"github.com/shoenig/test/wait"
 	"github.com/stretchr/testify/require"
 )
 
@@ -64,6 +65,35 @@ func TestBlockedEvals_Block_SameJob(t *testing.T) {
 	require.Len(blockedStats.BlockedResources.ByJob, 1)
 }
 
+func TestBlockedEvals_Block_Namespaces(t *testing.T) {
+	ci.Parallel(t)
+
+	blocked, _ := testBlockedEvals(t)
+
+	e := mock.BlockedEval()
+	e.ClassEligibility = map[string]bool{"v1:123": false}
+	e2 := mock.BlockedEval()
+	e2.ClassEligibility = map[string]bool{"v1:123": false}
+	e3 := mock.BlockedEval()
+	e3.Namespace = "other"
+	e3.EscapedComputedClass = true
+	blocked.Block(e)
+	blocked.Block(e2)
+	blocked.Block(e3)
+
+	must.Eq(t, map[string]int{
+		structs.DefaultNamespace: 2,
+		"other":                  1,
+	}, blocked.Stats().TotalBlockedByNamespace)
+
+	// Unblocking the escaped eval only affects its namespace.
+	blocked.Unblock("v1:123", 1000)
+	must.Wait(t, wait.InitialSuccess(wait.BoolFunc(func() bool {
+		return blocked.Stats().TotalBlockedByNamespace["other"] == 0
+	}), wait.Timeout(5*time.Second), wait.Gap(10*time.Millisecond)))
+	must.Eq(t, 2, blocked.Stats().TotalBlockedByNamespace[structs.DefaultNamespace])
+}
+
 func TestBlockedEvals_Block_Quota(t *testing.T) {
 	ci.Parallel(t)
 	require := require.New(t)
@@ -235,6 +265,39 @@ func TestBlockedEvals_UnblockEligible(t *testing.T) {
 	requireBlockedEvalsEnqueued(t, blocked, broker, 1)
 }
 
+func TestBlockedEvals_UnblockEligible_FairShare(t *testing.T) {
+	ci.Parallel(t)
+
+	blocked, broker := testBlockedEvals(t)
+	broker.SetFairShare(true, nil)
+
+	// The flood namespace blocks all its evals first.
+	index := uint64(0)
+	for _, namespace := range []string{"flood", "flood", "flood", "flood", "light", "light"} {
+		index++
+		e := mock.BlockedEval()
+		e.Namespace = namespace
+		e.CreateIndex = index
+		e.Status = structs.EvalStatusBlocked
+		e.ClassEligibility = map[string]bool{"v1:123": true}
+		blocked.Block(e)
+	}
+
+	// Unblocked evals are dequeued with the namespace weights, rather than in
+	// the order they were blocked.
+	blocked.Unblock("v1:123", 1000)
+	requireBlockedEvalsEnqueued(t, blocked, broker, 6)
+
+	var namespaces []string
+	for i := 0; i < 4; i++ {
+		out, _, err := broker.Dequeue(defaultSched, time.Second)
+		must.NoError(t, err)
+		must.NotNil(t, out)
+		namespaces = append(namespaces, out.Namespace)
+	}
+	must.Eq(t, []string{"flood", "light", "flood", "light"}, namespaces)
+}
+
 func TestBlockedEvals_UnblockIneligible(t *testing.T) {
 	ci.Parallel(t)
 	require := require.New(t)
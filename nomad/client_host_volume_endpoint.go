@@ -60,6 +60,42 @@ func (c *ClientHostVolume) Delete(args *cstructs.ClientHostVolumeDeleteRequest,
 	)
 }
 
+func (c *ClientHostVolume) CreateSnapshot(args *cstructs.ClientHostVolumeSnapshotCreateRequest, reply *cstructs.ClientHostVolumeSnapshotCreateResponse) error {
+	defer metrics.MeasureSince([]string{"nomad", "client_host_volume", "create_snapshot"}, time.Now())
+	return c.sendVolumeRPC(
+		args.NodeID,
+		"HostVolume.CreateSnapshot",
+		"ClientHostVolume.CreateSnapshot",
+		structs.RateMetricWrite,
+		args,
+		reply,
+	)
+}
+
+func (c *ClientHostVolume) ListSnapshots(args *cstructs.ClientHostVolumeSnapshotListRequest, reply *cstructs.ClientHostVolumeSnapshotListResponse) error {
+	defer metrics.MeasureSince([]string{"nomad", "client_host_volume", "list_snapshots"}, time.Now())
+	return c.sendVolumeRPC(
+		args.NodeID,
+		"HostVolume.ListSnapshots",
+		"ClientHostVolume.ListSnapshots",
+		structs.RateMetricRead,
+		args,
+		reply,
+	)
+}
+
+func (c *ClientHostVolume) DeleteSnapshot(args *cstructs.ClientHostVolumeSnapshotDeleteRequest, reply *cstructs.ClientHostVolumeSnapshotDeleteResponse) error {
+	defer metrics.MeasureSince([]string{"nomad", "client_host_volume", "delete_snapshot"}, time.Now())
+	return c.sendVolumeRPC(
+		args.NodeID,
+		"HostVolume.DeleteSnapshot",
+		"ClientHostVolume.DeleteSnapshot",
+		structs.RateMetricWrite,
+		args,
+		reply,
+	)
+}
+
 func (c *ClientHostVolume) sendVolumeRPC(nodeID, method, fwdMethod, op string, args any, reply any) error {
 	// client requests aren't RequestWithIdentity, so we use a placeholder here
 	// to populate the identity data for metrics
@@ -15,6 +15,7 @@ import (
 	log "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/memberlist"
 	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/helper/pluginutils/loader"
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/deploymentwatcher"
@@ -150,6 +151,14 @@ type Config struct {
 	// that the workers dequeue for processing.
 	EnabledSchedulers []string
 
+	// ScorerPlugins is the set of scorer plugins used by the scheduling
+	// workers of this server to rank nodes. The plugins are dispensed from
+	// the PluginLoader.
+	ScorerPlugins []string
+
+	// PluginLoader is used to load plugins.
+	PluginLoader loader.PluginCatalog
+
 	// ReconcileInterval controls how often we reconcile the strongly
 	// consistent store with the Serf info. This is used to handle nodes
 	// that are force removed, as well as intermittent unavailability during
@@ -212,6 +221,10 @@ type Config struct {
 	// one-time tokens.
 	OneTimeTokenGCInterval time.Duration
 
+	// ReservationGCInterval is how often we dispatch a job to GC
+	// reservations whose window has ended.
+	ReservationGCInterval time.Duration
+
 	// ACLTokenExpirationGCInterval is how often we dispatch a job to GC
 	// expired ACL tokens.
 	ACLTokenExpirationGCInterval time.Duration
@@ -236,6 +249,35 @@ type Config struct {
 	// rekey any variables associated with a key in the Rekeying state
 	VariablesRekeyInterval time.Duration
 
+	// NodePoolRebalanceEnabled controls whether the leader periodically
+	// dispatches a job to migrate allocations off lightly utilized nodes in
+	// order to reduce fragmentation within node pools.
+	NodePoolRebalanceEnabled bool
+
+	// NodePoolRebalanceInterval is how often we dispatch a job to rebalance
+	// node pools.
+	NodePoolRebalanceInterval time.Duration
+
+	// NodePoolRebalanceUtilizationThreshold is the normalized bin packing
+	// score, between 0 and 1, below which a node is considered a candidate to
+	// be emptied by the rebalancer.
+	NodePoolRebalanceUtilizationThreshold float64
+
+	// NodePoolRebalanceMaxAllocs is the maximum number of allocations that
+	// may be marked for migration by a single rebalance.
+	NodePoolRebalanceMaxAllocs int
+
+	// EvalFairShareEnabled controls whether ready evaluations are dequeued
+	// using weighted fair sharing across namespaces, so that a namespace with
+	// a large number of evaluations cannot starve the other namespaces.
+	// Evaluations of a higher priority are still dequeued first.
+	EvalFairShareEnabled bool
+
+	// EvalFairShareWeights is the weight of each namespace when
+	// EvalFairShareEnabled is set. Namespaces without a weight have a weight
+	// of 1.
+	EvalFairShareWeights map[string]int
+
 	// EvalNackTimeout controls how long we allow a sub-scheduler to
 	// work on an evaluation before we consider it failed and Nack it.
 	// This allows that evaluation to be handed to another sub-scheduler
@@ -459,6 +501,7 @@ func (c *Config) Copy() *Config {
 	nc.RaftConfig = pointer.Copy(c.RaftConfig)
 	nc.SerfConfig = pointer.Copy(c.SerfConfig)
 	nc.EnabledSchedulers = slices.Clone(c.EnabledSchedulers)
+	nc.ScorerPlugins = slices.Clone(c.ScorerPlugins)
 	nc.ConsulConfigs = helper.DeepCopyMap(c.ConsulConfigs)
 	nc.VaultConfigs = helper.DeepCopyMap(c.VaultConfigs)
 	nc.TLSConfig = c.TLSConfig.Copy()
@@ -590,6 +633,7 @@ func DefaultConfig() *Config {
 		CSIVolumeClaimGCInterval:         5 * time.Minute,
 		CSIVolumeClaimGCThreshold:        5 * time.Minute,
 		OneTimeTokenGCInterval:           10 * time.Minute,
+		ReservationGCInterval:            5 * time.Minute,
 		ACLTokenExpirationGCInterval:     5 * time.Minute,
 		ACLTokenExpirationGCThreshold:    1 * time.Hour,
 		RootKeyGCInterval:                10 * time.Minute,
@@ -647,6 +691,11 @@ func DefaultConfig() *Config {
 		JobTrackedVersions:       structs.JobDefaultTrackedVersions,
 	}
 
+	// Node pool rebalancing is disabled by default
+	c.NodePoolRebalanceInterval = 10 * time.Minute
+	c.NodePoolRebalanceMaxAllocs = 10
+	c.NodePoolRebalanceUtilizationThreshold = 0.25
+
 	// Enable all known schedulers by default
 	c.EnabledSchedulers = make([]string, 0, len(scheduler.BuiltinSchedulers))
 	for name := range scheduler.BuiltinSchedulers {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -79,6 +80,10 @@ func (c *CoreScheduler) Process(eval *structs.Evaluation) error {
 		return c.variablesRekey(eval)
 	case structs.CoreJobForceGC:
 		return c.forceGC(eval)
+	case structs.CoreJobNodePoolRebalance:
+		return c.nodePoolRebalance(eval)
+	case structs.CoreJobReservationGC:
+		return c.reservationGC(eval, time.Now())
 	default:
 		return fmt.Errorf("core scheduler cannot handle job '%s'", eval.JobID)
 	}
@@ -116,6 +121,9 @@ func (c *CoreScheduler) forceGC(eval *structs.Evaluation) error {
 	if err := c.rootKeyGC(eval, time.Now()); err != nil {
 		return err
 	}
+	if err := c.reservationGC(eval, time.Now()); err != nil {
+		return err
+	}
 
 	// Node GC must occur after the others to ensure the allocations are
 	// cleared.
@@ -970,6 +978,44 @@ func (c *CoreScheduler) expiredACLTokenGC(eval *structs.Evaluation, global bool,
 	return c.srv.RPC(structs.ACLDeleteTokensRPCMethod, req, &structs.GenericResponse{})
 }
 
+// reservationGC is used to garbage collect reservations whose window ended
+// before now. Deleting the reservations also unblocks the evaluations that
+// were waiting on the reserved capacity.
+func (c *CoreScheduler) reservationGC(eval *structs.Evaluation, now time.Time) error {
+	iter, err := c.snap.Reservations(nil, state.SortDefault)
+	if err != nil {
+		return err
+	}
+
+	// Reservations are deleted per namespace.
+	expired := make(map[string][]string)
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		res := raw.(*structs.Reservation)
+		if res.IsExpired(now) {
+			expired[res.Namespace] = append(expired[res.Namespace], res.Name)
+		}
+	}
+
+	for ns, names := range expired {
+		c.logger.Debug("reservation GC found eligible reservations",
+			"namespace", ns, "num", len(names))
+
+		req := &structs.ReservationDeleteRequest{
+			Names: names,
+			WriteRequest: structs.WriteRequest{
+				Region:    c.srv.Region(),
+				Namespace: ns,
+				AuthToken: eval.LeaderACL,
+			},
+		}
+		if err := c.srv.RPC(structs.ReservationDeleteRPCMethod, req, &structs.GenericResponse{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // rootKeyRotateOrGC is used to rotate or garbage collect root keys
 func (c *CoreScheduler) rootKeyRotateOrGC(eval *structs.Evaluation) error {
 
@@ -1360,3 +1406,430 @@ func (c *CoreScheduler) rotateVariables(iter memdb.ResultIterator, eval *structs
 func (c *CoreScheduler) getCutoffTime(configThreshold time.Duration) time.Time {
 	return time.Now().UTC().Add(-1 * configThreshold)
 }
+
+// rebalanceNode tracks the allocations and bin packing score of a node
+// considered by nodePoolRebalance, and whether it received allocations
+// virtually moved from another node.
+type rebalanceNode struct {
+	node     *structs.Node
+	allocs   []*structs.Allocation
+	score    float64
+	received bool
+}
+
+// rebalanceMove is an allocation selected for migration and the node it was
+// fit on, which the scheduler prefers when placing the replacement.
+type rebalanceMove struct {
+	alloc    *structs.Allocation
+	proposed *structs.Allocation
+	target   *rebalanceNode
+}
+
+// nodePoolRebalance is used to reduce fragmentation within node pools that
+// use the binpack scheduler algorithm. The scheduler only optimizes packing at
+// placement time, so over time allocations drift onto many lightly utilized
+// nodes. For each pool, nodes are scored with the same bin packing fitness
+// function used by the scheduler and the allocations of the least utilized
+// nodes are marked for migration when they can all be placed on the remaining
+// nodes. Placements are checked with the scheduler's own feasibility and bin
+// packing iterators, and the replacements are placed by the regular scheduler,
+// which prefers the node each allocation was fit on.
+//
+// Only allocations of service jobs are moved, and the migrate block's
+// max_parallel is honored the same way the node drainer does. The total number
+// of allocations migrated in a single pass is bounded by the server's
+// configured disruption budget.
+func (c *CoreScheduler) nodePoolRebalance(eval *structs.Evaluation) error {
+	ws := memdb.NewWatchSet()
+
+	_, schedConfig, err := c.snap.SchedulerConfig()
+	if err != nil {
+		return err
+	}
+
+	iter, err := c.snap.NodePools(ws, state.SortDefault)
+	if err != nil {
+		return err
+	}
+
+	budget := c.srv.config.NodePoolRebalanceMaxAllocs
+	migrating := make(map[string]int)
+	var migrate []*rebalanceMove
+
+	for raw := iter.Next(); raw != nil && budget > 0; raw = iter.Next() {
+		pool := raw.(*structs.NodePool)
+		if pool.Name == structs.NodePoolAll {
+			continue
+		}
+
+		poolConfig := schedConfig.WithNodePool(pool)
+		if poolConfig.EffectiveSchedulerAlgorithm() != structs.SchedulerAlgorithmBinpack {
+			continue
+		}
+
+		moves, err := c.rebalanceNodePool(ws, eval, pool.Name, poolConfig, migrating, budget)
+		if err != nil {
+			return err
+		}
+		budget -= len(moves)
+		migrate = append(migrate, moves...)
+	}
+
+	if len(migrate) == 0 {
+		return nil
+	}
+	return c.rebalanceMigrate(eval, migrate)
+}
+
+// rebalanceNodePool returns the allocations in the node pool that should be
+// migrated to improve packing, without exceeding the given budget. The
+// migrating map holds the number of allocations already selected for
+// migration per task group and is updated as allocations are selected.
+func (c *CoreScheduler) rebalanceNodePool(ws memdb.WatchSet, eval *structs.Evaluation,
+	pool string, schedConfig *structs.SchedulerConfiguration, migrating map[string]int,
+	budget int) ([]*rebalanceMove, error) {
+
+	iter, err := c.snap.NodesByNodePool(ws, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*rebalanceNode
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		node := raw.(*structs.Node)
+		if !node.Ready() {
+			continue
+		}
+
+		allocs, err := c.snap.AllocsByNodeTerminal(ws, node.ID, false)
+		if err != nil {
+			return nil, err
+		}
+
+		score, err := rebalanceScore(node, allocs)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, &rebalanceNode{
+			node:   node,
+			allocs: allocs,
+			score:  score,
+		})
+	}
+
+	if len(nodes) < 2 {
+		return nil, nil
+	}
+
+	// Consider the least utilized nodes first, as emptying them has the
+	// largest impact on packing.
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].score < nodes[j].score
+	})
+
+	var fragmentation float64
+	for _, n := range nodes {
+		fragmentation += 1 - n.score
+	}
+	fragmentation /= float64(len(nodes))
+	c.logger.Debug("scored node pool fragmentation",
+		"node_pool", pool, "nodes", len(nodes), "fragmentation", fragmentation)
+
+	threshold := c.srv.config.NodePoolRebalanceUtilizationThreshold
+
+	// The plan holds the virtual moves, so that later placements see the
+	// allocations moved off donors and onto targets as the scheduler would.
+	plan := &structs.Plan{
+		EvalID:          eval.ID,
+		NodeUpdate:      make(map[string][]*structs.Allocation),
+		NodeAllocation:  make(map[string][]*structs.Allocation),
+		NodePreemptions: make(map[string][]*structs.Allocation),
+	}
+
+	var out []*rebalanceMove
+	for i, donor := range nodes {
+		if donor.score >= threshold {
+			break
+		}
+
+		// Nodes that received allocations from an earlier donor are kept,
+		// otherwise the allocations moved onto them would be selected twice.
+		if donor.received {
+			continue
+		}
+
+		movable, ok := c.rebalanceMovableAllocs(ws, donor.allocs, migrating)
+		if !ok || len(movable) == 0 || len(movable) > budget-len(out) {
+			continue
+		}
+
+		// Only move the allocations if all of them can be placed on the more
+		// densely packed nodes, otherwise the donor node can't be emptied.
+		targets := nodes[i+1:]
+		moves := make([]*rebalanceMove, 0, len(movable))
+		fits := true
+		for _, alloc := range movable {
+			move, err := c.rebalanceFitAlloc(ws, schedConfig, plan, alloc, targets)
+			if err != nil {
+				return nil, err
+			}
+			if move == nil {
+				fits = false
+				break
+			}
+			moves = append(moves, move)
+		}
+		if !fits {
+			for _, move := range moves {
+				plan.RemoveUpdate(move.alloc)
+				plan.RemoveAlloc(move.proposed)
+			}
+			continue
+		}
+
+		for _, move := range moves {
+			target := move.target
+			target.allocs = append(target.allocs, move.proposed)
+			score, err := rebalanceScore(target.node, target.allocs)
+			if err != nil {
+				return nil, err
+			}
+			target.score = score
+			target.received = true
+			migrating[rebalanceGroupKey(move.alloc)]++
+		}
+		donor.allocs = nil
+		out = append(out, moves...)
+	}
+
+	return out, nil
+}
+
+// rebalanceMovableAllocs returns the allocations on a node that should be
+// migrated for the node to be emptied. Allocations of system jobs are ignored
+// as they run on every node, and batch allocations are never interrupted. The
+// boolean return is false if any allocation can't be moved, in which case the
+// node can't be emptied.
+func (c *CoreScheduler) rebalanceMovableAllocs(ws memdb.WatchSet,
+	allocs []*structs.Allocation, migrating map[string]int) ([]*structs.Allocation, bool) {
+
+	var movable []*structs.Allocation
+	for _, alloc := range allocs {
+		if alloc.Job == nil {
+			return nil, false
+		}
+		switch alloc.Job.Type {
+		case structs.JobTypeSystem, structs.JobTypeSysBatch:
+			continue
+		case structs.JobTypeService:
+		default:
+			return nil, false
+		}
+
+		if alloc.ClientStatus != structs.AllocClientStatusRunning ||
+			alloc.DesiredTransition.ShouldMigrate() {
+			return nil, false
+		}
+
+		tg := alloc.Job.LookupTaskGroup(alloc.TaskGroup)
+		if tg == nil {
+			return nil, false
+		}
+
+		// Service jobs are canonicalized with a migrate block, but fall back to
+		// the defaults for allocations of jobs that predate it.
+		maxParallel := structs.DefaultMigrateStrategy().MaxParallel
+		if tg.Migrate != nil {
+			maxParallel = tg.Migrate.MaxParallel
+		}
+
+		key := rebalanceGroupKey(alloc)
+		if _, ok := migrating[key]; !ok {
+			inflight, err := c.rebalanceInflight(ws, alloc)
+			if err != nil {
+				c.logger.Error("failed to count migrating allocations",
+					"job_id", alloc.JobID, "namespace", alloc.Namespace, "error", err)
+				return nil, false
+			}
+			migrating[key] = inflight
+		}
+
+		// Count the allocations already selected on this node against the
+		// group's max_parallel as well.
+		selected := 0
+		for _, m := range movable {
+			if rebalanceGroupKey(m) == key {
+				selected++
+			}
+		}
+		if migrating[key]+selected >= maxParallel {
+			return nil, false
+		}
+
+		movable = append(movable, alloc)
+	}
+
+	return movable, true
+}
+
+// rebalanceInflight returns the number of allocations of the alloc's task
+// group that are currently migrating or that have not yet started running.
+func (c *CoreScheduler) rebalanceInflight(ws memdb.WatchSet, alloc *structs.Allocation) (int, error) {
+	allocs, err := c.snap.AllocsByJob(ws, alloc.Namespace, alloc.JobID, false)
+	if err != nil {
+		return 0, err
+	}
+
+	inflight := 0
+	for _, a := range allocs {
+		if a.TaskGroup != alloc.TaskGroup || a.TerminalStatus() {
+			continue
+		}
+		if a.DesiredTransition.ShouldMigrate() || a.ClientStatus != structs.AllocClientStatusRunning {
+			inflight++
+		}
+	}
+	return inflight, nil
+}
+
+// rebalanceFitAlloc finds the most densely packed target node a replacement
+// for the allocation can be placed on, using the same feasibility checks and
+// bin packing as the scheduler. The move is recorded in the plan, and nil is
+// returned if the replacement can't be placed on any of the targets.
+func (c *CoreScheduler) rebalanceFitAlloc(ws memdb.WatchSet, schedConfig *structs.SchedulerConfiguration,
+	plan *structs.Plan, alloc *structs.Allocation, targets []*rebalanceNode) (*rebalanceMove, error) {
+
+	// The replacement is placed for the latest version of the job
+	job, err := c.snap.JobByID(ws, alloc.Namespace, alloc.JobID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil || job.Stopped() {
+		return nil, nil
+	}
+	tg := job.LookupTaskGroup(alloc.TaskGroup)
+	if tg == nil {
+		return nil, nil
+	}
+
+	// Stacks cache the job they were set up for, so each placement uses its
+	// own stack over the shared plan.
+	ctx := scheduler.NewEvalContext(nil, c.snap, plan, c.logger)
+	stack := scheduler.NewGenericStack(false, ctx)
+	stack.SetSchedulerConfiguration(schedConfig)
+	stack.SetJob(job)
+
+	// Stop the allocation first so it's discounted from the donor, as the
+	// scheduler does for migrations.
+	plan.AppendStoppedAlloc(alloc, "", "", "")
+
+	for i := len(targets) - 1; i >= 0; i-- {
+		target := targets[i]
+		if !target.node.IsInAnyDC(job.Datacenters) || !target.node.IsInPool(job.NodePool) {
+			continue
+		}
+
+		stack.SetNodes([]*structs.Node{target.node})
+		option := stack.Select(tg, &scheduler.SelectOptions{AllocName: alloc.Name})
+		if option == nil {
+			continue
+		}
+
+		resources := &structs.AllocatedResources{
+			Tasks:          option.TaskResources,
+			TaskLifecycles: option.TaskLifecycles,
+			Shared: structs.AllocatedSharedResources{
+				DiskMB:   int64(tg.EphemeralDisk.SizeMB),
+				DiskIOPS: int64(tg.EphemeralDisk.IOPS),
+			},
+		}
+		if option.AllocResources != nil {
+			resources.Shared.Networks = option.AllocResources.Networks
+			resources.Shared.Ports = option.AllocResources.Ports
+		}
+
+		proposed := &structs.Allocation{
+			ID:                 uuid.Generate(),
+			Namespace:          alloc.Namespace,
+			Name:               alloc.Name,
+			JobID:              alloc.JobID,
+			TaskGroup:          alloc.TaskGroup,
+			NodeID:             target.node.ID,
+			NodeName:           target.node.Name,
+			AllocatedResources: resources,
+			DesiredStatus:      structs.AllocDesiredStatusRun,
+			ClientStatus:       structs.AllocClientStatusPending,
+		}
+		plan.AppendAlloc(proposed, job)
+		return &rebalanceMove{alloc: alloc, proposed: proposed, target: target}, nil
+	}
+
+	plan.PopUpdate(alloc)
+	return nil, nil
+}
+
+// rebalanceScore returns the normalized bin packing score of a node running
+// the given allocations.
+func rebalanceScore(node *structs.Node, allocs []*structs.Allocation) (float64, error) {
+	_, _, used, err := structs.AllocsFit(node, allocs, nil, false)
+	if err != nil {
+		return 0, err
+	}
+	return structs.ScoreFitBinPack(node, used) / structs.BinPackingMaxFitScore, nil
+}
+
+// rebalanceGroupKey returns the key used to track the number of migrating
+// allocations per task group.
+func rebalanceGroupKey(alloc *structs.Allocation) string {
+	return fmt.Sprintf("%s/%s/%s", alloc.Namespace, alloc.JobID, alloc.TaskGroup)
+}
+
+// rebalanceMigrate marks the allocations for migration to the nodes they were
+// fit on and creates an evaluation for each of the affected jobs.
+func (c *CoreScheduler) rebalanceMigrate(eval *structs.Evaluation, moves []*rebalanceMove) error {
+	transitions := make(map[string]*structs.DesiredTransition, len(moves))
+	jobs := make(map[structs.NamespacedID]*structs.Allocation)
+	for _, move := range moves {
+		alloc := move.alloc
+		transitions[alloc.ID] = &structs.DesiredTransition{
+			Migrate:         pointer.Of(true),
+			RebalanceNodeID: move.target.node.ID,
+		}
+		jobs[alloc.JobNamespacedID()] = alloc
+	}
+
+	evals := make([]*structs.Evaluation, 0, len(jobs))
+	now := time.Now().UTC().UnixNano()
+	for _, alloc := range jobs {
+		evals = append(evals, &structs.Evaluation{
+			ID:          uuid.Generate(),
+			Namespace:   alloc.Namespace,
+			Priority:    alloc.Job.Priority,
+			Type:        alloc.Job.Type,
+			TriggeredBy: structs.EvalTriggerNodePoolRebalance,
+			JobID:       alloc.JobID,
+			Status:      structs.EvalStatusPending,
+			CreateTime:  now,
+			ModifyTime:  now,
+		})
+	}
+
+	req := structs.AllocUpdateDesiredTransitionRequest{
+		Allocs: transitions,
+		Evals:  evals,
+		WriteRequest: structs.WriteRequest{
+			Region:    c.srv.config.Region,
+			AuthToken: eval.LeaderACL,
+		},
+	}
+	var resp structs.GenericResponse
+	if err := c.srv.RPC("Alloc.UpdateDesiredTransition", &req, &resp); err != nil {
+		c.logger.Error("node pool rebalance failed", "error", err)
+		return err
+	}
+
+	c.logger.Info("migrating allocations to rebalance node pools",
+		"allocs", len(moves), "jobs", len(evals))
+	return nil
+}
@@ -3066,3 +3066,335 @@ func TestCoreScheduler_ExpiredACLTokenGC_Force(t *testing.T) {
 	tokens = fromIteratorFunc(iter)
 	must.SliceContainsAll(t, append(nonExpiredGlobalTokens, nonExpiredLocalTokens...), tokens)
 }
+
+func TestCoreScheduler_NodePoolRebalance(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+	store := s1.fsm.State()
+
+	// newAlloc returns a running alloc without networks, so allocs never
+	// collide on ports when checking if they fit on another node.
+	newAlloc := func(job *structs.Job, node *structs.Node) *structs.Allocation {
+		alloc := mock.AllocForNode(node)
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.ClientStatus = structs.AllocClientStatusRunning
+		alloc.AllocatedResources.Tasks["web"].Networks = nil
+		return alloc
+	}
+
+	donor := mock.Node()
+	target := mock.Node()
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1000, donor))
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1001, target))
+
+	job1 := mock.Job()
+	job1.TaskGroups[0].Count = 1
+	job2 := mock.Job()
+	must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1002, nil, job1))
+	must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1003, nil, job2))
+
+	moved := newAlloc(job1, donor)
+	stays := []*structs.Allocation{newAlloc(job2, target), newAlloc(job2, target)}
+	must.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, 1004,
+		append([]*structs.Allocation{moved}, stays...)))
+
+	snap, err := store.Snapshot()
+	must.NoError(t, err)
+	core := NewCoreScheduler(s1, snap)
+
+	eval := s1.coreJobEval(structs.CoreJobNodePoolRebalance, 2000)
+	must.NoError(t, core.Process(eval))
+
+	// The alloc on the lightly utilized node should be migrating to the node
+	// it was fit on.
+	out, err := store.AllocByID(nil, moved.ID)
+	must.NoError(t, err)
+	must.True(t, out.DesiredTransition.ShouldMigrate())
+	must.Eq(t, target.ID, out.DesiredTransition.RebalanceNodeID)
+
+	for _, alloc := range stays {
+		out, err := store.AllocByID(nil, alloc.ID)
+		must.NoError(t, err)
+		must.False(t, out.DesiredTransition.ShouldMigrate())
+	}
+
+	evals, err := store.EvalsByJob(nil, job1.Namespace, job1.ID)
+	must.NoError(t, err)
+	must.Len(t, 1, evals)
+	must.Eq(t, structs.EvalTriggerNodePoolRebalance, evals[0].TriggeredBy)
+
+	// The scheduler places the replacement on the target, which empties the
+	// donor node after a single round.
+	testutil.WaitForResult(func() (bool, error) {
+		allocs, err := store.AllocsByNodeTerminal(nil, donor.ID, false)
+		if err != nil {
+			return false, err
+		}
+		if len(allocs) != 0 {
+			return false, fmt.Errorf("expected donor to be empty, found %d allocs", len(allocs))
+		}
+		allocs, err = store.AllocsByNodeTerminal(nil, target.ID, false)
+		if err != nil {
+			return false, err
+		}
+		if len(allocs) != 3 {
+			return false, fmt.Errorf("expected 3 allocs on target, found %d", len(allocs))
+		}
+		return true, nil
+	}, func(err error) {
+		must.NoError(t, err)
+	})
+
+	// Running the rebalance again must not select more allocs from the same
+	// task group while the migration is in flight.
+	snap, err = store.Snapshot()
+	must.NoError(t, err)
+	core = NewCoreScheduler(s1, snap)
+	must.NoError(t, core.Process(s1.coreJobEval(structs.CoreJobNodePoolRebalance, 2001)))
+
+	evals, err = store.EvalsByJob(nil, job1.Namespace, job1.ID)
+	must.NoError(t, err)
+	must.Len(t, 1, evals)
+}
+
+func TestCoreScheduler_NodePoolRebalance_Receiver(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+	store := s1.fsm.State()
+
+	// Allow the whole group to migrate at once, so max_parallel doesn't
+	// prevent allocs from being selected twice.
+	job := mock.Job()
+	job.TaskGroups[0].Migrate.MaxParallel = 10
+	job.TaskGroups[0].Networks[0].ReservedPorts = []structs.Port{{Label: "admin", Value: 5000, HostNetwork: "default"}}
+	job.TaskGroups[0].Networks[0].DynamicPorts = []structs.Port{{Label: "http", HostNetwork: "default"}}
+	must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1000, nil, job))
+
+	newAlloc := func(node *structs.Node, networks bool) *structs.Allocation {
+		alloc := mock.AllocForNode(node)
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.ClientStatus = structs.AllocClientStatusRunning
+		if !networks {
+			alloc.AllocatedResources.Tasks["web"].Networks = nil
+		}
+		return alloc
+	}
+
+	// The alloc on the least utilized node reserves the same static port as
+	// an alloc on the most utilized node, so it can only be moved to the
+	// node in between.
+	donor := mock.Node()
+	receiver := mock.Node()
+	full := mock.Node()
+	for i, node := range []*structs.Node{donor, receiver, full} {
+		must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, uint64(1001+i), node))
+	}
+
+	moved := newAlloc(donor, true)
+	allocs := []*structs.Allocation{
+		moved,
+		newAlloc(receiver, false),
+		newAlloc(receiver, false),
+		newAlloc(full, true),
+		newAlloc(full, false),
+		newAlloc(full, false),
+	}
+	must.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, 1004, allocs))
+
+	snap, err := store.Snapshot()
+	must.NoError(t, err)
+	core := NewCoreScheduler(s1, snap).(*CoreScheduler)
+
+	_, schedConfig, err := snap.SchedulerConfig()
+	must.NoError(t, err)
+
+	// The node that received the alloc must not donate it again.
+	eval := s1.coreJobEval(structs.CoreJobNodePoolRebalance, 2000)
+	out, err := core.rebalanceNodePool(nil, eval, structs.NodePoolDefault, schedConfig, map[string]int{}, 10)
+	must.NoError(t, err)
+	must.Len(t, 1, out)
+	must.Eq(t, moved.ID, out[0].alloc.ID)
+	must.Eq(t, receiver.ID, out[0].target.node.ID)
+}
+
+func TestCoreScheduler_NodePoolRebalance_NoFit(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+	store := s1.fsm.State()
+
+	donor := mock.Node()
+	target := mock.Node()
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1000, donor))
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1001, target))
+
+	job := mock.Job()
+	job.TaskGroups[0].Networks[0].ReservedPorts = []structs.Port{{Label: "admin", Value: 5000, HostNetwork: "default"}}
+	job.TaskGroups[0].Networks[0].DynamicPorts = []structs.Port{{Label: "http", HostNetwork: "default"}}
+	must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1002, nil, job))
+
+	// Both allocs reserve the same static port, so the alloc on the donor
+	// node can't be moved to the target node.
+	alloc1 := mock.AllocForNode(donor)
+	alloc1.Job = job
+	alloc1.JobID = job.ID
+	alloc1.ClientStatus = structs.AllocClientStatusRunning
+	alloc2 := mock.AllocForNode(target)
+	alloc2.Job = job
+	alloc2.JobID = job.ID
+	alloc2.ClientStatus = structs.AllocClientStatusRunning
+	must.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, 1003,
+		[]*structs.Allocation{alloc1, alloc2}))
+
+	snap, err := store.Snapshot()
+	must.NoError(t, err)
+	core := NewCoreScheduler(s1, snap)
+	must.NoError(t, core.Process(s1.coreJobEval(structs.CoreJobNodePoolRebalance, 2000)))
+
+	for _, alloc := range []*structs.Allocation{alloc1, alloc2} {
+		out, err := store.AllocByID(nil, alloc.ID)
+		must.NoError(t, err)
+		must.False(t, out.DesiredTransition.ShouldMigrate())
+	}
+}
+
+func TestCoreScheduler_NodePoolRebalance_Infeasible(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+	store := s1.fsm.State()
+
+	// The target has room for the alloc, but doesn't satisfy the job's
+	// constraint, so the scheduler could never move the alloc there.
+	donor := mock.Node()
+	donor.Meta["rack"] = "r1"
+	target := mock.Node()
+	target.Meta["rack"] = "r2"
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1000, donor))
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1001, target))
+
+	job := mock.Job()
+	job.Constraints = append(job.Constraints, &structs.Constraint{
+		LTarget: "${meta.rack}",
+		RTarget: "r1",
+		Operand: "=",
+	})
+	other := mock.Job()
+	must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1002, nil, job))
+	must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1003, nil, other))
+
+	newAlloc := func(job *structs.Job, node *structs.Node) *structs.Allocation {
+		alloc := mock.AllocForNode(node)
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.ClientStatus = structs.AllocClientStatusRunning
+		alloc.AllocatedResources.Tasks["web"].Networks = nil
+		return alloc
+	}
+	alloc := newAlloc(job, donor)
+	must.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, 1004, []*structs.Allocation{
+		alloc, newAlloc(other, target), newAlloc(other, target),
+	}))
+
+	snap, err := store.Snapshot()
+	must.NoError(t, err)
+	core := NewCoreScheduler(s1, snap)
+	must.NoError(t, core.Process(s1.coreJobEval(structs.CoreJobNodePoolRebalance, 2000)))
+
+	out, err := store.AllocByID(nil, alloc.ID)
+	must.NoError(t, err)
+	must.False(t, out.DesiredTransition.ShouldMigrate())
+}
+
+func TestCoreScheduler_NodePoolRebalance_Batch(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+	store := s1.fsm.State()
+
+	donor := mock.Node()
+	target := mock.Node()
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1000, donor))
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1001, target))
+
+	// Batch allocs fit on the target node, but are never interrupted.
+	job := mock.BatchJob()
+	must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1002, nil, job))
+
+	alloc := mock.AllocForNode(donor)
+	alloc.Job = job
+	alloc.JobID = job.ID
+	alloc.TaskGroup = job.TaskGroups[0].Name
+	alloc.ClientStatus = structs.AllocClientStatusRunning
+	alloc.AllocatedResources.Tasks["web"].Networks = nil
+	must.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, 1003, []*structs.Allocation{alloc}))
+
+	snap, err := store.Snapshot()
+	must.NoError(t, err)
+	core := NewCoreScheduler(s1, snap)
+	must.NoError(t, core.Process(s1.coreJobEval(structs.CoreJobNodePoolRebalance, 2000)))
+
+	out, err := store.AllocByID(nil, alloc.ID)
+	must.NoError(t, err)
+	must.False(t, out.DesiredTransition.ShouldMigrate())
+}
+
+func TestCoreScheduler_ReservationGC(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, cleanupSRV := TestServer(t, nil)
+	defer cleanupSRV()
+	testutil.WaitForLeader(t, srv.RPC)
+
+	store := srv.fsm.State()
+	ns := mock.Namespace()
+	must.NoError(t, store.UpsertNamespaces(1000, []*structs.Namespace{ns}))
+
+	now := time.Now()
+
+	active := mock.Reservation()
+	pending := mock.Reservation()
+	pending.StartTime = now.Add(time.Hour)
+	pending.EndTime = now.Add(2 * time.Hour)
+	expired := mock.Reservation()
+	expired.StartTime = now.Add(-2 * time.Hour)
+	expired.EndTime = now.Add(-time.Hour)
+	expiredOtherNS := expired.Copy()
+	expiredOtherNS.Namespace = ns.Name
+
+	must.NoError(t, store.UpsertReservations(structs.MsgTypeTestSetup, 1001,
+		[]*structs.Reservation{active, pending, expired, expiredOtherNS}))
+
+	snap, err := store.Snapshot()
+	must.NoError(t, err)
+	core := NewCoreScheduler(srv, snap)
+
+	gc := srv.coreJobEval(structs.CoreJobReservationGC, 1002)
+	must.NoError(t, core.Process(gc))
+
+	// Only the expired reservations are deleted.
+	for _, res := range []*structs.Reservation{active, pending} {
+		out, err := store.ReservationByName(nil, res.Namespace, res.Name)
+		must.NoError(t, err)
+		must.NotNil(t, out)
+	}
+	for _, res := range []*structs.Reservation{expired, expiredOtherNS} {
+		out, err := store.ReservationByName(nil, res.Namespace, res.Name)
+		must.NoError(t, err)
+		must.Nil(t, out)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math/rand"
 	"strconv"
 	"sync"
@@ -77,6 +78,15 @@ type EvalBroker struct {
 	// ready tracks the ready jobs by scheduler in a priority queue
 	ready map[string]ReadyEvaluations
 
+	// fairShare enables weighted fair-share dequeueing of ready evaluations
+	// across namespaces. When enabled, ready evaluations are tracked in
+	// fairReady instead of ready.
+	fairShare        bool
+	fairShareWeights map[string]int
+
+	// fairReady tracks the ready jobs by scheduler in a fair-share queue
+	fairReady map[string]*fairShareQueue
+
 	// unack is a map of evalID to an un-acknowledged evaluation
 	unack map[string]*unackEval
 
@@ -158,6 +168,7 @@ func NewEvalBroker(ctx context.Context, timeout, initialNackDelay, subsequentNac
 		pending:              make(map[structs.NamespacedID]PendingEvaluations),
 		cancelable:           make([]*structs.Evaluation, 0, structs.MaxUUIDsPerWriteRequest),
 		ready:                make(map[string]ReadyEvaluations),
+		fairReady:            make(map[string]*fairShareQueue),
 		unack:                make(map[string]*unackEval),
 		waiting:              make(map[string]chan struct{}),
 		requeue:              make(map[string]*structs.Evaluation),
@@ -210,6 +221,35 @@ func (b *EvalBroker) SetEnabled(enabled bool) {
 	b.enabledNotifier.Notify("eval broker enabled status changed to " + strconv.FormatBool(enabled))
 }
 
+// SetFairShare is used to control if ready evaluations are dequeued using
+// weighted fair sharing across namespaces. The weights map a namespace to its
+// share of the dequeues relative to other namespaces, and namespaces without a
+// weight have a weight of 1. Evaluations that are already ready are moved to
+// the new queues.
+func (b *EvalBroker) SetFairShare(enabled bool, weights map[string]int) {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	ready := make(map[string][]*structs.Evaluation)
+	for sched, readyQueue := range b.ready {
+		ready[sched] = readyQueue
+	}
+	for sched, readyQueue := range b.fairReady {
+		ready[sched] = readyQueue.Evals()
+	}
+
+	b.fairShare = enabled
+	b.fairShareWeights = maps.Clone(weights)
+	b.ready = make(map[string]ReadyEvaluations)
+	b.fairReady = make(map[string]*fairShareQueue)
+
+	for sched, evals := range ready {
+		for _, eval := range evals {
+			b.pushReady(sched, eval)
+		}
+	}
+}
+
 // Enqueue is used to enqueue a new evaluation
 func (b *EvalBroker) Enqueue(eval *structs.Evaluation) {
 	b.l.Lock()
@@ -348,18 +388,8 @@ func (b *EvalBroker) enqueueLocked(eval *structs.Evaluation, sched string, track
 		return
 	}
 
-	// Find the next ready eval by scheduler class
-	readyQueue, ok := b.ready[sched]
-	if !ok {
-		readyQueue = make([]*structs.Evaluation, 0, 16)
-		if _, ok := b.waiting[sched]; !ok {
-			b.waiting[sched] = make(chan struct{}, 1)
-		}
-	}
-
-	// Push onto the heap
-	heap.Push(&readyQueue, eval)
-	b.ready[sched] = readyQueue
+	// Push onto the ready queue of the scheduler
+	b.pushReady(sched, eval)
 
 	// Update the stats
 	b.stats.TotalReady += 1
@@ -445,14 +475,8 @@ func (b *EvalBroker) scanForSchedulers(schedulers []string) (*structs.Evaluation
 	var eligibleSched []string
 	var eligiblePriority int
 	for _, sched := range schedulers {
-		// Get the ready queue for this scheduler
-		readyQueue, ok := b.ready[sched]
-		if !ok {
-			continue
-		}
-
 		// Peek at the next item
-		ready := readyQueue.Peek()
+		ready := b.peekReady(sched)
 		if ready == nil {
 			continue
 		}
@@ -491,10 +515,7 @@ func (b *EvalBroker) scanForSchedulers(schedulers []string) (*structs.Evaluation
 // dequeueForSched is used to dequeue the next work item for a given scheduler.
 // This assumes locks are held and that this scheduler has work
 func (b *EvalBroker) dequeueForSched(sched string) (*structs.Evaluation, string, error) {
-	readyQueue := b.ready[sched]
-	raw := heap.Pop(&readyQueue)
-	b.ready[sched] = readyQueue
-	eval := raw.(*structs.Evaluation)
+	eval := b.popReady(sched)
 
 	// Generate a UUID for the token
 	token := uuid.Generate()
@@ -524,6 +545,57 @@ func (b *EvalBroker) dequeueForSched(sched string) (*structs.Evaluation, string,
 	return eval, token, nil
 }
 
+// pushReady adds the evaluation to the ready queue of the scheduler. This
+// assumes locks are held.
+func (b *EvalBroker) pushReady(sched string, eval *structs.Evaluation) {
+	if _, ok := b.waiting[sched]; !ok {
+		b.waiting[sched] = make(chan struct{}, 1)
+	}
+
+	if b.fairShare {
+		readyQueue, ok := b.fairReady[sched]
+		if !ok {
+			readyQueue = newFairShareQueue(b.fairShareWeights)
+			b.fairReady[sched] = readyQueue
+		}
+		readyQueue.Push(eval)
+		return
+	}
+
+	readyQueue, ok := b.ready[sched]
+	if !ok {
+		readyQueue = make([]*structs.Evaluation, 0, 16)
+	}
+	heap.Push(&readyQueue, eval)
+	b.ready[sched] = readyQueue
+}
+
+// peekReady returns the next evaluation in the ready queue of the scheduler,
+// or nil if there is none. This assumes locks are held.
+func (b *EvalBroker) peekReady(sched string) *structs.Evaluation {
+	if b.fairShare {
+		readyQueue, ok := b.fairReady[sched]
+		if !ok {
+			return nil
+		}
+		return readyQueue.Peek()
+	}
+	return b.ready[sched].Peek()
+}
+
+// popReady removes the next evaluation from the ready queue of the
+// scheduler. This assumes locks are held and that this scheduler has work.
+func (b *EvalBroker) popReady(sched string) *structs.Evaluation {
+	if b.fairShare {
+		return b.fairReady[sched].Pop()
+	}
+
+	readyQueue := b.ready[sched]
+	raw := heap.Pop(&readyQueue)
+	b.ready[sched] = readyQueue
+	return raw.(*structs.Evaluation)
+}
+
 // waitForSchedulers is used to wait for work on any of the scheduler or until a timeout.
 // Returns if there is work waiting potentially.
 func (b *EvalBroker) waitForSchedulers(schedulers []string, timeoutCh <-chan time.Time) bool {
@@ -844,6 +916,7 @@ func (b *EvalBroker) flush() {
 	b.pending = make(map[structs.NamespacedID]PendingEvaluations)
 	b.cancelable = make([]*structs.Evaluation, 0, structs.MaxUUIDsPerWriteRequest)
 	b.ready = make(map[string]ReadyEvaluations)
+	b.fairReady = make(map[string]*fairShareQueue)
 	b.unack = make(map[string]*unackEval)
 	b.timeWait = make(map[string]*time.Timer)
 	b.delayHeap = delayheap.NewDelayHeap()
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"container/heap"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// fairShareQueue is the ready queue of a single scheduler when the broker is
+// configured for fair sharing. Evaluations are kept in a priority queue per
+// namespace, and dequeues are shared between the namespaces in proportion to
+// their weight using stride scheduling.
+//
+// Priority is always honored first: the next evaluation is taken from the
+// namespaces whose next evaluation has the highest priority. Among those, the
+// namespace that has received the least service relative to its weight wins.
+// This prevents a single namespace that enqueues a large number of
+// evaluations from starving the evaluations of other namespaces.
+type fairShareQueue struct {
+	// weights is the weight of each namespace. Namespaces without a weight
+	// have a weight of 1.
+	weights map[string]int
+
+	// namespaces tracks the ready evaluations of each namespace that has
+	// ready evaluations.
+	namespaces map[string]*namespaceReadyQueue
+
+	// vtime is the virtual time of the queue, which is the pass of the last
+	// namespace dequeued from. Namespaces that become ready start at the
+	// virtual time so they cannot accumulate credit while idle.
+	vtime float64
+}
+
+// namespaceReadyQueue is the queue of ready evaluations of a single namespace.
+type namespaceReadyQueue struct {
+	namespace string
+	ready     ReadyEvaluations
+
+	// pass is the amount of service the namespace has received, in units of
+	// dequeues divided by the namespace weight.
+	pass float64
+}
+
+func newFairShareQueue(weights map[string]int) *fairShareQueue {
+	return &fairShareQueue{
+		weights:    weights,
+		namespaces: make(map[string]*namespaceReadyQueue),
+	}
+}
+
+// weight returns the weight of the namespace.
+func (q *fairShareQueue) weight(namespace string) int {
+	if w := q.weights[namespace]; w > 0 {
+		return w
+	}
+	return 1
+}
+
+// Len returns the number of ready evaluations across all namespaces.
+func (q *fairShareQueue) Len() int {
+	n := 0
+	for _, nq := range q.namespaces {
+		n += len(nq.ready)
+	}
+	return n
+}
+
+// Push adds a ready evaluation to the queue of its namespace.
+func (q *fairShareQueue) Push(eval *structs.Evaluation) {
+	nq, ok := q.namespaces[eval.Namespace]
+	if !ok {
+		nq = &namespaceReadyQueue{
+			namespace: eval.Namespace,
+			ready:     make(ReadyEvaluations, 0, 16),
+			pass:      q.vtime,
+		}
+		q.namespaces[eval.Namespace] = nq
+	}
+	heap.Push(&nq.ready, eval)
+}
+
+// Peek returns the evaluation that would be returned by Pop.
+func (q *fairShareQueue) Peek() *structs.Evaluation {
+	nq := q.next()
+	if nq == nil {
+		return nil
+	}
+	return nq.ready.Peek()
+}
+
+// Pop removes and returns the next evaluation, charging its namespace for the
+// dequeue.
+func (q *fairShareQueue) Pop() *structs.Evaluation {
+	nq := q.next()
+	if nq == nil {
+		return nil
+	}
+
+	eval := heap.Pop(&nq.ready).(*structs.Evaluation)
+	q.vtime = nq.pass
+	nq.pass += 1 / float64(q.weight(nq.namespace))
+
+	if len(nq.ready) == 0 {
+		delete(q.namespaces, nq.namespace)
+	}
+	return eval
+}
+
+// Evals returns all the ready evaluations in the queue in no particular order.
+func (q *fairShareQueue) Evals() []*structs.Evaluation {
+	evals := make([]*structs.Evaluation, 0, q.Len())
+	for _, nq := range q.namespaces {
+		evals = append(evals, nq.ready...)
+	}
+	return evals
+}
+
+// next returns the namespace queue to dequeue from next.
+func (q *fairShareQueue) next() *namespaceReadyQueue {
+	var next *namespaceReadyQueue
+	for _, nq := range q.namespaces {
+		if next == nil || nq.before(next) {
+			next = nq
+		}
+	}
+	return next
+}
+
+// before returns whether the namespace queue should be dequeued from before
+// the other queue. Both queues must have ready evaluations.
+func (nq *namespaceReadyQueue) before(other *namespaceReadyQueue) bool {
+	head, otherHead := nq.ready.Peek(), other.ready.Peek()
+	switch {
+	case head.Priority != otherHead.Priority:
+		return head.Priority > otherHead.Priority
+	case nq.pass != other.pass:
+		return nq.pass < other.pass
+	case head.CreateIndex != otherHead.CreateIndex:
+		return head.CreateIndex < otherHead.CreateIndex
+	default:
+		return nq.namespace < other.namespace
+	}
+}
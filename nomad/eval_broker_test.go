@@ -752,6 +752,91 @@ func TestEvalBroker_Dequeue_Fairness(t *testing.T) {
 	}
 }
 
+// Ensure fair sharing between namespaces
+func TestEvalBroker_Dequeue_FairShare(t *testing.T) {
+	ci.Parallel(t)
+	b := testBroker(t, 0)
+	b.SetFairShare(true, map[string]int{"heavy": 2})
+	b.SetEnabled(true)
+
+	newEval := func(namespace string, priority int, index uint64) *structs.Evaluation {
+		eval := mock.Eval()
+		eval.Namespace = namespace
+		eval.Priority = priority
+		eval.CreateIndex = index
+		return eval
+	}
+
+	// The flood namespace enqueues all its evals first, so without fair
+	// sharing the other namespaces would have to wait for all of them.
+	index := uint64(0)
+	for i := 0; i < 6; i++ {
+		index++
+		b.Enqueue(newEval("flood", 50, index))
+	}
+	for i := 0; i < 4; i++ {
+		index++
+		b.Enqueue(newEval("heavy", 50, index))
+	}
+	for i := 0; i < 2; i++ {
+		index++
+		b.Enqueue(newEval("light", 50, index))
+	}
+
+	// A higher priority eval is always dequeued first.
+	index++
+	b.Enqueue(newEval("flood", 70, index))
+
+	var namespaces []string
+	for i := 0; i < 13; i++ {
+		out, _, err := b.Dequeue(defaultSched, time.Second)
+		must.NoError(t, err)
+		must.NotNil(t, out)
+		namespaces = append(namespaces, out.Namespace)
+	}
+
+	must.Eq(t, []string{
+		"flood",
+		"heavy", "light", "heavy", "flood",
+		"heavy", "light", "heavy", "flood",
+		"flood", "flood", "flood", "flood",
+	}, namespaces)
+}
+
+func TestEvalBroker_SetFairShare(t *testing.T) {
+	ci.Parallel(t)
+	b := testBroker(t, 0)
+	b.SetEnabled(true)
+
+	var evals []*structs.Evaluation
+	for i := 0; i < 3; i++ {
+		eval := mock.Eval()
+		eval.CreateIndex = uint64(i + 1)
+		evals = append(evals, eval)
+		b.Enqueue(eval)
+	}
+
+	// Ready evals are moved to the fair share queues and back.
+	b.SetFairShare(true, nil)
+	must.MapEmpty(t, b.ready)
+	must.Eq(t, 3, b.fairReady[structs.JobTypeService].Len())
+
+	out, _, err := b.Dequeue(defaultSched, time.Second)
+	must.NoError(t, err)
+	must.Eq(t, evals[0], out)
+
+	b.SetFairShare(false, nil)
+	must.MapEmpty(t, b.fairReady)
+	must.Len(t, 2, b.ready[structs.JobTypeService])
+
+	for _, expected := range evals[1:] {
+		out, _, err := b.Dequeue(defaultSched, time.Second)
+		must.NoError(t, err)
+		must.Eq(t, expected, out)
+	}
+	must.Eq(t, 0, b.Stats().TotalReady)
+}
+
 // Ensure we get unblocked
 func TestEvalBroker_Dequeue_Blocked(t *testing.T) {
 	ci.Parallel(t)
@@ -314,6 +314,94 @@ func (e *Eval) Update(args *structs.EvalUpdateRequest,
 	return nil
 }
 
+// UpsertTrace is used by scheduler workers to store the decision trace of
+// the evaluation they are processing.
+func (e *Eval) UpsertTrace(args *structs.EvalTraceUpsertRequest,
+	reply *structs.GenericResponse) error {
+
+	aclObj, err := e.srv.AuthenticateServerOnly(e.ctx, args)
+	e.srv.MeasureRPCRate("eval", structs.RateMetricWrite, args)
+	if err != nil || !aclObj.AllowServerOp() {
+		return structs.ErrPermissionDenied
+	}
+
+	if done, err := e.srv.forward(structs.EvalTraceUpsertRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "eval", "upsert_trace"}, time.Now())
+
+	if args.Trace == nil {
+		return fmt.Errorf("missing eval trace")
+	}
+
+	// Verify the evaluation is outstanding, and that the tokens match.
+	if err := e.srv.evalBroker.OutstandingReset(args.Trace.EvalID, args.EvalToken); err != nil {
+		return err
+	}
+
+	_, index, err := e.srv.raftApply(structs.EvalTraceUpsertRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// GetTrace is used to read the decision trace of an evaluation.
+func (e *Eval) GetTrace(args *structs.EvalTraceSpecificRequest,
+	reply *structs.SingleEvalTraceResponse) error {
+
+	authErr := e.srv.Authenticate(e.ctx, args)
+	if done, err := e.srv.forward(structs.EvalTraceGetRPCMethod, args, args, reply); done {
+		return err
+	}
+	e.srv.MeasureRPCRate("eval", structs.RateMetricRead, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "eval", "get_trace"}, time.Now())
+
+	// Check for read-job permissions before performing blocking query.
+	allowNsOp := acl.NamespaceValidator(acl.NamespaceCapabilityReadJob)
+	aclObj, err := e.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	} else if !allowNsOp(aclObj, args.RequestNamespace()) {
+		return structs.ErrPermissionDenied
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, store *state.StateStore) error {
+			trace, err := store.EvalTraceByID(ws, args.EvalID)
+			if err != nil {
+				return fmt.Errorf("failed to lookup eval trace: %v", err)
+			}
+
+			// Re-check namespace in case it differs from request.
+			if trace != nil && !allowNsOp(aclObj, trace.Namespace) {
+				return structs.ErrPermissionDenied
+			}
+
+			reply.Trace = trace
+			if trace != nil {
+				reply.Index = trace.ModifyIndex
+			} else {
+				index, err := store.Index(state.TableEvalTraces)
+				if err != nil {
+					return err
+				}
+				reply.Index = index
+			}
+
+			e.srv.setQueryMeta(&reply.QueryMeta)
+			return nil
+		}}
+	return e.srv.blockingRPC(&opts)
+}
+
 // Create is used to make a new evaluation
 func (e *Eval) Create(args *structs.EvalUpdateRequest,
 	reply *structs.GenericResponse) error {
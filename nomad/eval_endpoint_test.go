@@ -630,6 +630,64 @@ func TestEvalEndpoint_Update(t *testing.T) {
 	}
 }
 
+func TestEvalEndpoint_Trace(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+
+	testutil.WaitForResult(func() (bool, error) {
+		return s1.evalBroker.Enabled(), nil
+	}, func(err error) {
+		t.Fatalf("should enable eval broker")
+	})
+
+	eval := mock.Eval()
+	must.NoError(t, s1.fsm.State().UpsertEvals(structs.MsgTypeTestSetup, 1000, []*structs.Evaluation{eval}))
+	s1.evalBroker.Enqueue(eval)
+	out, token, err := s1.evalBroker.Dequeue(defaultSched, time.Second)
+	must.NoError(t, err)
+	must.NotNil(t, out)
+
+	recorder := structs.NewEvalTraceRecorder(eval)
+	recorder.SetPlacement("web", "example.web[0]")
+	recorder.Filtered(mock.Node(), "missing drivers")
+
+	// Only the worker holding the eval token can write the trace.
+	upsert := &structs.EvalTraceUpsertRequest{
+		Trace:        recorder.Trace(),
+		EvalToken:    "wrong",
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var upsertResp structs.GenericResponse
+	err = msgpackrpc.CallWithCodec(codec, structs.EvalTraceUpsertRPCMethod, upsert, &upsertResp)
+	must.ErrorContains(t, err, "token does not match")
+
+	upsert.EvalToken = token
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.EvalTraceUpsertRPCMethod, upsert, &upsertResp))
+	must.NonZero(t, upsertResp.Index)
+
+	get := &structs.EvalTraceSpecificRequest{
+		EvalID: eval.ID,
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			Namespace: eval.Namespace,
+		},
+	}
+	var getResp structs.SingleEvalTraceResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.EvalTraceGetRPCMethod, get, &getResp))
+	must.NotNil(t, getResp.Trace)
+	must.Eq(t, upsertResp.Index, getResp.Index)
+	must.Len(t, 1, getResp.Trace.Events)
+	must.Eq(t, "missing drivers", getResp.Trace.Events[0].Reason)
+
+	// Unknown evals have no trace.
+	get.EvalID = uuid.Generate()
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.EvalTraceGetRPCMethod, get, &getResp))
+	must.Nil(t, getResp.Trace)
+}
+
 func TestEvalEndpoint_Create(t *testing.T) {
 	ci.Parallel(t)
 
@@ -58,6 +58,8 @@ const (
 	JobSubmissionSnapshot                SnapshotType = 29
 	RootKeySnapshot                      SnapshotType = 30
 	HostVolumeSnapshot                   SnapshotType = 31
+	ReservationSnapshot                  SnapshotType = 32
+	EvalTraceSnapshot                    SnapshotType = 33
 
 	// TimeTableSnapshot
 	// Deprecated: Nomad no longer supports TimeTable snapshots since 1.9.2
@@ -109,6 +111,8 @@ var snapshotTypeStrings = map[SnapshotType]string{
 	JobSubmissionSnapshot:                "JobSubmission",
 	RootKeySnapshot:                      "WrappedRootKeys",
 	HostVolumeSnapshot:                   "HostVolumeSnapshot",
+	ReservationSnapshot:                  "Reservation",
+	EvalTraceSnapshot:                    "EvalTrace",
 	NamespaceSnapshot:                    "Namespace",
 }
 
@@ -396,6 +400,12 @@ func (n *nomadFSM) Apply(log *raft.Log) interface{} {
 		return n.applyHostVolumeDelete(msgType, buf[1:], log.Index)
 	case structs.TaskGroupHostVolumeClaimDeleteRequestType:
 		return n.applyTaskGroupHostVolumeClaimDelete(buf[1:], log.Index)
+	case structs.ReservationUpsertRequestType:
+		return n.applyReservationUpsert(msgType, buf[1:], log.Index)
+	case structs.ReservationDeleteRequestType:
+		return n.applyReservationDelete(msgType, buf[1:], log.Index)
+	case structs.EvalTraceUpsertRequestType:
+		return n.applyEvalTraceUpsert(msgType, buf[1:], log.Index)
 	}
 
 	// Check enterprise only message types.
@@ -1892,6 +1902,28 @@ func (n *nomadFSM) restoreImpl(old io.ReadCloser, filter *FSMFilter) error {
 				}
 			}
 
+		case ReservationSnapshot:
+			res := new(structs.Reservation)
+			if err := dec.Decode(res); err != nil {
+				return err
+			}
+			if filter.Include(res) {
+				if err := restore.ReservationRestore(res); err != nil {
+					return err
+				}
+			}
+
+		case EvalTraceSnapshot:
+			trace := new(structs.EvalTrace)
+			if err := dec.Decode(trace); err != nil {
+				return err
+			}
+			if filter.Include(trace) {
+				if err := restore.EvalTraceRestore(trace); err != nil {
+					return err
+				}
+			}
+
 		default:
 			// Check if this is an enterprise only object being restored
 			restorer, ok := n.enterpriseRestorers[snapType]
@@ -2390,6 +2422,124 @@ func (n *nomadFSM) applyHostVolumeDelete(msgType structs.MessageType, buf []byte
 	return nil
 }
 
+func (n *nomadFSM) applyReservationUpsert(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_reservation_upsert"}, time.Now())
+
+	var req structs.ReservationUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	// Look up the reservations before they are updated so we know which nodes
+	// had capacity held by their previous version.
+	var changed []*structs.Reservation
+	for _, res := range req.Reservations {
+		existing, err := n.state.ReservationByName(nil, res.Namespace, res.Name)
+		if err != nil {
+			n.logger.Error("looking up reservation failed", "reservation", res.Name, "error", err)
+			return err
+		}
+		if existing != nil {
+			changed = append(changed, existing)
+		}
+	}
+
+	if err := n.state.UpsertReservations(msgType, index, req.Reservations); err != nil {
+		n.logger.Error("UpsertReservations failed", "error", err)
+		return err
+	}
+
+	// Unblock evals for the nodes of both versions of the reservations, since
+	// modifying a reservation may release capacity and jobs referencing a new
+	// reservation may now use its capacity.
+	changed = append(changed, req.Reservations...)
+	if err := n.unblockReservationNodes(changed, index); err != nil {
+		n.logger.Error("unblocking evals failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (n *nomadFSM) applyEvalTraceUpsert(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_eval_trace_upsert"}, time.Now())
+
+	var req structs.EvalTraceUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertEvalTrace(msgType, index, req.Trace); err != nil {
+		n.logger.Error("UpsertEvalTrace failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (n *nomadFSM) applyReservationDelete(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_reservation_delete"}, time.Now())
+
+	var req structs.ReservationDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	// Look up the reservations before they are deleted so we know which nodes
+	// had capacity released.
+	var released []*structs.Reservation
+	for _, name := range req.Names {
+		res, err := n.state.ReservationByName(nil, req.RequestNamespace(), name)
+		if err != nil {
+			n.logger.Error("looking up reservation failed", "reservation", name, "error", err)
+			return err
+		}
+		if res != nil {
+			released = append(released, res)
+		}
+	}
+
+	if err := n.state.DeleteReservations(msgType, index, req.RequestNamespace(), req.Names); err != nil {
+		n.logger.Error("DeleteReservations failed", "error", err)
+		return err
+	}
+
+	// Unblock evals for the nodes that had reserved capacity, since that
+	// capacity is now available to every job.
+	if err := n.unblockReservationNodes(released, index); err != nil {
+		n.logger.Error("unblocking evals failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// unblockReservationNodes unblocks the evals for the computed node classes of
+// the nodes targeted by the reservations.
+func (n *nomadFSM) unblockReservationNodes(reservations []*structs.Reservation, index uint64) error {
+	if len(reservations) == 0 {
+		return nil
+	}
+
+	iter, err := n.state.Nodes(nil)
+	if err != nil {
+		return fmt.Errorf("looking up nodes failed: %w", err)
+	}
+
+	unblocked := make(map[string]struct{})
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		node := raw.(*structs.Node)
+		if _, ok := unblocked[node.ComputedClass]; ok {
+			continue
+		}
+		for _, res := range reservations {
+			if res.MatchesNode(node) {
+				n.blockedEvals.Unblock(node.ComputedClass, index)
+				unblocked[node.ComputedClass] = struct{}{}
+				break
+			}
+		}
+	}
+	return nil
+}
+
 func (n *nomadFSM) applyTaskGroupHostVolumeClaimDelete(buf []byte, index uint64) interface{} {
 	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_task_group_host_volume_claim_delete"}, time.Now())
 
@@ -2534,6 +2684,14 @@ func (s *nomadSnapshot) Persist(sink raft.SnapshotSink) error {
 		sink.Cancel()
 		return err
 	}
+	if err := s.persistReservations(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
+	if err := s.persistEvalTraces(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
 	return nil
 }
 
@@ -3245,6 +3403,38 @@ func (s *nomadSnapshot) persistHostVolumes(sink raft.SnapshotSink, encoder *code
 	return nil
 }
 
+func (s *nomadSnapshot) persistReservations(sink raft.SnapshotSink, encoder *codec.Encoder) error {
+	iter, err := s.snap.Reservations(nil, state.SortDefault)
+	if err != nil {
+		return err
+	}
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		res := raw.(*structs.Reservation)
+
+		sink.Write([]byte{byte(ReservationSnapshot)})
+		if err := encoder.Encode(res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *nomadSnapshot) persistEvalTraces(sink raft.SnapshotSink, encoder *codec.Encoder) error {
+	iter, err := s.snap.EvalTraces(nil)
+	if err != nil {
+		return err
+	}
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		trace := raw.(*structs.EvalTrace)
+
+		sink.Write([]byte{byte(EvalTraceSnapshot)})
+		if err := encoder.Encode(trace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Release is a no-op, as we just need to GC the pointer
 // to the state store snapshot. There is nothing to explicitly
 // cleanup.
@@ -668,6 +668,77 @@ func TestFSM_NodePoolDelete(t *testing.T) {
 	}
 }
 
+func TestFSM_ReservationUpsertDelete(t *testing.T) {
+	ci.Parallel(t)
+	fsm := testFSM(t)
+	fsm.blockedEvals.SetEnabled(true)
+
+	node := mock.Node()
+	must.NoError(t, fsm.State().UpsertNode(structs.MsgTypeTestSetup, 1000, node))
+
+	// Create a reservation.
+	res := mock.Reservation()
+	buf, err := structs.Encode(structs.ReservationUpsertRequestType,
+		structs.ReservationUpsertRequest{Reservations: []*structs.Reservation{res}})
+	must.NoError(t, err)
+	must.Nil(t, fsm.Apply(makeLog(buf)))
+
+	got, err := fsm.State().ReservationByName(nil, res.Namespace, res.Name)
+	must.NoError(t, err)
+	must.NotNil(t, got)
+
+	waitUnblocked := func() {
+		testutil.WaitForResult(func() (bool, error) {
+			bStats := fsm.blockedEvals.Stats()
+			if bStats.TotalBlocked != 0 {
+				return false, fmt.Errorf("bad: %#v", bStats)
+			}
+			return true, nil
+		}, func(err error) {
+			t.Fatalf("err: %s", err)
+		})
+	}
+
+	// Mark an eval as blocked.
+	eval := mock.Eval()
+	eval.ClassEligibility = map[string]bool{node.ComputedClass: true}
+	fsm.blockedEvals.Block(eval)
+
+	// Modify the reservation and verify the eval was unblocked since the
+	// node may have had reserved capacity released.
+	res = res.Copy()
+	res.Resources.MemoryMB = 256
+	buf, err = structs.Encode(structs.ReservationUpsertRequestType,
+		structs.ReservationUpsertRequest{Reservations: []*structs.Reservation{res}})
+	must.NoError(t, err)
+	must.Nil(t, fsm.Apply(makeLog(buf)))
+	waitUnblocked()
+
+	// Mark another eval as blocked.
+	eval = mock.Eval()
+	eval.ClassEligibility = map[string]bool{node.ComputedClass: true}
+	fsm.blockedEvals.Block(eval)
+
+	// Delete the reservation.
+	req := structs.ReservationDeleteRequest{
+		Names: []string{res.Name},
+		WriteRequest: structs.WriteRequest{
+			Namespace: res.Namespace,
+		},
+	}
+	buf, err = structs.Encode(structs.ReservationDeleteRequestType, req)
+	must.NoError(t, err)
+	must.Nil(t, fsm.Apply(makeLog(buf)))
+
+	got, err = fsm.State().ReservationByName(nil, res.Namespace, res.Name)
+	must.NoError(t, err)
+	must.Nil(t, got)
+
+	// Verify the eval was unblocked since the node had reserved capacity
+	// released.
+	waitUnblocked()
+}
+
 func TestFSM_NodePoolUpsert(t *testing.T) {
 	ci.Parallel(t)
 
@@ -2273,6 +2344,52 @@ func TestFSM_SnapshotRestore_NodePools(t *testing.T) {
 	must.Eq(t, pool, out)
 }
 
+func TestFSM_SnapshotRestore_Reservations(t *testing.T) {
+	ci.Parallel(t)
+
+	// Add some state
+	fsm := testFSM(t)
+	state := fsm.State()
+	res := mock.Reservation()
+	must.NoError(t, state.UpsertReservations(structs.MsgTypeTestSetup, 1000,
+		[]*structs.Reservation{res}))
+
+	// Verify the contents
+	fsm2 := testSnapshotRestore(t, fsm)
+	state2 := fsm2.State()
+	out, err := state2.ReservationByName(nil, res.Namespace, res.Name)
+	must.NoError(t, err)
+	must.Eq(t, res, out)
+}
+
+func TestFSM_SnapshotRestore_EvalTraces(t *testing.T) {
+	ci.Parallel(t)
+
+	// Add some state
+	fsm := testFSM(t)
+	state := fsm.State()
+	eval := mock.Eval()
+	must.NoError(t, state.UpsertEvals(structs.MsgTypeTestSetup, 1000, []*structs.Evaluation{eval}))
+
+	recorder := structs.NewEvalTraceRecorder(eval)
+	recorder.Filtered(mock.Node(), "missing drivers")
+	buf, err := structs.Encode(structs.EvalTraceUpsertRequestType,
+		structs.EvalTraceUpsertRequest{Trace: recorder.Trace()})
+	must.NoError(t, err)
+	must.Nil(t, fsm.Apply(makeLog(buf)))
+
+	trace, err := state.EvalTraceByID(nil, eval.ID)
+	must.NoError(t, err)
+	must.NotNil(t, trace)
+
+	// Verify the contents
+	fsm2 := testSnapshotRestore(t, fsm)
+	state2 := fsm2.State()
+	out, err := state2.EvalTraceByID(nil, eval.ID)
+	must.NoError(t, err)
+	must.Eq(t, trace, out)
+}
+
 func TestFSM_SnapshotRestore_Jobs(t *testing.T) {
 	ci.Parallel(t)
 	// Add some state
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/nomad/acl"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/state"
 	"github.com/hashicorp/nomad/nomad/state/paginator"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -221,6 +222,15 @@ func (v *HostVolume) Create(args *structs.HostVolumeCreateRequest, reply *struct
 		return err
 	}
 
+	// a cloned volume is created by the plugin of its source volume, on the
+	// same node
+	if existing == nil && vol.SourceVolumeID != "" {
+		err = v.validateVolumeSource(vol, snap)
+		if err != nil {
+			return fmt.Errorf("cannot clone volume %q: %w", vol.SourceVolumeID, err)
+		}
+	}
+
 	// set zero values as needed, possibly from existing
 	now := time.Now()
 	vol.CanonicalizeForCreate(existing, now)
@@ -319,6 +329,9 @@ func (v *HostVolume) Register(args *structs.HostVolumeRegisterRequest, reply *st
 	if vol.HostPath == "" {
 		return errors.New("cannot register volume: host path is required")
 	}
+	if vol.SourceVolumeID != "" || vol.SnapshotID != "" {
+		return errors.New("cannot register volume: only created volumes can be cloned")
+	}
 
 	existing, err := v.validateVolumeUpdate(vol, snap)
 	if err != nil {
@@ -403,6 +416,32 @@ func (v *HostVolume) validateVolumeUpdate(
 	return existing, nil
 }
 
+// validateVolumeSource ensures that the source of a cloned volume exists, and
+// sets the node and plugin of the volume from it.
+func (v *HostVolume) validateVolumeSource(vol *structs.HostVolume, snap *state.StateSnapshot) error {
+	source, err := snap.HostVolumeByID(nil, vol.Namespace, vol.SourceVolumeID, false)
+	if err != nil {
+		return err // should never hit, bail out
+	}
+	if source == nil {
+		return errors.New("source volume does not exist")
+	}
+	if source.PluginID == "" {
+		return errors.New("source volume has no plugin")
+	}
+	if vol.NodeID != "" && vol.NodeID != source.NodeID {
+		return fmt.Errorf("node ID %q does not match node %q of source volume",
+			vol.NodeID, source.NodeID)
+	}
+	if vol.PluginID != "" && vol.PluginID != source.PluginID {
+		return fmt.Errorf("plugin ID %q does not match plugin %q of source volume",
+			vol.PluginID, source.PluginID)
+	}
+	vol.NodeID = source.NodeID
+	vol.PluginID = source.PluginID
+	return nil
+}
+
 // validateVolumeForState ensures that any references to node IDs or node pools are valid
 func (v *HostVolume) validateVolumeForState(vol *structs.HostVolume, snap *state.StateSnapshot) error {
 	var poolFromExistingNode string
@@ -445,6 +484,8 @@ func (v *HostVolume) createVolume(vol *structs.HostVolume) error {
 		RequestedCapacityMinBytes: vol.RequestedCapacityMinBytes,
 		RequestedCapacityMaxBytes: vol.RequestedCapacityMaxBytes,
 		Parameters:                vol.Parameters,
+		SourceVolumeID:            vol.SourceVolumeID,
+		SnapshotID:                vol.SnapshotID,
 	}
 	cResp := &cstructs.ClientHostVolumeCreateResponse{}
 	err := v.srv.RPC(method, cReq, cResp)
@@ -689,6 +730,174 @@ func (v *HostVolume) deleteVolume(vol *structs.HostVolume) error {
 	return nil
 }
 
+func (v *HostVolume) CreateSnapshot(args *structs.HostVolumeSnapshotCreateRequest, reply *structs.HostVolumeSnapshotCreateResponse) error {
+
+	authErr := v.srv.Authenticate(v.ctx, args)
+	if done, err := v.srv.forward("HostVolume.CreateSnapshot", args, args, reply); done {
+		return err
+	}
+	v.srv.MeasureRPCRate("host_volume", structs.RateMetricWrite, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "host_volume", "create_snapshot"}, time.Now())
+
+	allowVolume := acl.NamespaceValidator(acl.NamespaceCapabilityHostVolumeCreate)
+	aclObj, err := v.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+	if !allowVolume(aclObj, args.RequestNamespace()) {
+		return structs.ErrPermissionDenied
+	}
+
+	vol, err := v.snapshotVolume(args.RequestNamespace(), args.VolumeID)
+	if err != nil {
+		return err
+	}
+
+	cReq := &cstructs.ClientHostVolumeSnapshotCreateRequest{
+		ID:                 uuid.Generate(),
+		Name:               args.Name,
+		VolumeID:           vol.ID,
+		VolumeName:         vol.Name,
+		PluginID:           vol.PluginID,
+		Namespace:          vol.Namespace,
+		NodeID:             vol.NodeID,
+		HostPath:           vol.HostPath,
+		Parameters:         vol.Parameters,
+		SnapshotParameters: args.Parameters,
+	}
+	cResp := &cstructs.ClientHostVolumeSnapshotCreateResponse{}
+
+	// serialize client RPC per volume ID, so that snapshots don't race with
+	// updates or deletes of the volume
+	_, err = v.serializeCall(vol.ID, "create-snapshot", func() (uint64, error) {
+		return 0, v.srv.RPC("ClientHostVolume.CreateSnapshot", cReq, cResp)
+	})
+	if err != nil {
+		return err
+	}
+
+	reply.Snapshot = cResp.Snapshot
+	return nil
+}
+
+func (v *HostVolume) ListSnapshots(args *structs.HostVolumeSnapshotListRequest, reply *structs.HostVolumeSnapshotListResponse) error {
+
+	authErr := v.srv.Authenticate(v.ctx, args)
+	if done, err := v.srv.forward("HostVolume.ListSnapshots", args, args, reply); done {
+		return err
+	}
+	v.srv.MeasureRPCRate("host_volume", structs.RateMetricList, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "host_volume", "list_snapshots"}, time.Now())
+
+	allowVolume := acl.NamespaceValidator(acl.NamespaceCapabilityHostVolumeRead)
+	aclObj, err := v.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+	if !allowVolume(aclObj, args.RequestNamespace()) {
+		return structs.ErrPermissionDenied
+	}
+
+	vol, err := v.snapshotVolume(args.RequestNamespace(), args.VolumeID)
+	if err != nil {
+		return err
+	}
+
+	cReq := &cstructs.ClientHostVolumeSnapshotListRequest{
+		VolumeID:   vol.ID,
+		VolumeName: vol.Name,
+		PluginID:   vol.PluginID,
+		Namespace:  vol.Namespace,
+		NodeID:     vol.NodeID,
+		HostPath:   vol.HostPath,
+		Parameters: vol.Parameters,
+	}
+	cResp := &cstructs.ClientHostVolumeSnapshotListResponse{}
+	err = v.srv.RPC("ClientHostVolume.ListSnapshots", cReq, cResp)
+	if err != nil {
+		return err
+	}
+
+	reply.Snapshots = cResp.Snapshots
+	return nil
+}
+
+func (v *HostVolume) DeleteSnapshot(args *structs.HostVolumeSnapshotDeleteRequest, reply *structs.HostVolumeSnapshotDeleteResponse) error {
+
+	authErr := v.srv.Authenticate(v.ctx, args)
+	if done, err := v.srv.forward("HostVolume.DeleteSnapshot", args, args, reply); done {
+		return err
+	}
+	v.srv.MeasureRPCRate("host_volume", structs.RateMetricWrite, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "host_volume", "delete_snapshot"}, time.Now())
+
+	allowVolume := acl.NamespaceValidator(acl.NamespaceCapabilityHostVolumeDelete)
+	aclObj, err := v.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+	if !allowVolume(aclObj, args.RequestNamespace()) {
+		return structs.ErrPermissionDenied
+	}
+
+	if args.SnapshotID == "" {
+		return fmt.Errorf("missing snapshot ID to delete")
+	}
+
+	vol, err := v.snapshotVolume(args.RequestNamespace(), args.VolumeID)
+	if err != nil {
+		return err
+	}
+
+	cReq := &cstructs.ClientHostVolumeSnapshotDeleteRequest{
+		ID:         args.SnapshotID,
+		VolumeID:   vol.ID,
+		VolumeName: vol.Name,
+		PluginID:   vol.PluginID,
+		Namespace:  vol.Namespace,
+		NodeID:     vol.NodeID,
+		HostPath:   vol.HostPath,
+		Parameters: vol.Parameters,
+	}
+	cResp := &cstructs.ClientHostVolumeSnapshotDeleteResponse{}
+	_, err = v.serializeCall(vol.ID, "delete-snapshot", func() (uint64, error) {
+		return 0, v.srv.RPC("ClientHostVolume.DeleteSnapshot", cReq, cResp)
+	})
+	return err
+}
+
+// snapshotVolume returns the volume for a snapshot operation. Only volumes
+// created by a plugin can have snapshots.
+func (v *HostVolume) snapshotVolume(ns, id string) (*structs.HostVolume, error) {
+	if id == "" {
+		return nil, errors.New("missing volume ID")
+	}
+	snap, err := v.srv.State().Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	vol, err := snap.HostVolumeByID(nil, ns, id, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not query host volume: %w", err)
+	}
+	if vol == nil {
+		return nil, fmt.Errorf("no such volume: %s", id)
+	}
+	if vol.PluginID == "" {
+		return nil, fmt.Errorf("volume %s has no plugin to take snapshots", id)
+	}
+	return vol, nil
+}
+
 // serializeCall serializes fn() per volume, so DHV plugins can assume that
 // Nomad will not run concurrent operations for the same volume, and for us
 // to avoid interleaving client RPCs with raft writes.
@@ -966,6 +966,160 @@ LOOP:
 	test.Eq(t, []string{}, opSet.Slice(), test.Sprint("remaining opSet should be empty"))
 }
 
+func TestHostVolumeEndpoint_Snapshots(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, _, cleanupSrv := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0
+	})
+	t.Cleanup(cleanupSrv)
+	testutil.WaitForLeader(t, srv.RPC)
+	store := srv.fsm.State()
+
+	c1, node1 := newMockHostVolumeClient(t, srv, structs.NodePoolDefault)
+	_, node2 := newMockHostVolumeClient(t, srv, structs.NodePoolDefault)
+
+	index := uint64(1001)
+	ns := "apps"
+	nspace := mock.Namespace()
+	nspace.Name = ns
+	must.NoError(t, store.UpsertNamespaces(index, []*structs.Namespace{nspace}))
+
+	index++
+	token := mock.CreatePolicyAndToken(t, store, index, "volume-manager",
+		`namespace "apps" { capabilities = ["host-volume-write"] }`).SecretID
+
+	index++
+	readToken := mock.CreatePolicyAndToken(t, store, index, "volume-reader",
+		`namespace "apps" { capabilities = ["host-volume-read"] }`).SecretID
+
+	codec := rpcClient(t, srv)
+	writeReq := structs.WriteRequest{Region: srv.Region(), Namespace: ns, AuthToken: token}
+
+	c1.setCreate(&cstructs.ClientHostVolumeCreateResponse{
+		VolumeName:    "example",
+		HostPath:      "/var/nomad/alloc_mounts/example",
+		CapacityBytes: 150000,
+	}, nil)
+
+	var createResp structs.HostVolumeCreateResponse
+	err := msgpackrpc.CallWithCodec(codec, "HostVolume.Create", &structs.HostVolumeCreateRequest{
+		Volume:       mock.HostVolumeRequestForNode(ns, node1),
+		WriteRequest: writeReq,
+	}, &createResp)
+	must.NoError(t, err)
+	source := createResp.Volume
+
+	var snap *structs.HostVolumeSnapshot
+
+	t.Run("create snapshot", func(t *testing.T) {
+		req := &structs.HostVolumeSnapshotCreateRequest{
+			VolumeID:     source.ID,
+			Name:         "nightly",
+			Parameters:   map[string]string{"foo": "bar"},
+			WriteRequest: writeReq,
+		}
+		req.AuthToken = readToken
+		var resp structs.HostVolumeSnapshotCreateResponse
+		err := msgpackrpc.CallWithCodec(codec, "HostVolume.CreateSnapshot", req, &resp)
+		must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+		req.AuthToken = token
+		req.VolumeID = uuid.Generate()
+		err = msgpackrpc.CallWithCodec(codec, "HostVolume.CreateSnapshot", req, &resp)
+		must.EqError(t, err, "no such volume: "+req.VolumeID)
+
+		req.VolumeID = source.ID
+		err = msgpackrpc.CallWithCodec(codec, "HostVolume.CreateSnapshot", req, &resp)
+		must.NoError(t, err)
+		must.NotNil(t, resp.Snapshot)
+		must.UUIDv4(t, resp.Snapshot.ID)
+		must.Eq(t, "nightly", resp.Snapshot.Name)
+		must.Eq(t, source.ID, resp.Snapshot.VolumeID)
+		snap = resp.Snapshot
+
+		// the client gets what the plugin needs to find the volume
+		cReq := c1.getLastSnapshotCreate()
+		must.Eq(t, snap.ID, cReq.ID)
+		must.Eq(t, source.PluginID, cReq.PluginID)
+		must.Eq(t, source.HostPath, cReq.HostPath)
+		must.Eq(t, source.Parameters, cReq.Parameters)
+		must.Eq(t, map[string]string{"foo": "bar"}, cReq.SnapshotParameters)
+	})
+
+	t.Run("list snapshots", func(t *testing.T) {
+		req := &structs.HostVolumeSnapshotListRequest{
+			VolumeID: source.ID,
+			QueryOptions: structs.QueryOptions{
+				Region: srv.Region(), Namespace: ns, AuthToken: readToken},
+		}
+		var resp structs.HostVolumeSnapshotListResponse
+		err := msgpackrpc.CallWithCodec(codec, "HostVolume.ListSnapshots", req, &resp)
+		must.NoError(t, err)
+		must.Eq(t, []*structs.HostVolumeSnapshot{snap}, resp.Snapshots)
+	})
+
+	t.Run("clone", func(t *testing.T) {
+		clone := mock.HostVolumeRequest(ns)
+		clone.Name = "example-clone"
+		clone.PluginID = ""
+		clone.SourceVolumeID = uuid.Generate()
+		req := &structs.HostVolumeCreateRequest{Volume: clone, WriteRequest: writeReq}
+
+		var resp structs.HostVolumeCreateResponse
+		err := msgpackrpc.CallWithCodec(codec, "HostVolume.Create", req, &resp)
+		must.EqError(t, err, fmt.Sprintf(
+			"cannot clone volume %q: source volume does not exist", clone.SourceVolumeID))
+
+		clone.SourceVolumeID = source.ID
+		clone.NodeID = node2.ID
+		err = msgpackrpc.CallWithCodec(codec, "HostVolume.Create", req, &resp)
+		must.EqError(t, err, fmt.Sprintf(
+			"cannot clone volume %q: node ID %q does not match node %q of source volume",
+			source.ID, node2.ID, node1.ID))
+
+		// the clone is created on the node of the source, with its plugin
+		clone.NodeID = ""
+		clone.SnapshotID = snap.ID
+		err = msgpackrpc.CallWithCodec(codec, "HostVolume.Create", req, &resp)
+		must.NoError(t, err)
+		must.Eq(t, node1.ID, resp.Volume.NodeID)
+		must.Eq(t, source.PluginID, resp.Volume.PluginID)
+		must.Eq(t, source.ID, resp.Volume.SourceVolumeID)
+		must.Eq(t, snap.ID, resp.Volume.SnapshotID)
+
+		cReq := c1.getLastCreate()
+		must.Eq(t, source.ID, cReq.SourceVolumeID)
+		must.Eq(t, snap.ID, cReq.SnapshotID)
+
+		// only created volumes can be cloned
+		regReq := &structs.HostVolumeRegisterRequest{
+			Volume:       resp.Volume.Copy(),
+			WriteRequest: writeReq,
+		}
+		regReq.Volume.ID = ""
+		regReq.Volume.Name = "example-registered"
+		var regResp structs.HostVolumeRegisterResponse
+		err = msgpackrpc.CallWithCodec(codec, "HostVolume.Register", regReq, &regResp)
+		must.EqError(t, err, "cannot register volume: only created volumes can be cloned")
+	})
+
+	t.Run("delete snapshot", func(t *testing.T) {
+		req := &structs.HostVolumeSnapshotDeleteRequest{
+			VolumeID:     source.ID,
+			WriteRequest: writeReq,
+		}
+		var resp structs.HostVolumeSnapshotDeleteResponse
+		err := msgpackrpc.CallWithCodec(codec, "HostVolume.DeleteSnapshot", req, &resp)
+		must.EqError(t, err, "missing snapshot ID to delete")
+
+		req.SnapshotID = snap.ID
+		err = msgpackrpc.CallWithCodec(codec, "HostVolume.DeleteSnapshot", req, &resp)
+		must.NoError(t, err)
+		must.Eq(t, snap.ID, c1.getLastSnapshotDelete().ID)
+	})
+}
+
 // mockHostVolumeClient models client RPCs that have side-effects on the
 // client host
 type mockHostVolumeClient struct {
@@ -974,6 +1128,11 @@ type mockHostVolumeClient struct {
 	nextCreateErr      error
 	nextRegisterErr    error
 	nextDeleteErr      error
+	lastCreate         *cstructs.ClientHostVolumeCreateRequest
+	// snapshots are kept by the mock, like they would be by a plugin
+	snapshots          []*structs.HostVolumeSnapshot
+	lastSnapshotCreate *cstructs.ClientHostVolumeSnapshotCreateRequest
+	lastSnapshotDelete *cstructs.ClientHostVolumeSnapshotDeleteRequest
 	// blockChan is used to test server->client RPC serialization.
 	// do not block on this channel while the main lock is held.
 	blockChan chan string
@@ -1037,6 +1196,7 @@ func (v *mockHostVolumeClient) Create(
 
 	v.lock.Lock()
 	defer v.lock.Unlock()
+	v.lastCreate = req
 	if v.nextCreateResponse == nil {
 		return nil // prevents panics from incorrect tests
 	}
@@ -1071,6 +1231,62 @@ func (v *mockHostVolumeClient) Delete(
 	return v.nextDeleteErr
 }
 
+func (v *mockHostVolumeClient) CreateSnapshot(
+	req *cstructs.ClientHostVolumeSnapshotCreateRequest,
+	resp *cstructs.ClientHostVolumeSnapshotCreateResponse) error {
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.lastSnapshotCreate = req
+	resp.Snapshot = &structs.HostVolumeSnapshot{
+		ID:         req.ID,
+		Name:       req.Name,
+		VolumeID:   req.VolumeID,
+		SizeBytes:  100,
+		CreateTime: time.Now().UnixNano(),
+	}
+	v.snapshots = append(v.snapshots, resp.Snapshot)
+	return nil
+}
+
+func (v *mockHostVolumeClient) ListSnapshots(
+	req *cstructs.ClientHostVolumeSnapshotListRequest,
+	resp *cstructs.ClientHostVolumeSnapshotListResponse) error {
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	resp.Snapshots = v.snapshots
+	return nil
+}
+
+func (v *mockHostVolumeClient) DeleteSnapshot(
+	req *cstructs.ClientHostVolumeSnapshotDeleteRequest,
+	resp *cstructs.ClientHostVolumeSnapshotDeleteResponse) error {
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.lastSnapshotDelete = req
+	return nil
+}
+
+func (v *mockHostVolumeClient) getLastCreate() *cstructs.ClientHostVolumeCreateRequest {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.lastCreate
+}
+
+func (v *mockHostVolumeClient) getLastSnapshotCreate() *cstructs.ClientHostVolumeSnapshotCreateRequest {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.lastSnapshotCreate
+}
+
+func (v *mockHostVolumeClient) getLastSnapshotDelete() *cstructs.ClientHostVolumeSnapshotDeleteRequest {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.lastSnapshotDelete
+}
+
 func (v *mockHostVolumeClient) setBlockChan() (context.CancelFunc, error) {
 	v.lock.Lock()
 	defer v.lock.Unlock()
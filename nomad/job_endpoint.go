@@ -1894,7 +1894,8 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 	// Create an in-memory Planner that returns no errors and stores the
 	// submitted plan and created evals.
 	planner := &scheduler.Harness{
-		State: &snap.StateStore,
+		State:   &snap.StateStore,
+		Scorers: j.srv.nodeScorers(),
 	}
 
 	// Create the scheduler and run it
@@ -427,6 +427,9 @@ func (s *Server) establishLeadership(stopCh chan struct{}) error {
 	// collection.
 	go s.schedulePeriodic(stopCh)
 
+	// Garbage collect reservations as they expire
+	go s.reservationExpiryWatcher(stopCh)
+
 	// Reap any failed evaluations
 	go s.reapFailedEvaluations(stopCh)
 
@@ -932,11 +935,21 @@ func (s *Server) schedulePeriodic(stopCh chan struct{}) {
 	defer csiVolumeClaimGC.Stop()
 	oneTimeTokenGC := time.NewTicker(s.config.OneTimeTokenGCInterval)
 	defer oneTimeTokenGC.Stop()
+	reservationGC := time.NewTicker(s.config.ReservationGCInterval)
+	defer reservationGC.Stop()
 	rootKeyGC := time.NewTicker(s.config.RootKeyGCInterval)
 	defer rootKeyGC.Stop()
 	variablesRekey := time.NewTicker(s.config.VariablesRekeyInterval)
 	defer variablesRekey.Stop()
 
+	// Node pool rebalancing is opt-in, so only tick when it's enabled.
+	var nodePoolRebalanceCh <-chan time.Time
+	if s.config.NodePoolRebalanceEnabled {
+		nodePoolRebalance := time.NewTicker(s.config.NodePoolRebalanceInterval)
+		defer nodePoolRebalance.Stop()
+		nodePoolRebalanceCh = nodePoolRebalance.C
+	}
+
 	// Set up the expired ACL local token garbage collection timer.
 	localTokenExpiredGC, localTokenExpiredGCStop := helper.NewSafeTimer(s.config.ACLTokenExpirationGCInterval)
 	defer localTokenExpiredGCStop()
@@ -981,6 +994,10 @@ func (s *Server) schedulePeriodic(stopCh chan struct{}) {
 				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobLocalTokenExpiredGC, index))
 			}
 			localTokenExpiredGC.Reset(s.config.ACLTokenExpirationGCInterval)
+		case <-reservationGC.C:
+			if index, ok := s.getLatestIndex(); ok {
+				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobReservationGC, index))
+			}
 		case <-rootKeyGC.C:
 			if index, ok := s.getLatestIndex(); ok {
 				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobRootKeyRotateOrGC, index))
@@ -989,10 +1006,85 @@ func (s *Server) schedulePeriodic(stopCh chan struct{}) {
 			if index, ok := s.getLatestIndex(); ok {
 				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobVariablesRekey, index))
 			}
+		case <-nodePoolRebalanceCh:
+			if index, ok := s.getLatestIndex(); ok {
+				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobNodePoolRebalance, index))
+			}
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// reservationExpiryWatcher is a long-lived routine that enqueues a reservation
+// GC as soon as a reservation expires, rather than waiting for the periodic
+// GC, so that the evaluations blocked on the reserved capacity are unblocked
+// once the capacity is released.
+func (s *Server) reservationExpiryWatcher(stopCh chan struct{}) {
+	timer, timerStop := helper.NewSafeTimer(0)
+	defer timerStop()
+
+	// expired is the end time of the last reservation a GC was enqueued for,
+	// so that reservations which fail to be deleted are left to the periodic
+	// GC instead of being retried in a loop.
+	var expired time.Time
+
+	for {
+		ws := memdb.NewWatchSet()
+		ws.Add(stopCh)
+
+		next, err := s.nextReservationExpiry(ws, expired)
+		if err != nil {
+			s.logger.Error("failed to look up reservations", "error", err)
+			timer.Reset(s.config.ReservationGCInterval)
+			select {
+			case <-timer.C:
+				continue
+			case <-stopCh:
+				return
+			}
+		}
+
+		var timeoutCh <-chan time.Time
+		if !next.IsZero() {
+			timer.Reset(time.Until(next))
+			timeoutCh = timer.C
+		}
+
+		if ws.Watch(timeoutCh) {
+			expired = next
+			if index, ok := s.getLatestIndex(); ok {
+				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobReservationGC, index))
+			}
+		}
+
+		select {
 		case <-stopCh:
 			return
+		default:
+		}
+	}
+}
+
+// nextReservationExpiry returns the earliest end time of the reservations
+// that end after the given time, or the zero time if there are none.
+func (s *Server) nextReservationExpiry(ws memdb.WatchSet, after time.Time) (time.Time, error) {
+	iter, err := s.State().Reservations(ws, state.SortDefault)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var next time.Time
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		res := raw.(*structs.Reservation)
+		if !res.EndTime.After(after) {
+			continue
+		}
+		if next.IsZero() || res.EndTime.Before(next) {
+			next = res.EndTime
 		}
 	}
+	return next, nil
 }
 
 // schedulePeriodicAuthoritative is a long-lived routine intended for use on
@@ -1560,6 +1560,32 @@ func TestServer_ReconcileMember(t *testing.T) {
 	}
 }
 
+func TestLeader_ReservationExpiry(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.ReservationGCInterval = time.Hour
+	})
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// The reservation is deleted once it expires, without waiting for the
+	// periodic GC.
+	res := mock.Reservation()
+	res.EndTime = time.Now().Add(500 * time.Millisecond)
+	store := s1.fsm.State()
+	must.NoError(t, store.UpsertReservations(structs.MsgTypeTestSetup, 1000, []*structs.Reservation{res}))
+
+	must.Wait(t, wait.InitialSuccess(
+		wait.BoolFunc(func() bool {
+			out, err := store.ReservationByName(nil, res.Namespace, res.Name)
+			return err == nil && out == nil
+		}),
+		wait.Timeout(10*time.Second),
+		wait.Gap(50*time.Millisecond),
+	))
+}
+
 func TestLeader_ReplicateNamespaces(t *testing.T) {
 	ci.Parallel(t)
 	assert := assert.New(t)
@@ -254,6 +254,24 @@ func NodePool() *structs.NodePool {
 	return pool
 }
 
+// Reservation returns a reservation in the default namespace that is active
+// for an hour and holds capacity on the nodes of the default node pool.
+func Reservation() *structs.Reservation {
+	now := time.Now().UTC().Truncate(time.Second)
+	return &structs.Reservation{
+		Name:        fmt.Sprintf("reservation-%s", uuid.Short()),
+		Namespace:   structs.DefaultNamespace,
+		Description: "test reservation",
+		NodePool:    structs.NodePoolDefault,
+		Resources: &structs.ReservationResources{
+			CPU:      1000,
+			MemoryMB: 1024,
+		},
+		StartTime: now.Add(-time.Minute),
+		EndTime:   now.Add(time.Hour),
+	}
+}
+
 // ServiceRegistrations generates an array containing two unique service
 // registrations.
 func ServiceRegistrations() []*structs.ServiceRegistration {
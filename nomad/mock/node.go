@@ -19,10 +19,16 @@ func Node() *structs.Node {
 			"exec": {
 				Detected: true,
 				Healthy:  true,
+				Attributes: map[string]string{
+					"driver.exec.update_resources": "true",
+				},
 			},
 			"mock_driver": {
 				Detected: true,
 				Healthy:  true,
+				Attributes: map[string]string{
+					"driver.mock_driver.update_resources": "true",
+				},
 			},
 		},
 		Attributes: map[string]string{
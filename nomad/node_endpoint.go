@@ -1182,16 +1182,18 @@ func (n *Node) GetClientAllocs(args *structs.NodeSpecificRequest,
 				for _, alloc := range allocs {
 					reply.Allocs[alloc.ID] = alloc.AllocModifyIndex
 
-					// If the allocation is going to do a migration, create a
+					// If the allocation is going to do a migration, or restore
+					// the checkpoint of the previous allocation, create a
 					// migration token so that the client can authenticate with
 					// the node hosting the previous allocation.
-					if alloc.ShouldMigrate() {
+					if alloc.PreviousAllocation != "" {
 						prevAllocation, err := state.AllocByID(ws, alloc.PreviousAllocation)
 						if err != nil {
 							return err
 						}
 
-						if prevAllocation != nil && prevAllocation.NodeID != alloc.NodeID {
+						if prevAllocation != nil && prevAllocation.NodeID != alloc.NodeID &&
+							(alloc.ShouldMigrate() || prevAllocation.ShouldCheckpoint()) {
 							allocNode, err := state.NodeByID(ws, prevAllocation.NodeID)
 							if err != nil {
 								return err
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/state"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/scheduler"
 	"github.com/hashicorp/raft"
 )
 
@@ -786,7 +787,26 @@ func evaluateNodePlan(snap *state.StateSnapshot, plan *structs.Plan, nodeID stri
 
 	// Check if these allocations fit
 	fit, reason, _, err := structs.AllocsFit(node, proposed, nil, true)
-	return fit, reason, err
+	if err != nil || !fit {
+		return fit, reason, err
+	}
+
+	// Check that the capacity held by reservations is left available, as the
+	// plan may have been computed before a reservation was created or
+	// concurrently with plans using the same capacity. The reservations are
+	// looked up by the node pool index, so nodes without reservations are
+	// skipped cheaply.
+	if plan.Job == nil {
+		return true, "", nil
+	}
+	reservations, err := scheduler.JobReservations(snap, plan.Job, node.NodePool, time.Now())
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get reservations for '%s': %v", nodeID, err)
+	}
+	if len(reservations) == 0 {
+		return true, "", nil
+	}
+	return scheduler.ReservationsFit(node, reservations, proposed)
 }
 
 // The plan is only valid for disconnected nodes if it only contains
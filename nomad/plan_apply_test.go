@@ -901,6 +901,72 @@ func TestPlanApply_EvalNodePlan_NodeFull(t *testing.T) {
 	}
 }
 
+func TestPlanApply_EvalNodePlan_Reservation(t *testing.T) {
+	ci.Parallel(t)
+
+	state := testStateStore(t)
+	node := mock.Node()
+	must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 1000, node))
+
+	res := mock.Reservation()
+	must.NoError(t, state.UpsertReservations(structs.MsgTypeTestSetup, 1001, []*structs.Reservation{res}))
+
+	// The alloc fits on the node, but not in the capacity left available by
+	// the reservation.
+	alloc := mock.Alloc()
+	alloc.NodeID = node.ID
+	alloc.AllocatedResources.Tasks["web"].Cpu.CpuShares =
+		int64(node.NodeResources.Processors.TotalCompute()) - node.ReservedResources.Cpu.CpuShares - 500
+	alloc.AllocatedResources.Tasks["web"].Networks = nil
+	must.NoError(t, state.UpsertJobSummary(1002, mock.JobSummary(alloc.JobID)))
+
+	snap, err := state.Snapshot()
+	must.NoError(t, err)
+	plan := &structs.Plan{
+		Job: alloc.Job,
+		NodeAllocation: map[string][]*structs.Allocation{
+			node.ID: {alloc},
+		},
+	}
+
+	fit, reason, err := evaluateNodePlan(snap, plan, node.ID)
+	must.NoError(t, err)
+	must.False(t, fit)
+	must.Eq(t, "reserved cpu", reason)
+
+	// Allocs of jobs that reference the reservation use its capacity.
+	alloc.Job.Reservation = res.Name
+	fit, reason, err = evaluateNodePlan(snap, plan, node.ID)
+	must.NoError(t, err)
+	must.True(t, fit, must.Sprint(reason))
+
+	// Reservations whose window hasn't started yet hold capacity against
+	// service allocs, since they keep running once it starts.
+	alloc.Job.Reservation = ""
+	res = res.Copy()
+	res.StartTime = res.StartTime.Add(2 * time.Hour)
+	res.EndTime = res.EndTime.Add(2 * time.Hour)
+	must.NoError(t, state.UpsertReservations(structs.MsgTypeTestSetup, 1003, []*structs.Reservation{res}))
+	snap, err = state.Snapshot()
+	must.NoError(t, err)
+
+	fit, reason, err = evaluateNodePlan(snap, plan, node.ID)
+	must.NoError(t, err)
+	must.False(t, fit)
+	must.Eq(t, "reserved cpu", reason)
+
+	// Nodes in node pools without reservations aren't checked.
+	res = res.Copy()
+	res.NodePool = "other"
+	must.NoError(t, state.UpsertReservations(structs.MsgTypeTestSetup, 1004, []*structs.Reservation{res}))
+	snap, err = state.Snapshot()
+	must.NoError(t, err)
+
+	fit, reason, err = evaluateNodePlan(snap, plan, node.ID)
+	must.NoError(t, err)
+	must.True(t, fit, must.Sprint(reason))
+}
+
 // Test that we detect device oversubscription
 func TestPlanApply_EvalNodePlan_NodeFull_Device(t *testing.T) {
 	ci.Parallel(t)
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	metrics "github.com/hashicorp/go-metrics/compat"
+	"github.com/hashicorp/nomad/acl"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/state/paginator"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// Reservation endpoint is used for managing reservations of node capacity.
+type Reservation struct {
+	srv *Server
+	ctx *RPCContext
+}
+
+func NewReservationEndpoint(srv *Server, ctx *RPCContext) *Reservation {
+	return &Reservation{srv: srv, ctx: ctx}
+}
+
+// List is used to retrieve multiple reservations. It supports prefix
+// listing, pagination, and filtering.
+func (r *Reservation) List(args *structs.ReservationListRequest, reply *structs.ReservationListResponse) error {
+	authErr := r.srv.Authenticate(r.ctx, args)
+	if done, err := r.srv.forward(structs.ReservationListRPCMethod, args, args, reply); done {
+		return err
+	}
+	r.srv.MeasureRPCRate("reservation", structs.RateMetricList, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "reservation", "list"}, time.Now())
+
+	aclObj, err := r.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+
+	ns := args.RequestNamespace()
+
+	sort := state.SortOption(args.Reverse)
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, store *state.StateStore) error {
+
+			var iter memdb.ResultIterator
+			var err error
+
+			if ns == structs.AllNamespacesSentinel {
+				iter, err = store.Reservations(ws, sort)
+			} else {
+				iter, err = store.ReservationsByNamePrefix(ws, ns, args.Prefix, sort)
+			}
+			if err != nil {
+				return err
+			}
+
+			allowRead := acl.NamespaceValidator(acl.NamespaceCapabilityReadJob)
+			selector := func(res *structs.Reservation) bool {
+				if !strings.HasPrefix(res.Name, args.Prefix) {
+					return false
+				}
+				return allowRead(aclObj, res.Namespace)
+			}
+
+			pager, err := paginator.NewPaginator(iter, args.QueryOptions, selector,
+				paginator.NamespaceIDTokenizer[*structs.Reservation](args.NextToken),
+				(*structs.Reservation).Stub)
+			if err != nil {
+				return structs.NewErrRPCCodedf(
+					http.StatusBadRequest, "failed to create result paginator: %v", err)
+			}
+
+			reservations, nextToken, err := pager.Page()
+			if err != nil {
+				return structs.NewErrRPCCodedf(
+					http.StatusBadRequest, "failed to read result page: %v", err)
+			}
+
+			reply.Reservations = reservations
+			reply.NextToken = nextToken
+
+			// Use the index table to populate the query meta as we have no way
+			// of tracking the max index on deletes.
+			return r.srv.setReplyQueryMeta(store, state.TableReservations, &reply.QueryMeta)
+		},
+	}
+
+	return r.srv.blockingRPC(&opts)
+}
+
+// Get returns the requested reservation or nil if it doesn't exist.
+func (r *Reservation) Get(args *structs.ReservationSpecificRequest, reply *structs.SingleReservationResponse) error {
+	authErr := r.srv.Authenticate(r.ctx, args)
+	if done, err := r.srv.forward(structs.ReservationGetRPCMethod, args, args, reply); done {
+		return err
+	}
+	r.srv.MeasureRPCRate("reservation", structs.RateMetricRead, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "reservation", "get"}, time.Now())
+
+	allowRead := acl.NamespaceValidator(acl.NamespaceCapabilityReadJob)
+	aclObj, err := r.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+	if !allowRead(aclObj, args.RequestNamespace()) {
+		return structs.ErrPermissionDenied
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, store *state.StateStore) error {
+			res, err := store.ReservationByName(ws, args.RequestNamespace(), args.Name)
+			if err != nil {
+				return err
+			}
+
+			reply.Reservation = res
+			if res != nil {
+				reply.Index = res.ModifyIndex
+				return nil
+			}
+
+			// Return the last index that affected the reservations table if
+			// the requested reservation doesn't exist.
+			return r.srv.setReplyQueryMeta(store, state.TableReservations, &reply.QueryMeta)
+		},
+	}
+
+	return r.srv.blockingRPC(&opts)
+}
+
+// Upsert creates or updates the given reservations. Reserving capacity
+// affects every job in the cluster, so it requires operator write
+// permission.
+func (r *Reservation) Upsert(args *structs.ReservationUpsertRequest, reply *structs.GenericResponse) error {
+	authErr := r.srv.Authenticate(r.ctx, args)
+	if done, err := r.srv.forward(structs.ReservationUpsertRPCMethod, args, args, reply); done {
+		return err
+	}
+	r.srv.MeasureRPCRate("reservation", structs.RateMetricWrite, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "reservation", "upsert"}, time.Now())
+
+	aclObj, err := r.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+	if !aclObj.AllowOperatorWrite() {
+		return structs.ErrPermissionDenied
+	}
+
+	if len(args.Reservations) == 0 {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "must specify at least one reservation")
+	}
+	for _, res := range args.Reservations {
+		if res.Namespace == "" {
+			res.Namespace = args.RequestNamespace()
+		}
+		res.Canonicalize()
+
+		if err := res.Validate(); err != nil {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "invalid reservation %q: %v", res.Name, err)
+		}
+	}
+
+	_, index, err := r.srv.raftApply(structs.ReservationUpsertRequestType, args)
+	if err != nil {
+		return err
+	}
+	reply.Index = index
+	return nil
+}
+
+// Delete deletes the given reservations from the request namespace.
+func (r *Reservation) Delete(args *structs.ReservationDeleteRequest, reply *structs.GenericResponse) error {
+	authErr := r.srv.Authenticate(r.ctx, args)
+	if done, err := r.srv.forward(structs.ReservationDeleteRPCMethod, args, args, reply); done {
+		return err
+	}
+	r.srv.MeasureRPCRate("reservation", structs.RateMetricWrite, args)
+	if authErr != nil {
+		return structs.ErrPermissionDenied
+	}
+	defer metrics.MeasureSince([]string{"nomad", "reservation", "delete"}, time.Now())
+
+	aclObj, err := r.srv.ResolveACL(args)
+	if err != nil {
+		return err
+	}
+	if !aclObj.AllowOperatorWrite() {
+		return structs.ErrPermissionDenied
+	}
+
+	if len(args.Names) == 0 {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "must specify at least one reservation to delete")
+	}
+	for _, name := range args.Names {
+		if name == "" {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "reservation name is empty")
+		}
+	}
+
+	_, index, err := r.srv.raftApply(structs.ReservationDeleteRequestType, args)
+	if err != nil {
+		return err
+	}
+	reply.Index = index
+	return nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"testing"
+	"time"
+
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc/v2"
+	"github.com/hashicorp/nomad/acl"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+)
+
+func TestReservationEndpoint_CRUD(t *testing.T) {
+	ci.Parallel(t)
+
+	s, cleanupS := TestServer(t, nil)
+	defer cleanupS()
+
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	ns := mock.Namespace()
+	must.NoError(t, s.fsm.State().UpsertNamespaces(1000, []*structs.Namespace{ns}))
+
+	// Create reservations in two namespaces. The namespace of the request is
+	// used when the reservation doesn't set one.
+	res1 := mock.Reservation()
+	res1.Namespace = ""
+	res1.NodePool = ""
+	res2 := mock.Reservation()
+	res2.Namespace = ns.Name
+
+	upsertReq := &structs.ReservationUpsertRequest{
+		Reservations: []*structs.Reservation{res1, res2},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var upsertResp structs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, structs.ReservationUpsertRPCMethod, upsertReq, &upsertResp)
+	must.NoError(t, err)
+	must.NonZero(t, upsertResp.Index)
+
+	// Get the reservation and verify defaults were set.
+	getReq := &structs.ReservationSpecificRequest{
+		Name: res1.Name,
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			Namespace: structs.DefaultNamespace,
+		},
+	}
+	var getResp structs.SingleReservationResponse
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationGetRPCMethod, getReq, &getResp)
+	must.NoError(t, err)
+	must.NotNil(t, getResp.Reservation)
+	must.Eq(t, structs.DefaultNamespace, getResp.Reservation.Namespace)
+	must.Eq(t, structs.NodePoolDefault, getResp.Reservation.NodePool)
+	must.Eq(t, upsertResp.Index, getResp.Index)
+
+	// Reservations are namespaced.
+	getReq.Name = res2.Name
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationGetRPCMethod, getReq, &getResp)
+	must.NoError(t, err)
+	must.Nil(t, getResp.Reservation)
+
+	// List reservations.
+	listReq := &structs.ReservationListRequest{
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			Namespace: structs.AllNamespacesSentinel,
+		},
+	}
+	var listResp structs.ReservationListResponse
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationListRPCMethod, listReq, &listResp)
+	must.NoError(t, err)
+	must.Len(t, 2, listResp.Reservations)
+
+	listReq.Namespace = ns.Name
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationListRPCMethod, listReq, &listResp)
+	must.NoError(t, err)
+	must.Len(t, 1, listResp.Reservations)
+	must.Eq(t, res2.Name, listResp.Reservations[0].Name)
+
+	// Invalid reservations are rejected.
+	invalid := mock.Reservation()
+	invalid.EndTime = invalid.StartTime.Add(-time.Hour)
+	upsertReq.Reservations = []*structs.Reservation{invalid}
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationUpsertRPCMethod, upsertReq, &upsertResp)
+	must.ErrorContains(t, err, "end time must be after start time")
+
+	// Delete a reservation.
+	deleteReq := &structs.ReservationDeleteRequest{
+		Names: []string{res2.Name},
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: ns.Name,
+		},
+	}
+	var deleteResp structs.GenericResponse
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationDeleteRPCMethod, deleteReq, &deleteResp)
+	must.NoError(t, err)
+
+	got, err := s.fsm.State().ReservationByName(nil, ns.Name, res2.Name)
+	must.NoError(t, err)
+	must.Nil(t, got)
+}
+
+func TestReservationEndpoint_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	s, root, cleanupS := TestACLServer(t, nil)
+	defer cleanupS()
+
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	res := mock.Reservation()
+	must.NoError(t, s.fsm.State().UpsertReservations(structs.MsgTypeTestSetup, 1000,
+		[]*structs.Reservation{res}))
+
+	readToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1001, "read-job",
+		mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilityReadJob}))
+	operatorToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1003, "operator-write",
+		`operator { policy = "write" }`)
+
+	// Reads require read-job in the namespace.
+	getReq := &structs.ReservationSpecificRequest{
+		Name: res.Name,
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			Namespace: structs.DefaultNamespace,
+			AuthToken: operatorToken.SecretID,
+		},
+	}
+	var getResp structs.SingleReservationResponse
+	err := msgpackrpc.CallWithCodec(codec, structs.ReservationGetRPCMethod, getReq, &getResp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	getReq.AuthToken = readToken.SecretID
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationGetRPCMethod, getReq, &getResp)
+	must.NoError(t, err)
+	must.NotNil(t, getResp.Reservation)
+
+	// List filters out the reservations the token can't read.
+	listReq := &structs.ReservationListRequest{
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			Namespace: structs.AllNamespacesSentinel,
+			AuthToken: operatorToken.SecretID,
+		},
+	}
+	var listResp structs.ReservationListResponse
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationListRPCMethod, listReq, &listResp)
+	must.NoError(t, err)
+	must.Len(t, 0, listResp.Reservations)
+
+	listReq.AuthToken = root.SecretID
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationListRPCMethod, listReq, &listResp)
+	must.NoError(t, err)
+	must.Len(t, 1, listResp.Reservations)
+
+	// Writes require operator write.
+	upsertReq := &structs.ReservationUpsertRequest{
+		Reservations: []*structs.Reservation{mock.Reservation()},
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			AuthToken: readToken.SecretID,
+		},
+	}
+	var upsertResp structs.GenericResponse
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationUpsertRPCMethod, upsertReq, &upsertResp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	upsertReq.AuthToken = operatorToken.SecretID
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationUpsertRPCMethod, upsertReq, &upsertResp)
+	must.NoError(t, err)
+
+	deleteReq := &structs.ReservationDeleteRequest{
+		Names: []string{res.Name},
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: structs.DefaultNamespace,
+			AuthToken: readToken.SecretID,
+		},
+	}
+	var deleteResp structs.GenericResponse
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationDeleteRPCMethod, deleteReq, &deleteResp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	deleteReq.AuthToken = operatorToken.SecretID
+	err = msgpackrpc.CallWithCodec(codec, structs.ReservationDeleteRPCMethod, deleteReq, &deleteResp)
+	must.NoError(t, err)
+}
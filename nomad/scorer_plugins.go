@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/helper/pluginutils/loader"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/scorer"
+	"github.com/hashicorp/nomad/scheduler"
+)
+
+const (
+	// scorerPluginTimeout is the maximum amount of time the scheduler waits
+	// for a scorer plugin to score a set of nodes. Nodes are not scored by
+	// the plugin if it doesn't respond in time.
+	scorerPluginTimeout = 2 * time.Second
+)
+
+// setupScorerPlugins dispenses the scorer plugins configured for the server.
+// The plugins are used by the scheduling workers to rank nodes.
+func (s *Server) setupScorerPlugins() error {
+	if len(s.config.ScorerPlugins) == 0 {
+		return nil
+	}
+	if s.config.PluginLoader == nil {
+		return fmt.Errorf("no plugin loader available for scorer plugins")
+	}
+
+	for _, name := range s.config.ScorerPlugins {
+		p := &scorerPlugin{
+			name:   name,
+			loader: s.config.PluginLoader,
+			logger: s.logger.Named("scorer_plugin").With("plugin", name),
+		}
+		if _, err := p.dispense(); err != nil {
+			s.shutdownScorerPlugins()
+			return err
+		}
+		s.scorerPlugins = append(s.scorerPlugins, p)
+	}
+	return nil
+}
+
+// shutdownScorerPlugins kills the scorer plugins of the server.
+func (s *Server) shutdownScorerPlugins() {
+	for _, p := range s.scorerPlugins {
+		p.kill()
+	}
+}
+
+// nodeScorers returns the node scorers used by the scheduling workers.
+func (s *Server) nodeScorers() []scheduler.NodeScorer {
+	if len(s.scorerPlugins) == 0 {
+		return nil
+	}
+
+	scorers := make([]scheduler.NodeScorer, 0, len(s.scorerPlugins))
+	for _, p := range s.scorerPlugins {
+		scorers = append(scorers, p)
+	}
+	return scorers
+}
+
+// scorerPlugin wraps a scorer plugin and implements the scheduler.NodeScorer
+// interface. The plugin is dispensed again if it exits.
+type scorerPlugin struct {
+	name   string
+	loader loader.PluginCatalog
+	logger log.Logger
+
+	instance loader.PluginInstance
+	l        sync.Mutex
+}
+
+// dispense returns the scorer plugin, launching it if it isn't running.
+func (p *scorerPlugin) dispense() (scorer.ScorerPlugin, error) {
+	p.l.Lock()
+	defer p.l.Unlock()
+
+	if p.instance == nil || p.instance.Exited() {
+		instance, err := p.loader.Dispense(p.name, base.PluginTypeScorer, nil, p.logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dispense scorer plugin %q: %v", p.name, err)
+		}
+		p.instance = instance
+	}
+
+	impl, ok := p.instance.Plugin().(scorer.ScorerPlugin)
+	if !ok {
+		return nil, fmt.Errorf("plugin %q is not a scorer plugin: %T", p.name, p.instance.Plugin())
+	}
+	return impl, nil
+}
+
+// kill kills the plugin if it is running.
+func (p *scorerPlugin) kill() {
+	p.l.Lock()
+	defer p.l.Unlock()
+
+	if p.instance != nil {
+		p.instance.Kill()
+		p.instance = nil
+	}
+}
+
+// Name implements scheduler.NodeScorer.
+func (p *scorerPlugin) Name() string {
+	return p.name
+}
+
+// ScoreNodes implements scheduler.NodeScorer by sending the nodes to the
+// scorer plugin.
+func (p *scorerPlugin) ScoreNodes(job *structs.Job, tg *structs.TaskGroup, nodes []*structs.Node) (map[string]float64, error) {
+	impl, err := p.dispense()
+	if err != nil {
+		return nil, err
+	}
+
+	meta := maps.Clone(job.Meta)
+	if meta == nil {
+		meta = make(map[string]string, len(tg.Meta))
+	}
+	maps.Copy(meta, tg.Meta)
+
+	req := &scorer.ScoreRequest{
+		Namespace: job.Namespace,
+		JobID:     job.ID,
+		JobType:   job.Type,
+		TaskGroup: tg.Name,
+		Meta:      meta,
+		Nodes:     make([]*scorer.Node, 0, len(nodes)),
+	}
+	for _, node := range nodes {
+		req.Nodes = append(req.Nodes, &scorer.Node{
+			ID:         node.ID,
+			Name:       node.Name,
+			Datacenter: node.Datacenter,
+			NodePool:   node.NodePool,
+			NodeClass:  node.NodeClass,
+			Attributes: node.Attributes,
+			Meta:       node.Meta,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), scorerPluginTimeout)
+	defer cancel()
+
+	resp, err := impl.Score(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Scores, nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package nomad
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	log "github.com/hashicorp/go-hclog"
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc/v2"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pluginutils/loader"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/scorer"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+)
+
+func TestScorerPlugins_ScoreNodes(t *testing.T) {
+	ci.Parallel(t)
+
+	var l sync.Mutex
+	var requests []*scorer.ScoreRequest
+	mockScorer := &scorer.MockScorerPlugin{
+		ScoreF: func(_ context.Context, req *scorer.ScoreRequest) (*scorer.ScoreResponse, error) {
+			l.Lock()
+			defer l.Unlock()
+			requests = append(requests, req)
+
+			scores := make(map[string]float64, len(req.Nodes))
+			for _, node := range req.Nodes {
+				scores[node.ID] = -0.5
+				if node.Meta["rack"] == "r1" {
+					scores[node.ID] = 0.5
+				}
+			}
+			return &scorer.ScoreResponse{Scores: scores}, nil
+		},
+	}
+
+	var dispensed []string
+	catalog := &loader.MockCatalog{
+		DispenseF: func(name, pluginType string, _ *base.AgentConfig, _ log.Logger) (loader.PluginInstance, error) {
+			must.Eq(t, base.PluginTypeScorer, pluginType)
+			dispensed = append(dispensed, name)
+			return loader.MockBasicExternalPlugin(mockScorer, scorer.ApiVersion010), nil
+		},
+	}
+
+	srv, cleanupSrv := TestServer(t, func(c *Config) {
+		c.ScorerPlugins = []string{"rack-power"}
+		c.PluginLoader = catalog
+	})
+	defer cleanupSrv()
+	codec := rpcClient(t, srv)
+	testutil.WaitForLeader(t, srv.RPC)
+
+	must.Eq(t, []string{"rack-power"}, dispensed)
+	must.Len(t, 1, srv.nodeScorers())
+
+	// Register two nodes, only one of them in the preferred rack.
+	preferred := mock.Node()
+	preferred.Meta["rack"] = "r1"
+	other := mock.Node()
+	other.Meta["rack"] = "r2"
+	for _, node := range []*structs.Node{preferred, other} {
+		req := &structs.NodeRegisterRequest{
+			Node:         node,
+			WriteRequest: structs.WriteRequest{Region: "global"},
+		}
+		var resp structs.NodeUpdateResponse
+		must.NoError(t, msgpackrpc.CallWithCodec(codec, "Node.Register", req, &resp))
+	}
+
+	job := mock.Job()
+	job.Meta = map[string]string{"owner": "web-team"}
+	job.TaskGroups[0].Count = 1
+	regReq := &structs.JobRegisterRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+	var regResp structs.JobRegisterResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Register", regReq, &regResp))
+
+	var alloc *structs.Allocation
+	testutil.WaitForResult(func() (bool, error) {
+		allocs, err := srv.State().AllocsByJob(nil, job.Namespace, job.ID, false)
+		if err != nil {
+			return false, err
+		}
+		if len(allocs) != 1 {
+			return false, nil
+		}
+		alloc = allocs[0]
+		return true, nil
+	}, func(err error) {
+		t.Fatalf("allocation was not placed: %v", err)
+	})
+
+	// The allocation is placed on the node preferred by the plugin and the
+	// plugin score is visible in the allocation metrics.
+	must.Eq(t, preferred.ID, alloc.NodeID)
+	top := alloc.Metrics.MaxNormScore()
+	must.NotNil(t, top)
+	must.Eq(t, 0.5, top.Scores["rack-power"])
+
+	l.Lock()
+	defer l.Unlock()
+	must.SliceNotEmpty(t, requests)
+	must.Eq(t, job.ID, requests[0].JobID)
+	must.Eq(t, job.TaskGroups[0].Name, requests[0].TaskGroup)
+	must.Eq(t, "web-team", requests[0].Meta["owner"])
+	must.Len(t, 2, requests[0].Nodes)
+}
+
+func TestScorerPlugins_Redispense(t *testing.T) {
+	ci.Parallel(t)
+
+	mockScorer := &scorer.MockScorerPlugin{
+		ScoreF: scorer.StaticScores(map[string]float64{"node-1": 1}),
+	}
+
+	var instances []*loader.MockInstance
+	catalog := &loader.MockCatalog{
+		DispenseF: func(_, _ string, _ *base.AgentConfig, _ log.Logger) (loader.PluginInstance, error) {
+			instance := loader.MockBasicExternalPlugin(mockScorer, scorer.ApiVersion010)
+			instances = append(instances, instance)
+			return instance, nil
+		},
+	}
+
+	p := &scorerPlugin{
+		name:   "rack-power",
+		loader: catalog,
+		logger: log.NewNullLogger(),
+	}
+
+	job := mock.Job()
+	node := mock.Node()
+	scores, err := p.ScoreNodes(job, job.TaskGroups[0], []*structs.Node{node})
+	must.NoError(t, err)
+	must.Eq(t, map[string]float64{"node-1": 1}, scores)
+	must.Len(t, 1, instances)
+
+	// The plugin is dispensed again after it exits.
+	instances[0].Kill()
+	_, err = p.ScoreNodes(job, job.TaskGroups[0], []*structs.Node{node})
+	must.NoError(t, err)
+	must.Len(t, 2, instances)
+
+	p.kill()
+	must.True(t, instances[1].Exited())
+}
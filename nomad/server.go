@@ -294,6 +294,10 @@ type Server struct {
 	// Nomad router.
 	statsFetcher *StatsFetcher
 
+	// scorerPlugins are the scorer plugins used by the scheduling workers to
+	// rank nodes.
+	scorerPlugins []*scorerPlugin
+
 	// reportingManager is used to configure and handle all the license reporting
 	// dependencies.
 	reportingManager *reporting.Manager
@@ -377,6 +381,7 @@ func NewServer(config *Config, consulCatalog consul.CatalogAPI, consulConfigFunc
 	if err != nil {
 		return nil, err
 	}
+	evalBroker.SetFairShare(config.EvalFairShareEnabled, config.EvalFairShareWeights)
 	s.evalBroker = evalBroker
 
 	// Create the blocked evals
@@ -475,6 +480,13 @@ func NewServer(config *Config, consulCatalog consul.CatalogAPI, consulConfigFunc
 		return nil, fmt.Errorf("Failed to start serf: %v", err)
 	}
 
+	// Initialize the scorer plugins used by the scheduling workers
+	if err := s.setupScorerPlugins(); err != nil {
+		s.Shutdown()
+		s.logger.Error("failed to start scorer plugins", "error", err)
+		return nil, fmt.Errorf("Failed to start scorer plugins: %v", err)
+	}
+
 	// Initialize the scheduling workers
 	if err := s.setupWorkers(s.shutdownCtx); err != nil {
 		s.Shutdown()
@@ -726,6 +738,7 @@ func (s *Server) Shutdown() error {
 	workerShutdownTimeoutCtx, cancelWorkerShutdownTimeoutCtx := context.WithTimeout(context.Background(), workerShutdownGracePeriod)
 	defer cancelWorkerShutdownTimeoutCtx()
 	s.workerShutdownGroup.WaitWithContext(workerShutdownTimeoutCtx)
+	s.shutdownScorerPlugins()
 
 	if s.serf != nil {
 		s.serf.Shutdown()
@@ -1291,6 +1304,7 @@ func (s *Server) setupRpcServer(server *rpc.Server, ctx *RPCContext) {
 	_ = server.Register(NewPeriodicEndpoint(s, ctx))
 	_ = server.Register(NewPlanEndpoint(s, ctx))
 	_ = server.Register(NewRegionEndpoint(s, ctx))
+	_ = server.Register(NewReservationEndpoint(s, ctx))
 	_ = server.Register(NewScalingEndpoint(s, ctx))
 	_ = server.Register(NewSearchEndpoint(s, ctx))
 	_ = server.Register(NewServiceRegistrationEndpoint(s, ctx))
@@ -5,6 +5,7 @@ package nomad
 
 import (
 	"fmt"
+	"math"
 	"net/http"
 	"sort"
 	"strconv"
@@ -344,9 +345,16 @@ func (s *ServiceRegistration) GetService(
 	if err != nil {
 		return structs.ErrPermissionDenied
 	}
-	if !aclObj.AllowServiceRegistrationReadList(args.RequestNamespace(),
-		args.GetIdentity().Claims != nil) {
-		return structs.ErrPermissionDenied
+
+	// Clients read services to answer DNS queries for them, but only in the
+	// namespaces of the allocations they run.
+	var clientID string
+	if !aclObj.AllowServiceRegistrationReadList(
+		args.RequestNamespace(), args.GetIdentity().Claims != nil) {
+		if !aclObj.AllowClientOp() {
+			return structs.ErrPermissionDenied
+		}
+		clientID = args.GetIdentity().ClientID
 	}
 
 	// Set up the blocking query.
@@ -355,13 +363,29 @@ func (s *ServiceRegistration) GetService(
 		queryMeta: &reply.QueryMeta,
 		run: func(ws memdb.WatchSet, stateStore *state.StateStore) error {
 
+			if clientID != "" {
+				ok, err := nodeRunsNamespace(ws, stateStore, clientID, args.RequestNamespace())
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return structs.ErrPermissionDenied
+				}
+			}
+
 			// Perform the state query to get an iterator.
 			iter, err := stateStore.GetServiceRegistrationByName(ws, args.RequestNamespace(), args.ServiceName)
 			if err != nil {
 				return err
 			}
 
-			pager, err := paginator.NewPaginator(iter, args.QueryOptions, nil,
+			// Skip services with checks that are not passing if requested.
+			var selector paginator.SelectorFunc[*structs.ServiceRegistration]
+			if args.Passing {
+				selector = (*structs.ServiceRegistration).Passing
+			}
+
+			pager, err := paginator.NewPaginator(iter, args.QueryOptions, selector,
 				paginator.NamespaceIDTokenizer[*structs.ServiceRegistration](args.NextToken),
 				(*structs.ServiceRegistration).Stub)
 			if err != nil {
@@ -396,6 +420,21 @@ func (s *ServiceRegistration) GetService(
 	})
 }
 
+// nodeRunsNamespace returns whether the node has allocations in the namespace
+// that are not terminal on the client.
+func nodeRunsNamespace(ws memdb.WatchSet, stateStore *state.StateStore, nodeID, namespace string) (bool, error) {
+	allocs, err := stateStore.AllocsByNode(ws, nodeID)
+	if err != nil {
+		return false, err
+	}
+	for _, alloc := range allocs {
+		if alloc.Namespace == namespace && !alloc.ClientTerminalStatus() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // choose uses rendezvous hashing to make a stable selection of a subset of services
 // to return.
 //
@@ -405,6 +444,10 @@ func (s *ServiceRegistration) GetService(
 // In practice (i.e. via consul-template), the key is the AllocID generating a request
 // for upstream services.
 //
+// Services are chosen in proportion to their weight, using weighted rendezvous
+// hashing. Services of equal weight are ordered by their hash value, so the
+// selection is unchanged for services that do not set a weight.
+//
 // https://en.wikipedia.org/wiki/Rendezvous_hashing
 // w := priority (i.e. hash value)
 // h := hash function
@@ -434,20 +477,27 @@ func (*ServiceRegistration) choose(services []*structs.ServiceRegistration, para
 
 	type pair struct {
 		hash    string
+		score   float64
 		service *structs.ServiceRegistration
 	}
 
-	// associate hash for each service
+	// associate hash and weighted score for each service
 	priorities := make([]*pair, len(services))
 	for i, service := range services {
+		hash := service.HashWith(key)
 		priorities[i] = &pair{
-			hash:    service.HashWith(key),
+			hash:    hash,
+			score:   weightedScore(hash, service.Weight()),
 			service: service,
 		}
 	}
 
-	// sort by the hash; creating random distribution of priority
+	// sort by the score, then the hash; creating random distribution of
+	// priority
 	sort.SliceStable(priorities, func(i, j int) bool {
+		if priorities[i].score != priorities[j].score {
+			return priorities[i].score < priorities[j].score
+		}
 		return priorities[i].hash < priorities[j].hash
 	})
 
@@ -459,3 +509,16 @@ func (*ServiceRegistration) choose(services []*structs.ServiceRegistration, para
 
 	return chosen, nil
 }
+
+// weightedScore converts the hex hash of a service into its score for weighted
+// rendezvous hashing, where lower scores have higher priority. The hash maps to
+// u in [0, 1) and the score is -ln(1-u)/weight, which increases with u so that
+// services of equal weight keep the order of their hashes.
+func weightedScore(hash string, weight int) float64 {
+	x, err := strconv.ParseUint(hash[:16], 16, 64)
+	if err != nil {
+		return math.Inf(1)
+	}
+	u := float64(x) / (1 << 64)
+	return -math.Log1p(-u) / float64(weight)
+}
@@ -1505,3 +1505,140 @@ func TestServiceRegistration_choose(t *testing.T) {
 		{ID: "abc001", ServiceName: "s1"},
 	}, "3|ccc")
 }
+
+func TestServiceRegistration_choose_weighted(t *testing.T) {
+	ci.Parallel(t)
+
+	sr := (*ServiceRegistration)(nil)
+
+	regs := []*structs.ServiceRegistration{
+		{ID: "abc001", ServiceName: "s1"},
+		{ID: "abc002", ServiceName: "s1", Meta: map[string]string{"weight": "3"}},
+	}
+
+	// the heavier service is chosen about 3 times as often over many keys
+	const keys = 4000
+	chosen := make(map[string]int)
+	for i := 0; i < keys; i++ {
+		result, err := sr.choose(regs, fmt.Sprintf("1|key-%d", i))
+		must.NoError(t, err)
+		must.Len(t, 1, result)
+		chosen[result[0].ID]++
+	}
+	must.Between(t, 0.70*keys, float64(chosen["abc002"]), 0.80*keys)
+
+	// the selection is stable for a key
+	first, err := sr.choose(regs, "2|aaa")
+	must.NoError(t, err)
+	second, err := sr.choose(regs, "2|aaa")
+	must.NoError(t, err)
+	must.Eq(t, first, second)
+}
+
+func TestServiceRegistration_GetService_passing(t *testing.T) {
+	ci.Parallel(t)
+
+	s, cleanup := TestServer(t, nil)
+	t.Cleanup(cleanup)
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	services := mock.ServiceRegistrations()
+	unchecked := services[0]
+	passing := unchecked.Copy()
+	passing.ID += "-passing"
+	passing.CheckStatus = structs.CheckSuccess
+	pending := unchecked.Copy()
+	pending.ID += "-pending"
+	pending.CheckStatus = structs.CheckPending
+	failing := unchecked.Copy()
+	failing.ID += "-failing"
+	failing.CheckStatus = structs.CheckFailure
+
+	must.NoError(t, s.fsm.State().UpsertServiceRegistrations(structs.MsgTypeTestSetup, 10,
+		[]*structs.ServiceRegistration{unchecked, passing, pending, failing}))
+
+	get := func(req *structs.ServiceRegistrationByNameRequest) []string {
+		req.ServiceName = unchecked.ServiceName
+		req.QueryOptions = structs.QueryOptions{
+			Namespace: unchecked.Namespace,
+			Region:    s.Region(),
+		}
+		var resp structs.ServiceRegistrationByNameResponse
+		must.NoError(t, msgpackrpc.CallWithCodec(codec,
+			structs.ServiceRegistrationGetServiceRPCMethod, req, &resp))
+		ids := make([]string, len(resp.Services))
+		for i, service := range resp.Services {
+			ids[i] = service.ID
+		}
+		return ids
+	}
+
+	// all services are returned by default
+	must.SliceContainsAll(t, []string{unchecked.ID, passing.ID, pending.ID, failing.ID},
+		get(&structs.ServiceRegistrationByNameRequest{}))
+
+	// only passing services are returned when requested
+	must.SliceContainsAll(t, []string{unchecked.ID, passing.ID},
+		get(&structs.ServiceRegistrationByNameRequest{Passing: true}))
+
+	// only passing services are chosen from
+	must.SliceContainsAll(t, []string{unchecked.ID, passing.ID},
+		get(&structs.ServiceRegistrationByNameRequest{Passing: true, Choose: "4|aaa"}))
+}
+
+func TestServiceRegistration_GetService_nodeSecret(t *testing.T) {
+	ci.Parallel(t)
+
+	s, _, cleanup := TestACLServer(t, nil)
+	t.Cleanup(cleanup)
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	services := mock.ServiceRegistrations()
+	must.NoError(t, s.fsm.State().UpsertServiceRegistrations(
+		structs.MsgTypeTestSetup, 10, services))
+
+	node := mock.Node()
+	must.NoError(t, s.State().UpsertNode(structs.MsgTypeTestSetup, 20, node))
+
+	get := func(authToken string) error {
+		req := &structs.ServiceRegistrationByNameRequest{
+			ServiceName: services[0].ServiceName,
+			QueryOptions: structs.QueryOptions{
+				Namespace: services[0].Namespace,
+				Region:    s.Region(),
+				AuthToken: authToken,
+			},
+		}
+		var resp structs.ServiceRegistrationByNameResponse
+		err := msgpackrpc.CallWithCodec(codec,
+			structs.ServiceRegistrationGetServiceRPCMethod, req, &resp)
+		if err == nil {
+			must.Len(t, 1, resp.Services)
+		}
+		return err
+	}
+
+	// clients can't read services of namespaces they have no allocations in
+	must.EqError(t, get(node.SecretID), structs.ErrPermissionDenied.Error())
+
+	alloc := mock.Alloc()
+	alloc.NodeID = node.ID
+	alloc.Namespace = services[0].Namespace
+	must.NoError(t, s.State().UpsertAllocs(structs.MsgTypeTestSetup, 30,
+		[]*structs.Allocation{alloc}))
+
+	// clients can read services to answer DNS queries for their allocations
+	must.NoError(t, get(node.SecretID))
+
+	// but not once the allocations are terminal
+	alloc = alloc.Copy()
+	alloc.ClientStatus = structs.AllocClientStatusComplete
+	must.NoError(t, s.State().UpdateAllocsFromClient(structs.MsgTypeTestSetup, 40,
+		[]*structs.Allocation{alloc}))
+	must.EqError(t, get(node.SecretID), structs.ErrPermissionDenied.Error())
+
+	// anonymous requests are still denied
+	must.EqError(t, get(""), structs.ErrPermissionDenied.Error())
+}
@@ -30,6 +30,8 @@ const (
 	TableCSIVolumes               = "csi_volumes"
 	TableCSIPlugins               = "csi_plugins"
 	TableTaskGroupHostVolumeClaim = "task_volume"
+	TableReservations             = "reservations"
+	TableEvalTraces               = "eval_traces"
 )
 
 const (
@@ -104,6 +106,8 @@ func init() {
 		bindingRulesTableSchema,
 		hostVolumeTableSchema,
 		taskGroupHostVolumeClaimSchema,
+		reservationTableSchema,
+		evalTraceTableSchema,
 	}...)
 }
 
@@ -754,6 +758,32 @@ func allocTableSchema() *memdb.TableSchema {
 				},
 			},
 
+			// Preempted index is used to lookup the allocations of a namespace
+			// that were preempted
+			"preempted": {
+				Name:         "preempted",
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.CompoundIndex{
+					Indexes: []memdb.Indexer{
+						&memdb.StringFieldIndex{
+							Field: "Namespace",
+						},
+
+						// Conditional indexer on if allocation was preempted
+						&memdb.ConditionalIndex{
+							Conditional: func(obj interface{}) (bool, error) {
+								alloc, ok := obj.(*structs.Allocation)
+								if !ok {
+									return false, fmt.Errorf("wrong type, got %t should be Allocation", obj)
+								}
+								return alloc.PreemptedTime != 0, nil
+							},
+						},
+					},
+				},
+			},
+
 			// Job index is used to lookup allocations by job
 			"job": {
 				Name:         "job",
@@ -1713,3 +1743,53 @@ func taskGroupHostVolumeClaimSchema() *memdb.TableSchema {
 		},
 	}
 }
+
+// reservationTableSchema returns the MemDB schema for the reservations table.
+func reservationTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: TableReservations,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.CompoundIndex{
+					Indexes: []memdb.Indexer{
+						&memdb.StringFieldIndex{
+							Field: "Namespace",
+						},
+						&memdb.StringFieldIndex{
+							Field: "Name",
+						},
+					},
+				},
+			},
+			indexNodePool: {
+				Name:         indexNodePool,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "NodePool",
+				},
+			},
+		},
+	}
+}
+
+// evalTraceTableSchema returns the MemDB schema for the evaluation traces
+// table. Traces are keyed by the ID of the evaluation they belong to.
+func evalTraceTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: TableEvalTraces,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "EvalID",
+				},
+			},
+		},
+	}
+}
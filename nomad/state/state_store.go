@@ -3553,6 +3553,9 @@ func (s *StateStore) DeleteEvalsByFilter(index uint64, filterExpr string, pageTo
 		if err := txn.Delete("evals", eval); err != nil {
 			return fmt.Errorf("eval delete failed: %v", err)
 		}
+		if err := s.deleteEvalTraceTxn(txn, eval.ID); err != nil {
+			return err
+		}
 		pageCount++
 	}
 
@@ -3676,6 +3679,9 @@ func (s *StateStore) DeleteEval(index uint64, evals, allocs []string, userInitia
 		if err := txn.Delete("evals", existing); err != nil {
 			return fmt.Errorf("eval delete failed: %v", err)
 		}
+		if err := s.deleteEvalTraceTxn(txn, eval); err != nil {
+			return err
+		}
 
 		// Mark that we have made a successful modification to the evals
 		// table.
@@ -4619,6 +4625,21 @@ func (s *StateStore) allocsByNamespaceImpl(ws memdb.WatchSet, txn *txn, namespac
 	return iter, nil
 }
 
+// AllocsPreemptedByNamespace returns an iterator over the allocations in the
+// namespace that were preempted.
+func (s *StateStore) AllocsPreemptedByNamespace(ws memdb.WatchSet, namespace string) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	iter, err := txn.Get("allocs", "preempted", namespace, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ws.Add(iter.WatchCh())
+
+	return iter, nil
+}
+
 const siTokenAccessorTable = "si_token_accessors"
 
 // UpdateDeploymentStatus is used to make deployment status updates and
@@ -7229,6 +7250,7 @@ func (s *StateSnapshot) DenormalizeAllocationDiffSlice(allocDiffs []*structs.All
 
 		if allocDiff.PreemptedByAllocation != "" {
 			allocCopy.PreemptedByAllocation = allocDiff.PreemptedByAllocation
+			allocCopy.PreemptedTime = allocDiff.ModifyTime
 			allocCopy.DesiredDescription = getPreemptedAllocDesiredDescription(allocDiff.PreemptedByAllocation)
 			allocCopy.DesiredStatus = structs.AllocDesiredStatusEvict
 		} else {
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"fmt"
+
+	memdb "github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// EvalTraces returns an iterator over all evaluation traces.
+func (s *StateStore) EvalTraces(ws memdb.WatchSet) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	iter, err := txn.Get(TableEvalTraces, indexID)
+	if err != nil {
+		return nil, fmt.Errorf("eval traces lookup failed: %w", err)
+	}
+
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// EvalTraceByID returns the trace of the given evaluation or nil if the
+// evaluation has no trace.
+func (s *StateStore) EvalTraceByID(ws memdb.WatchSet, evalID string) (*structs.EvalTrace, error) {
+	txn := s.db.ReadTxn()
+
+	watchCh, existing, err := txn.FirstWatch(TableEvalTraces, indexID, evalID)
+	if err != nil {
+		return nil, fmt.Errorf("eval trace lookup failed: %w", err)
+	}
+	ws.Add(watchCh)
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	return existing.(*structs.EvalTrace), nil
+}
+
+// UpsertEvalTrace inserts or replaces the trace of an evaluation. The trace
+// is only stored if its evaluation exists, so a trace written after the
+// evaluation has been garbage collected is dropped.
+func (s *StateStore) UpsertEvalTrace(msgType structs.MessageType, index uint64, trace *structs.EvalTrace) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	if trace == nil {
+		return nil
+	}
+
+	eval, err := txn.First("evals", indexID, trace.EvalID)
+	if err != nil {
+		return fmt.Errorf("eval lookup failed: %w", err)
+	}
+	if eval == nil {
+		return nil
+	}
+
+	existing, err := txn.First(TableEvalTraces, indexID, trace.EvalID)
+	if err != nil {
+		return fmt.Errorf("eval trace lookup failed: %w", err)
+	}
+	if existing != nil {
+		trace.CreateIndex = existing.(*structs.EvalTrace).CreateIndex
+	} else {
+		trace.CreateIndex = index
+	}
+	trace.ModifyIndex = index
+
+	if err := txn.Insert(TableEvalTraces, trace); err != nil {
+		return fmt.Errorf("eval trace insert failed: %w", err)
+	}
+	if err := txn.Insert(tableIndex, &IndexEntry{TableEvalTraces, index}); err != nil {
+		return fmt.Errorf("index update failed: %w", err)
+	}
+
+	return txn.Commit()
+}
+
+// deleteEvalTraceTxn removes the trace of an evaluation, if any, as part of
+// deleting the evaluation.
+func (s *StateStore) deleteEvalTraceTxn(txn *txn, evalID string) error {
+	existing, err := txn.First(TableEvalTraces, indexID, evalID)
+	if err != nil {
+		return fmt.Errorf("eval trace lookup failed: %w", err)
+	}
+	if existing == nil {
+		return nil
+	}
+	if err := txn.Delete(TableEvalTraces, existing); err != nil {
+		return fmt.Errorf("eval trace delete failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"testing"
+
+	memdb "github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestStateStore_UpsertEvalTrace(t *testing.T) {
+	ci.Parallel(t)
+	store := testStateStore(t)
+
+	eval := mock.Eval()
+	must.NoError(t, store.UpsertEvals(structs.MsgTypeTestSetup, 1000, []*structs.Evaluation{eval}))
+
+	ws := memdb.NewWatchSet()
+	got, err := store.EvalTraceByID(ws, eval.ID)
+	must.NoError(t, err)
+	must.Nil(t, got)
+
+	trace := structs.NewEvalTraceRecorder(eval)
+	trace.Filtered(mock.Node(), "missing drivers")
+	must.NoError(t, store.UpsertEvalTrace(structs.MsgTypeTestSetup, 1001, trace.Trace()))
+	must.True(t, watchFired(ws))
+
+	got, err = store.EvalTraceByID(nil, eval.ID)
+	must.NoError(t, err)
+	must.Len(t, 1, got.Events)
+	must.Eq(t, 1001, got.CreateIndex)
+	must.Eq(t, 1001, got.ModifyIndex)
+
+	// Replacing a trace keeps the create index.
+	trace.Filtered(mock.Node(), "missing drivers")
+	must.NoError(t, store.UpsertEvalTrace(structs.MsgTypeTestSetup, 1002, trace.Trace().Copy()))
+	got, err = store.EvalTraceByID(nil, eval.ID)
+	must.NoError(t, err)
+	must.Len(t, 2, got.Events)
+	must.Eq(t, 1001, got.CreateIndex)
+	must.Eq(t, 1002, got.ModifyIndex)
+
+	index, err := store.Index(TableEvalTraces)
+	must.NoError(t, err)
+	must.Eq(t, 1002, index)
+
+	// Traces of unknown evals are dropped.
+	other := structs.NewEvalTraceRecorder(mock.Eval())
+	must.NoError(t, store.UpsertEvalTrace(structs.MsgTypeTestSetup, 1003, other.Trace()))
+	got, err = store.EvalTraceByID(nil, other.Trace().EvalID)
+	must.NoError(t, err)
+	must.Nil(t, got)
+
+	// Deleting the eval deletes its trace.
+	must.NoError(t, store.DeleteEval(1004, []string{eval.ID}, nil, false))
+	got, err = store.EvalTraceByID(nil, eval.ID)
+	must.NoError(t, err)
+	must.Nil(t, got)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"fmt"
+
+	memdb "github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// Reservations returns an iterator over all reservations in all namespaces.
+func (s *StateStore) Reservations(ws memdb.WatchSet, sort SortOption) (memdb.ResultIterator, error) {
+	return s.reservationsIter(ws, indexID, sort)
+}
+
+// ReservationsByNamespace returns an iterator over all reservations in the
+// given namespace.
+func (s *StateStore) ReservationsByNamespace(ws memdb.WatchSet, namespace string, sort SortOption) (memdb.ResultIterator, error) {
+	return s.reservationsIter(ws, "id_prefix", sort, namespace, "")
+}
+
+// ReservationsByNamePrefix returns an iterator over all reservations in the
+// given namespace that match the name prefix.
+func (s *StateStore) ReservationsByNamePrefix(ws memdb.WatchSet, namespace, prefix string, sort SortOption) (memdb.ResultIterator, error) {
+	return s.reservationsIter(ws, "id_prefix", sort, namespace, prefix)
+}
+
+// ReservationsByNodePool returns an iterator over the reservations of all
+// namespaces that target the given node pool.
+func (s *StateStore) ReservationsByNodePool(ws memdb.WatchSet, pool string) (memdb.ResultIterator, error) {
+	return s.reservationsIter(ws, indexNodePool, SortDefault, pool)
+}
+
+func (s *StateStore) reservationsIter(ws memdb.WatchSet, index string, sort SortOption, args ...any) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	var iter memdb.ResultIterator
+	var err error
+
+	switch sort {
+	case SortReverse:
+		iter, err = txn.GetReverse(TableReservations, index, args...)
+	default:
+		iter, err = txn.Get(TableReservations, index, args...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reservations lookup failed: %w", err)
+	}
+
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// ReservationByName returns the reservation that matches the given namespace
+// and name or nil if there is no match.
+func (s *StateStore) ReservationByName(ws memdb.WatchSet, namespace, name string) (*structs.Reservation, error) {
+	txn := s.db.ReadTxn()
+
+	watchCh, existing, err := txn.FirstWatch(TableReservations, indexID, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("reservation lookup failed: %w", err)
+	}
+	ws.Add(watchCh)
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	return existing.(*structs.Reservation), nil
+}
+
+// UpsertReservations inserts or updates the given set of reservations.
+func (s *StateStore) UpsertReservations(msgType structs.MessageType, index uint64, reservations []*structs.Reservation) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	for _, res := range reservations {
+		if err := s.upsertReservationTxn(txn, index, res); err != nil {
+			return err
+		}
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableReservations, index}); err != nil {
+		return fmt.Errorf("index update failed: %w", err)
+	}
+
+	return txn.Commit()
+}
+
+func (s *StateStore) upsertReservationTxn(txn *txn, index uint64, res *structs.Reservation) error {
+	if res == nil {
+		return nil
+	}
+
+	if exists, err := s.namespaceExists(txn, res.Namespace); err != nil {
+		return err
+	} else if !exists {
+		return fmt.Errorf("reservation %s is in nonexistent namespace %s", res.Name, res.Namespace)
+	}
+
+	existing, err := txn.First(TableReservations, indexID, res.Namespace, res.Name)
+	if err != nil {
+		return fmt.Errorf("reservation lookup failed: %w", err)
+	}
+
+	if existing != nil {
+		exist := existing.(*structs.Reservation)
+		res.CreateIndex = exist.CreateIndex
+		res.ModifyIndex = index
+	} else {
+		res.CreateIndex = index
+		res.ModifyIndex = index
+	}
+
+	if err := txn.Insert(TableReservations, res); err != nil {
+		return fmt.Errorf("reservation insert failed: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteReservations removes the given set of reservations from the
+// namespace.
+func (s *StateStore) DeleteReservations(msgType structs.MessageType, index uint64, namespace string, names []string) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	for _, name := range names {
+		existing, err := txn.First(TableReservations, indexID, namespace, name)
+		if err != nil {
+			return fmt.Errorf("reservation lookup failed: %w", err)
+		}
+		if existing == nil {
+			return fmt.Errorf("reservation %s not found", name)
+		}
+
+		if err := txn.Delete(TableReservations, existing); err != nil {
+			return fmt.Errorf("reservation deletion failed: %w", err)
+		}
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableReservations, index}); err != nil {
+		return fmt.Errorf("index update failed: %w", err)
+	}
+
+	return txn.Commit()
+}
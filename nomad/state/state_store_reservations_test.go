@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"testing"
+
+	memdb "github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestStateStore_UpsertReservations(t *testing.T) {
+	ci.Parallel(t)
+	store := testStateStore(t)
+
+	ns := mock.Namespace()
+	must.NoError(t, store.UpsertNamespaces(1000, []*structs.Namespace{ns}))
+
+	res1 := mock.Reservation()
+	res2 := mock.Reservation()
+	res2.Namespace = ns.Name
+	must.NoError(t, store.UpsertReservations(structs.MsgTypeTestSetup, 1001,
+		[]*structs.Reservation{res1, res2}))
+
+	ws := memdb.NewWatchSet()
+	got, err := store.ReservationByName(ws, res1.Namespace, res1.Name)
+	must.NoError(t, err)
+	must.Eq(t, res1, got)
+	must.Eq(t, 1001, got.CreateIndex)
+	must.Eq(t, 1001, got.ModifyIndex)
+
+	// Reservations are namespaced.
+	got, err = store.ReservationByName(ws, res1.Namespace, res2.Name)
+	must.NoError(t, err)
+	must.Nil(t, got)
+
+	iter, err := store.ReservationsByNamespace(nil, ns.Name, SortDefault)
+	must.NoError(t, err)
+	must.Eq(t, res2, iter.Next().(*structs.Reservation))
+	must.Nil(t, iter.Next())
+
+	// Updating a reservation keeps the create index and fires watches.
+	res1 = res1.Copy()
+	res1.Description = "updated"
+	must.NoError(t, store.UpsertReservations(structs.MsgTypeTestSetup, 1002,
+		[]*structs.Reservation{res1}))
+	must.True(t, watchFired(ws))
+
+	got, err = store.ReservationByName(nil, res1.Namespace, res1.Name)
+	must.NoError(t, err)
+	must.Eq(t, "updated", got.Description)
+	must.Eq(t, 1001, got.CreateIndex)
+	must.Eq(t, 1002, got.ModifyIndex)
+
+	index, err := store.Index(TableReservations)
+	must.NoError(t, err)
+	must.Eq(t, 1002, index)
+
+	// Reservations can't be created in namespaces that don't exist.
+	res3 := mock.Reservation()
+	res3.Namespace = "nonexistent"
+	err = store.UpsertReservations(structs.MsgTypeTestSetup, 1003,
+		[]*structs.Reservation{res3})
+	must.ErrorContains(t, err, "nonexistent namespace")
+}
+
+func TestStateStore_DeleteReservations(t *testing.T) {
+	ci.Parallel(t)
+	store := testStateStore(t)
+
+	res1 := mock.Reservation()
+	res2 := mock.Reservation()
+	must.NoError(t, store.UpsertReservations(structs.MsgTypeTestSetup, 1000,
+		[]*structs.Reservation{res1, res2}))
+
+	// Deleting a missing reservation fails without deleting the others.
+	err := store.DeleteReservations(structs.MsgTypeTestSetup, 1001,
+		structs.DefaultNamespace, []string{res1.Name, "missing"})
+	must.ErrorContains(t, err, "reservation missing not found")
+
+	got, err := store.ReservationByName(nil, res1.Namespace, res1.Name)
+	must.NoError(t, err)
+	must.NotNil(t, got)
+
+	must.NoError(t, store.DeleteReservations(structs.MsgTypeTestSetup, 1002,
+		structs.DefaultNamespace, []string{res1.Name}))
+
+	iter, err := store.Reservations(nil, SortDefault)
+	must.NoError(t, err)
+	must.Eq(t, res2.Name, iter.Next().(*structs.Reservation).Name)
+	must.Nil(t, iter.Next())
+
+	index, err := store.Index(TableReservations)
+	must.NoError(t, err)
+	must.Eq(t, 1002, index)
+}
+
+func TestStateStore_ReservationsByNodePool(t *testing.T) {
+	ci.Parallel(t)
+	store := testStateStore(t)
+
+	res1 := mock.Reservation()
+	res2 := mock.Reservation()
+	res2.NodePool = "gpu"
+	must.NoError(t, store.UpsertReservations(structs.MsgTypeTestSetup, 1000,
+		[]*structs.Reservation{res1, res2}))
+
+	iter, err := store.ReservationsByNodePool(nil, "gpu")
+	must.NoError(t, err)
+	must.Eq(t, res2.Name, iter.Next().(*structs.Reservation).Name)
+	must.Nil(t, iter.Next())
+
+	iter, err = store.ReservationsByNodePool(nil, "other")
+	must.NoError(t, err)
+	must.Nil(t, iter.Next())
+}
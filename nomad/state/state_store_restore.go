@@ -283,3 +283,19 @@ func (r *StateRestore) HostVolumeRestore(vol *structs.HostVolume) error {
 	}
 	return nil
 }
+
+// ReservationRestore is used to restore a reservation
+func (r *StateRestore) ReservationRestore(res *structs.Reservation) error {
+	if err := r.txn.Insert(TableReservations, res); err != nil {
+		return fmt.Errorf("reservation insert failed: %w", err)
+	}
+	return nil
+}
+
+// EvalTraceRestore is used to restore an evaluation trace
+func (r *StateRestore) EvalTraceRestore(trace *structs.EvalTrace) error {
+	if err := r.txn.Insert(TableEvalTraces, trace); err != nil {
+		return fmt.Errorf("eval trace insert failed: %w", err)
+	}
+	return nil
+}
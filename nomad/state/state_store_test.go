@@ -443,6 +443,16 @@ func TestStateStore_UpsertPlanResults_PreemptedAllocs(t *testing.T) {
 	require.Equal(preempted.DesiredDescription, fmt.Sprintf("Preempted by alloc ID %v", alloc.ID))
 	require.Equal(preempted.Job.ID, preemptedAlloc.Job.ID)
 	require.Equal(preempted.Job, preemptedAlloc.Job)
+	require.Equal(minimalPreemptedAlloc.ModifyTime, preempted.PreemptedTime)
+
+	// Verify the preempted alloc is indexed by namespace
+	iter, err := state.AllocsPreemptedByNamespace(ws, preemptedAlloc.Namespace)
+	require.NoError(err)
+	var preemptedIDs []string
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		preemptedIDs = append(preemptedIDs, raw.(*structs.Allocation).ID)
+	}
+	require.Equal([]string{preemptedAlloc.ID}, preemptedIDs)
 
 	// Verify eval for preempted job
 	preemptedJobEval, err := state.EvalByID(ws, eval2.ID)
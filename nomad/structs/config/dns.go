@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import (
+	"slices"
+
+	"github.com/hashicorp/nomad/helper/pointer"
+)
+
+// DNSConfig describes the client's DNS server for Nomad native services.
+type DNSConfig struct {
+	// Enabled starts the DNS server.
+	Enabled *bool `hcl:"enabled"`
+
+	// Address is the address the DNS server listens on, for the host. An
+	// empty address only serves allocations.
+	Address *string `hcl:"address"`
+
+	// Port is the TCP and UDP port the DNS server listens on, for the host.
+	Port *int `hcl:"port"`
+
+	// Domain is the domain of Nomad services.
+	Domain *string `hcl:"domain"`
+
+	// TTL is the time-to-live of the records of Nomad services.
+	TTL *string `hcl:"ttl"`
+
+	// Recursors are the addresses of the DNS servers that queries outside of
+	// the domain are forwarded to.
+	Recursors []string `hcl:"recursors"`
+
+	// BindBridge serves DNS within the network namespace of allocations in
+	// bridge networking mode, and makes it their nameserver.
+	BindBridge *bool `hcl:"bind_bridge"`
+}
+
+func (d *DNSConfig) Copy() *DNSConfig {
+	if d == nil {
+		return nil
+	}
+
+	nd := new(DNSConfig)
+	*nd = *d
+	nd.Enabled = pointer.Copy(d.Enabled)
+	nd.Address = pointer.Copy(d.Address)
+	nd.Port = pointer.Copy(d.Port)
+	nd.Domain = pointer.Copy(d.Domain)
+	nd.TTL = pointer.Copy(d.TTL)
+	nd.Recursors = slices.Clone(d.Recursors)
+	nd.BindBridge = pointer.Copy(d.BindBridge)
+	return nd
+}
+
+func (d *DNSConfig) Merge(o *DNSConfig) *DNSConfig {
+	switch {
+	case d == nil:
+		return o.Copy()
+	case o == nil:
+		return d.Copy()
+	default:
+		nd := d.Copy()
+		if o.Enabled != nil {
+			nd.Enabled = pointer.Copy(o.Enabled)
+		}
+		if o.Address != nil {
+			nd.Address = pointer.Copy(o.Address)
+		}
+		if o.Port != nil {
+			nd.Port = pointer.Copy(o.Port)
+		}
+		if o.Domain != nil {
+			nd.Domain = pointer.Copy(o.Domain)
+		}
+		if o.TTL != nil {
+			nd.TTL = pointer.Copy(o.TTL)
+		}
+		if len(o.Recursors) != 0 {
+			nd.Recursors = slices.Clone(o.Recursors)
+		}
+		if o.BindBridge != nil {
+			nd.BindBridge = pointer.Copy(o.BindBridge)
+		}
+		return nd
+	}
+}
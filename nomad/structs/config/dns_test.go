@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/shoenig/test/must"
+)
+
+func TestDNSConfig_Copy(t *testing.T) {
+	ci.Parallel(t)
+
+	var nilConfig *DNSConfig
+	must.Nil(t, nilConfig.Copy())
+
+	original := &DNSConfig{
+		Enabled:    pointer.Of(true),
+		Address:    pointer.Of("127.0.0.1"),
+		Port:       pointer.Of(4653),
+		Domain:     pointer.Of("nomad"),
+		TTL:        pointer.Of("5s"),
+		Recursors:  []string{"192.0.2.1"},
+		BindBridge: pointer.Of(true),
+	}
+	copied := original.Copy()
+	must.Eq(t, original, copied)
+
+	copied.Recursors[0] = "192.0.2.2"
+	*copied.Port = 53
+	must.Eq(t, []string{"192.0.2.1"}, original.Recursors)
+	must.Eq(t, 4653, *original.Port)
+}
+
+func TestDNSConfig_Merge(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name           string
+		inputConfig    *DNSConfig
+		mergeConfig    *DNSConfig
+		expectedOutput *DNSConfig
+	}{
+		{
+			name:           "nil",
+			inputConfig:    nil,
+			mergeConfig:    nil,
+			expectedOutput: nil,
+		},
+		{
+			name:        "nil input",
+			inputConfig: nil,
+			mergeConfig: &DNSConfig{
+				Enabled: pointer.Of(true),
+				Port:    pointer.Of(53),
+			},
+			expectedOutput: &DNSConfig{
+				Enabled: pointer.Of(true),
+				Port:    pointer.Of(53),
+			},
+		},
+		{
+			name: "nil merge",
+			inputConfig: &DNSConfig{
+				Enabled:   pointer.Of(true),
+				Recursors: []string{"192.0.2.1"},
+			},
+			mergeConfig: nil,
+			expectedOutput: &DNSConfig{
+				Enabled:   pointer.Of(true),
+				Recursors: []string{"192.0.2.1"},
+			},
+		},
+		{
+			name: "partial",
+			inputConfig: &DNSConfig{
+				Enabled:    pointer.Of(true),
+				Address:    pointer.Of("127.0.0.1"),
+				Port:       pointer.Of(4653),
+				Recursors:  []string{"192.0.2.1"},
+				BindBridge: pointer.Of(true),
+			},
+			mergeConfig: &DNSConfig{
+				Enabled:    pointer.Of(false),
+				Domain:     pointer.Of("example"),
+				TTL:        pointer.Of("10s"),
+				Recursors:  []string{"192.0.2.2"},
+				BindBridge: pointer.Of(false),
+			},
+			expectedOutput: &DNSConfig{
+				Enabled:    pointer.Of(false),
+				Address:    pointer.Of("127.0.0.1"),
+				Port:       pointer.Of(4653),
+				Domain:     pointer.Of("example"),
+				TTL:        pointer.Of("10s"),
+				Recursors:  []string{"192.0.2.2"},
+				BindBridge: pointer.Of(false),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actualOutput := tc.inputConfig.Merge(tc.mergeConfig)
+			must.Eq(t, tc.expectedOutput, actualOutput)
+		})
+	}
+}
@@ -151,6 +151,16 @@ func (j *Job) Diff(other *Job, contextual bool) (*JobDiff, error) {
 		diff.Objects = append(diff.Objects, mrDiff)
 	}
 
+	// Gang diff
+	if gDiff := gangDiff(j.Gang, other.Gang, contextual); gDiff != nil {
+		diff.Objects = append(diff.Objects, gDiff)
+	}
+
+	// Preemption budget diff
+	if pbDiff := primitiveObjectDiff(j.PreemptionBudget, other.PreemptionBudget, nil, "PreemptionBudget", contextual); pbDiff != nil {
+		diff.Objects = append(diff.Objects, pbDiff)
+	}
+
 	// UI diff
 	if uiDiff := uiDiff(j.UI, other.UI, contextual); uiDiff != nil {
 		diff.Objects = append(diff.Objects, uiDiff)
@@ -2200,6 +2210,28 @@ func parameterizedJobDiff(old, new *ParameterizedJobConfig, contextual bool) *Ob
 	return diff
 }
 
+func gangDiff(old, new *JobGang, contextual bool) *ObjectDiff {
+	diff := &ObjectDiff{Type: DiffTypeNone, Name: "Gang"}
+
+	if reflect.DeepEqual(old, new) {
+		return nil
+	} else if old == nil {
+		old = &JobGang{}
+		diff.Type = DiffTypeAdded
+	} else if new == nil {
+		new = &JobGang{}
+		diff.Type = DiffTypeDeleted
+	} else {
+		diff.Type = DiffTypeEdited
+	}
+
+	if groupsDiff := stringSetDiff(old.Groups, new.Groups, "Groups", contextual); groupsDiff != nil {
+		diff.Objects = append(diff.Objects, groupsDiff)
+	}
+
+	return diff
+}
+
 func multiregionDiff(old, new *Multiregion, contextual bool) *ObjectDiff {
 
 	diff := &ObjectDiff{Type: DiffTypeNone, Name: "Multiregion"}
@@ -177,6 +177,12 @@ func TestJobDiff(t *testing.T) {
 						Old:  "foo",
 						New:  "",
 					},
+					{
+						Type: DiffTypeDeleted,
+						Name: "PreemptionCost",
+						Old:  "0",
+						New:  "",
+					},
 					{
 						Type: DiffTypeDeleted,
 						Name: "Priority",
@@ -246,6 +252,12 @@ func TestJobDiff(t *testing.T) {
 						Old:  "",
 						New:  "foo",
 					},
+					{
+						Type: DiffTypeAdded,
+						Name: "PreemptionCost",
+						Old:  "",
+						New:  "0",
+					},
 					{
 						Type: DiffTypeAdded,
 						Name: "Priority",
@@ -1354,6 +1366,80 @@ func TestJobDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Gang added
+			Old: &Job{},
+			New: &Job{
+				Gang: &JobGang{
+					Groups: []string{"foo"},
+				},
+			},
+			Expected: &JobDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeAdded,
+						Name: "Gang",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "Groups",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Groups",
+										Old:  "",
+										New:  "foo",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			// Gang edited
+			Old: &Job{
+				Gang: &JobGang{
+					Groups: []string{"foo"},
+				},
+			},
+			New: &Job{
+				Gang: &JobGang{
+					Groups: []string{"bar"},
+				},
+			},
+			Expected: &JobDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Gang",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "Groups",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Groups",
+										Old:  "",
+										New:  "bar",
+									},
+									{
+										Type: DiffTypeDeleted,
+										Name: "Groups",
+										Old:  "foo",
+										New:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			// Parameterized Job edited
 			Old: &Job{
@@ -3179,6 +3265,7 @@ func TestTaskGroupDiff(t *testing.T) {
 					Migrate: true,
 					Sticky:  true,
 					SizeMB:  100,
+					IOPS:    500,
 				},
 			},
 			Expected: &TaskGroupDiff{
@@ -3188,6 +3275,12 @@ func TestTaskGroupDiff(t *testing.T) {
 						Type: DiffTypeAdded,
 						Name: "EphemeralDisk",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "IOPS",
+								Old:  "",
+								New:  "500",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "Migrate",
@@ -3218,6 +3311,7 @@ func TestTaskGroupDiff(t *testing.T) {
 					Migrate: true,
 					Sticky:  true,
 					SizeMB:  100,
+					IOPS:    500,
 				},
 			},
 			New: &TaskGroup{},
@@ -3228,6 +3322,12 @@ func TestTaskGroupDiff(t *testing.T) {
 						Type: DiffTypeDeleted,
 						Name: "EphemeralDisk",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeDeleted,
+								Name: "IOPS",
+								Old:  "500",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "Migrate",
@@ -3322,6 +3422,12 @@ func TestTaskGroupDiff(t *testing.T) {
 						Type: DiffTypeEdited,
 						Name: "EphemeralDisk",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeNone,
+								Name: "IOPS",
+								Old:  "0",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeEdited,
 								Name: "Migrate",
@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"maps"
+)
+
+const (
+	// EvalTraceUpsertRPCMethod is the RPC method used by scheduler workers to
+	// store the decision trace of an evaluation.
+	//
+	// Args: EvalTraceUpsertRequest
+	// Reply: GenericResponse
+	EvalTraceUpsertRPCMethod = "Eval.UpsertTrace"
+
+	// EvalTraceGetRPCMethod is the RPC method for reading the decision trace
+	// of an evaluation.
+	//
+	// Args: EvalTraceSpecificRequest
+	// Reply: SingleEvalTraceResponse
+	EvalTraceGetRPCMethod = "Eval.GetTrace"
+
+	// MaxEvalTraceEvents is the maximum number of events retained in a single
+	// evaluation trace. Events recorded past this limit are dropped and the
+	// trace is marked as truncated so that a large cluster can't produce an
+	// unbounded Raft entry.
+	MaxEvalTraceEvents = 5000
+)
+
+const (
+	// EvalTraceDecisionFiltered is recorded when a node is removed by a
+	// feasibility check, such as a constraint or a missing driver.
+	EvalTraceDecisionFiltered = "filtered"
+
+	// EvalTraceDecisionExhausted is recorded when a node is feasible but
+	// doesn't have enough of a resource left for the allocation.
+	EvalTraceDecisionExhausted = "exhausted"
+
+	// EvalTraceDecisionScored is recorded when a node makes it through the
+	// ranking iterators and receives a final normalized score.
+	EvalTraceDecisionScored = "scored"
+
+	// EvalTraceDecisionSelected is recorded for the node picked for an
+	// allocation.
+	EvalTraceDecisionSelected = "selected"
+
+	// EvalTraceDecisionFailed is recorded when no node could be found for an
+	// allocation.
+	EvalTraceDecisionFailed = "failed"
+)
+
+// EvalTrace is the structured record of every scheduler decision made while
+// processing an evaluation. Traces are only recorded when enabled in the
+// scheduler configuration and are removed along with their evaluation.
+type EvalTrace struct {
+	// EvalID is the ID of the evaluation the trace belongs to.
+	EvalID string
+
+	// Namespace and JobID identify the job of the evaluation.
+	Namespace string
+	JobID     string
+
+	// Events is the ordered list of scheduler decisions.
+	Events []*EvalTraceEvent
+
+	// Truncated is true if events were dropped because the trace reached
+	// MaxEvalTraceEvents.
+	Truncated bool
+
+	// Raft indexes.
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// Copy returns a deep copy of the trace.
+func (t *EvalTrace) Copy() *EvalTrace {
+	if t == nil {
+		return nil
+	}
+	nt := new(EvalTrace)
+	*nt = *t
+	if t.Events != nil {
+		nt.Events = make([]*EvalTraceEvent, len(t.Events))
+		for i, ev := range t.Events {
+			nt.Events[i] = ev.Copy()
+		}
+	}
+	return nt
+}
+
+// EvalTraceEvent is a single scheduler decision about a node while placing
+// an allocation.
+type EvalTraceEvent struct {
+	// TaskGroup and AllocName identify the placement being computed.
+	TaskGroup string
+	AllocName string
+
+	// NodeID is the node the decision is about. It is empty for failed
+	// placements.
+	NodeID string
+
+	// Decision is one of the EvalTraceDecision constants.
+	Decision string
+
+	// Reason is the constraint or resource dimension that filtered or
+	// exhausted the node, or the failure reason of a placement.
+	Reason string
+
+	// Scores holds the score given by each ranking iterator to the node,
+	// keyed by scorer name.
+	Scores map[string]float64
+
+	// NormScore is the final normalized score of the node.
+	NormScore float64
+}
+
+// Copy returns a deep copy of the event.
+func (e *EvalTraceEvent) Copy() *EvalTraceEvent {
+	if e == nil {
+		return nil
+	}
+	ne := new(EvalTraceEvent)
+	*ne = *e
+	ne.Scores = maps.Clone(e.Scores)
+	return ne
+}
+
+// EvalTraceRecorder collects scheduler decisions into an EvalTrace. All of
+// its methods are safe to call on a nil recorder, which records nothing, so
+// callers don't need to check whether tracing is enabled.
+type EvalTraceRecorder struct {
+	trace *EvalTrace
+
+	// taskGroup and allocName identify the placement currently being
+	// computed and are attached to every recorded event.
+	taskGroup string
+	allocName string
+}
+
+// NewEvalTraceRecorder returns a recorder for the given evaluation.
+func NewEvalTraceRecorder(eval *Evaluation) *EvalTraceRecorder {
+	return &EvalTraceRecorder{
+		trace: &EvalTrace{
+			EvalID:    eval.ID,
+			Namespace: eval.Namespace,
+			JobID:     eval.JobID,
+			Events:    []*EvalTraceEvent{},
+		},
+	}
+}
+
+// SetPlacement sets the task group and allocation name of the placement the
+// following events belong to.
+func (r *EvalTraceRecorder) SetPlacement(taskGroup, allocName string) {
+	if r == nil {
+		return
+	}
+	r.taskGroup = taskGroup
+	r.allocName = allocName
+}
+
+// Filtered records that a node was removed by a feasibility check.
+func (r *EvalTraceRecorder) Filtered(node *Node, reason string) {
+	r.record(traceNodeID(node), EvalTraceDecisionFiltered, reason, nil, 0)
+}
+
+// Exhausted records that a node didn't have enough of a resource.
+func (r *EvalTraceRecorder) Exhausted(node *Node, dimension string) {
+	r.record(traceNodeID(node), EvalTraceDecisionExhausted, dimension, nil, 0)
+}
+
+// Scored records the component and normalized scores of a node.
+func (r *EvalTraceRecorder) Scored(meta *NodeScoreMeta) {
+	if r == nil || meta == nil {
+		return
+	}
+	r.record(meta.NodeID, EvalTraceDecisionScored, "", maps.Clone(meta.Scores), meta.NormScore)
+}
+
+// Selected records the node picked for the current placement.
+func (r *EvalTraceRecorder) Selected(nodeID string, normScore float64) {
+	r.record(nodeID, EvalTraceDecisionSelected, "", nil, normScore)
+}
+
+// Failed records that no node was found for the current placement.
+func (r *EvalTraceRecorder) Failed(reason string) {
+	r.record("", EvalTraceDecisionFailed, reason, nil, 0)
+}
+
+// Trace returns the recorded trace, or nil for a nil recorder.
+func (r *EvalTraceRecorder) Trace() *EvalTrace {
+	if r == nil {
+		return nil
+	}
+	return r.trace
+}
+
+func (r *EvalTraceRecorder) record(nodeID, decision, reason string, scores map[string]float64, normScore float64) {
+	if r == nil {
+		return
+	}
+	if len(r.trace.Events) >= MaxEvalTraceEvents {
+		r.trace.Truncated = true
+		return
+	}
+	r.trace.Events = append(r.trace.Events, &EvalTraceEvent{
+		TaskGroup: r.taskGroup,
+		AllocName: r.allocName,
+		NodeID:    nodeID,
+		Decision:  decision,
+		Reason:    reason,
+		Scores:    scores,
+		NormScore: normScore,
+	})
+}
+
+func traceNodeID(node *Node) string {
+	if node == nil {
+		return ""
+	}
+	return node.ID
+}
+
+// EvalTraceUpsertRequest is used by scheduler workers to store the decision
+// trace of the evaluation they are processing.
+type EvalTraceUpsertRequest struct {
+	Trace *EvalTrace
+
+	// EvalToken is the token of the outstanding evaluation, used to ensure
+	// only the worker processing the evaluation can write its trace.
+	EvalToken string
+
+	WriteRequest
+}
+
+// EvalTraceSpecificRequest is used to read the trace of an evaluation.
+type EvalTraceSpecificRequest struct {
+	EvalID string
+	QueryOptions
+}
+
+// SingleEvalTraceResponse is the response to an EvalTraceSpecificRequest.
+type SingleEvalTraceResponse struct {
+	Trace *EvalTrace
+	QueryMeta
+}
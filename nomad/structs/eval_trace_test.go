@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestEvalTraceRecorder_AllocMetric(t *testing.T) {
+	ci.Parallel(t)
+
+	eval := &Evaluation{ID: "eval", Namespace: DefaultNamespace, JobID: "example"}
+	tracer := NewEvalTraceRecorder(eval)
+
+	node1 := &Node{ID: "node1", NodeClass: "large"}
+	node2 := &Node{ID: "node2"}
+	node3 := &Node{ID: "node3"}
+
+	metric := new(AllocMetric)
+	metric.SetTracer(tracer)
+
+	tracer.SetPlacement("web", "example.web[0]")
+	metric.FilterNode(node1, "missing drivers")
+	metric.ExhaustedNode(node2, "memory")
+	metric.ScoreNode(node3, "binpack", 0.5)
+	metric.ScoreNode(node3, "job-anti-affinity", -0.5)
+	metric.ScoreNode(node3, NormScorerName, 0)
+	tracer.Selected(node3.ID, 0)
+
+	tracer.SetPlacement("web", "example.web[1]")
+	tracer.Failed("no nodes")
+
+	// The metric is still tracked alongside the trace.
+	must.Eq(t, 1, metric.NodesFiltered)
+	must.Eq(t, 1, metric.NodesExhausted)
+
+	trace := tracer.Trace()
+	must.Eq(t, "eval", trace.EvalID)
+	must.Eq(t, "example", trace.JobID)
+	must.False(t, trace.Truncated)
+	must.Eq(t, []*EvalTraceEvent{
+		{
+			TaskGroup: "web", AllocName: "example.web[0]", NodeID: "node1",
+			Decision: EvalTraceDecisionFiltered, Reason: "missing drivers",
+		},
+		{
+			TaskGroup: "web", AllocName: "example.web[0]", NodeID: "node2",
+			Decision: EvalTraceDecisionExhausted, Reason: "memory",
+		},
+		{
+			TaskGroup: "web", AllocName: "example.web[0]", NodeID: "node3",
+			Decision: EvalTraceDecisionScored,
+			Scores:   map[string]float64{"binpack": 0.5, "job-anti-affinity": -0.5},
+		},
+		{
+			TaskGroup: "web", AllocName: "example.web[0]", NodeID: "node3",
+			Decision: EvalTraceDecisionSelected,
+		},
+		{
+			TaskGroup: "web", AllocName: "example.web[1]",
+			Decision: EvalTraceDecisionFailed, Reason: "no nodes",
+		},
+	}, trace.Events)
+}
+
+func TestEvalTraceRecorder_Nil(t *testing.T) {
+	ci.Parallel(t)
+
+	var tracer *EvalTraceRecorder
+	tracer.SetPlacement("web", "example.web[0]")
+	tracer.Filtered(&Node{ID: "node1"}, "missing drivers")
+	tracer.Scored(&NodeScoreMeta{NodeID: "node1"})
+	tracer.Selected("node1", 1)
+	tracer.Failed("no nodes")
+	must.Nil(t, tracer.Trace())
+
+	// Metrics without a tracer keep working.
+	metric := new(AllocMetric)
+	metric.FilterNode(&Node{ID: "node1"}, "missing drivers")
+	metric.ScoreNode(&Node{ID: "node1"}, NormScorerName, 1)
+	must.Eq(t, 1, metric.NodesFiltered)
+}
+
+func TestEvalTraceRecorder_Truncated(t *testing.T) {
+	ci.Parallel(t)
+
+	tracer := NewEvalTraceRecorder(&Evaluation{ID: "eval"})
+	node := &Node{ID: "node1"}
+	for i := 0; i < MaxEvalTraceEvents+10; i++ {
+		tracer.Filtered(node, "missing drivers")
+	}
+
+	trace := tracer.Trace()
+	must.Len(t, MaxEvalTraceEvents, trace.Events)
+	must.True(t, trace.Truncated)
+}
@@ -191,6 +191,9 @@ func AllocsFit(node *Node, allocs []*Allocation, netIdx *NetworkIndex, checkDevi
 	if superset, dimension := available.Superset(used); !superset {
 		return false, dimension, used, nil
 	}
+	if superset, dimension := available.SupersetThroughput(used); !superset {
+		return false, dimension, used, nil
+	}
 
 	// Create the network index if missing
 	if netIdx == nil {
@@ -231,7 +234,11 @@ func AllocsFit(node *Node, allocs []*Allocation, netIdx *NetworkIndex, checkDevi
 	return true, "", used, nil
 }
 
-func computeFreePercentage(node *Node, util *ComparableResources) (freePctCpu, freePctRam float64) {
+// computeFreePercentage returns the free percentage of each dimension scored
+// on the node. CPU and memory are always scored, while disk IOPS and network
+// bandwidth are only scored when the node reports a capacity for them and
+// they are in use.
+func computeFreePercentage(node *Node, util *ComparableResources) []float64 {
 	reserved := node.ReservedResources.Comparable()
 	res := node.NodeResources.Comparable()
 
@@ -244,22 +251,43 @@ func computeFreePercentage(node *Node, util *ComparableResources) (freePctCpu, f
 	}
 
 	// Compute the free percentage
-	freePctCpu = 1 - (float64(util.Flattened.Cpu.CpuShares) / nodeCpu)
-	freePctRam = 1 - (float64(util.Flattened.Memory.MemoryMB) / nodeMem)
-	return freePctCpu, freePctRam
+	freePct := []float64{
+		1 - (float64(util.Flattened.Cpu.CpuShares) / nodeCpu),
+		1 - (float64(util.Flattened.Memory.MemoryMB) / nodeMem),
+	}
+	if nodeIOPS := res.Shared.DiskIOPS; nodeIOPS > 0 && util.Shared.DiskIOPS > 0 {
+		freePct = append(freePct, 1-(float64(util.Shared.DiskIOPS)/float64(nodeIOPS)))
+	}
+	if nodeMBits := res.NetworkMBits(); nodeMBits > 0 && util.NetworkMBits() > 0 {
+		freePct = append(freePct, 1-(float64(util.NetworkMBits())/float64(nodeMBits)))
+	}
+	return freePct
 }
 
+// scoreFitTotal returns the sum of 10 raised to the free percentage of each
+// dimension, scaled so the total stays in [2, 20] regardless of the number of
+// dimensions scored.
+func scoreFitTotal(freePct []float64) float64 {
+	total := 0.0
+	for _, pct := range freePct {
+		total += math.Pow(10, pct)
+	}
+	return total * 2 / float64(len(freePct))
+}
+
+// BinPackingMaxFitScore is the maximum possible bin packing fitness score.
+// This is used to normalize bin packing score to a value between 0 and 1
+const BinPackingMaxFitScore = 18.0
+
 // ScoreFitBinPack computes a fit score to achieve pinbacking behavior.
-// Score is in [0, 18]
+// Score is in [0, BinPackingMaxFitScore]
 //
 // It's the BestFit v3 on the Google work published here:
 // http://www.columbia.edu/~cs2035/courses/ieor4405.S13/datacenter_scheduling.ppt
 func ScoreFitBinPack(node *Node, util *ComparableResources) float64 {
-	freePctCpu, freePctRam := computeFreePercentage(node, util)
-
 	// Total will be "maximized" the smaller the value is.
 	// At 100% utilization, the total is 2, while at 0% util it is 20.
-	total := math.Pow(10, freePctCpu) + math.Pow(10, freePctRam)
+	total := scoreFitTotal(computeFreePercentage(node, util))
 
 	// Invert so that the "maximized" total represents a high-value
 	// score. Because the floor is 20, we simply use that as an anchor.
@@ -282,8 +310,7 @@ func ScoreFitBinPack(node *Node, util *ComparableResources) float64 {
 // This is equivalent to Worst Fit of
 // http://www.columbia.edu/~cs2035/courses/ieor4405.S13/datacenter_scheduling.ppt
 func ScoreFitSpread(node *Node, util *ComparableResources) float64 {
-	freePctCpu, freePctRam := computeFreePercentage(node, util)
-	total := math.Pow(10, freePctCpu) + math.Pow(10, freePctRam)
+	total := scoreFitTotal(computeFreePercentage(node, util))
 	score := total - 2
 
 	if score > 18.0 {
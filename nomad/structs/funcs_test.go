@@ -6,6 +6,7 @@ package structs
 import (
 	"encoding/base64"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/hashicorp/nomad/acl"
@@ -636,6 +637,62 @@ func TestAllocsFit_MemoryOversubscription(t *testing.T) {
 	must.Eq(t, 12000, used.Flattened.Memory.MemoryMaxMB)
 }
 
+func TestAllocsFit_Throughput(t *testing.T) {
+	ci.Parallel(t)
+
+	n := node2k()
+	n.NodeResources.Disk.IOPS = 1000
+
+	a1 := &Allocation{
+		AllocatedResources: &AllocatedResources{
+			Tasks: map[string]*AllocatedTaskResources{
+				"web": {
+					Cpu:    AllocatedCpuResources{CpuShares: 100},
+					Memory: AllocatedMemoryResources{MemoryMB: 100},
+				},
+			},
+			Shared: AllocatedSharedResources{
+				DiskMB:   100,
+				DiskIOPS: 400,
+				Networks: []*NetworkResource{
+					{
+						Device: "eth0",
+						IP:     "10.0.0.1",
+						MBits:  40,
+					},
+				},
+			},
+		},
+	}
+
+	// Should fit two allocations
+	fit, dim, used, err := AllocsFit(n, []*Allocation{a1, a1}, nil, false)
+	must.NoError(t, err)
+	must.True(t, fit, must.Sprintf("bad dimension: %q", dim))
+	must.Eq(t, 800, used.Shared.DiskIOPS)
+	must.Eq(t, 80, used.NetworkMBits())
+
+	// Should not fit a third allocation because of disk IOPS
+	fit, dim, _, err = AllocsFit(n, []*Allocation{a1, a1, a1}, nil, false)
+	must.NoError(t, err)
+	must.False(t, fit)
+	must.Eq(t, "disk iops", dim)
+
+	// Should not fit a third allocation because of network bandwidth when
+	// the node doesn't limit disk IOPS
+	n.NodeResources.Disk.IOPS = 0
+	fit, dim, _, err = AllocsFit(n, []*Allocation{a1, a1, a1}, nil, false)
+	must.NoError(t, err)
+	must.False(t, fit)
+	must.Eq(t, "network bandwidth", dim)
+
+	// Should fit when the node doesn't report either capacity
+	n.NodeResources.Networks[0].MBits = 0
+	fit, dim, _, err = AllocsFit(n, []*Allocation{a1, a1, a1}, nil, false)
+	must.NoError(t, err)
+	must.True(t, fit, must.Sprintf("bad dimension: %q", dim))
+}
+
 func TestScoreFitBinPack(t *testing.T) {
 	ci.Parallel(t)
 
@@ -716,6 +773,36 @@ func TestScoreFitBinPack(t *testing.T) {
 	}
 }
 
+func TestScoreFitBinPack_Throughput(t *testing.T) {
+	ci.Parallel(t)
+
+	node := node2k()
+	node.ReservedResources = nil
+	node.NodeResources.Disk.IOPS = 1000
+
+	util := &ComparableResources{
+		Flattened: AllocatedTaskResources{
+			Cpu:    AllocatedCpuResources{CpuShares: 1000},
+			Memory: AllocatedMemoryResources{MemoryMB: 1024},
+		},
+	}
+
+	// CPU and memory are half used, so the score is the same as if only
+	// they were scored when disk IOPS and network bandwidth aren't used
+	must.Eq(t, 13.675, math.Round(ScoreFitBinPack(node, util)*1000)/1000)
+
+	// Disk IOPS and network bandwidth are scored when used
+	util.Shared.DiskIOPS = 1000
+	util.Flattened.Networks = []*NetworkResource{{Device: "eth0", MBits: 100}}
+	must.Greater(t, 13.675, ScoreFitBinPack(node, util))
+	must.Less(t, 4.325, ScoreFitSpread(node, util))
+
+	// Nodes that don't report a capacity don't score the dimension
+	node.NodeResources.Disk.IOPS = 0
+	node.NodeResources.Networks[0].MBits = 0
+	must.Eq(t, 13.675, math.Round(ScoreFitBinPack(node, util)*1000)/1000)
+}
+
 func TestACLPolicyListHash(t *testing.T) {
 	ci.Parallel(t)
 
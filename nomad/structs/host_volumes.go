@@ -70,6 +70,14 @@ type HostVolume struct {
 	// created. We record this to make debugging easier.
 	HostPath string
 
+	// SourceVolumeID is the ID of a volume this volume is cloned from when
+	// it's created. The volume is created on the node of the source volume.
+	SourceVolumeID string
+
+	// SnapshotID is the ID of a snapshot of the source volume this volume is
+	// created from, instead of the current contents of the source volume.
+	SnapshotID string
+
 	// State represents the overall state of the volume. One of pending, ready,
 	// deleted.
 	State HostVolumeState
@@ -148,6 +156,13 @@ func (hv *HostVolume) Validate() error {
 			hv.RequestedCapacityMaxBytes, hv.RequestedCapacityMinBytes))
 	}
 
+	if hv.SourceVolumeID != "" && !helper.IsUUID(hv.SourceVolumeID) {
+		mErr = multierror.Append(mErr, fmt.Errorf("invalid source volume ID %q", hv.SourceVolumeID))
+	}
+	if hv.SnapshotID != "" && hv.SourceVolumeID == "" {
+		mErr = multierror.Append(mErr, errors.New("snapshot ID requires a source volume ID"))
+	}
+
 	for _, cap := range hv.RequestedCapabilities {
 		err := cap.Validate()
 		if err != nil {
@@ -192,6 +207,13 @@ func (hv *HostVolume) ValidateUpdate(existing *HostVolume) error {
 		mErr = multierror.Append(mErr, errors.New("node pool cannot be updated"))
 	}
 
+	if hv.SourceVolumeID != "" && hv.SourceVolumeID != existing.SourceVolumeID {
+		mErr = multierror.Append(mErr, errors.New("source volume ID cannot be updated"))
+	}
+	if hv.SnapshotID != "" && hv.SnapshotID != existing.SnapshotID {
+		mErr = multierror.Append(mErr, errors.New("snapshot ID cannot be updated"))
+	}
+
 	if hv.RequestedCapacityMaxBytes > 0 &&
 		hv.RequestedCapacityMaxBytes < existing.CapacityBytes {
 		mErr = multierror.Append(mErr, fmt.Errorf(
@@ -236,6 +258,8 @@ func (hv *HostVolume) CanonicalizeForCreate(existing *HostVolume, now time.Time)
 		hv.Constraints = existing.Constraints
 		hv.CapacityBytes = existing.CapacityBytes
 		hv.HostPath = existing.HostPath
+		hv.SourceVolumeID = existing.SourceVolumeID
+		hv.SnapshotID = existing.SnapshotID
 		hv.CreateTime = existing.CreateTime
 	}
 
@@ -408,6 +432,59 @@ type HostVolumeDeleteResponse struct {
 	WriteMeta
 }
 
+// HostVolumeSnapshot is a point-in-time copy of the contents of a host volume,
+// kept by the host volume plugin on the node of the volume.
+type HostVolumeSnapshot struct {
+	// ID is a UUID-like string generated by the server.
+	ID string
+
+	// Name is the name given to the snapshot when it was created.
+	Name string
+
+	// VolumeID is the ID of the volume the snapshot was taken from.
+	VolumeID string
+
+	// SizeBytes is the size of the snapshot as reported by the plugin.
+	SizeBytes int64
+
+	CreateTime int64 // Unix timestamp in nanoseconds since epoch
+}
+
+type HostVolumeSnapshotCreateRequest struct {
+	VolumeID string
+	Name     string
+
+	// Parameters are an opaque map of parameters for the host volume plugin.
+	Parameters map[string]string
+
+	WriteRequest
+}
+
+type HostVolumeSnapshotCreateResponse struct {
+	Snapshot *HostVolumeSnapshot
+	WriteMeta
+}
+
+type HostVolumeSnapshotListRequest struct {
+	VolumeID string
+	QueryOptions
+}
+
+type HostVolumeSnapshotListResponse struct {
+	Snapshots []*HostVolumeSnapshot
+	QueryMeta
+}
+
+type HostVolumeSnapshotDeleteRequest struct {
+	VolumeID   string
+	SnapshotID string
+	WriteRequest
+}
+
+type HostVolumeSnapshotDeleteResponse struct {
+	WriteMeta
+}
+
 type HostVolumeGetRequest struct {
 	ID string
 	QueryOptions
@@ -127,6 +127,17 @@ func TestHostVolume_Validate(t *testing.T) {
 		Parameters: map[string]string{"foo": "bar"},
 	}
 	must.NoError(t, vol.Validate())
+
+	clone := vol.Copy()
+	clone.SourceVolumeID = "../not-a-uuid"
+	must.EqError(t, clone.Validate(), `invalid source volume ID "../not-a-uuid"`)
+
+	clone.SourceVolumeID = ""
+	clone.SnapshotID = uuid.Generate()
+	must.EqError(t, clone.Validate(), "snapshot ID requires a source volume ID")
+
+	clone.SourceVolumeID = uuid.Generate()
+	must.NoError(t, clone.Validate())
 }
 
 func TestHostVolume_ValidateUpdate(t *testing.T) {
@@ -168,6 +179,24 @@ func TestHostVolume_ValidateUpdate(t *testing.T) {
 
 `)
 
+	// the source of a clone is fixed when it's created, but may be omitted
+	// from updates
+	existing = &HostVolume{
+		SourceVolumeID: uuid.Generate(),
+		SnapshotID:     uuid.Generate(),
+	}
+	vol = &HostVolume{}
+	must.NoError(t, vol.ValidateUpdate(existing))
+
+	vol = &HostVolume{
+		SourceVolumeID: uuid.Generate(),
+		SnapshotID:     uuid.Generate(),
+	}
+	must.EqError(t, vol.ValidateUpdate(existing), `2 errors occurred:
+	* source volume ID cannot be updated
+	* snapshot ID cannot be updated
+
+`)
 }
 
 func TestHostVolume_CanonicalizeForCreate(t *testing.T) {
@@ -354,6 +354,12 @@ func (di *DriverInfo) MergeFingerprintInfo(other *DriverInfo) {
 	di.Attributes = other.Attributes
 }
 
+// DriverAttrUpdateResources returns the name of the driver attribute that is
+// set when the driver can apply cpu and memory changes to running tasks.
+func DriverAttrUpdateResources(driver string) string {
+	return fmt.Sprintf("driver.%s.update_resources", driver)
+}
+
 // HealthCheckEquals determines if two driver info objects are equal. As this
 // is used in the process of health checking, we only check the fields that are
 // computed by the health checker. In the future, this will be merged.
@@ -233,6 +233,11 @@ type SchedulerConfiguration struct {
 	// during leadership transitions.
 	PauseEvalBroker bool `hcl:"pause_eval_broker"`
 
+	// EvalTraceEnabled specifies whether the schedulers record a trace of
+	// every node filtering, exhaustion and scoring decision made while
+	// processing an evaluation.
+	EvalTraceEnabled bool `hcl:"eval_trace_enabled"`
+
 	// CreateIndex/ModifyIndex store the create/modify indexes of this configuration.
 	CreateIndex uint64
 	ModifyIndex uint64
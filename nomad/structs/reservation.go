@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/nomad/helper"
+)
+
+const (
+	// ReservationListRPCMethod is the RPC method for listing reservations.
+	ReservationListRPCMethod = "Reservation.List"
+
+	// ReservationGetRPCMethod is the RPC method for reading a reservation.
+	ReservationGetRPCMethod = "Reservation.Get"
+
+	// ReservationUpsertRPCMethod is the RPC method for creating or updating
+	// reservations.
+	ReservationUpsertRPCMethod = "Reservation.Upsert"
+
+	// ReservationDeleteRPCMethod is the RPC method for deleting reservations.
+	ReservationDeleteRPCMethod = "Reservation.Delete"
+
+	// maxReservationDescriptionLength is the maximum length allowed for a
+	// reservation description.
+	maxReservationDescriptionLength = 256
+)
+
+var (
+	// validReservationName is the rule used to validate a reservation name.
+	validReservationName = regexp.MustCompile("^[a-zA-Z0-9-_]{1,128}$")
+)
+
+// Reservation holds node capacity for a window of time. Until a reservation
+// expires, the scheduler treats the reserved resources on each node that
+// matches the reservation as unavailable to jobs that don't reference it and
+// whose placements may still be running when its window starts.
+type Reservation struct {
+	// Name is the name of the reservation. It must be unique within the
+	// namespace.
+	Name string
+
+	// Namespace is the namespace of the reservation. Only jobs in the same
+	// namespace can use the reserved capacity.
+	Namespace string
+
+	// Description is the human-friendly description of the reservation.
+	Description string
+
+	// NodePool is the node pool of the nodes to reserve capacity on. The
+	// built-in "all" node pool may be used to reserve capacity on nodes of
+	// every node pool.
+	NodePool string
+
+	// NodeClass optionally restricts the reservation to nodes of the given
+	// node class.
+	NodeClass string
+
+	// Constraints optionally restrict the reservation to the nodes that meet
+	// the constraints.
+	Constraints []*Constraint
+
+	// Resources is the amount of resources reserved on each node that
+	// matches the reservation.
+	Resources *ReservationResources
+
+	// StartTime and EndTime define the window during which the reservation
+	// is active.
+	StartTime time.Time
+	EndTime   time.Time
+
+	// Raft indexes.
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ReservationResources are the resources reserved on each node that matches
+// a reservation.
+type ReservationResources struct {
+	CPU      int
+	MemoryMB int
+	Devices  []*RequestedDevice
+}
+
+// GetID implements the IDGetter interface required for pagination.
+func (r *Reservation) GetID() string {
+	return r.Name
+}
+
+// GetNamespace implements the NamespaceGetter interface required for
+// pagination.
+func (r *Reservation) GetNamespace() string {
+	return r.Namespace
+}
+
+// Stub implements support for pagination.
+func (r *Reservation) Stub() (*Reservation, error) {
+	return r, nil
+}
+
+// Canonicalize sets defaults on the reservation.
+func (r *Reservation) Canonicalize() {
+	if r.Namespace == "" {
+		r.Namespace = DefaultNamespace
+	}
+	if r.NodePool == "" {
+		r.NodePool = NodePoolDefault
+	}
+}
+
+// Validate returns an error if the reservation is invalid.
+func (r *Reservation) Validate() error {
+	var mErr *multierror.Error
+
+	if !validReservationName.MatchString(r.Name) {
+		mErr = multierror.Append(mErr, fmt.Errorf("invalid name %q, must match regex %s",
+			r.Name, validReservationName))
+	}
+	if len(r.Description) > maxReservationDescriptionLength {
+		mErr = multierror.Append(mErr, fmt.Errorf("description longer than %d",
+			maxReservationDescriptionLength))
+	}
+	if err := ValidateNodePoolName(r.NodePool); err != nil {
+		mErr = multierror.Append(mErr, fmt.Errorf("invalid node pool: %w", err))
+	}
+
+	for idx, c := range r.Constraints {
+		if err := c.Validate(); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("constraint %d validation failed: %w", idx+1, err))
+		}
+	}
+
+	if r.StartTime.IsZero() || r.EndTime.IsZero() {
+		mErr = multierror.Append(mErr, errors.New("start and end time must be set"))
+	} else if !r.EndTime.After(r.StartTime) {
+		mErr = multierror.Append(mErr, errors.New("end time must be after start time"))
+	}
+
+	if r.Resources == nil {
+		mErr = multierror.Append(mErr, errors.New("missing resources"))
+	} else if err := r.Resources.Validate(); err != nil {
+		mErr = multierror.Append(mErr, err)
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// Copy returns a deep copy of the reservation.
+func (r *Reservation) Copy() *Reservation {
+	if r == nil {
+		return nil
+	}
+
+	nr := new(Reservation)
+	*nr = *r
+	nr.Constraints = CopySliceConstraints(r.Constraints)
+	nr.Resources = r.Resources.Copy()
+	return nr
+}
+
+// IsActive returns true if the reservation window includes the given time.
+func (r *Reservation) IsActive(now time.Time) bool {
+	return !now.Before(r.StartTime) && now.Before(r.EndTime)
+}
+
+// IsExpired returns true if the reservation window ended before the given
+// time.
+func (r *Reservation) IsExpired(now time.Time) bool {
+	return !now.Before(r.EndTime)
+}
+
+// MatchesNode returns true if the node is in the node pool and node class
+// targeted by the reservation. The reservation constraints are not checked
+// and must be evaluated by the caller.
+func (r *Reservation) MatchesNode(node *Node) bool {
+	if r.NodePool != NodePoolAll && r.NodePool != node.NodePool {
+		return false
+	}
+	if r.NodeClass != "" && r.NodeClass != node.NodeClass {
+		return false
+	}
+	return true
+}
+
+// Validate returns an error if the reservation resources are invalid.
+func (r *ReservationResources) Validate() error {
+	var mErr *multierror.Error
+
+	if r.CPU < 0 {
+		mErr = multierror.Append(mErr, fmt.Errorf("cpu must be greater than or equal to 0, got %d", r.CPU))
+	}
+	if r.MemoryMB < 0 {
+		mErr = multierror.Append(mErr, fmt.Errorf("memory must be greater than or equal to 0, got %d", r.MemoryMB))
+	}
+	for idx, d := range r.Devices {
+		if err := d.Validate(); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("device %d validation failed: %w", idx+1, err))
+		}
+	}
+	if r.CPU == 0 && r.MemoryMB == 0 && len(r.Devices) == 0 {
+		mErr = multierror.Append(mErr, errors.New("at least one of cpu, memory, or device must be reserved"))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// Copy returns a deep copy of the reservation resources.
+func (r *ReservationResources) Copy() *ReservationResources {
+	if r == nil {
+		return nil
+	}
+
+	nr := new(ReservationResources)
+	*nr = *r
+	nr.Devices = helper.CopySlice(r.Devices)
+	return nr
+}
+
+// ReservationListRequest is used to list reservations.
+type ReservationListRequest struct {
+	QueryOptions
+}
+
+// ReservationListResponse is the response to a reservations list request.
+type ReservationListResponse struct {
+	Reservations []*Reservation
+	QueryMeta
+}
+
+// ReservationSpecificRequest is used to make a request for a specific
+// reservation.
+type ReservationSpecificRequest struct {
+	Name string
+	QueryOptions
+}
+
+// SingleReservationResponse is the response to a specific reservation
+// request.
+type SingleReservationResponse struct {
+	Reservation *Reservation
+	QueryMeta
+}
+
+// ReservationUpsertRequest is used to make a request to insert or update
+// reservations.
+type ReservationUpsertRequest struct {
+	Reservations []*Reservation
+	WriteRequest
+}
+
+// ReservationDeleteRequest is used to make a request to delete reservations
+// in the request namespace.
+type ReservationDeleteRequest struct {
+	Names []string
+	WriteRequest
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestReservation_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	start := time.Date(2026, 10, 18, 1, 0, 0, 0, time.UTC)
+
+	validReservation := func() *Reservation {
+		return &Reservation{
+			Name:      "nightly",
+			Namespace: DefaultNamespace,
+			NodePool:  NodePoolDefault,
+			Resources: &ReservationResources{
+				CPU:      1000,
+				MemoryMB: 1024,
+				Devices:  []*RequestedDevice{{Name: "nvidia/gpu", Count: 1}},
+			},
+			StartTime: start,
+			EndTime:   start.Add(4 * time.Hour),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		modifyFn func(*Reservation)
+		expErr   []string
+	}{
+		{
+			name:     "valid",
+			modifyFn: func(*Reservation) {},
+		},
+		{
+			name:     "invalid name",
+			modifyFn: func(r *Reservation) { r.Name = "not a valid name" },
+			expErr:   []string{"invalid name"},
+		},
+		{
+			name:     "invalid node pool",
+			modifyFn: func(r *Reservation) { r.NodePool = "" },
+			expErr:   []string{"invalid node pool"},
+		},
+		{
+			name:     "missing times",
+			modifyFn: func(r *Reservation) { r.EndTime = time.Time{} },
+			expErr:   []string{"start and end time must be set"},
+		},
+		{
+			name:     "end before start",
+			modifyFn: func(r *Reservation) { r.EndTime = r.StartTime.Add(-time.Minute) },
+			expErr:   []string{"end time must be after start time"},
+		},
+		{
+			name:     "missing resources",
+			modifyFn: func(r *Reservation) { r.Resources = nil },
+			expErr:   []string{"missing resources"},
+		},
+		{
+			name: "empty resources",
+			modifyFn: func(r *Reservation) {
+				r.Resources = &ReservationResources{}
+			},
+			expErr: []string{"at least one of cpu, memory, or device must be reserved"},
+		},
+		{
+			name: "negative resources",
+			modifyFn: func(r *Reservation) {
+				r.Resources.CPU = -1
+				r.Resources.MemoryMB = -1
+			},
+			expErr: []string{
+				"cpu must be greater than or equal to 0",
+				"memory must be greater than or equal to 0",
+			},
+		},
+		{
+			name: "invalid constraint",
+			modifyFn: func(r *Reservation) {
+				r.Constraints = []*Constraint{{Operand: "~"}}
+			},
+			expErr: []string{"constraint 1 validation failed"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := validReservation()
+			tc.modifyFn(res)
+
+			err := res.Validate()
+			if len(tc.expErr) == 0 {
+				must.NoError(t, err)
+				return
+			}
+
+			must.Error(t, err)
+			for _, exp := range tc.expErr {
+				must.ErrorContains(t, err, exp)
+			}
+		})
+	}
+}
+
+func TestReservation_Copy(t *testing.T) {
+	ci.Parallel(t)
+
+	res := &Reservation{
+		Name:        "original",
+		Constraints: []*Constraint{{LTarget: "${node.class}", RTarget: "large", Operand: "="}},
+		Resources: &ReservationResources{
+			CPU:     1000,
+			Devices: []*RequestedDevice{{Name: "gpu", Count: 1}},
+		},
+	}
+	resCopy := res.Copy()
+	resCopy.Name = "copy"
+	resCopy.Constraints[0].RTarget = "small"
+	resCopy.Resources.CPU = 2000
+	resCopy.Resources.Devices[0].Count = 2
+
+	must.Eq(t, "original", res.Name)
+	must.Eq(t, "large", res.Constraints[0].RTarget)
+	must.Eq(t, 1000, res.Resources.CPU)
+	must.Eq(t, 1, res.Resources.Devices[0].Count)
+}
+
+func TestReservation_IsActive(t *testing.T) {
+	ci.Parallel(t)
+
+	start := time.Date(2026, 10, 18, 1, 0, 0, 0, time.UTC)
+	res := &Reservation{
+		StartTime: start,
+		EndTime:   start.Add(time.Hour),
+	}
+
+	must.False(t, res.IsActive(start.Add(-time.Second)))
+	must.False(t, res.IsExpired(start.Add(-time.Second)))
+
+	must.True(t, res.IsActive(start))
+	must.True(t, res.IsActive(start.Add(30*time.Minute)))
+	must.False(t, res.IsExpired(start.Add(30*time.Minute)))
+
+	must.False(t, res.IsActive(start.Add(time.Hour)))
+	must.True(t, res.IsExpired(start.Add(time.Hour)))
+}
+
+func TestReservation_MatchesNode(t *testing.T) {
+	ci.Parallel(t)
+
+	node := &Node{NodePool: "batch", NodeClass: "large"}
+
+	testCases := []struct {
+		name      string
+		nodePool  string
+		nodeClass string
+		exp       bool
+	}{
+		{name: "pool", nodePool: "batch", exp: true},
+		{name: "all pool", nodePool: NodePoolAll, exp: true},
+		{name: "other pool", nodePool: NodePoolDefault, exp: false},
+		{name: "pool and class", nodePool: "batch", nodeClass: "large", exp: true},
+		{name: "other class", nodePool: "batch", nodeClass: "small", exp: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := &Reservation{NodePool: tc.nodePool, NodeClass: tc.nodeClass}
+			must.Eq(t, tc.exp, res.MatchesNode(node))
+		})
+	}
+}
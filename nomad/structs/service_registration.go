@@ -7,7 +7,9 @@ import (
 	"crypto/md5"
 	"encoding/binary"
 	"fmt"
+	"maps"
 	"slices"
+	"strconv"
 
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/ipaddr"
@@ -43,6 +45,11 @@ const (
 	ServiceRegistrationGetServiceRPCMethod = "ServiceRegistration.GetService"
 )
 
+// ServiceRegistrationWeightMetaKey is the service meta key that sets the weight
+// of a service registration when choosing between registrations. Registrations
+// with no or an invalid weight have a weight of 1.
+const ServiceRegistrationWeightMetaKey = "weight"
+
 // ServiceRegistration is the internal representation of a Nomad service
 // registration.
 type ServiceRegistration struct {
@@ -91,6 +98,15 @@ type ServiceRegistration struct {
 	// is determined by a combination of factors on the client.
 	Port int
 
+	// Meta is determined from either Service.Meta or Service.CanaryMeta.
+	Meta map[string]string
+
+	// CheckStatus is the aggregate status of the checks of the service, as
+	// reported by the client running it. It is success once all checks pass,
+	// failure if any check fails, and pending otherwise. It is empty for
+	// services without checks or registered by older clients.
+	CheckStatus CheckStatus
+
 	CreateIndex uint64
 	ModifyIndex uint64
 }
@@ -105,6 +121,7 @@ func (s *ServiceRegistration) Copy() *ServiceRegistration {
 	ns := new(ServiceRegistration)
 	*ns = *s
 	ns.Tags = slices.Clone(ns.Tags)
+	ns.Meta = maps.Clone(ns.Meta)
 
 	return ns
 }
@@ -145,9 +162,31 @@ func (s *ServiceRegistration) Equal(o *ServiceRegistration) bool {
 	if !helper.SliceSetEq(s.Tags, o.Tags) {
 		return false
 	}
+	if !maps.Equal(s.Meta, o.Meta) {
+		return false
+	}
+	if s.CheckStatus != o.CheckStatus {
+		return false
+	}
 	return true
 }
 
+// Passing returns whether the checks of the service are passing. Services
+// without a check status are considered passing.
+func (s *ServiceRegistration) Passing() bool {
+	return s.CheckStatus == "" || s.CheckStatus == CheckSuccess
+}
+
+// Weight returns the weight of the service registration when choosing between
+// registrations, as set by the ServiceRegistrationWeightMetaKey meta key.
+func (s *ServiceRegistration) Weight() int {
+	weight, err := strconv.Atoi(s.Meta[ServiceRegistrationWeightMetaKey])
+	if err != nil || weight < 1 {
+		return 1
+	}
+	return weight
+}
+
 // Validate ensures the upserted service registration contains valid
 // information and routing capabilities. Objects should never fail here as
 // Nomad controls the entire registration process; but it's possible
@@ -276,6 +315,7 @@ type ServiceRegistrationStub struct {
 type ServiceRegistrationByNameRequest struct {
 	ServiceName string
 	Choose      string // stable selection of n services
+	Passing     bool   // only services with passing checks
 	QueryOptions
 }
 
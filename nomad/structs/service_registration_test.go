@@ -20,11 +20,16 @@ func TestServiceRegistration_Copy(t *testing.T) {
 		JobID:       "example",
 		AllocID:     "2873cf75-42e5-7c45-ca1c-415f3e18be3d",
 		Tags:        []string{"foo"},
+		Meta:        map[string]string{"weight": "2"},
 		Address:     "192.168.13.13",
 		Port:        23813,
+		CheckStatus: CheckSuccess,
 	}
 	newSR := sr.Copy()
 	require.True(t, sr.Equal(newSR))
+
+	newSR.Meta["weight"] = "3"
+	require.False(t, sr.Equal(newSR))
 }
 
 func TestServiceRegistration_Equal(t *testing.T) {
@@ -376,6 +381,30 @@ func TestServiceRegistration_Equal(t *testing.T) {
 			expectedOutput: true,
 			name:           "both equal",
 		},
+		{
+			serviceReg1: &ServiceRegistration{
+				ID:   "_nomad-task-2873cf75-42e5-7c45-ca1c-415f3e18be3d-group-cache-example-cache-db",
+				Meta: map[string]string{"weight": "2"},
+			},
+			serviceReg2: &ServiceRegistration{
+				ID:   "_nomad-task-2873cf75-42e5-7c45-ca1c-415f3e18be3d-group-cache-example-cache-db",
+				Meta: map[string]string{"weight": "3"},
+			},
+			expectedOutput: false,
+			name:           "different meta",
+		},
+		{
+			serviceReg1: &ServiceRegistration{
+				ID:          "_nomad-task-2873cf75-42e5-7c45-ca1c-415f3e18be3d-group-cache-example-cache-db",
+				CheckStatus: CheckPending,
+			},
+			serviceReg2: &ServiceRegistration{
+				ID:          "_nomad-task-2873cf75-42e5-7c45-ca1c-415f3e18be3d-group-cache-example-cache-db",
+				CheckStatus: CheckSuccess,
+			},
+			expectedOutput: false,
+			name:           "different check status",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -475,3 +504,31 @@ func TestServiceRegistration_HashWith(t *testing.T) {
 	// different service, different key -> different hash
 	must.NotEq(t, a.HashWith("aaa"), b.HashWith("bbb"))
 }
+
+func TestServiceRegistration_Passing(t *testing.T) {
+	must.True(t, (&ServiceRegistration{}).Passing())
+	must.True(t, (&ServiceRegistration{CheckStatus: CheckSuccess}).Passing())
+	must.False(t, (&ServiceRegistration{CheckStatus: CheckPending}).Passing())
+	must.False(t, (&ServiceRegistration{CheckStatus: CheckFailure}).Passing())
+}
+
+func TestServiceRegistration_Weight(t *testing.T) {
+	testCases := []struct {
+		name string
+		meta map[string]string
+		exp  int
+	}{
+		{name: "no meta", meta: nil, exp: 1},
+		{name: "no weight", meta: map[string]string{"foo": "bar"}, exp: 1},
+		{name: "weight", meta: map[string]string{"weight": "5"}, exp: 5},
+		{name: "zero", meta: map[string]string{"weight": "0"}, exp: 1},
+		{name: "negative", meta: map[string]string{"weight": "-2"}, exp: 1},
+		{name: "invalid", meta: map[string]string{"weight": "heavy"}, exp: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			must.Eq(t, tc.exp, (&ServiceRegistration{Meta: tc.meta}).Weight())
+		})
+	}
+}
@@ -347,7 +347,7 @@ func (sc *ServiceCheck) validateCommon(allowableTypes []string) error {
 
 // validate a Service's ServiceCheck in the context of the Nomad provider.
 func (sc *ServiceCheck) validateNomad() error {
-	allowable := []string{ServiceCheckTCP, ServiceCheckHTTP}
+	allowable := []string{ServiceCheckTCP, ServiceCheckHTTP, ServiceCheckGRPC, ServiceCheckScript}
 	if err := sc.validateCommon(allowable); err != nil {
 		return err
 	}
@@ -616,8 +616,8 @@ type Service struct {
 	CanaryTags []string          // List of tags for the service when it is a canary
 	Checks     []*ServiceCheck   // List of checks associated with the service
 	Connect    *ConsulConnect    // Consul Connect configuration
-	Meta       map[string]string // Consul service meta
-	CanaryMeta map[string]string // Consul service meta when it is a canary
+	Meta       map[string]string // service meta
+	CanaryMeta map[string]string // service meta when it is a canary
 	Weights    *ServiceWeights   // Service weights for DNS SRV request
 
 	// The values to set for tagged_addresses in Consul service registration.
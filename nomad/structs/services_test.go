@@ -255,8 +255,49 @@ func TestServiceCheck_validateNomad(t *testing.T) {
 		sc   *ServiceCheck
 		exp  string
 	}{
-		{name: "grpc", sc: &ServiceCheck{Type: ServiceCheckGRPC}, exp: `invalid check type ("grpc"), must be one of tcp, http`},
-		{name: "script", sc: &ServiceCheck{Type: ServiceCheckScript}, exp: `invalid check type ("script"), must be one of tcp, http`},
+		{name: "docker", sc: &ServiceCheck{Type: "docker"}, exp: `invalid check type ("docker"), must be one of tcp, http, grpc, script`},
+		{
+			name: "grpc",
+			sc: &ServiceCheck{
+				Type:          ServiceCheckGRPC,
+				GRPCService:   "api.v1",
+				GRPCUseTLS:    true,
+				TLSSkipVerify: true,
+				Interval:      3 * time.Second,
+				Timeout:       1 * time.Second,
+			},
+		},
+		{
+			name: "grpc tls_server_name",
+			sc: &ServiceCheck{
+				Type:          ServiceCheckGRPC,
+				GRPCUseTLS:    true,
+				TLSServerName: "api.example.com", // consul only
+				Interval:      3 * time.Second,
+				Timeout:       1 * time.Second,
+			},
+			exp: `tls_server_name may only be set for Consul service checks`,
+		},
+		{
+			name: "script",
+			sc: &ServiceCheck{
+				Type:     ServiceCheckScript,
+				Command:  "/bin/check",
+				Args:     []string{"-v"},
+				TaskName: "web",
+				Interval: 3 * time.Second,
+				Timeout:  1 * time.Second,
+			},
+		},
+		{
+			name: "script without command",
+			sc: &ServiceCheck{
+				Type:     ServiceCheckScript,
+				Interval: 3 * time.Second,
+				Timeout:  1 * time.Second,
+			},
+			exp: `script type must have a valid script path`,
+		},
 		{
 			name: "expose",
 			sc: &ServiceCheck{
@@ -2206,7 +2247,7 @@ func TestService_validateNomadService(t *testing.T) {
 			},
 			inputErr: &multierror.Error{},
 			expectedOutputErrors: []error{
-				errors.New(`invalid check type (""), must be one of tcp, http`),
+				errors.New(`invalid check type (""), must be one of tcp, http, grpc, script`),
 			},
 			name: "bad nomad check",
 		},
@@ -136,6 +136,9 @@ const (
 	HostVolumeRegisterRequestType             MessageType = 75
 	HostVolumeDeleteRequestType               MessageType = 76
 	TaskGroupHostVolumeClaimDeleteRequestType MessageType = 77
+	ReservationUpsertRequestType              MessageType = 78
+	ReservationDeleteRequestType              MessageType = 79
+	EvalTraceUpsertRequestType                MessageType = 80
 
 	// NOTE: MessageTypes are shared between CE and ENT. If you need to add a
 	// new type, check that ENT is not already using that value.
@@ -1154,6 +1157,10 @@ type AllocStopRequest struct {
 	AllocID         string
 	NoShutdownDelay bool
 
+	// Checkpoint requests that the allocation's tasks be checkpointed and
+	// restored by the replacement allocation.
+	Checkpoint bool
+
 	WriteRequest
 }
 
@@ -2312,6 +2319,16 @@ func (n *Node) IsInPool(pool string) bool {
 	return pool == NodePoolAll || n.NodePool == pool
 }
 
+// CanUpdateTaskResources returns true if the node's driver reports that it
+// can apply cpu and memory changes to running tasks.
+func (n *Node) CanUpdateTaskResources(driver string) bool {
+	info, ok := n.Drivers[driver]
+	if !ok || info == nil || !info.Detected {
+		return false
+	}
+	return info.Attributes[DriverAttrUpdateResources(driver)] == "true"
+}
+
 // HasEvent returns true if the node has the given message in its events list.
 func (n *Node) HasEvent(msg string) bool {
 	for _, ev := range n.Events {
@@ -3267,7 +3284,8 @@ func (n *NodeResources) Comparable() *ComparableResources {
 			Networks: n.Networks,
 		},
 		Shared: AllocatedSharedResources{
-			DiskMB: n.Disk.DiskMB,
+			DiskMB:   n.Disk.DiskMB,
+			DiskIOPS: n.Disk.IOPS,
 		},
 	}
 	return c
@@ -3444,6 +3462,10 @@ func (n *NodeMemoryResources) Equal(o *NodeMemoryResources) bool {
 type NodeDiskResources struct {
 	// DiskMB is the total available disk space on the node
 	DiskMB int64
+
+	// IOPS is the disk IO operations per second capacity of the node. A
+	// value of 0 means the node doesn't limit allocations on disk IOPS.
+	IOPS int64
 }
 
 func (n *NodeDiskResources) Merge(o *NodeDiskResources) {
@@ -3453,6 +3475,9 @@ func (n *NodeDiskResources) Merge(o *NodeDiskResources) {
 	if o.DiskMB != 0 {
 		n.DiskMB = o.DiskMB
 	}
+	if o.IOPS != 0 {
+		n.IOPS = o.IOPS
+	}
 }
 
 func (n *NodeDiskResources) Equal(o *NodeDiskResources) bool {
@@ -3467,6 +3492,9 @@ func (n *NodeDiskResources) Equal(o *NodeDiskResources) bool {
 	if n.DiskMB != o.DiskMB {
 		return false
 	}
+	if n.IOPS != o.IOPS {
+		return false
+	}
 
 	return true
 }
@@ -4033,6 +4061,7 @@ func (a *AllocatedTaskResources) Subtract(delta *AllocatedTaskResources) {
 type AllocatedSharedResources struct {
 	Networks Networks
 	DiskMB   int64
+	DiskIOPS int64
 	Ports    AllocatedPorts
 }
 
@@ -4040,6 +4069,7 @@ func (a AllocatedSharedResources) Copy() AllocatedSharedResources {
 	return AllocatedSharedResources{
 		Networks: a.Networks.Copy(),
 		DiskMB:   a.DiskMB,
+		DiskIOPS: a.DiskIOPS,
 		Ports:    a.Ports,
 	}
 }
@@ -4050,6 +4080,7 @@ func (a *AllocatedSharedResources) Add(delta *AllocatedSharedResources) {
 	}
 	a.Networks = append(a.Networks, delta.Networks...)
 	a.DiskMB += delta.DiskMB
+	a.DiskIOPS += delta.DiskIOPS
 
 }
 
@@ -4070,6 +4101,7 @@ func (a *AllocatedSharedResources) Subtract(delta *AllocatedSharedResources) {
 	}
 	a.Networks = nets
 	a.DiskMB -= delta.DiskMB
+	a.DiskIOPS -= delta.DiskIOPS
 }
 
 func (a *AllocatedSharedResources) Canonicalize() {
@@ -4304,6 +4336,31 @@ func (c *ComparableResources) Superset(other *ComparableResources) (bool, string
 	return true, ""
 }
 
+// SupersetThroughput checks if the disk IOPS and network bandwidth of one set
+// of resources are a superset of another. Dimensions for which c has no
+// capacity are ignored, since nodes that don't report a capacity for them
+// aren't limited on them.
+func (c *ComparableResources) SupersetThroughput(other *ComparableResources) (bool, string) {
+	if c.Shared.DiskIOPS > 0 && c.Shared.DiskIOPS < other.Shared.DiskIOPS {
+		return false, "disk iops"
+	}
+
+	if mbits := c.NetworkMBits(); mbits > 0 && mbits < other.NetworkMBits() {
+		return false, "network bandwidth"
+	}
+	return true, ""
+}
+
+// NetworkMBits returns the total network bandwidth of the flattened networks,
+// which include the task group networks of allocations.
+func (c *ComparableResources) NetworkMBits() int {
+	mbits := 0
+	for _, n := range c.Flattened.Networks {
+		mbits += n.MBits
+	}
+	return mbits
+}
+
 // NetIndex finds the matching net index using device name
 func (c *ComparableResources) NetIndex(n *NetworkResource) int {
 	return c.Flattened.Networks.NetIndex(n)
@@ -4338,6 +4395,9 @@ const (
 	// JobMaxPriority is the maximum allowed configuration value for maximum job priority
 	JobMaxPriority = math.MaxInt16 - 1
 
+	// JobMaxPreemptionCost is the maximum allowed preemption cost of a job.
+	JobMaxPreemptionCost = 100
+
 	// CoreJobPriority should be higher than any user
 	// specified job so that it gets priority. This is important
 	// for the system to remain healthy.
@@ -4452,6 +4512,16 @@ type Job struct {
 	// can preempt other jobs.
 	Priority int
 
+	// PreemptionCost is the relative cost of preempting the allocations of
+	// this job, between 0 and JobMaxPreemptionCost. Among allocations of the
+	// same priority, the scheduler prefers to preempt those with the lowest
+	// cost.
+	PreemptionCost int
+
+	// PreemptionBudget limits the number of allocations of this job that can
+	// be preempted within a window of time.
+	PreemptionBudget *PreemptionBudget
+
 	// AllAtOnce is used to control if incremental scheduling of task groups
 	// is allowed or if we must do a gang scheduling of the entire job. This
 	// can slow down larger jobs if resources are not available.
@@ -4490,6 +4560,14 @@ type Job struct {
 
 	Multiregion *Multiregion
 
+	// Gang is used to require that all the allocations of a set of task
+	// groups are placed together or not at all.
+	Gang *JobGang
+
+	// Reservation is the name of a reservation in the job namespace whose
+	// reserved capacity the job is allowed to use.
+	Reservation string
+
 	// Periodic is used to define the interval the job is run at.
 	Periodic *PeriodicConfig
 
@@ -4627,6 +4705,108 @@ func (l *JobUILink) Copy() *JobUILink {
 	return copy
 }
 
+// JobGang configures all-or-nothing placement for a set of task groups. The
+// scheduler only submits a plan for the job when every allocation of every
+// gang task group can be placed at once.
+type JobGang struct {
+	// Groups is the list of task group names that are part of the gang. If
+	// empty, all task groups of the job are part of the gang.
+	Groups []string
+}
+
+func (g *JobGang) Copy() *JobGang {
+	if g == nil {
+		return nil
+	}
+	return &JobGang{
+		Groups: slices.Clone(g.Groups),
+	}
+}
+
+// Includes returns whether the task group is part of the gang.
+func (g *JobGang) Includes(tg string) bool {
+	if g == nil {
+		return false
+	}
+	if len(g.Groups) == 0 {
+		return true
+	}
+	return slices.Contains(g.Groups, tg)
+}
+
+// Validate checks the gang configuration against the job it belongs to.
+func (g *JobGang) Validate(j *Job) error {
+	if g == nil {
+		return nil
+	}
+
+	var mErr multierror.Error
+	if j.Type == JobTypeSystem || j.Type == JobTypeSysBatch {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf(
+			"Gang can only be used with %q or %q scheduler", JobTypeService, JobTypeBatch))
+	}
+
+	seen := make(map[string]struct{}, len(g.Groups))
+	for _, name := range g.Groups {
+		if _, ok := seen[name]; ok {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("Gang group %q is listed more than once", name))
+			continue
+		}
+		seen[name] = struct{}{}
+
+		if j.LookupTaskGroup(name) == nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("Gang group %q does not exist", name))
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// PreemptionBudget limits the number of allocations that can be preempted
+// within a sliding window of time. Budgets can be set on namespaces and jobs,
+// and allocations are only preempted while all the budgets that apply to them
+// have room left.
+type PreemptionBudget struct {
+	// MaxAllocs is the maximum number of allocations that can be preempted
+	// within the window. A value of 0 prevents preemption entirely.
+	MaxAllocs int
+
+	// Window is the length of the sliding window over which preemptions are
+	// counted.
+	Window time.Duration
+}
+
+func (b *PreemptionBudget) Copy() *PreemptionBudget {
+	if b == nil {
+		return nil
+	}
+	nb := *b
+	return &nb
+}
+
+func (b *PreemptionBudget) Equal(o *PreemptionBudget) bool {
+	if b == nil || o == nil {
+		return b == o
+	}
+	return *b == *o
+}
+
+// Validate returns an error if the preemption budget is invalid.
+func (b *PreemptionBudget) Validate() error {
+	if b == nil {
+		return nil
+	}
+
+	var mErr multierror.Error
+	if b.MaxAllocs < 0 {
+		mErr.Errors = append(mErr.Errors, errors.New("max allocs must be greater than or equal to 0"))
+	}
+	if b.Window <= 0 {
+		mErr.Errors = append(mErr.Errors, errors.New("window must be greater than 0"))
+	}
+	return mErr.ErrorOrNil()
+}
+
 // NamespacedID returns the namespaced id useful for logging
 func (j *Job) NamespacedID() NamespacedID {
 	return NamespacedID{
@@ -4743,6 +4923,8 @@ func (j *Job) Copy() *Job {
 	nj.Constraints = CopySliceConstraints(j.Constraints)
 	nj.Affinities = CopySliceAffinities(j.Affinities)
 	nj.Multiregion = j.Multiregion.Copy()
+	nj.Gang = j.Gang.Copy()
+	nj.PreemptionBudget = j.PreemptionBudget.Copy()
 	nj.UI = j.UI.Copy()
 	nj.VersionTag = j.VersionTag.Copy()
 
@@ -4909,6 +5091,21 @@ func (j *Job) Validate() error {
 		}
 	}
 
+	if err := j.Gang.Validate(j); err != nil {
+		mErr.Errors = append(mErr.Errors, err)
+	}
+
+	if j.Reservation != "" && !validReservationName.MatchString(j.Reservation) {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("Invalid reservation name %q", j.Reservation))
+	}
+
+	if j.PreemptionCost < 0 || j.PreemptionCost > JobMaxPreemptionCost {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("Job preemption cost must be between 0 and %d", JobMaxPreemptionCost))
+	}
+	if err := j.PreemptionBudget.Validate(); err != nil {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("Preemption budget validation failed: %v", err))
+	}
+
 	return mErr.ErrorOrNil()
 }
 
@@ -5536,6 +5733,10 @@ type Namespace struct {
 	VaultConfiguration  *NamespaceVaultConfiguration
 	ConsulConfiguration *NamespaceConsulConfiguration
 
+	// PreemptionBudget limits the number of allocations in this namespace
+	// that can be preempted within a window of time.
+	PreemptionBudget *PreemptionBudget
+
 	// Meta is the set of metadata key/value pairs that attached to the namespace
 	Meta map[string]string
 
@@ -5622,6 +5823,16 @@ func (n *Namespace) Validate() error {
 		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid consul configuration: %v", e))
 	}
 
+	err = n.PreemptionBudget.Validate()
+	switch e := err.(type) {
+	case *multierror.Error:
+		for _, bErr := range e.Errors {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid preemption budget: %v", bErr))
+		}
+	case error:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid preemption budget: %v", e))
+	}
+
 	return mErr.ErrorOrNil()
 }
 
@@ -5681,6 +5892,11 @@ func (n *Namespace) SetHash() []byte {
 		}
 	}
 
+	if n.PreemptionBudget != nil {
+		_, _ = hash.Write([]byte(strconv.Itoa(n.PreemptionBudget.MaxAllocs)))
+		_, _ = hash.Write([]byte(n.PreemptionBudget.Window.String()))
+	}
+
 	// sort keys to ensure hash stability when meta is stored later
 	var keys []string
 	for k := range n.Meta {
@@ -5732,6 +5948,7 @@ func (n *Namespace) Copy() *Namespace {
 		nc.Allowed = slices.Clone(n.ConsulConfiguration.Allowed)
 		nc.Denied = slices.Clone(n.ConsulConfiguration.Denied)
 	}
+	nc.PreemptionBudget = n.PreemptionBudget.Copy()
 
 	if n.Meta != nil {
 		nc.Meta = make(map[string]string, len(n.Meta))
@@ -6885,6 +7102,11 @@ type TaskGroup struct {
 	// To be deprecated after 1.8.0
 	// To be deprecated after 1.8.0 infavor of Disconnect.Replace
 	PreventRescheduleOnLost bool
+
+	// Checkpoint enables checkpointing tasks when they are migrated so the
+	// replacement allocation can restore them instead of starting over. It
+	// requires a task driver with the checkpoint capability.
+	Checkpoint bool
 }
 
 func (tg *TaskGroup) Copy() *TaskGroup {
@@ -7550,11 +7772,6 @@ func (tg *TaskGroup) Warnings(j *Job) error {
 		mErr.Errors = append(mErr.Errors, errors.New("PreventRescheduleOnLost is deprecated and ignored in favor of Disconnect.Replace"))
 	}
 
-	// Check for mbits network field
-	if len(tg.Networks) > 0 && tg.Networks[0].MBits > 0 {
-		mErr.Errors = append(mErr.Errors, fmt.Errorf("mbits has been deprecated as of Nomad 0.12.0. Please remove mbits from the network block"))
-	}
-
 	// Validate group-level services.
 	for _, s := range tg.Services {
 		if err := s.Warnings(); err != nil {
@@ -9220,6 +9437,29 @@ const (
 	// TaskRunning indicates a task is running due to a schedule or schedule
 	// override. (Enterprise)
 	TaskRunning = "Running"
+
+	// TaskCheckpointed indicates the task was checkpointed before being
+	// stopped so that its replacement can restore it.
+	TaskCheckpointed = "Checkpointed"
+
+	// TaskCheckpointFailed indicates the task could not be checkpointed and
+	// will be killed without one.
+	TaskCheckpointFailed = "Checkpoint Failed"
+
+	// TaskRestoredFromCheckpoint indicates the task was restored from the
+	// checkpoint of the allocation it replaced instead of being started.
+	TaskRestoredFromCheckpoint = "Restored From Checkpoint"
+
+	// TaskResourcesUpdated indicates the CPU and memory limits of the running
+	// task were updated without restarting it.
+	TaskResourcesUpdated = "Resources Updated"
+
+	// TaskHibernated indicates the task was frozen by its driver. It keeps
+	// its memory but isn't scheduled on the CPU until it's woken.
+	TaskHibernated = "Hibernated"
+
+	// TaskWoken indicates a hibernated task was resumed by its driver.
+	TaskWoken = "Woken"
 )
 
 // TaskEvent is an event that effects the state of a task and contains meta-data
@@ -9433,6 +9673,18 @@ func (e *TaskEvent) PopulateEventDisplayMessage() {
 		desc = "Main tasks in the group died"
 	case TaskClientReconnected:
 		desc = "Client reconnected"
+	case TaskCheckpointed:
+		desc = "Task checkpointed for migration"
+	case TaskCheckpointFailed:
+		desc = fmt.Sprintf("Failed to checkpoint task: %s", e.Message)
+	case TaskRestoredFromCheckpoint:
+		desc = "Task restored from checkpoint"
+	case TaskResourcesUpdated:
+		desc = "Task resources updated without restart"
+	case TaskHibernated:
+		desc = "Task hibernated"
+	case TaskWoken:
+		desc = "Task woken from hibernation"
 	default:
 		desc = e.Message
 	}
@@ -10061,6 +10313,12 @@ type Spread struct {
 	// Attribute is the node attribute used as the spread criteria
 	Attribute string
 
+	// Hierarchy is the ordered list of node attributes, from the broadest
+	// to the narrowest fault domain, used as the criteria of a hierarchical
+	// spread. Allocations are spread evenly across the values of each level
+	// that share the same parent. It is mutually exclusive with Attribute.
+	Hierarchy []string
+
 	// Weight is the relative weight of this spread, useful when there are multiple
 	// spread and affinities
 	Weight int8
@@ -10079,6 +10337,8 @@ func (s *Spread) Equal(o *Spread) bool {
 	switch {
 	case s.Attribute != o.Attribute:
 		return false
+	case !slices.Equal(s.Hierarchy, o.Hierarchy):
+		return false
 	case s.Weight != o.Weight:
 		return false
 	case !slices.EqualFunc(s.SpreadTarget, o.SpreadTarget, func(a, b *SpreadTarget) bool { return a.Equal(b) }):
@@ -10119,6 +10379,7 @@ func (s *Spread) Copy() *Spread {
 	ns := new(Spread)
 	*ns = *s
 
+	ns.Hierarchy = slices.Clone(s.Hierarchy)
 	ns.SpreadTarget = CopySliceSpreadTarget(s.SpreadTarget)
 	return ns
 }
@@ -10127,13 +10388,21 @@ func (s *Spread) String() string {
 	if s.str != "" {
 		return s.str
 	}
+	if len(s.Hierarchy) > 0 {
+		s.str = fmt.Sprintf("%s %v", strings.Join(s.Hierarchy, " > "), s.Weight)
+		return s.str
+	}
 	s.str = fmt.Sprintf("%s %s %v", s.Attribute, s.SpreadTarget, s.Weight)
 	return s.str
 }
 
 func (s *Spread) Validate() error {
 	var mErr multierror.Error
-	if s.Attribute == "" {
+	if len(s.Hierarchy) > 0 {
+		if err := s.validateHierarchy(); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+		}
+	} else if s.Attribute == "" {
 		mErr.Errors = append(mErr.Errors, errors.New("Missing spread attribute"))
 	}
 	if s.Weight <= 0 || s.Weight > 100 {
@@ -10161,6 +10430,33 @@ func (s *Spread) Validate() error {
 	return mErr.ErrorOrNil()
 }
 
+// validateHierarchy validates the attributes of a hierarchical spread.
+func (s *Spread) validateHierarchy() error {
+	var mErr multierror.Error
+	if s.Attribute != "" {
+		mErr.Errors = append(mErr.Errors, errors.New("Spread attribute and hierarchy are mutually exclusive"))
+	}
+	if len(s.SpreadTarget) > 0 {
+		mErr.Errors = append(mErr.Errors, errors.New("Spread targets are not supported with a hierarchy"))
+	}
+	if len(s.Hierarchy) < 2 {
+		mErr.Errors = append(mErr.Errors, errors.New("Spread hierarchy must have at least two attributes"))
+	}
+
+	seen := make(map[string]struct{}, len(s.Hierarchy))
+	for _, attr := range s.Hierarchy {
+		if attr == "" {
+			mErr.Errors = append(mErr.Errors, errors.New("Spread hierarchy attributes must not be empty"))
+			continue
+		}
+		if _, ok := seen[attr]; ok {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("Spread hierarchy attribute %q already defined", attr))
+		}
+		seen[attr] = struct{}{}
+	}
+	return mErr.ErrorOrNil()
+}
+
 // SpreadTarget is used to specify desired percentages for each attribute value
 type SpreadTarget struct {
 	// Value is a single attribute value, like "dc1"
@@ -10212,6 +10508,10 @@ type EphemeralDisk struct {
 	// SizeMB is the size of the local disk
 	SizeMB int
 
+	// IOPS is the disk IO operations per second requested by the task group.
+	// It is only enforced on nodes that report their disk IOPS capacity.
+	IOPS int
+
 	// Migrate determines if Nomad client should migrate the allocation dir for
 	// sticky allocations
 	Migrate bool
@@ -10233,6 +10533,8 @@ func (d *EphemeralDisk) Equal(o *EphemeralDisk) bool {
 		return false
 	case d.SizeMB != o.SizeMB:
 		return false
+	case d.IOPS != o.IOPS:
+		return false
 	case d.Migrate != o.Migrate:
 		return false
 	}
@@ -10244,6 +10546,9 @@ func (d *EphemeralDisk) Validate() error {
 	if d.SizeMB < 10 {
 		return fmt.Errorf("minimum DiskMB value is 10; got %d", d.SizeMB)
 	}
+	if d.IOPS < 0 {
+		return fmt.Errorf("IOPS must be greater than or equal to 0; got %d", d.IOPS)
+	}
 	return nil
 }
 
@@ -10819,6 +11124,16 @@ type DesiredTransition struct {
 	// task shutdown_delay configuration and ignore the delay for any
 	// allocations stopped as a result of this Deregister call.
 	NoShutdownDelay *bool
+
+	// Checkpoint is used to indicate that the tasks of a migrating
+	// allocation should be checkpointed so that the replacement allocation
+	// can restore them, regardless of the task group's migrate block.
+	Checkpoint *bool
+
+	// RebalanceNodeID is the node a migrating allocation was fit on by the
+	// node pool rebalance core job. The scheduler prefers this node when
+	// placing the replacement.
+	RebalanceNodeID string
 }
 
 // Merge merges the two desired transitions, preferring the values from the
@@ -10839,6 +11154,14 @@ func (d *DesiredTransition) Merge(o *DesiredTransition) {
 	if o.NoShutdownDelay != nil {
 		d.NoShutdownDelay = o.NoShutdownDelay
 	}
+
+	if o.Checkpoint != nil {
+		d.Checkpoint = o.Checkpoint
+	}
+
+	if o.RebalanceNodeID != "" {
+		d.RebalanceNodeID = o.RebalanceNodeID
+	}
 }
 
 // ShouldMigrate returns whether the transition object dictates a migration.
@@ -10870,6 +11193,15 @@ func (d *DesiredTransition) ShouldIgnoreShutdownDelay() bool {
 	return d.NoShutdownDelay != nil && *d.NoShutdownDelay
 }
 
+// ShouldCheckpoint returns whether the transition object dictates that the
+// tasks be checkpointed before they are stopped.
+func (d *DesiredTransition) ShouldCheckpoint() bool {
+	if d == nil {
+		return false
+	}
+	return d.Checkpoint != nil && *d.Checkpoint
+}
+
 const (
 	AllocDesiredStatusRun   = "run"   // Allocation should run
 	AllocDesiredStatusStop  = "stop"  // Allocation should stop
@@ -11004,6 +11336,10 @@ type Allocation struct {
 	// to stop running because it got preempted
 	PreemptedByAllocation string
 
+	// PreemptedTime is the time the allocation was preempted, in Unix
+	// nanoseconds. It is used to enforce preemption budgets.
+	PreemptedTime int64
+
 	// SignedIdentities is a map of task names to signed identity/capability
 	// claim tokens for those tasks. If needed, it is populated in the plan
 	// applier.
@@ -11537,6 +11873,24 @@ func (a *Allocation) RanSuccessfully() bool {
 	return allSuccess
 }
 
+// ShouldCheckpoint returns whether the tasks of the allocation should be
+// checkpointed when they are stopped, so that its replacement can restore
+// them. This is only the case for allocations being migrated, when either the
+// task group or the stop request enabled checkpointing.
+func (a *Allocation) ShouldCheckpoint() bool {
+	if !a.DesiredTransition.ShouldMigrate() {
+		return false
+	}
+	if a.DesiredTransition.ShouldCheckpoint() {
+		return true
+	}
+	if a.Job == nil {
+		return false
+	}
+	tg := a.Job.LookupTaskGroup(a.TaskGroup)
+	return tg != nil && tg.Checkpoint
+}
+
 // ShouldMigrate returns if the allocation needs data migration
 func (a *Allocation) ShouldMigrate() bool {
 	if a.PreviousAllocation == "" {
@@ -11887,6 +12241,16 @@ type AllocMetric struct {
 	// This is to prevent creating many failed allocations for a
 	// single task group.
 	CoalescedFailures int
+
+	// tracer records every filtering, exhaustion and scoring decision when
+	// evaluation tracing is enabled. It is nil otherwise.
+	tracer *EvalTraceRecorder
+}
+
+// SetTracer sets the recorder that receives the decisions tracked by the
+// metric.
+func (a *AllocMetric) SetTracer(tracer *EvalTraceRecorder) {
+	a.tracer = tracer
 }
 
 func (a *AllocMetric) Copy() *AllocMetric {
@@ -11912,6 +12276,7 @@ func (a *AllocMetric) EvaluateNode() {
 
 func (a *AllocMetric) FilterNode(node *Node, constraint string) {
 	a.NodesFiltered += 1
+	a.tracer.Filtered(node, constraint)
 	if node != nil && node.NodeClass != "" {
 		if a.ClassFiltered == nil {
 			a.ClassFiltered = make(map[string]int)
@@ -11928,6 +12293,7 @@ func (a *AllocMetric) FilterNode(node *Node, constraint string) {
 
 func (a *AllocMetric) ExhaustedNode(node *Node, dimension string) {
 	a.NodesExhausted += 1
+	a.tracer.Exhausted(node, dimension)
 	if node != nil && node.NodeClass != "" {
 		if a.ClassExhausted == nil {
 			a.ClassExhausted = make(map[string]int)
@@ -11990,6 +12356,8 @@ func (a *AllocMetric) ScoreNode(node *Node, name string, score float64) {
 	}
 	if name == NormScorerName {
 		a.nodeScoreMeta.NormScore = score
+		a.tracer.Scored(a.nodeScoreMeta)
+
 		// Once we have the normalized score we can push to the heap
 		// that tracks top K by normalized score
 
@@ -12240,6 +12608,7 @@ const (
 	EvalTriggerScaling              = "job-scaling"
 	EvalTriggerMaxDisconnectTimeout = "max-disconnect-timeout"
 	EvalTriggerReconnect            = "reconnect"
+	EvalTriggerNodePoolRebalance    = "node-pool-rebalance"
 )
 
 const (
@@ -12300,6 +12669,16 @@ const (
 
 	// CoreJobForceGC is used to force garbage collection of all GCable objects.
 	CoreJobForceGC = "force-gc"
+
+	// CoreJobNodePoolRebalance is used to reduce fragmentation within node
+	// pools. We periodically score how well each node is packed and migrate
+	// the allocations off lightly utilized nodes when they fit elsewhere in
+	// the pool.
+	CoreJobNodePoolRebalance = "node-pool-rebalance"
+
+	// CoreJobReservationGC is used for the garbage collection of
+	// reservations whose window has ended.
+	CoreJobReservationGC = "reservation-gc"
 )
 
 // Evaluation is used anytime we need to apply business logic as a result
@@ -12610,7 +12989,9 @@ func (e *Evaluation) MakePlan(j *Job) *Plan {
 		NodePreemptions: make(map[string][]*Allocation),
 	}
 	if j != nil {
-		p.AllAtOnce = j.AllAtOnce
+		// Gang jobs must not be partially committed if the plan applier
+		// rejects some of the nodes.
+		p.AllAtOnce = j.AllAtOnce || j.Gang != nil
 	}
 	return p
 }
@@ -12907,6 +13288,52 @@ func (p *Plan) PopUpdate(alloc *Allocation) {
 	}
 }
 
+// RemoveUpdate removes the stop or eviction for the given allocation from the
+// plan. Unlike PopUpdate, the update does not need to be the last one appended
+// for the node.
+func (p *Plan) RemoveUpdate(alloc *Allocation) {
+	existing := p.NodeUpdate[alloc.NodeID]
+	existing = slices.DeleteFunc(existing, func(a *Allocation) bool {
+		return a.ID == alloc.ID
+	})
+	if len(existing) > 0 {
+		p.NodeUpdate[alloc.NodeID] = existing
+	} else {
+		delete(p.NodeUpdate, alloc.NodeID)
+	}
+}
+
+// RemoveAlloc removes a placement from the plan, along with any preemptions
+// that were added to make room for it. The removed preemptions are returned.
+func (p *Plan) RemoveAlloc(alloc *Allocation) []*Allocation {
+	existing := p.NodeAllocation[alloc.NodeID]
+	existing = slices.DeleteFunc(existing, func(a *Allocation) bool {
+		return a.ID == alloc.ID
+	})
+	if len(existing) > 0 {
+		p.NodeAllocation[alloc.NodeID] = existing
+	} else {
+		delete(p.NodeAllocation, alloc.NodeID)
+	}
+
+	var removed []*Allocation
+	preempted := p.NodePreemptions[alloc.NodeID]
+	preempted = slices.DeleteFunc(preempted, func(a *Allocation) bool {
+		if a.PreemptedByAllocation == alloc.ID {
+			removed = append(removed, a)
+			return true
+		}
+		return false
+	})
+	if len(preempted) > 0 {
+		p.NodePreemptions[alloc.NodeID] = preempted
+	} else {
+		delete(p.NodePreemptions, alloc.NodeID)
+	}
+
+	return removed
+}
+
 // AppendAlloc appends the alloc to the plan allocations.
 // Uses the passed job if explicitly passed, otherwise
 // it is assumed the alloc will use the plan Job version.
@@ -13020,6 +13447,29 @@ type PlanAnnotations struct {
 
 	// PreemptedAllocs is the set of allocations to be preempted to make the placement successful.
 	PreemptedAllocs []*AllocListStub
+
+	// PreemptionReasons explains why each of the PreemptedAllocs is
+	// preempted, keyed by allocation ID.
+	PreemptionReasons map[string]*PreemptionReason
+}
+
+// PreemptionReason explains why the scheduler chose to preempt an allocation.
+type PreemptionReason struct {
+	// PreemptedFor is the name of the allocation whose placement requires
+	// the preemption.
+	PreemptedFor string
+
+	// NodeID is the ID of the node the allocations are placed on.
+	NodeID string
+
+	// Resources are the resources that were exhausted on the node, and that
+	// the preemption frees for the placement.
+	Resources []string
+
+	// JobPriority and PreemptionCost are the priority and preemption cost of
+	// the job of the preempted allocation.
+	JobPriority    int
+	PreemptionCost int
 }
 
 // DesiredUpdates is the set of changes the scheduler would like to make given
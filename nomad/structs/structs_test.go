@@ -419,6 +419,25 @@ func TestJob_Validate(t *testing.T) {
 
 }
 
+func TestJob_ValidatePreemption(t *testing.T) {
+	ci.Parallel(t)
+
+	job := testJob()
+	job.PreemptionCost = 50
+	job.PreemptionBudget = &PreemptionBudget{MaxAllocs: 2, Window: time.Hour}
+	must.NoError(t, job.Validate())
+
+	job.PreemptionCost = JobMaxPreemptionCost + 1
+	err := job.Validate()
+	must.ErrorContains(t, err, "Job preemption cost must be between 0 and 100")
+
+	job.PreemptionCost = 0
+	job.PreemptionBudget = &PreemptionBudget{MaxAllocs: -1}
+	err = job.Validate()
+	must.ErrorContains(t, err, "max allocs must be greater than or equal to 0")
+	must.ErrorContains(t, err, "window must be greater than 0")
+}
+
 func TestJob_ValidateScaling(t *testing.T) {
 	ci.Parallel(t)
 
@@ -5188,6 +5207,73 @@ func TestAllocation_ShouldMigrate(t *testing.T) {
 	}
 }
 
+func TestAllocation_ShouldCheckpoint(t *testing.T) {
+	ci.Parallel(t)
+
+	job := func(checkpoint bool) *Job {
+		return &Job{
+			TaskGroups: []*TaskGroup{
+				{
+					Name:       "foo",
+					Checkpoint: checkpoint,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		expect bool
+		alloc  Allocation
+	}{
+		{
+			name:   "task group enables checkpoint",
+			expect: true,
+			alloc: Allocation{
+				TaskGroup:         "foo",
+				Job:               job(true),
+				DesiredTransition: DesiredTransition{Migrate: pointer.Of(true)},
+			},
+		},
+		{
+			name:   "stop request enables checkpoint",
+			expect: true,
+			alloc: Allocation{
+				TaskGroup: "foo",
+				Job:       job(false),
+				DesiredTransition: DesiredTransition{
+					Migrate:    pointer.Of(true),
+					Checkpoint: pointer.Of(true),
+				},
+			},
+		},
+		{
+			name:   "not migrating",
+			expect: false,
+			alloc: Allocation{
+				TaskGroup:         "foo",
+				Job:               job(true),
+				DesiredTransition: DesiredTransition{Checkpoint: pointer.Of(true)},
+			},
+		},
+		{
+			name:   "checkpoint not enabled",
+			expect: false,
+			alloc: Allocation{
+				TaskGroup:         "foo",
+				Job:               job(false),
+				DesiredTransition: DesiredTransition{Migrate: pointer.Of(true)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			must.Eq(t, tc.expect, tc.alloc.ShouldCheckpoint())
+		})
+	}
+}
+
 func TestTaskArtifact_Validate_Checksum(t *testing.T) {
 	ci.Parallel(t)
 
@@ -5326,6 +5412,56 @@ func TestPlan_AppendPreemptedAllocAppendsAllocWithUpdatedAttrs(t *testing.T) {
 	assert.Equal(t, expectedAlloc, appendedAlloc)
 }
 
+func TestPlan_RemoveAlloc(t *testing.T) {
+	ci.Parallel(t)
+
+	plan := &Plan{
+		NodeAllocation:  make(map[string][]*Allocation),
+		NodePreemptions: make(map[string][]*Allocation),
+	}
+
+	keep := MockAlloc()
+	remove := MockAlloc()
+	remove.NodeID = keep.NodeID
+	plan.AppendAlloc(keep, nil)
+	plan.AppendAlloc(remove, nil)
+
+	preempted := MockAlloc()
+	preempted.NodeID = keep.NodeID
+	plan.AppendPreemptedAlloc(preempted, remove.ID)
+
+	removed := plan.RemoveAlloc(remove)
+	must.Len(t, 1, removed)
+	must.Eq(t, preempted.ID, removed[0].ID)
+	must.Eq(t, []*Allocation{keep}, plan.NodeAllocation[keep.NodeID])
+	must.MapNotContainsKey(t, plan.NodePreemptions, keep.NodeID)
+
+	must.SliceEmpty(t, plan.RemoveAlloc(keep))
+	must.MapEmpty(t, plan.NodeAllocation)
+}
+
+func TestPlan_RemoveUpdate(t *testing.T) {
+	ci.Parallel(t)
+
+	plan := &Plan{
+		NodeUpdate: make(map[string][]*Allocation),
+	}
+
+	first := MockAlloc()
+	second := MockAlloc()
+	second.NodeID = first.NodeID
+	plan.AppendStoppedAlloc(first, "", "", "")
+	plan.AppendStoppedAlloc(second, "", "", "")
+
+	// Unlike PopUpdate, the update does not need to be the last one.
+	plan.RemoveUpdate(first)
+	must.Len(t, 1, plan.NodeUpdate[first.NodeID])
+	must.Eq(t, second.ID, plan.NodeUpdate[first.NodeID][0].ID)
+
+	plan.RemoveUpdate(second)
+	must.MapEmpty(t, plan.NodeUpdate)
+}
+
 func TestMsgPackTags(t *testing.T) {
 	ci.Parallel(t)
 
@@ -6468,6 +6604,37 @@ func TestParameterizedJobConfig_Validate_NonBatch(t *testing.T) {
 	}
 }
 
+func TestJobGang_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	job := testJob()
+	job.Gang = &JobGang{}
+	must.NoError(t, job.Validate())
+
+	job.Gang.Groups = []string{"web", "web", "missing"}
+	err := job.Validate()
+	must.ErrorContains(t, err, `Gang group "web" is listed more than once`)
+	must.ErrorContains(t, err, `Gang group "missing" does not exist`)
+
+	job.Gang.Groups = []string{"web"}
+	job.Type = JobTypeSysBatch
+	must.ErrorContains(t, job.Validate(), "Gang can only be used with")
+}
+
+func TestJobGang_Includes(t *testing.T) {
+	ci.Parallel(t)
+
+	var gang *JobGang
+	must.False(t, gang.Includes("web"))
+
+	gang = &JobGang{}
+	must.True(t, gang.Includes("web"))
+
+	gang.Groups = []string{"api"}
+	must.False(t, gang.Includes("web"))
+	must.True(t, gang.Includes("api"))
+}
+
 func TestParameterizedJobConfig_Canonicalize(t *testing.T) {
 	ci.Parallel(t)
 
@@ -7243,6 +7410,53 @@ func TestSpread_Validate(t *testing.T) {
 			err:  nil,
 			name: "Valid spread",
 		},
+		{
+			spread: &Spread{
+				Attribute: "${node.datacenter}",
+				Hierarchy: []string{"${meta.zone}", "${meta.rack}"},
+				Weight:    50,
+			},
+			err:  fmt.Errorf("Spread attribute and hierarchy are mutually exclusive"),
+			name: "Hierarchy with attribute",
+		},
+		{
+			spread: &Spread{
+				Hierarchy: []string{"${meta.zone}", "${meta.rack}"},
+				Weight:    50,
+				SpreadTarget: []*SpreadTarget{
+					{
+						Value:   "z1",
+						Percent: 50,
+					},
+				},
+			},
+			err:  fmt.Errorf("Spread targets are not supported with a hierarchy"),
+			name: "Hierarchy with targets",
+		},
+		{
+			spread: &Spread{
+				Hierarchy: []string{"${meta.zone}"},
+				Weight:    50,
+			},
+			err:  fmt.Errorf("Spread hierarchy must have at least two attributes"),
+			name: "Hierarchy with a single level",
+		},
+		{
+			spread: &Spread{
+				Hierarchy: []string{"${meta.zone}", "${meta.zone}"},
+				Weight:    50,
+			},
+			err:  fmt.Errorf("Spread hierarchy attribute \"${meta.zone}\" already defined"),
+			name: "Hierarchy with duplicate attribute",
+		},
+		{
+			spread: &Spread{
+				Hierarchy: []string{"${meta.zone}", "${meta.rack}", "${node.unique.id}"},
+				Weight:    50,
+			},
+			err:  nil,
+			name: "Valid hierarchy",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -53,6 +53,13 @@ const (
 	// TaskScheduleStateSchedResume is a transitory state that will become
 	// either SchedPause or (sched) Run
 	TaskScheduleStateSchedResume TaskScheduleState = "schedule_resume"
+
+	// TaskScheduleStateHibernate and TaskScheduleStateWake are requests to
+	// freeze and thaw a running task through its driver, which keeps the
+	// task's memory instead of stopping it. They are never stored as a
+	// task's schedule state.
+	TaskScheduleStateHibernate TaskScheduleState = "hibernate"
+	TaskScheduleStateWake      TaskScheduleState = "wake"
 )
 
 // TaskSchedule allows specifying a time based execution schedule for tasks.
@@ -645,6 +645,12 @@ func (w *Worker) ServersMeetMinimumVersion(minVersion *version.Version, checkFai
 	return ServersMeetMinimumVersion(w.srv.Members(), w.srv.Region(), minVersion, checkFailedServers)
 }
 
+// NodeScorers returns the external node scorers of the server, allowing the
+// scheduler to rank nodes with scorer plugins.
+func (w *Worker) NodeScorers() []scheduler.NodeScorer {
+	return w.srv.nodeScorers()
+}
+
 // SubmitPlan is used to submit a plan for consideration. This allows
 // the worker to act as the planner for the scheduler.
 func (w *Worker) SubmitPlan(plan *structs.Plan) (*structs.PlanResult, scheduler.State, error) {
@@ -755,6 +761,29 @@ SUBMIT:
 	return nil
 }
 
+// UpsertEvalTrace is used to store the decision trace of the evaluation
+// being processed. Traces are best-effort, so failures aren't retried.
+func (w *Worker) UpsertEvalTrace(trace *structs.EvalTrace) error {
+	if w.srv.IsShutdown() {
+		return fmt.Errorf("shutdown while planning")
+	}
+	defer metrics.MeasureSince([]string{"nomad", "worker", "upsert_eval_trace"}, time.Now())
+
+	req := structs.EvalTraceUpsertRequest{
+		Trace:     trace,
+		EvalToken: w.evalToken,
+		WriteRequest: structs.WriteRequest{
+			Region: w.srv.config.Region,
+		},
+	}
+	var resp structs.GenericResponse
+	if err := w.srv.RPC(structs.EvalTraceUpsertRPCMethod, &req, &resp); err != nil {
+		return err
+	}
+	w.logger.Debug("stored evaluation trace", "eval_id", trace.EvalID, "events", len(trace.Events))
+	return nil
+}
+
 // CreateEval is used to create a new evaluation. This allows
 // the worker to act as the planner for the scheduler.
 func (w *Worker) CreateEval(eval *structs.Evaluation) error {
@@ -82,6 +82,10 @@ type ClientDriverConfig struct {
 	// Topology is the system hardware topology that is the result of scanning
 	// hardware combined with client configuration.
 	Topology *numalib.Topology
+
+	// DataDir is the data directory of the client, under which drivers may
+	// persist state.
+	DataDir string
 }
 
 func (c *AgentConfig) toProto() *proto.NomadConfig {
@@ -94,6 +98,7 @@ func (c *AgentConfig) toProto() *proto.NomadConfig {
 			ClientMaxPort: uint32(c.Driver.ClientMaxPort),
 			ClientMinPort: uint32(c.Driver.ClientMinPort),
 			Topology:      nomadTopologyToProto(c.Driver.Topology),
+			DataDir:       c.Driver.DataDir,
 		}
 	}
 	return cfg
@@ -109,6 +114,7 @@ func nomadConfigFromProto(pb *proto.NomadConfig) *AgentConfig {
 			ClientMaxPort: uint(pb.Driver.ClientMaxPort),
 			ClientMinPort: uint(pb.Driver.ClientMinPort),
 			Topology:      nomadTopologyFromProto(pb.Driver.Topology),
+			DataDir:       pb.Driver.DataDir,
 		}
 	}
 	return cfg
@@ -23,6 +23,9 @@ const (
 
 	// PluginTypeDevice implements the device plugin interface
 	PluginTypeDevice = "device"
+
+	// PluginTypeScorer implements the scorer plugin interface
+	PluginTypeScorer = "scorer"
 )
 
 var (
@@ -32,18 +32,21 @@ const (
 	PluginType_UNKNOWN PluginType = 0
 	PluginType_DRIVER  PluginType = 2
 	PluginType_DEVICE  PluginType = 3
+	PluginType_SCORER  PluginType = 4
 )
 
 var PluginType_name = map[int32]string{
 	0: "UNKNOWN",
 	2: "DRIVER",
 	3: "DEVICE",
+	4: "SCORER",
 }
 
 var PluginType_value = map[string]int32{
 	"UNKNOWN": 0,
 	"DRIVER":  2,
 	"DEVICE":  3,
+	"SCORER":  4,
 }
 
 func (x PluginType) String() string {
@@ -368,10 +371,13 @@ type NomadDriverConfig struct {
 	ClientMinPort uint32 `protobuf:"varint,2,opt,name=ClientMinPort,proto3" json:"ClientMinPort,omitempty"`
 	// Topology is the complex hardware topology detected by the client
 	// combined with client configuration.
-	Topology             *ClientTopology `protobuf:"bytes,3,opt,name=Topology,proto3" json:"Topology,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Topology *ClientTopology `protobuf:"bytes,3,opt,name=Topology,proto3" json:"Topology,omitempty"`
+	// DataDir is the data directory of the client, under which drivers may
+	// persist state
+	DataDir              string   `protobuf:"bytes,4,opt,name=DataDir,proto3" json:"DataDir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *NomadDriverConfig) Reset()         { *m = NomadDriverConfig{} }
@@ -420,6 +426,13 @@ func (m *NomadDriverConfig) GetTopology() *ClientTopology {
 	return nil
 }
 
+func (m *NomadDriverConfig) GetDataDir() string {
+	if m != nil {
+		return m.DataDir
+	}
+	return ""
+}
+
 // numalib/Topology
 type ClientTopology struct {
 	NodeIds                []uint32              `protobuf:"varint,1,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
@@ -691,61 +704,62 @@ func init() {
 }
 
 var fileDescriptor_19edef855873449e = []byte{
-	// 861 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0x23, 0x45,
-	0x10, 0xcd, 0xd8, 0x8e, 0x3f, 0xca, 0xb1, 0x71, 0x2a, 0x0b, 0x0c, 0x86, 0x15, 0xd6, 0x88, 0x95,
-	0xa2, 0x55, 0x98, 0x08, 0xb3, 0x59, 0xf6, 0x08, 0xf1, 0x46, 0xc8, 0x22, 0x6b, 0xa2, 0xb6, 0xc9,
-	0x22, 0x84, 0x64, 0x75, 0x66, 0xda, 0x76, 0x6b, 0x3d, 0xd3, 0xc3, 0xf4, 0x38, 0x24, 0x48, 0x9c,
-	0x38, 0xf3, 0x3f, 0xb8, 0xf1, 0x03, 0x38, 0x70, 0xe0, 0x8f, 0xa1, 0xfe, 0x18, 0xdb, 0x49, 0x84,
-	0xb0, 0xf7, 0xe4, 0xee, 0x7a, 0xaf, 0x5e, 0x75, 0xbd, 0x1e, 0x57, 0xc3, 0xe3, 0x64, 0xbe, 0x98,
-	0xf2, 0x58, 0x1e, 0x5f, 0x51, 0xc9, 0x8e, 0x93, 0x54, 0x64, 0x42, 0x2f, 0x7d, 0xbd, 0x44, 0x6f,
-	0x46, 0xe5, 0x8c, 0x07, 0x22, 0x4d, 0xfc, 0x58, 0x44, 0x34, 0xf4, 0x2d, 0xdd, 0x5f, 0x71, 0xda,
-	0x4f, 0x72, 0x09, 0x39, 0xa3, 0x29, 0x0b, 0x8f, 0x67, 0xc1, 0x5c, 0x26, 0x2c, 0x50, 0xbf, 0x63,
-	0xb5, 0x30, 0x34, 0xef, 0x00, 0xf6, 0x2f, 0x34, 0xb1, 0x1f, 0x4f, 0x04, 0x61, 0x3f, 0x2d, 0x98,
-	0xcc, 0xbc, 0x7f, 0x1c, 0xc0, 0xf5, 0xa8, 0x4c, 0x44, 0x2c, 0x19, 0x9e, 0x42, 0x29, 0xbb, 0x4d,
-	0x98, 0xeb, 0x74, 0x9c, 0xc3, 0x66, 0xd7, 0xf7, 0xff, 0xff, 0x14, 0xbe, 0x51, 0x19, 0xdd, 0x26,
-	0x8c, 0xe8, 0x5c, 0xf4, 0xe1, 0xc0, 0xd0, 0xc6, 0x34, 0xe1, 0xe3, 0x6b, 0x96, 0x4a, 0x2e, 0x62,
-	0xe9, 0x16, 0x3a, 0xc5, 0xc3, 0x1a, 0xd9, 0x37, 0xd0, 0x57, 0x09, 0xbf, 0xb4, 0x00, 0x3e, 0x81,
-	0xa6, 0xe5, 0x5b, 0xae, 0x5b, 0xec, 0x38, 0x87, 0x35, 0xd2, 0x30, 0x51, 0xcb, 0x43, 0x84, 0x52,
-	0x4c, 0x23, 0xe6, 0x96, 0x34, 0xa8, 0xd7, 0xde, 0xbb, 0x70, 0xd0, 0x13, 0xf1, 0x84, 0x4f, 0x87,
-	0xc1, 0x8c, 0x45, 0x34, 0x6f, 0xee, 0x7b, 0x78, 0x74, 0x37, 0x6c, 0xbb, 0xfb, 0x12, 0x4a, 0xca,
-	0x17, 0xdd, 0x5d, 0xbd, 0x7b, 0xf4, 0x9f, 0xdd, 0x19, 0x3f, 0x7d, 0xeb, 0xa7, 0x3f, 0x4c, 0x58,
-	0x40, 0x74, 0xa6, 0xf7, 0x97, 0x03, 0xad, 0x21, 0xcb, 0x8c, 0xba, 0x2d, 0xa7, 0x1a, 0x88, 0xe4,
-	0x34, 0xa1, 0xc1, 0x9b, 0x71, 0xa0, 0x01, 0x5d, 0x60, 0x8f, 0x34, 0x6c, 0xd4, 0xb0, 0x91, 0xc0,
-	0x9e, 0x2e, 0x93, 0x93, 0x0a, 0xfa, 0x14, 0xc7, 0x9b, 0x78, 0x3c, 0x50, 0x80, 0x2d, 0x5a, 0x8f,
-	0x57, 0x1b, 0x3c, 0x02, 0x7c, 0xe8, 0xb5, 0xf5, 0xaf, 0x75, 0xdf, 0x6a, 0xef, 0x47, 0xa8, 0xaf,
-	0x29, 0xe1, 0x2b, 0x28, 0x87, 0x29, 0xbf, 0x66, 0xa9, 0x35, 0xe4, 0x64, 0xe3, 0xa3, 0xbc, 0xd4,
-	0x69, 0xf6, 0x40, 0x56, 0xc4, 0xfb, 0xd3, 0x81, 0xfd, 0x07, 0x28, 0x7e, 0x02, 0x8d, 0xde, 0x9c,
-	0xb3, 0x38, 0x7b, 0x45, 0x6f, 0x2e, 0x44, 0x9a, 0xe9, 0x5a, 0x0d, 0x72, 0x37, 0xb8, 0xc6, 0xe2,
-	0xb1, 0x66, 0x15, 0xee, 0xb0, 0x4c, 0x10, 0x07, 0x50, 0x1d, 0x89, 0x44, 0xcc, 0xc5, 0xf4, 0x56,
-	0xf7, 0x58, 0xef, 0x76, 0x37, 0x39, 0xb2, 0x11, 0xc9, 0x33, 0xc9, 0x52, 0xc3, 0xfb, 0xbb, 0x00,
-	0xcd, 0xbb, 0x20, 0x7e, 0x00, 0xd5, 0x58, 0x84, 0x6c, 0xcc, 0x43, 0xe9, 0x3a, 0x9d, 0xe2, 0x61,
-	0x83, 0x54, 0xd4, 0xbe, 0x1f, 0x4a, 0x1c, 0x41, 0x2d, 0xe4, 0x32, 0xa3, 0x71, 0xc0, 0xa4, 0xbd,
-	0xbc, 0xe7, 0xdb, 0x97, 0x1f, 0x9e, 0xf7, 0x47, 0x64, 0x25, 0x84, 0xe7, 0xb0, 0x1b, 0x88, 0x94,
-	0x49, 0xb7, 0xd8, 0x29, 0xbe, 0x9d, 0x62, 0x4f, 0xa4, 0x8c, 0x18, 0x11, 0x7c, 0x06, 0xef, 0x89,
-	0x6b, 0x96, 0xa6, 0x3c, 0x64, 0xe3, 0x4c, 0x64, 0x74, 0x3e, 0x0e, 0x44, 0x94, 0x2c, 0x32, 0xf3,
-	0xb7, 0x29, 0x91, 0x47, 0x39, 0x3a, 0x52, 0x60, 0xcf, 0x60, 0xf8, 0x02, 0xdc, 0x65, 0xd6, 0xcf,
-	0x3c, 0x9b, 0x89, 0x79, 0xb8, 0xcc, 0xdb, 0xd5, 0x79, 0x4b, 0xd5, 0xd7, 0x06, 0xb6, 0x99, 0xde,
-	0x00, 0xf0, 0x61, 0x7b, 0xf8, 0x91, 0x72, 0x2a, 0x62, 0xb1, 0xfe, 0x18, 0xcd, 0x7d, 0xaf, 0x02,
-	0xd8, 0x86, 0xf2, 0x35, 0x9d, 0x2f, 0x98, 0x19, 0x09, 0x8d, 0xd3, 0x42, 0xcb, 0x21, 0x36, 0xe2,
-	0xfd, 0x51, 0xb8, 0x2f, 0xa8, 0xba, 0xc3, 0x0f, 0xa1, 0x26, 0x45, 0xf0, 0x86, 0x65, 0x63, 0x1e,
-	0x5a, 0xc1, 0xaa, 0x09, 0xf4, 0x43, 0x7c, 0x1f, 0x2a, 0xf6, 0xca, 0xec, 0x57, 0x53, 0x36, 0x37,
-	0xa6, 0x00, 0xe5, 0x8a, 0x02, 0x8a, 0x06, 0x50, 0xdb, 0x7e, 0x88, 0xe7, 0x00, 0x1a, 0x98, 0xa6,
-	0x34, 0x34, 0xce, 0x34, 0xbb, 0x9f, 0x6e, 0x64, 0xbc, 0x48, 0xd9, 0xd7, 0x2a, 0x89, 0xd4, 0x82,
-	0x7c, 0x89, 0x2e, 0x54, 0x42, 0x2e, 0xe9, 0xd5, 0xdc, 0x98, 0x55, 0x25, 0xf9, 0x16, 0x1f, 0x03,
-	0xa8, 0x64, 0x35, 0x8c, 0x59, 0xe8, 0x96, 0xb5, 0x93, 0x35, 0x15, 0x19, 0xaa, 0x80, 0xea, 0x2a,
-	0xa2, 0x37, 0x16, 0xad, 0x68, 0xb4, 0x1a, 0xd1, 0x1b, 0x03, 0x7e, 0x0c, 0xf5, 0xe9, 0x82, 0x49,
-	0x69, 0xe1, 0xaa, 0x86, 0x41, 0x87, 0x34, 0x41, 0x8d, 0xf5, 0xb5, 0x49, 0x64, 0x26, 0xdc, 0xd3,
-	0xcf, 0x00, 0x56, 0xf3, 0x18, 0xeb, 0x50, 0xf9, 0x6e, 0xf0, 0xcd, 0xe0, 0xdb, 0xd7, 0x83, 0xd6,
-	0x0e, 0x02, 0x94, 0x5f, 0x92, 0xfe, 0xe5, 0x19, 0x69, 0x15, 0xf4, 0xfa, 0xec, 0xb2, 0xdf, 0x3b,
-	0x6b, 0x15, 0x9f, 0x1e, 0x41, 0x6d, 0xd9, 0x16, 0xbe, 0x03, 0xf5, 0x0b, 0x96, 0x4e, 0x44, 0x1a,
-	0xa9, 0xaf, 0xb3, 0xb5, 0x83, 0x4d, 0x80, 0xb3, 0xc9, 0x84, 0x07, 0x9c, 0xc5, 0xc1, 0x6d, 0xcb,
-	0xe9, 0xfe, 0x5e, 0x04, 0x38, 0xa5, 0x92, 0x99, 0x2a, 0xf8, 0x6b, 0x5e, 0x4f, 0xbd, 0x22, 0x78,
-	0xb2, 0xf9, 0x7b, 0xb1, 0xf6, 0x16, 0xb5, 0x9f, 0x6f, 0x9b, 0x66, 0x9a, 0xf5, 0x76, 0xf0, 0x37,
-	0x07, 0xf6, 0xd6, 0x27, 0x3d, 0x7e, 0xb1, 0xd9, 0x2d, 0x3e, 0x78, 0x32, 0xda, 0x2f, 0xb6, 0x4f,
-	0x5c, 0x9e, 0xe2, 0x17, 0xa8, 0x2d, 0x6f, 0x02, 0x9f, 0x6d, 0x22, 0x74, 0xff, 0x09, 0x69, 0x9f,
-	0x6c, 0x99, 0x95, 0xd7, 0x3e, 0xad, 0xfc, 0xb0, 0xab, 0xc1, 0xab, 0xb2, 0xfe, 0xf9, 0xfc, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xe1, 0xe9, 0x67, 0x7b, 0x58, 0x08, 0x00, 0x00,
+	// 878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x5d, 0x6f, 0xe3, 0x44,
+	0x14, 0xad, 0x93, 0x34, 0x1f, 0x37, 0x4d, 0x49, 0x6f, 0x17, 0x30, 0x81, 0x15, 0x91, 0xc5, 0x4a,
+	0xd5, 0xaa, 0xb8, 0x52, 0xd8, 0x2e, 0xfb, 0x82, 0x04, 0x4d, 0x23, 0x14, 0xd1, 0xcd, 0x56, 0x93,
+	0xd0, 0x45, 0x08, 0x29, 0x9a, 0xda, 0x93, 0x64, 0xb4, 0xb1, 0xc7, 0x78, 0x9c, 0xd2, 0x22, 0xf1,
+	0xc4, 0x33, 0xff, 0x83, 0xff, 0xc0, 0x03, 0x0f, 0x3c, 0xf1, 0xaf, 0xd0, 0x7c, 0x38, 0x49, 0x37,
+	0x42, 0xa4, 0x3c, 0x79, 0xe6, 0x9e, 0x73, 0xee, 0xdc, 0x7b, 0x66, 0x3c, 0x03, 0x8f, 0x93, 0xf9,
+	0x62, 0xca, 0x63, 0x79, 0x72, 0x4d, 0x25, 0x3b, 0x49, 0x52, 0x91, 0x09, 0x3d, 0xf4, 0xf5, 0x10,
+	0xbd, 0x19, 0x95, 0x33, 0x1e, 0x88, 0x34, 0xf1, 0x63, 0x11, 0xd1, 0xd0, 0xb7, 0x74, 0x7f, 0xc5,
+	0x69, 0x3d, 0xc9, 0x53, 0xc8, 0x19, 0x4d, 0x59, 0x78, 0x32, 0x0b, 0xe6, 0x32, 0x61, 0x81, 0xfa,
+	0x8e, 0xd5, 0xc0, 0xd0, 0xbc, 0x43, 0x38, 0xb8, 0xd4, 0xc4, 0x7e, 0x3c, 0x11, 0x84, 0xfd, 0xb8,
+	0x60, 0x32, 0xf3, 0xfe, 0x72, 0x00, 0xd7, 0xa3, 0x32, 0x11, 0xb1, 0x64, 0x78, 0x06, 0xa5, 0xec,
+	0x2e, 0x61, 0xae, 0xd3, 0x76, 0x8e, 0xf6, 0x3b, 0xbe, 0xff, 0xdf, 0x55, 0xf8, 0x26, 0xcb, 0xe8,
+	0x2e, 0x61, 0x44, 0x6b, 0xd1, 0x87, 0x43, 0x43, 0x1b, 0xd3, 0x84, 0x8f, 0x6f, 0x58, 0x2a, 0xb9,
+	0x88, 0xa5, 0x5b, 0x68, 0x17, 0x8f, 0x6a, 0xe4, 0xc0, 0x40, 0x5f, 0x25, 0xfc, 0xca, 0x02, 0xf8,
+	0x04, 0xf6, 0x2d, 0xdf, 0x72, 0xdd, 0x62, 0xdb, 0x39, 0xaa, 0x91, 0x86, 0x89, 0x5a, 0x1e, 0x22,
+	0x94, 0x62, 0x1a, 0x31, 0xb7, 0xa4, 0x41, 0x3d, 0xf6, 0xde, 0x85, 0xc3, 0xae, 0x88, 0x27, 0x7c,
+	0x3a, 0x0c, 0x66, 0x2c, 0xa2, 0x79, 0x73, 0xdf, 0xc1, 0xa3, 0xfb, 0x61, 0xdb, 0xdd, 0x97, 0x50,
+	0x52, 0xbe, 0xe8, 0xee, 0xea, 0x9d, 0xe3, 0x7f, 0xed, 0xce, 0xf8, 0xe9, 0x5b, 0x3f, 0xfd, 0x61,
+	0xc2, 0x02, 0xa2, 0x95, 0xde, 0x1f, 0x0e, 0x34, 0x87, 0x2c, 0x33, 0xd9, 0xed, 0x72, 0xaa, 0x81,
+	0x48, 0x4e, 0x13, 0x1a, 0xbc, 0x19, 0x07, 0x1a, 0xd0, 0x0b, 0xec, 0x91, 0x86, 0x8d, 0x1a, 0x36,
+	0x12, 0xd8, 0xd3, 0xcb, 0xe4, 0xa4, 0x82, 0xae, 0xe2, 0x64, 0x1b, 0x8f, 0x07, 0x0a, 0xb0, 0x8b,
+	0xd6, 0xe3, 0xd5, 0x04, 0x8f, 0x01, 0x37, 0xbd, 0xb6, 0xfe, 0x35, 0xdf, 0xb6, 0xda, 0xfb, 0x01,
+	0xea, 0x6b, 0x99, 0xf0, 0x25, 0x94, 0xc3, 0x94, 0xdf, 0xb0, 0xd4, 0x1a, 0x72, 0xba, 0x75, 0x29,
+	0xe7, 0x5a, 0x66, 0x0b, 0xb2, 0x49, 0xbc, 0xbf, 0x1d, 0x38, 0xd8, 0x40, 0xf1, 0x13, 0x68, 0x74,
+	0xe7, 0x9c, 0xc5, 0xd9, 0x4b, 0x7a, 0x7b, 0x29, 0xd2, 0x4c, 0xaf, 0xd5, 0x20, 0xf7, 0x83, 0x6b,
+	0x2c, 0x1e, 0x6b, 0x56, 0xe1, 0x1e, 0xcb, 0x04, 0x71, 0x00, 0xd5, 0x91, 0x48, 0xc4, 0x5c, 0x4c,
+	0xef, 0x74, 0x8f, 0xf5, 0x4e, 0x67, 0x9b, 0x92, 0x4d, 0x92, 0x5c, 0x49, 0x96, 0x39, 0xd0, 0x85,
+	0xca, 0x39, 0xcd, 0xe8, 0x39, 0x4f, 0xed, 0xa9, 0xca, 0xa7, 0xde, 0x9f, 0x05, 0xd8, 0xbf, 0x2f,
+	0xc3, 0x0f, 0xa0, 0x1a, 0x8b, 0x90, 0x8d, 0x79, 0x28, 0x5d, 0xa7, 0x5d, 0x3c, 0x6a, 0x90, 0x8a,
+	0x9a, 0xf7, 0x43, 0x89, 0x23, 0xa8, 0x85, 0x5c, 0x66, 0x34, 0x0e, 0x98, 0xb4, 0xdb, 0xfa, 0xfc,
+	0xe1, 0x85, 0x0d, 0x2f, 0xfa, 0x23, 0xb2, 0x4a, 0x84, 0x17, 0xb0, 0x1b, 0x88, 0x94, 0x49, 0xb7,
+	0xd8, 0x2e, 0xfe, 0xbf, 0x8c, 0x5d, 0x91, 0x32, 0x62, 0x92, 0xe0, 0x33, 0x78, 0x4f, 0xdc, 0xb0,
+	0x34, 0xe5, 0x21, 0x1b, 0x67, 0x22, 0xa3, 0xf3, 0x71, 0x20, 0xa2, 0x64, 0x91, 0x99, 0x1f, 0xaa,
+	0x44, 0x1e, 0xe5, 0xe8, 0x48, 0x81, 0x5d, 0x83, 0xe1, 0x0b, 0x70, 0x97, 0xaa, 0x9f, 0x78, 0x36,
+	0x13, 0xf3, 0x70, 0xa9, 0xdb, 0xd5, 0xba, 0x65, 0xd6, 0xd7, 0x06, 0xb6, 0x4a, 0x6f, 0x00, 0xb8,
+	0xd9, 0x1e, 0x7e, 0xa4, 0x9c, 0x8a, 0x58, 0xac, 0x8f, 0xa9, 0x39, 0x09, 0xab, 0x00, 0xb6, 0xa0,
+	0x7c, 0x43, 0xe7, 0x0b, 0x66, 0x2e, 0x8b, 0xc6, 0x59, 0xa1, 0xe9, 0x10, 0x1b, 0xf1, 0x7e, 0x2f,
+	0x00, 0x6e, 0x76, 0x87, 0x1f, 0x42, 0x4d, 0x8a, 0xe0, 0x0d, 0xcb, 0xc6, 0x3c, 0xb4, 0x09, 0xab,
+	0x26, 0xd0, 0x0f, 0xf1, 0x7d, 0xa8, 0xd8, 0x2d, 0xb3, 0xe7, 0xa9, 0x6c, 0x76, 0x4c, 0x01, 0xca,
+	0x15, 0x05, 0x14, 0x0d, 0xa0, 0xa6, 0xfd, 0x10, 0x2f, 0x00, 0x34, 0x30, 0x4d, 0x69, 0x68, 0x9c,
+	0xd9, 0xef, 0x7c, 0xba, 0x95, 0xf1, 0x22, 0x65, 0x5f, 0x2b, 0x11, 0xa9, 0x05, 0xf9, 0x50, 0x9d,
+	0xaf, 0x90, 0x4b, 0x7a, 0x3d, 0x37, 0x66, 0x55, 0x49, 0x3e, 0xc5, 0xc7, 0x00, 0x4a, 0xac, 0xae,
+	0x69, 0x16, 0xba, 0x65, 0xed, 0x64, 0x4d, 0x45, 0x86, 0x2a, 0xa0, 0xba, 0x8a, 0xe8, 0xad, 0x45,
+	0x2b, 0x1a, 0xad, 0x46, 0xf4, 0xd6, 0x80, 0x1f, 0x43, 0x7d, 0xba, 0x60, 0x52, 0x5a, 0xb8, 0xaa,
+	0x61, 0xd0, 0x21, 0x4d, 0x50, 0x17, 0xfe, 0xda, 0x1d, 0x65, 0xee, 0xbe, 0xa7, 0x5f, 0x00, 0xac,
+	0x6e, 0x6a, 0xac, 0x43, 0xe5, 0xdb, 0xc1, 0x37, 0x83, 0x57, 0xaf, 0x07, 0xcd, 0x1d, 0x04, 0x28,
+	0x9f, 0x93, 0xfe, 0x55, 0x8f, 0x34, 0x0b, 0x7a, 0xdc, 0xbb, 0xea, 0x77, 0x7b, 0xcd, 0xa2, 0x1a,
+	0x0f, 0xbb, 0xaf, 0x48, 0x8f, 0x34, 0x4b, 0x4f, 0x8f, 0xa1, 0xb6, 0x6c, 0x11, 0xdf, 0x81, 0xfa,
+	0x25, 0x4b, 0x27, 0x22, 0x8d, 0xd4, 0x49, 0x6d, 0xee, 0xe0, 0x3e, 0x40, 0x6f, 0x32, 0xe1, 0x01,
+	0x67, 0x71, 0x70, 0xd7, 0x74, 0x3a, 0xbf, 0x15, 0x01, 0xce, 0xa8, 0x64, 0x66, 0x45, 0xfc, 0x05,
+	0x60, 0xf5, 0xd6, 0xe0, 0xe9, 0xf6, 0xaf, 0xca, 0xda, 0x8b, 0xd5, 0x7a, 0xfe, 0x50, 0x99, 0x69,
+	0xdc, 0xdb, 0xc1, 0x5f, 0x1d, 0xd8, 0x5b, 0x7f, 0x0f, 0xf0, 0xf3, 0xed, 0x76, 0x74, 0xe3, 0x61,
+	0x69, 0xbd, 0x78, 0xb8, 0x70, 0x59, 0xc5, 0xcf, 0x50, 0x5b, 0xee, 0x0a, 0x3e, 0xdb, 0x26, 0xd1,
+	0xdb, 0x0f, 0x4d, 0xeb, 0xf4, 0x81, 0xaa, 0x7c, 0xed, 0xb3, 0xca, 0xf7, 0xbb, 0x1a, 0xbc, 0x2e,
+	0xeb, 0xcf, 0x67, 0xff, 0x0c, 0x00, 0xa3, 0xbe, 0x60, 0xa0, 0x7e, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -29,6 +29,8 @@ func (b *basePluginServer) PluginInfo(context.Context, *proto.PluginInfoRequest)
 		ptype = proto.PluginType_DRIVER
 	case PluginTypeDevice:
 		ptype = proto.PluginType_DEVICE
+	case PluginTypeScorer:
+		ptype = proto.PluginType_SCORER
 	default:
 		return nil, fmt.Errorf("plugin is of unknown type: %q", resp.Type)
 	}
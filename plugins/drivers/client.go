@@ -81,6 +81,10 @@ func (d *driverPluginClient) Capabilities() (*Capabilities, error) {
 		caps.MountConfigs = MountConfigSupport(resp.Capabilities.MountConfigs)
 		caps.DisableLogCollection = resp.Capabilities.DisableLogCollection
 		caps.DynamicWorkloadUsers = resp.Capabilities.DynamicWorkloadUsers
+		caps.Checkpoint = resp.Capabilities.Checkpoint
+		caps.UpdateResources = resp.Capabilities.UpdateResources
+		caps.Pause = resp.Capabilities.Pause
+		caps.ProvidesRootFS = resp.Capabilities.ProvidesRootfs
 	}
 
 	return caps, nil
@@ -509,3 +513,91 @@ func (d *driverPluginClient) DestroyNetwork(allocID string, spec *NetworkIsolati
 
 	return nil
 }
+
+func (d *driverPluginClient) CheckpointTask(taskID, imagePath string, leaveRunning bool) error {
+	req := &proto.CheckpointTaskRequest{
+		TaskId:       taskID,
+		ImagePath:    imagePath,
+		LeaveRunning: leaveRunning,
+	}
+
+	_, err := d.client.CheckpointTask(d.doneCtx, req)
+	if err != nil {
+		return grpcutils.HandleGrpcErr(err, d.doneCtx)
+	}
+
+	return nil
+}
+
+func (d *driverPluginClient) RestoreTask(c *TaskConfig, imagePath string) (*TaskHandle, *DriverNetwork, error) {
+	req := &proto.RestoreTaskRequest{
+		Task:      taskConfigToProto(c),
+		ImagePath: imagePath,
+	}
+
+	resp, err := d.client.RestoreTask(d.doneCtx, req)
+	if err != nil {
+		return nil, nil, grpcutils.HandleGrpcErr(err, d.doneCtx)
+	}
+
+	var net *DriverNetwork
+	if resp.NetworkOverride != nil {
+		net = &DriverNetwork{
+			PortMap:       map[string]int{},
+			IP:            resp.NetworkOverride.Addr,
+			AutoAdvertise: resp.NetworkOverride.AutoAdvertise,
+		}
+		for k, v := range resp.NetworkOverride.PortMap {
+			net.PortMap[k] = int(v)
+		}
+	}
+
+	return taskHandleFromProto(resp.Handle), net, nil
+}
+
+func (d *driverPluginClient) UpdateTaskResources(taskID string, resources *Resources) error {
+	req := &proto.UpdateTaskResourcesRequest{
+		TaskId:    taskID,
+		Resources: ResourcesToProto(resources),
+	}
+
+	_, err := d.client.UpdateTaskResources(d.doneCtx, req)
+	if err != nil {
+		return grpcutils.HandleGrpcErr(err, d.doneCtx)
+	}
+
+	return nil
+}
+
+func (d *driverPluginClient) PauseTask(taskID string) error {
+	req := &proto.PauseTaskRequest{TaskId: taskID}
+
+	_, err := d.client.PauseTask(d.doneCtx, req)
+	if err != nil {
+		return grpcutils.HandleGrpcErr(err, d.doneCtx)
+	}
+
+	return nil
+}
+
+func (d *driverPluginClient) ResumeTask(taskID string) error {
+	req := &proto.ResumeTaskRequest{TaskId: taskID}
+
+	_, err := d.client.ResumeTask(d.doneCtx, req)
+	if err != nil {
+		return grpcutils.HandleGrpcErr(err, d.doneCtx)
+	}
+
+	return nil
+}
+
+func (d *driverPluginClient) TaskProvidesRootFS(c *TaskConfig) (bool, error) {
+	req := &proto.TaskProvidesRootFSRequest{Task: taskConfigToProto(c)}
+
+	resp, err := d.client.TaskProvidesRootFS(d.doneCtx, req)
+	if err != nil {
+		return false, grpcutils.HandleGrpcErr(err, d.doneCtx)
+	}
+
+	return resp.ProvidesRootfs, nil
+}
@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !linux
+
+package conformance
+
+func makeTaskCgroup(string, string) (func(), error) {
+	return func() {}, nil
+}
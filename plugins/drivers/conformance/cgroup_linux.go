@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build linux
+
+package conformance
+
+import (
+	"github.com/hashicorp/nomad/client/lib/cgroupslib"
+)
+
+// makeTaskCgroup creates the cgroup of the task, which drivers expect the
+// Nomad client to have created before StartTask.
+func makeTaskCgroup(allocID, task string) (func(), error) {
+	f := cgroupslib.Factory(allocID, task, false)
+	if err := f.Setup(); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		_ = f.Kill()
+		_ = f.Teardown()
+	}, nil
+}
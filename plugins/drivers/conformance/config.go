@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conformance
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
+	"github.com/hashicorp/nomad/helper"
+)
+
+// ParseConfigFile parses the scenario file at path. See ParseConfig.
+func ParseConfigFile(path string) (*Config, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := ParseConfig(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return cfg, nil
+}
+
+// ParseConfig parses an HCL scenario file describing how to configure the
+// driver and the tasks it runs:
+//
+//	timeout = "30s"
+//	user    = "nobody"
+//	signal  = "SIGHUP"
+//
+//	plugin_config {
+//	  # plugin block of the driver
+//	}
+//
+//	task {
+//	  config {
+//	    # config block of a task that runs until it's stopped
+//	  }
+//	  env {
+//	    KEY = "value"
+//	  }
+//	}
+//
+//	exit_task {
+//	  exit_code = 3
+//	  config {
+//	    # config block of a task that exits on its own
+//	  }
+//	}
+//
+//	exec {
+//	  command   = ["/bin/echo", "hello"]
+//	  output    = "hello"
+//	  exit_code = 0
+//	}
+//
+// The Launch, Filter and Logger fields of the returned config are left for
+// the caller to set.
+func ParseConfig(src []byte) (*Config, error) {
+	root, err := hcl.Parse(string(src))
+	if err != nil {
+		return nil, err
+	}
+
+	list, ok := root.Node.(*ast.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("root should be an object")
+	}
+
+	valid := []string{"timeout", "user", "signal", "plugin_config", "task", "exit_task", "exec"}
+	if err := helper.CheckHCLKeys(list, valid); err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{}
+
+	var timeout string
+	if err := decodeAttr(list, "timeout", &timeout); err != nil {
+		return nil, err
+	}
+	if timeout != "" {
+		if cfg.Timeout, err = time.ParseDuration(timeout); err != nil {
+			return nil, fmt.Errorf("invalid timeout: %v", err)
+		}
+	}
+	if err := decodeAttr(list, "user", &cfg.User); err != nil {
+		return nil, err
+	}
+	if err := decodeAttr(list, "signal", &cfg.Signal); err != nil {
+		return nil, err
+	}
+
+	if o, err := block(list, "plugin_config"); err != nil {
+		return nil, err
+	} else if o != nil {
+		if err := hcl.DecodeObject(&cfg.PluginConfig, o.Val); err != nil {
+			return nil, fmt.Errorf("invalid plugin_config: %v", err)
+		}
+	}
+
+	if o, err := block(list, "task"); err != nil {
+		return nil, err
+	} else if o != nil {
+		if cfg.Task, err = parseTask(o, "config", "env"); err != nil {
+			return nil, fmt.Errorf("invalid task: %v", err)
+		}
+	}
+
+	if o, err := block(list, "exit_task"); err != nil {
+		return nil, err
+	} else if o != nil {
+		if cfg.ExitTask, err = parseTask(o, "config", "env", "exit_code"); err != nil {
+			return nil, fmt.Errorf("invalid exit_task: %v", err)
+		}
+		if err := decodeAttr(o.Val.(*ast.ObjectType).List, "exit_code", &cfg.ExitCode); err != nil {
+			return nil, fmt.Errorf("invalid exit_task: %v", err)
+		}
+	}
+
+	if o, err := block(list, "exec"); err != nil {
+		return nil, err
+	} else if o != nil {
+		if err := helper.CheckHCLKeys(o.Val, []string{"command", "output", "exit_code"}); err != nil {
+			return nil, fmt.Errorf("invalid exec: %v", err)
+		}
+		cfg.Exec = &ExecSpec{}
+		if err := hcl.DecodeObject(cfg.Exec, o.Val); err != nil {
+			return nil, fmt.Errorf("invalid exec: %v", err)
+		}
+		if len(cfg.Exec.Command) == 0 {
+			return nil, fmt.Errorf("invalid exec: missing command")
+		}
+	}
+
+	return cfg, nil
+}
+
+// parseTask parses a task block with the given valid keys.
+func parseTask(o *ast.ObjectItem, valid ...string) (*TaskSpec, error) {
+	if err := helper.CheckHCLKeys(o.Val, valid); err != nil {
+		return nil, err
+	}
+	list := o.Val.(*ast.ObjectType).List
+
+	spec := &TaskSpec{}
+	if c, err := block(list, "config"); err != nil {
+		return nil, err
+	} else if c != nil {
+		if err := hcl.DecodeObject(&spec.Config, c.Val); err != nil {
+			return nil, fmt.Errorf("invalid config: %v", err)
+		}
+	}
+
+	if e, err := block(list, "env"); err != nil {
+		return nil, err
+	} else if e != nil {
+		if err := hcl.DecodeObject(&spec.Env, e.Val); err != nil {
+			return nil, fmt.Errorf("invalid env: %v", err)
+		}
+	}
+
+	return spec, nil
+}
+
+// block returns the single block named name in list, or nil if there is
+// none.
+func block(list *ast.ObjectList, name string) (*ast.ObjectItem, error) {
+	items := list.Filter(name).Items
+	switch len(items) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, fmt.Errorf("only one %s block is allowed", name)
+	}
+
+	item := items[0]
+	if _, ok := item.Val.(*ast.ObjectType); !ok {
+		return nil, fmt.Errorf("%s should be a block", name)
+	}
+	return item, nil
+}
+
+// decodeAttr decodes the attribute named name in list into out, if it's set.
+func decodeAttr(list *ast.ObjectList, name string, out any) error {
+	items := list.Filter(name).Items
+	switch len(items) {
+	case 0:
+		return nil
+	case 1:
+	default:
+		return fmt.Errorf("%s is set more than once", name)
+	}
+
+	if err := hcl.DecodeObject(out, items[0].Val); err != nil {
+		return fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return nil
+}
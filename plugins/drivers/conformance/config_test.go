@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conformance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestParseConfig(t *testing.T) {
+	ci.Parallel(t)
+
+	src := `
+timeout = "10s"
+user    = "nobody"
+signal  = "SIGHUP"
+
+plugin_config {
+  enabled = true
+}
+
+task {
+  config {
+    command = "/bin/sleep"
+    args    = ["600"]
+  }
+  env {
+    FOO = "bar"
+  }
+}
+
+exit_task {
+  exit_code = 3
+  config {
+    command = "/bin/sh"
+    args    = ["-c", "exit 3"]
+  }
+}
+
+exec {
+  command = ["/bin/echo", "hello"]
+  output  = "hello"
+}
+`
+
+	cfg, err := ParseConfig([]byte(src))
+	must.NoError(t, err)
+
+	must.Eq(t, 10*time.Second, cfg.Timeout)
+	must.Eq(t, "nobody", cfg.User)
+	must.Eq(t, "SIGHUP", cfg.Signal)
+	must.Eq(t, map[string]interface{}{"enabled": true}, cfg.PluginConfig)
+
+	must.NotNil(t, cfg.Task)
+	must.Eq[any](t, "/bin/sleep", cfg.Task.Config["command"])
+	must.Eq[any](t, []interface{}{"600"}, cfg.Task.Config["args"])
+	must.Eq(t, map[string]string{"FOO": "bar"}, cfg.Task.Env)
+
+	must.NotNil(t, cfg.ExitTask)
+	must.Eq[any](t, "/bin/sh", cfg.ExitTask.Config["command"])
+	must.Eq(t, 3, cfg.ExitCode)
+
+	must.Eq(t, &ExecSpec{
+		Command: []string{"/bin/echo", "hello"},
+		Output:  "hello",
+	}, cfg.Exec)
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name string
+		src  string
+		err  string
+	}{
+		{
+			name: "unknown key",
+			src:  `tasks {}`,
+			err:  "invalid key: tasks",
+		},
+		{
+			name: "bad timeout",
+			src:  `timeout = "soon"`,
+			err:  "invalid timeout",
+		},
+		{
+			name: "repeated task",
+			src:  "task {}\ntask {}",
+			err:  "only one task block is allowed",
+		},
+		{
+			name: "unknown task key",
+			src:  `task { exit_code = 1 }`,
+			err:  "invalid key: exit_code",
+		},
+		{
+			name: "exec without command",
+			src:  `exec { output = "hello" }`,
+			err:  "missing command",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseConfig([]byte(tc.src))
+			must.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package conformance implements a suite of scenarios that exercise a task
+// driver plugin the way the Nomad client does. It's used by the
+// "nomad plugin test-driver" command to check third party drivers and by the
+// driver tests of this repository through testutils.RunConformance.
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+const (
+	// DefaultTimeout is the time a scenario waits for the driver to reach
+	// an expected state when Config.Timeout isn't set.
+	DefaultTimeout = 30 * time.Second
+
+	// DefaultSignal is the signal sent by the signal scenario when
+	// Config.Signal isn't set. SIGCONT is harmless to most processes.
+	DefaultSignal = "SIGCONT"
+)
+
+// Status is the outcome of a scenario.
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusFail Status = "fail"
+	StatusSkip Status = "skip"
+)
+
+// Config is the configuration of a conformance run.
+type Config struct {
+	// Launch starts an instance of the driver plugin. It's called once when
+	// the run starts and again by the recover scenario to simulate a Nomad
+	// client restart.
+	Launch LaunchFunc
+
+	// PluginConfig is the plugin block of the driver, decoded against the
+	// schema returned by its ConfigSchema RPC.
+	PluginConfig map[string]interface{}
+
+	// Task is the task started by the scenarios that need a running task. Its
+	// config must describe a task that keeps running until it's stopped.
+	Task *TaskSpec
+
+	// ExitTask is the task started by the exit_code scenario. Its config
+	// must describe a task that exits on its own with ExitCode.
+	ExitTask *TaskSpec
+
+	// ExitCode is the exit code expected from ExitTask.
+	ExitCode int
+
+	// Exec is the command run in Task by the exec scenario.
+	Exec *ExecSpec
+
+	// Chroot maps host paths to the paths they're copied to in the task
+	// directory of drivers with chroot isolation, like the client's chroot_env.
+	Chroot map[string]string
+
+	// User is the user tasks are started as.
+	User string
+
+	// Signal is the signal sent to Task by the signal scenario.
+	Signal string
+
+	// Timeout bounds each wait of a scenario.
+	Timeout time.Duration
+
+	// Filter, if set, only runs the scenarios whose name matches it.
+	Filter *regexp.Regexp
+
+	// Logger receives the logs of the suite and of the plugin.
+	Logger hclog.Logger
+}
+
+// TaskSpec describes a task started by the suite.
+type TaskSpec struct {
+	// Config is the task's config block, decoded against the schema returned
+	// by the driver's TaskConfigSchema RPC.
+	Config map[string]interface{}
+
+	// Env is added to the environment of the task.
+	Env map[string]string
+}
+
+// ExecSpec describes a command run in a task.
+type ExecSpec struct {
+	Command []string `hcl:"command"`
+
+	// Output, if set, must be contained in the stdout of the command.
+	Output string `hcl:"output"`
+
+	// ExitCode is the exit code expected from the command.
+	ExitCode int `hcl:"exit_code"`
+}
+
+func (c *Config) canonicalize() {
+	if c.Timeout == 0 {
+		c.Timeout = DefaultTimeout
+	}
+	if c.Signal == "" {
+		c.Signal = DefaultSignal
+	}
+	if c.Logger == nil {
+		c.Logger = hclog.NewNullLogger()
+	}
+}
+
+// Result is the outcome of a single scenario.
+type Result struct {
+	Name     string
+	Status   Status
+	Message  string
+	Duration time.Duration
+}
+
+// Report is the outcome of a conformance run.
+type Report struct {
+	// Driver is the name the driver reports in its plugin info.
+	Driver string
+
+	// Capabilities are the capabilities advertised by the driver, or nil if
+	// it couldn't be launched.
+	Capabilities *drivers.Capabilities
+
+	Results []*Result
+}
+
+// Failed returns true if any scenario of the run failed.
+func (r *Report) Failed() bool {
+	for _, res := range r.Results {
+		if res.Status == StatusFail {
+			return true
+		}
+	}
+	return false
+}
+
+// errSkip is returned by a scenario that doesn't apply to the driver.
+type errSkip struct {
+	reason string
+}
+
+func (e *errSkip) Error() string { return e.reason }
+
+func skipf(format string, args ...any) error {
+	return &errSkip{reason: fmt.Sprintf(format, args...)}
+}
+
+// capabilityMismatch is returned when the driver advertises a capability
+// but doesn't implement the RPCs backing it.
+func capabilityMismatch(capability string, err error) error {
+	return fmt.Errorf("capability mismatch: driver advertises %s but the RPC failed: %v", capability, err)
+}
+
+// Scenarios returns the names of the scenarios of the suite, in the order
+// they run.
+func Scenarios() []string {
+	names := make([]string, 0, len(scenarios))
+	for _, s := range scenarios {
+		names = append(names, s.name)
+	}
+	return names
+}
+
+// Run launches the driver of the config and runs every scenario against it.
+// Errors are reported in the results rather than returned, so that a single
+// run reports every problem of the driver.
+func Run(cfg *Config) *Report {
+	cfg.canonicalize()
+	report := &Report{}
+
+	if cfg.Launch == nil {
+		report.Results = append(report.Results, &Result{
+			Name:    "launch",
+			Status:  StatusFail,
+			Message: "no launcher configured",
+		})
+		return report
+	}
+
+	s := &suite{
+		cfg:    cfg,
+		logger: cfg.Logger.Named("conformance"),
+	}
+	defer s.shutdown()
+
+	start := time.Now()
+	if err := s.launch(); err != nil {
+		report.Results = append(report.Results, &Result{
+			Name:     "launch",
+			Status:   StatusFail,
+			Message:  err.Error(),
+			Duration: time.Since(start),
+		})
+		return report
+	}
+	report.Driver = s.name
+
+	var healthy bool
+	for _, sc := range scenarios {
+		if cfg.Filter != nil && sc.name != "fingerprint" && !cfg.Filter.MatchString(sc.name) {
+			continue
+		}
+
+		res := &Result{Name: sc.name}
+		start := time.Now()
+
+		var err error
+		switch {
+		case sc.name != "fingerprint" && !healthy:
+			err = skipf("driver isn't healthy")
+		case s.driver == nil:
+			err = skipf("driver couldn't be restarted")
+		case sc.needsTask && cfg.Task == nil:
+			err = skipf("no task configured")
+		default:
+			err = sc.run(s)
+			s.cleanupTasks()
+		}
+
+		res.Duration = time.Since(start)
+
+		var skip *errSkip
+		switch {
+		case err == nil:
+			res.Status = StatusPass
+		case errors.As(err, &skip):
+			res.Status = StatusSkip
+			res.Message = skip.reason
+		default:
+			res.Status = StatusFail
+			res.Message = err.Error()
+		}
+
+		if sc.name == "fingerprint" {
+			healthy = res.Status == StatusPass
+			report.Capabilities = s.caps
+		}
+
+		s.logger.Debug("scenario complete", "scenario", sc.name, "status", res.Status, "message", res.Message)
+		report.Results = append(report.Results, res)
+	}
+
+	return report
+}
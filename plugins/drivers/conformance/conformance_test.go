@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conformance_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/drivers/mock"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/hashicorp/nomad/plugins/drivers/conformance"
+	dtestutil "github.com/hashicorp/nomad/plugins/drivers/testutils"
+	"github.com/shoenig/test/must"
+)
+
+// noPauseDriver advertises the capabilities of the mock driver but only
+// implements the RPCs of the DriverPlugin interface.
+type noPauseDriver struct {
+	drivers.DriverPlugin
+}
+
+func TestRun_CapabilityMismatch(t *testing.T) {
+	ci.Parallel(t)
+
+	report := conformance.Run(&conformance.Config{
+		Launch: dtestutil.InProcessLauncher(t, func(ctx context.Context, logger hclog.Logger) drivers.DriverPlugin {
+			return &noPauseDriver{mock.NewMockDriver(ctx, logger)}
+		}),
+		Task: &conformance.TaskSpec{
+			Config: map[string]interface{}{"run_for": "60s"},
+		},
+		Filter: regexp.MustCompile("^pause$"),
+		Logger: testlog.HCLogger(t),
+	})
+
+	must.True(t, report.Failed())
+	must.True(t, report.Capabilities.Pause)
+	must.Len(t, 2, report.Results)
+
+	must.Eq(t, "fingerprint", report.Results[0].Name)
+	must.Eq(t, conformance.StatusPass, report.Results[0].Status)
+
+	must.Eq(t, "pause", report.Results[1].Name)
+	must.Eq(t, conformance.StatusFail, report.Results[1].Status)
+	must.StrContains(t, report.Results[1].Message, "capability mismatch")
+}
+
+func TestRun_NoTask(t *testing.T) {
+	ci.Parallel(t)
+
+	report := conformance.Run(&conformance.Config{
+		Launch: dtestutil.InProcessLauncher(t, mock.NewMockDriver),
+		Logger: testlog.HCLogger(t),
+	})
+
+	must.False(t, report.Failed())
+	must.Len(t, len(conformance.Scenarios()), report.Results)
+	must.Eq(t, conformance.StatusPass, report.Results[0].Status)
+	for _, res := range report.Results[1:] {
+		must.Eq(t, conformance.StatusSkip, res.Status, must.Sprint(res.Name))
+	}
+}
+
+func TestRun_LaunchError(t *testing.T) {
+	ci.Parallel(t)
+
+	report := conformance.Run(&conformance.Config{
+		Launch: conformance.BinaryLauncher("/does/not/exist"),
+		Logger: testlog.HCLogger(t),
+	})
+
+	must.True(t, report.Failed())
+	must.Len(t, 1, report.Results)
+	must.Eq(t, "launch", report.Results[0].Name)
+	must.Nil(t, report.Capabilities)
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+
+	hclog "github.com/hashicorp/go-hclog"
+	multierror "github.com/hashicorp/go-multierror"
+	plugin "github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/hcl/v2/hcldec"
+	"github.com/hashicorp/nomad/client/lib/numalib"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclspecutils"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/hashicorp/nomad/plugins/shared/hclspec"
+	"github.com/zclconf/go-cty/cty/msgpack"
+)
+
+const (
+	// clientMinPort and clientMaxPort are the default dynamic port range of
+	// the Nomad client, passed to the driver in its agent config.
+	clientMinPort = 14000
+	clientMaxPort = 14512
+)
+
+// LaunchFunc starts an instance of a driver plugin. It returns a client of
+// the plugin and a function that kills it.
+type LaunchFunc func(logger hclog.Logger) (drivers.DriverPlugin, func(), error)
+
+// BinaryLauncher returns a LaunchFunc that runs the driver plugin binary at
+// path, the same way the Nomad client launches external plugins.
+func BinaryLauncher(path string, args ...string) LaunchFunc {
+	return func(logger hclog.Logger) (drivers.DriverPlugin, func(), error) {
+		client := plugin.NewClient(&plugin.ClientConfig{
+			HandshakeConfig: base.Handshake,
+			Plugins: map[string]plugin.Plugin{
+				base.PluginTypeBase:   &base.PluginBase{},
+				base.PluginTypeDriver: drivers.NewDriverPlugin(nil, logger),
+			},
+			Cmd:              exec.Command(path, args...),
+			AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+			Logger:           logger,
+		})
+
+		rpcClient, err := client.Client()
+		if err != nil {
+			client.Kill()
+			return nil, nil, fmt.Errorf("failed to start plugin: %v", err)
+		}
+
+		raw, err := rpcClient.Dispense(base.PluginTypeDriver)
+		if err != nil {
+			client.Kill()
+			return nil, nil, fmt.Errorf("failed to dispense driver: %v", err)
+		}
+
+		driver, ok := raw.(drivers.DriverPlugin)
+		if !ok {
+			client.Kill()
+			return nil, nil, fmt.Errorf("plugin doesn't implement the driver interface")
+		}
+
+		return driver, client.Kill, nil
+	}
+}
+
+// launch starts a new instance of the driver and configures it. Any instance
+// started before is killed first.
+func (s *suite) launch() error {
+	s.kill()
+
+	driver, kill, err := s.cfg.Launch(s.logger.ResetNamed("plugin"))
+	if err != nil {
+		return err
+	}
+	s.driver = driver
+	s.killFn = kill
+
+	info, err := driver.PluginInfo()
+	if err != nil {
+		return fmt.Errorf("failed to get plugin info: %v", err)
+	}
+	if info.Type != base.PluginTypeDriver {
+		return fmt.Errorf("plugin is a %q plugin, not a driver", info.Type)
+	}
+	if !slices.Contains(info.PluginApiVersions, drivers.ApiVersion010) {
+		return fmt.Errorf("plugin API versions %v aren't supported, expected %s",
+			info.PluginApiVersions, drivers.ApiVersion010)
+	}
+	s.name = info.Name
+
+	if err := s.setConfig(); err != nil {
+		return err
+	}
+
+	if s.taskSpec == nil {
+		schema, err := driver.TaskConfigSchema()
+		if err != nil {
+			return fmt.Errorf("failed to get task config schema: %v", err)
+		}
+		if s.taskSpec, err = convertSpec(schema); err != nil {
+			return fmt.Errorf("failed to convert task config schema: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// setConfig sends the plugin config to the driver with the agent config of a
+// default Nomad client.
+func (s *suite) setConfig() error {
+	schema, err := s.driver.ConfigSchema()
+	if err != nil {
+		return fmt.Errorf("failed to get config schema: %v", err)
+	}
+
+	var cdata []byte
+	if schema != nil {
+		spec, err := convertSpec(schema)
+		if err != nil {
+			return fmt.Errorf("failed to convert config schema: %v", err)
+		}
+
+		config := s.cfg.PluginConfig
+		if config == nil {
+			config = map[string]interface{}{}
+		}
+
+		val, diag, diagErrs := hclutils.ParseHclInterface(config, spec, nil)
+		if diag.HasErrors() {
+			return multierror.Append(errors.New("failed to parse plugin config"), diagErrs...)
+		}
+
+		cdata, err = msgpack.Marshal(val, val.Type())
+		if err != nil {
+			return fmt.Errorf("failed to encode plugin config: %v", err)
+		}
+	} else if len(s.cfg.PluginConfig) != 0 {
+		return errors.New("plugin config set but driver doesn't accept a config")
+	}
+
+	err = s.driver.SetConfig(&base.Config{
+		PluginConfig: cdata,
+		AgentConfig: &base.AgentConfig{
+			Driver: &base.ClientDriverConfig{
+				ClientMinPort: clientMinPort,
+				ClientMaxPort: clientMaxPort,
+				Topology:      numalib.Scan(numalib.PlatformScanners(false)),
+			},
+		},
+		ApiVersion: drivers.ApiVersion010,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set plugin config: %v", err)
+	}
+	return nil
+}
+
+// kill stops the running instance of the driver, if any.
+func (s *suite) kill() {
+	if s.killFn != nil {
+		s.killFn()
+		s.killFn = nil
+	}
+	s.driver = nil
+}
+
+func convertSpec(schema *hclspec.Spec) (hcldec.Spec, error) {
+	spec, diag := hclspecutils.Convert(schema)
+	if diag.HasErrors() {
+		return nil, multierror.Append(errors.New("invalid schema"), diag.Errs()...)
+	}
+	return spec, nil
+}
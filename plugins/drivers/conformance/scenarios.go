@@ -0,0 +1,442 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/nomad/plugins/drivers"
+	dproto "github.com/hashicorp/nomad/plugins/drivers/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// taskName is the name of the tasks started by the scenarios.
+const taskName = "conformance"
+
+// scenario is a check of the suite. Scenarios start their own tasks, which
+// are destroyed once they return.
+type scenario struct {
+	name string
+
+	// needsTask is set if the scenario runs Config.Task
+	needsTask bool
+
+	run func(*suite) error
+}
+
+// scenarios are run in order. The fingerprint scenario must be first, as
+// every other scenario is skipped if the driver isn't healthy.
+var scenarios = []scenario{
+	{name: "fingerprint", run: (*suite).fingerprint},
+	{name: "start_stop", needsTask: true, run: (*suite).startStop},
+	{name: "exit_code", run: (*suite).exitCode},
+	{name: "recover", needsTask: true, run: (*suite).recover},
+	{name: "signal", needsTask: true, run: (*suite).signal},
+	{name: "exec", needsTask: true, run: (*suite).exec},
+	{name: "stats", needsTask: true, run: (*suite).stats},
+	{name: "update_resources", needsTask: true, run: (*suite).updateResources},
+	{name: "pause", needsTask: true, run: (*suite).pause},
+}
+
+// fingerprint checks that the driver reports its capabilities and becomes
+// healthy.
+func (s *suite) fingerprint() error {
+	caps, err := s.driver.Capabilities()
+	if err != nil {
+		return fmt.Errorf("failed to get capabilities: %v", err)
+	}
+	if caps == nil {
+		return errors.New("driver returned no capabilities")
+	}
+	s.caps = caps
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+
+	ch, err := s.driver.Fingerprint(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fingerprint: %v", err)
+	}
+
+	var last *drivers.Fingerprint
+	for {
+		select {
+		case fp, ok := <-ch:
+			if !ok {
+				return errors.New("fingerprint stream closed before the driver was healthy")
+			}
+			if fp.Err != nil {
+				return fmt.Errorf("fingerprint failed: %v", fp.Err)
+			}
+			if fp.Health == drivers.HealthStateHealthy {
+				return nil
+			}
+			last = fp
+		case <-ctx.Done():
+			if last != nil {
+				return fmt.Errorf("driver isn't healthy: %s: %s", last.Health, last.HealthDescription)
+			}
+			return errors.New("timed out waiting for a fingerprint")
+		}
+	}
+}
+
+// startStop checks the lifecycle of a task: it runs until it's stopped, its
+// exit is reported by WaitTask and it's forgotten once destroyed.
+func (s *suite) startStop() error {
+	t, err := s.startTask(s.cfg.Task, taskName)
+	if err != nil {
+		return err
+	}
+
+	if err := s.waitRunning(t); err != nil {
+		return err
+	}
+
+	// The task must not be destroyed while it's running
+	if err := s.driver.DestroyTask(t.cfg.ID, false); err == nil {
+		return errors.New("DestroyTask succeeded for a running task without force")
+	}
+
+	return s.stopTask(t)
+}
+
+// exitCode checks that a task exiting on its own reports its exit code.
+func (s *suite) exitCode() error {
+	if s.cfg.ExitTask == nil {
+		return skipf("no exit task configured")
+	}
+
+	t, err := s.startTask(s.cfg.ExitTask, taskName)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := s.driver.WaitTask(ctx, t.cfg.ID)
+	if err != nil {
+		return fmt.Errorf("failed to wait for task: %v", err)
+	}
+
+	res, err := s.waitExit(ch)
+	if err != nil {
+		return err
+	}
+	if res.Err != nil {
+		return fmt.Errorf("task exited with an error: %v", res.Err)
+	}
+	if res.ExitCode != s.cfg.ExitCode {
+		return fmt.Errorf("expected exit code %d, got %d", s.cfg.ExitCode, res.ExitCode)
+	}
+
+	status, err := s.driver.InspectTask(t.cfg.ID)
+	if err != nil {
+		return fmt.Errorf("failed to inspect exited task: %v", err)
+	}
+	if status.State != drivers.TaskStateExited {
+		return fmt.Errorf("expected exited task to be %q, got %q", drivers.TaskStateExited, status.State)
+	}
+
+	return s.destroyTask(t)
+}
+
+// recover checks that a running task survives a restart of the plugin and
+// is recovered from the handle returned by StartTask, as the Nomad client
+// does after it restarts.
+func (s *suite) recover() error {
+	t, err := s.startTask(s.cfg.Task, taskName)
+	if err != nil {
+		return err
+	}
+
+	if err := s.waitRunning(t); err != nil {
+		return err
+	}
+
+	handle := t.handle.Copy()
+	if err := s.launch(); err != nil {
+		return fmt.Errorf("failed to restart plugin: %v", err)
+	}
+
+	if err := s.driver.RecoverTask(handle); err != nil {
+		return fmt.Errorf("failed to recover task: %v", err)
+	}
+
+	status, err := s.driver.InspectTask(t.cfg.ID)
+	if err != nil {
+		return fmt.Errorf("failed to inspect recovered task: %v", err)
+	}
+	if status.State != drivers.TaskStateRunning {
+		return fmt.Errorf("expected recovered task to be %q, got %q", drivers.TaskStateRunning, status.State)
+	}
+
+	return s.stopTask(t)
+}
+
+// signal checks that a running task can be signaled.
+func (s *suite) signal() error {
+	if !s.caps.SendSignals {
+		return skipf("driver doesn't advertise the SendSignals capability")
+	}
+
+	t, err := s.startTask(s.cfg.Task, taskName)
+	if err != nil {
+		return err
+	}
+
+	if err := s.waitRunning(t); err != nil {
+		return err
+	}
+
+	if err := s.driver.SignalTask(t.cfg.ID, s.cfg.Signal); err != nil {
+		if isNotSupported(err) {
+			return capabilityMismatch("SendSignals", err)
+		}
+		return fmt.Errorf("failed to signal task: %v", err)
+	}
+
+	if err := s.waitRunning(t); err != nil {
+		return err
+	}
+	return s.stopTask(t)
+}
+
+// exec checks that a command can be run in a task with ExecTaskStreaming.
+func (s *suite) exec() error {
+	if !s.caps.Exec {
+		return skipf("driver doesn't advertise the Exec capability")
+	}
+	if s.cfg.Exec == nil {
+		return skipf("no exec command configured")
+	}
+
+	execer, ok := s.driver.(drivers.ExecTaskStreamingRawDriver)
+	if !ok {
+		return errors.New("driver client doesn't support ExecTaskStreaming")
+	}
+
+	t, err := s.startTask(s.cfg.Task, taskName)
+	if err != nil {
+		return err
+	}
+
+	if err := s.waitRunning(t); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+
+	stream := newExecStream()
+	err = execer.ExecTaskStreamingRaw(ctx, t.cfg.ID, s.cfg.Exec.Command, false, stream)
+	if err != nil {
+		if isNotSupported(err) {
+			return capabilityMismatch("Exec", err)
+		}
+		return fmt.Errorf("failed to exec in task: %v", err)
+	}
+
+	stdout, exited, code := stream.result()
+	if !exited {
+		return errors.New("exec stream ended without an exit result")
+	}
+	if code != s.cfg.Exec.ExitCode {
+		return fmt.Errorf("expected exec exit code %d, got %d", s.cfg.Exec.ExitCode, code)
+	}
+	if !strings.Contains(stdout, s.cfg.Exec.Output) {
+		return fmt.Errorf("expected exec output to contain %q, got %q", s.cfg.Exec.Output, stdout)
+	}
+
+	return s.stopTask(t)
+}
+
+// stats checks that the driver streams the resource usage of a task.
+func (s *suite) stats() error {
+	t, err := s.startTask(s.cfg.Task, taskName)
+	if err != nil {
+		return err
+	}
+
+	if err := s.waitRunning(t); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+
+	ch, err := s.driver.TaskStats(ctx, t.cfg.ID, time.Second)
+	if err != nil {
+		if strings.Contains(err.Error(), drivers.DriverStatsNotImplemented.Error()) {
+			return skipf("driver doesn't implement TaskStats")
+		}
+		return fmt.Errorf("failed to get task stats: %v", err)
+	}
+
+	select {
+	case usage, ok := <-ch:
+		if !ok || usage == nil {
+			return errors.New("TaskStats stream closed without reporting usage")
+		}
+		if usage.ResourceUsage == nil {
+			return errors.New("TaskStats reported no resource usage")
+		}
+		if usage.Timestamp == 0 {
+			return errors.New("TaskStats reported usage without a timestamp")
+		}
+	case <-ctx.Done():
+		return errors.New("timed out waiting for task stats")
+	}
+
+	cancel()
+	return s.stopTask(t)
+}
+
+// updateResources checks that the resources of a running task can be
+// changed in place.
+func (s *suite) updateResources() error {
+	if !s.caps.UpdateResources {
+		return skipf("driver doesn't advertise the UpdateResources capability")
+	}
+
+	updater, ok := s.driver.(drivers.DriverTaskResourceUpdater)
+	if !ok {
+		return errors.New("driver client doesn't support UpdateTaskResources")
+	}
+
+	t, err := s.startTask(s.cfg.Task, taskName)
+	if err != nil {
+		return err
+	}
+
+	if err := s.waitRunning(t); err != nil {
+		return err
+	}
+
+	resources := t.cfg.Resources.Copy()
+	resources.NomadResources.Memory.MemoryMB *= 2
+	resources.LinuxResources.MemoryLimitBytes *= 2
+
+	if err := updater.UpdateTaskResources(t.cfg.ID, resources); err != nil {
+		if isNotSupported(err) {
+			return capabilityMismatch("UpdateResources", err)
+		}
+		return fmt.Errorf("failed to update task resources: %v", err)
+	}
+
+	if err := s.waitRunning(t); err != nil {
+		return err
+	}
+	return s.stopTask(t)
+}
+
+// pause checks that a running task can be paused and resumed.
+func (s *suite) pause() error {
+	if !s.caps.Pause {
+		return skipf("driver doesn't advertise the Pause capability")
+	}
+
+	pauser, ok := s.driver.(drivers.DriverPauser)
+	if !ok {
+		return errors.New("driver client doesn't support PauseTask")
+	}
+
+	t, err := s.startTask(s.cfg.Task, taskName)
+	if err != nil {
+		return err
+	}
+
+	if err := s.waitRunning(t); err != nil {
+		return err
+	}
+
+	if err := pauser.PauseTask(t.cfg.ID); err != nil {
+		if isNotSupported(err) {
+			return capabilityMismatch("Pause", err)
+		}
+		return fmt.Errorf("failed to pause task: %v", err)
+	}
+
+	if err := pauser.ResumeTask(t.cfg.ID); err != nil {
+		if isNotSupported(err) {
+			return capabilityMismatch("Pause", err)
+		}
+		return fmt.Errorf("failed to resume task: %v", err)
+	}
+
+	if err := s.waitRunning(t); err != nil {
+		return err
+	}
+	return s.stopTask(t)
+}
+
+// isNotSupported returns true if err is returned for an RPC the driver
+// doesn't implement.
+func isNotSupported(err error) bool {
+	if status.Code(err) == codes.Unimplemented {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "not supported") || strings.Contains(msg, "does not support")
+}
+
+var _ drivers.ExecTaskStream = (*execStream)(nil)
+
+// execStream is the stream of an exec scenario. It closes stdin right away
+// and collects the output of the command.
+type execStream struct {
+	input []*drivers.ExecTaskStreamingRequestMsg
+
+	lock     sync.Mutex
+	stdout   bytes.Buffer
+	exited   bool
+	exitCode int
+}
+
+func newExecStream() *execStream {
+	return &execStream{
+		input: []*drivers.ExecTaskStreamingRequestMsg{{
+			Stdin: &dproto.ExecTaskStreamingIOOperation{Close: true},
+		}},
+	}
+}
+
+func (s *execStream) Recv() (*drivers.ExecTaskStreamingRequestMsg, error) {
+	if len(s.input) == 0 {
+		return nil, io.EOF
+	}
+
+	msg := s.input[0]
+	s.input = s.input[1:]
+	return msg, nil
+}
+
+func (s *execStream) Send(m *drivers.ExecTaskStreamingResponseMsg) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch {
+	case m.Stdout != nil && m.Stdout.Data != nil:
+		s.stdout.Write(m.Stdout.Data)
+	case m.Exited && m.Result != nil:
+		s.exited = true
+		s.exitCode = int(m.Result.ExitCode)
+	}
+	return nil
+}
+
+func (s *execStream) result() (string, bool, int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.stdout.String(), s.exited, s.exitCode
+}
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hcl/v2/hcldec"
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/hashicorp/nomad/client/lib/cgroupslib"
+	"github.com/hashicorp/nomad/client/logmon"
+	"github.com/hashicorp/nomad/client/taskenv"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/hashicorp/nomad/plugins/drivers/fsisolation"
+)
+
+const (
+	// jobName and groupName are the names of the fake job and group
+	// conformance tasks belong to.
+	jobName   = "conformance"
+	groupName = "conformance"
+
+	// taskCPU and taskMemoryMB are the resources of conformance tasks.
+	taskCPU      = 100
+	taskMemoryMB = 256
+)
+
+// suite is the state of a conformance run.
+type suite struct {
+	cfg    *Config
+	logger hclog.Logger
+
+	// driver is the running instance of the plugin and killFn stops it
+	driver drivers.DriverPlugin
+	killFn func()
+
+	// name is the name the driver reports in its plugin info
+	name string
+
+	// caps are the capabilities of the driver, set by the fingerprint
+	// scenario
+	caps *drivers.Capabilities
+
+	// taskSpec is the decoder of task configs
+	taskSpec hcldec.Spec
+
+	// tasks are the tasks started by the running scenario
+	tasks []*task
+}
+
+// task is a task started by the suite.
+type task struct {
+	cfg    *drivers.TaskConfig
+	handle *drivers.TaskHandle
+
+	// cleanup is called in reverse order once the task is destroyed
+	cleanup []func()
+}
+
+// startTask builds the alloc directory, log fifos, environment and
+// resources the Nomad client would provide and starts a task of spec.
+func (s *suite) startTask(spec *TaskSpec, name string) (*task, error) {
+	allocID := uuid.Generate()
+	cfg := &drivers.TaskConfig{
+		ID:            fmt.Sprintf("%s/%s/%s", allocID, name, uuid.Short()),
+		AllocID:       allocID,
+		Name:          name,
+		JobName:       jobName,
+		JobID:         jobName,
+		TaskGroupName: groupName,
+		Namespace:     structs.DefaultNamespace,
+		User:          s.cfg.User,
+		Resources: &drivers.Resources{
+			NomadResources: &structs.AllocatedTaskResources{
+				Cpu:    structs.AllocatedCpuResources{CpuShares: taskCPU},
+				Memory: structs.AllocatedMemoryResources{MemoryMB: taskMemoryMB},
+			},
+			LinuxResources: &drivers.LinuxResources{
+				CPUShares:        taskCPU,
+				MemoryLimitBytes: taskMemoryMB * 1024 * 1024,
+				CpusetCgroupPath: cgroupslib.LinuxResourcesPath(allocID, name, false),
+			},
+			Ports: &structs.AllocatedPorts{},
+		},
+	}
+
+	t := &task{cfg: cfg}
+	s.tasks = append(s.tasks, t)
+
+	if err := s.buildTaskDir(t); err != nil {
+		return nil, err
+	}
+
+	for k, v := range spec.Env {
+		cfg.Env[k] = v
+	}
+
+	config := spec.Config
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+	val, diag, diagErrs := hclutils.ParseHclInterface(config, s.taskSpec, nil)
+	if diag.HasErrors() {
+		return nil, multierror.Append(errors.New("failed to parse task config"), diagErrs...)
+	}
+	if err := cfg.EncodeDriverConfig(val); err != nil {
+		return nil, fmt.Errorf("failed to encode task config: %v", err)
+	}
+
+	cleanupCgroup, err := makeTaskCgroup(allocID, name)
+	if err != nil {
+		// Creating cgroups usually requires root, and drivers that don't
+		// manage resources run fine without them.
+		s.logger.Warn("failed to create task cgroup", "error", err)
+	} else {
+		t.cleanup = append(t.cleanup, cleanupCgroup)
+	}
+
+	handle, _, err := s.driver.StartTask(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start task: %v", err)
+	}
+	if handle == nil {
+		return nil, errors.New("StartTask returned no task handle")
+	}
+	if handle.Config == nil || handle.Config.ID != cfg.ID {
+		return nil, errors.New("StartTask returned a handle for another task")
+	}
+	t.handle = handle
+
+	return t, nil
+}
+
+// buildTaskDir creates the alloc and task directories and the log fifos of
+// the task, and sets its environment.
+func (s *suite) buildTaskDir(t *task) error {
+	cfg := t.cfg
+
+	dir, err := os.MkdirTemp("", "nomad-conformance-")
+	if err != nil {
+		return fmt.Errorf("failed to create alloc dir: %v", err)
+	}
+	t.cleanup = append(t.cleanup, func() { os.RemoveAll(dir) })
+
+	mountsDir, err := os.MkdirTemp("", "nomad-conformance-mounts-")
+	if err != nil {
+		return fmt.Errorf("failed to create mounts dir: %v", err)
+	}
+	t.cleanup = append(t.cleanup, func() { os.RemoveAll(mountsDir) })
+	if err := os.Chmod(mountsDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create mounts dir: %v", err)
+	}
+
+	allocDir := allocdir.NewAllocDir(s.logger, dir, mountsDir, cfg.AllocID)
+	if err := allocDir.Build(); err != nil {
+		return fmt.Errorf("failed to build alloc dir: %v", err)
+	}
+	t.cleanup = append(t.cleanup, func() { allocDir.Destroy() })
+	cfg.AllocDir = allocDir.AllocDir
+
+	fsi := s.caps.FSIsolation
+	taskDir := allocDir.NewTaskDir(&structs.Task{Name: cfg.Name})
+	if err := taskDir.Build(fsi, s.cfg.Chroot, cfg.User); err != nil {
+		return fmt.Errorf("failed to build task dir: %v", err)
+	}
+
+	cfg.Env = taskEnv(cfg, fsi, taskDir)
+
+	if runtime.GOOS == "windows" {
+		id := uuid.Short()
+		cfg.StdoutPath = fmt.Sprintf("//./pipe/%s-%s.stdout", cfg.Name, id)
+		cfg.StderrPath = fmt.Sprintf("//./pipe/%s-%s.stderr", cfg.Name, id)
+	} else {
+		cfg.StdoutPath = filepath.Join(taskDir.LogDir, fmt.Sprintf(".%s.stdout.fifo", cfg.Name))
+		cfg.StderrPath = filepath.Join(taskDir.LogDir, fmt.Sprintf(".%s.stderr.fifo", cfg.Name))
+	}
+
+	lm := logmon.NewLogMon(s.logger.Named("logmon"))
+	err = lm.Start(&logmon.LogConfig{
+		LogDir:        taskDir.LogDir,
+		StdoutLogFile: fmt.Sprintf("%s.stdout", cfg.Name),
+		StderrLogFile: fmt.Sprintf("%s.stderr", cfg.Name),
+		StdoutFifo:    cfg.StdoutPath,
+		StderrFifo:    cfg.StderrPath,
+		MaxFiles:      1,
+		MaxFileSizeMB: 10,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start logmon: %v", err)
+	}
+	t.cleanup = append(t.cleanup, func() { lm.Stop() })
+
+	return nil
+}
+
+// taskEnv returns the environment the Nomad client gives to a task, with the
+// task directories as seen by the task for the filesystem isolation mode.
+func taskEnv(cfg *drivers.TaskConfig, fsi fsisolation.Mode, taskDir *allocdir.TaskDir) map[string]string {
+	env := map[string]string{}
+
+	// Non-image drivers inherit the environment of the client
+	if fsi != fsisolation.Image {
+		for _, kv := range os.Environ() {
+			if k, v, ok := strings.Cut(kv, "="); ok {
+				env[k] = v
+			}
+		}
+	}
+
+	switch fsi {
+	case fsisolation.Unveil:
+		env[taskenv.AllocDir] = taskDir.MountsAllocDir
+		env[taskenv.TaskLocalDir] = filepath.Join(taskDir.MountsTaskDir, "local")
+		env[taskenv.SecretsDir] = taskDir.MountsSecretsDir
+	case fsisolation.None:
+		env[taskenv.AllocDir] = taskDir.SharedAllocDir
+		env[taskenv.TaskLocalDir] = taskDir.LocalDir
+		env[taskenv.SecretsDir] = taskDir.SecretsDir
+	default:
+		env[taskenv.AllocDir] = allocdir.SharedAllocContainerPath
+		env[taskenv.TaskLocalDir] = allocdir.TaskLocalContainerPath
+		env[taskenv.SecretsDir] = allocdir.TaskSecretsContainerPath
+	}
+
+	env[taskenv.AllocID] = cfg.AllocID
+	env[taskenv.ShortAllocID] = cfg.AllocID[:8]
+	env[taskenv.AllocName] = fmt.Sprintf("%s.%s[0]", cfg.JobName, cfg.TaskGroupName)
+	env[taskenv.AllocIndex] = "0"
+	env[taskenv.TaskName] = cfg.Name
+	env[taskenv.GroupName] = cfg.TaskGroupName
+	env[taskenv.JobID] = cfg.JobID
+	env[taskenv.JobName] = cfg.JobName
+	env[taskenv.Namespace] = cfg.Namespace
+	env[taskenv.CpuLimit] = fmt.Sprint(taskCPU)
+	env[taskenv.MemLimit] = fmt.Sprint(taskMemoryMB)
+
+	return env
+}
+
+// waitRunning blocks until the driver reports the task as running.
+func (s *suite) waitRunning(t *task) error {
+	deadline := time.Now().Add(s.cfg.Timeout)
+	for {
+		status, err := s.driver.InspectTask(t.cfg.ID)
+		if err != nil {
+			return fmt.Errorf("failed to inspect task: %v", err)
+		}
+		if status.State == drivers.TaskStateRunning {
+			return nil
+		}
+		if status.State == drivers.TaskStateExited {
+			return fmt.Errorf("task exited before it was stopped: %v", status.ExitResult)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("task never transitioned to running, currently %q", status.State)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// waitExit waits for the exit result of the task on the channel returned by
+// WaitTask.
+func (s *suite) waitExit(ch <-chan *drivers.ExitResult) (*drivers.ExitResult, error) {
+	select {
+	case res, ok := <-ch:
+		if !ok || res == nil {
+			return nil, errors.New("WaitTask channel closed without an exit result")
+		}
+		return res, nil
+	case <-time.After(s.cfg.Timeout):
+		return nil, errors.New("timed out waiting for the task to exit")
+	}
+}
+
+// stopTask stops the task the same way the Nomad client does, and checks
+// that its exit is reported by WaitTask and that it can then be destroyed.
+func (s *suite) stopTask(t *task) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := s.driver.WaitTask(ctx, t.cfg.ID)
+	if err != nil {
+		return fmt.Errorf("failed to wait for task: %v", err)
+	}
+
+	if err := s.driver.StopTask(t.cfg.ID, s.cfg.Timeout, ""); err != nil {
+		return fmt.Errorf("failed to stop task: %v", err)
+	}
+
+	if _, err := s.waitExit(ch); err != nil {
+		return err
+	}
+
+	return s.destroyTask(t)
+}
+
+// destroyTask destroys a task that exited and checks that the driver forgot
+// about it.
+func (s *suite) destroyTask(t *task) error {
+	if err := s.driver.DestroyTask(t.cfg.ID, false); err != nil {
+		return fmt.Errorf("failed to destroy exited task: %v", err)
+	}
+
+	if _, err := s.driver.InspectTask(t.cfg.ID); err == nil {
+		return errors.New("InspectTask succeeded for a destroyed task")
+	}
+	return nil
+}
+
+// cleanupTasks destroys the tasks started by a scenario and removes their
+// directories.
+func (s *suite) cleanupTasks() {
+	for _, t := range s.tasks {
+		if s.driver != nil {
+			_ = s.driver.DestroyTask(t.cfg.ID, true)
+		}
+		for i := len(t.cleanup) - 1; i >= 0; i-- {
+			t.cleanup[i]()
+		}
+	}
+	s.tasks = nil
+}
+
+// shutdown cleans up after the run.
+func (s *suite) shutdown() {
+	s.cleanupTasks()
+	s.kill()
+}
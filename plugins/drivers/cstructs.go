@@ -20,6 +20,9 @@ type CpuStats = cstructs.CpuStats
 // ResourceUsage holds information related to cpu and memory stats
 type ResourceUsage = cstructs.ResourceUsage
 
+// ProcessInfo identifies a process in the process tree of a task
+type ProcessInfo = cstructs.ProcessInfo
+
 // TaskResourceUsage holds aggregated resource usage of all processes in a Task
 // and the resource usage of the individual pids
 type TaskResourceUsage = cstructs.TaskResourceUsage
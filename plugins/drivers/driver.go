@@ -98,6 +98,48 @@ type DriverNetworkManager interface {
 	DestroyNetwork(allocID string, spec *NetworkIsolationSpec) error
 }
 
+// DriverCheckpointer is the interface for drivers that can checkpoint a
+// running task to an image directory and later restore a task from it. This
+// only needs to be implemented if the driver sets the Checkpoint capability.
+type DriverCheckpointer interface {
+	// CheckpointTask dumps the state of the task to imagePath. If
+	// leaveRunning is false the task is stopped once the checkpoint is taken.
+	CheckpointTask(taskID, imagePath string, leaveRunning bool) error
+
+	// RestoreTask starts the task from the checkpoint image at imagePath
+	// instead of launching it from scratch. It behaves like StartTask.
+	RestoreTask(cfg *TaskConfig, imagePath string) (*TaskHandle, *DriverNetwork, error)
+}
+
+// DriverTaskResourceUpdater is the interface for drivers that can change the
+// CPU and memory limits of a running task without restarting it. This only
+// needs to be implemented if the driver sets the UpdateResources capability.
+type DriverTaskResourceUpdater interface {
+	UpdateTaskResources(taskID string, resources *Resources) error
+}
+
+// DriverPauser is the interface for drivers that can pause a running task,
+// so that it stops using the CPU but keeps its memory and allocation, and
+// resume it later. This only needs to be implemented if the driver sets the
+// Pause capability.
+type DriverPauser interface {
+	// PauseTask freezes every process of the task
+	PauseTask(taskID string) error
+
+	// ResumeTask thaws a task frozen by PauseTask
+	ResumeTask(taskID string) error
+}
+
+// DriverRootFSProvider is the interface for drivers that can populate the root
+// filesystem of a chroot task themselves, for example from an image, so the
+// client must not copy its chroot_env into the task directory. This only
+// needs to be implemented if the driver sets the ProvidesRootFS capability.
+type DriverRootFSProvider interface {
+	// TaskProvidesRootFS returns true if the driver populates the root
+	// filesystem of the task described by cfg when it is started.
+	TaskProvidesRootFS(cfg *TaskConfig) (bool, error)
+}
+
 // DriverSignalTaskNotSupported can be embedded by drivers which don't support
 // the SignalTask RPC. This satisfies the SignalTask func requirement for the
 // DriverPlugin interface.
@@ -177,6 +219,25 @@ type Capabilities struct {
 	// The allocation of a unique, not-in-use UID/GID is managed by Nomad client
 	// ensuring no overlap.
 	DynamicWorkloadUsers bool
+
+	// Checkpoint indicates the driver can checkpoint a running task and
+	// restore it on another node, and that the CheckpointTask and RestoreTask
+	// RPCs are implemented.
+	Checkpoint bool
+
+	// UpdateResources indicates the driver can apply new CPU and memory
+	// limits to a running task, and that the UpdateTaskResources RPC is
+	// implemented.
+	UpdateResources bool
+
+	// Pause indicates the driver can pause a running task without stopping
+	// it, and that the PauseTask and ResumeTask RPCs are implemented.
+	Pause bool
+
+	// ProvidesRootFS indicates the driver may populate the root filesystem of
+	// a chroot task itself, and that the TaskProvidesRootFS RPC is
+	// implemented.
+	ProvidesRootFS bool
 }
 
 func (c *Capabilities) HasNetIsolationMode(m NetIsolationMode) bool {
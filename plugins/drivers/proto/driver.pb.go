@@ -140,7 +140,7 @@ func (x DriverCapabilities_FSIsolation) String() string {
 }
 
 func (DriverCapabilities_FSIsolation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{32, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{44, 0}
 }
 
 type DriverCapabilities_MountConfigs int32
@@ -168,7 +168,7 @@ func (x DriverCapabilities_MountConfigs) String() string {
 }
 
 func (DriverCapabilities_MountConfigs) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{32, 1}
+	return fileDescriptor_4a8f45747846a74d, []int{44, 1}
 }
 
 type NetworkIsolationSpec_NetworkIsolationMode int32
@@ -199,7 +199,7 @@ func (x NetworkIsolationSpec_NetworkIsolationMode) String() string {
 }
 
 func (NetworkIsolationSpec_NetworkIsolationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{33, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{45, 0}
 }
 
 type CPUUsage_Fields int32
@@ -236,7 +236,7 @@ func (x CPUUsage_Fields) String() string {
 }
 
 func (CPUUsage_Fields) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{54, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{67, 0}
 }
 
 type MemoryUsage_Fields int32
@@ -276,7 +276,7 @@ func (x MemoryUsage_Fields) String() string {
 }
 
 func (MemoryUsage_Fields) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{55, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{68, 0}
 }
 
 type TaskConfigSchemaRequest struct {
@@ -1836,6 +1836,485 @@ func (m *DestroyNetworkResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DestroyNetworkResponse proto.InternalMessageInfo
 
+type CheckpointTaskRequest struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ImagePath            string   `protobuf:"bytes,2,opt,name=image_path,json=imagePath,proto3" json:"image_path,omitempty"`
+	LeaveRunning         bool     `protobuf:"varint,3,opt,name=leave_running,json=leaveRunning,proto3" json:"leave_running,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointTaskRequest) Reset()         { *m = CheckpointTaskRequest{} }
+func (m *CheckpointTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTaskRequest) ProtoMessage()    {}
+func (*CheckpointTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{32}
+}
+
+func (m *CheckpointTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointTaskRequest.Unmarshal(m, b)
+}
+func (m *CheckpointTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckpointTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTaskRequest.Merge(m, src)
+}
+func (m *CheckpointTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckpointTaskRequest.Size(m)
+}
+func (m *CheckpointTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTaskRequest proto.InternalMessageInfo
+
+func (m *CheckpointTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *CheckpointTaskRequest) GetImagePath() string {
+	if m != nil {
+		return m.ImagePath
+	}
+	return ""
+}
+
+func (m *CheckpointTaskRequest) GetLeaveRunning() bool {
+	if m != nil {
+		return m.LeaveRunning
+	}
+	return false
+}
+
+type CheckpointTaskResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointTaskResponse) Reset()         { *m = CheckpointTaskResponse{} }
+func (m *CheckpointTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTaskResponse) ProtoMessage()    {}
+func (*CheckpointTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{33}
+}
+
+func (m *CheckpointTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointTaskResponse.Unmarshal(m, b)
+}
+func (m *CheckpointTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckpointTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTaskResponse.Merge(m, src)
+}
+func (m *CheckpointTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckpointTaskResponse.Size(m)
+}
+func (m *CheckpointTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTaskResponse proto.InternalMessageInfo
+
+type RestoreTaskRequest struct {
+	Task                 *TaskConfig `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	ImagePath            string      `protobuf:"bytes,2,opt,name=image_path,json=imagePath,proto3" json:"image_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RestoreTaskRequest) Reset()         { *m = RestoreTaskRequest{} }
+func (m *RestoreTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreTaskRequest) ProtoMessage()    {}
+func (*RestoreTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{34}
+}
+
+func (m *RestoreTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTaskRequest.Unmarshal(m, b)
+}
+func (m *RestoreTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTaskRequest.Merge(m, src)
+}
+func (m *RestoreTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreTaskRequest.Size(m)
+}
+func (m *RestoreTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTaskRequest proto.InternalMessageInfo
+
+func (m *RestoreTaskRequest) GetTask() *TaskConfig {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+func (m *RestoreTaskRequest) GetImagePath() string {
+	if m != nil {
+		return m.ImagePath
+	}
+	return ""
+}
+
+type RestoreTaskResponse struct {
+	Handle               *TaskHandle      `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	NetworkOverride      *NetworkOverride `protobuf:"bytes,2,opt,name=network_override,json=networkOverride,proto3" json:"network_override,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RestoreTaskResponse) Reset()         { *m = RestoreTaskResponse{} }
+func (m *RestoreTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreTaskResponse) ProtoMessage()    {}
+func (*RestoreTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{35}
+}
+
+func (m *RestoreTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTaskResponse.Unmarshal(m, b)
+}
+func (m *RestoreTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTaskResponse.Merge(m, src)
+}
+func (m *RestoreTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreTaskResponse.Size(m)
+}
+func (m *RestoreTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTaskResponse proto.InternalMessageInfo
+
+func (m *RestoreTaskResponse) GetHandle() *TaskHandle {
+	if m != nil {
+		return m.Handle
+	}
+	return nil
+}
+
+func (m *RestoreTaskResponse) GetNetworkOverride() *NetworkOverride {
+	if m != nil {
+		return m.NetworkOverride
+	}
+	return nil
+}
+
+type UpdateTaskResourcesRequest struct {
+	TaskId               string     `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Resources            *Resources `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *UpdateTaskResourcesRequest) Reset()         { *m = UpdateTaskResourcesRequest{} }
+func (m *UpdateTaskResourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTaskResourcesRequest) ProtoMessage()    {}
+func (*UpdateTaskResourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{36}
+}
+
+func (m *UpdateTaskResourcesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTaskResourcesRequest.Unmarshal(m, b)
+}
+func (m *UpdateTaskResourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTaskResourcesRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateTaskResourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTaskResourcesRequest.Merge(m, src)
+}
+func (m *UpdateTaskResourcesRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateTaskResourcesRequest.Size(m)
+}
+func (m *UpdateTaskResourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTaskResourcesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTaskResourcesRequest proto.InternalMessageInfo
+
+func (m *UpdateTaskResourcesRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *UpdateTaskResourcesRequest) GetResources() *Resources {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+type UpdateTaskResourcesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateTaskResourcesResponse) Reset()         { *m = UpdateTaskResourcesResponse{} }
+func (m *UpdateTaskResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateTaskResourcesResponse) ProtoMessage()    {}
+func (*UpdateTaskResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{37}
+}
+
+func (m *UpdateTaskResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTaskResourcesResponse.Unmarshal(m, b)
+}
+func (m *UpdateTaskResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTaskResourcesResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateTaskResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTaskResourcesResponse.Merge(m, src)
+}
+func (m *UpdateTaskResourcesResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateTaskResourcesResponse.Size(m)
+}
+func (m *UpdateTaskResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTaskResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTaskResourcesResponse proto.InternalMessageInfo
+
+type PauseTaskRequest struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseTaskRequest) Reset()         { *m = PauseTaskRequest{} }
+func (m *PauseTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseTaskRequest) ProtoMessage()    {}
+func (*PauseTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{38}
+}
+
+func (m *PauseTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseTaskRequest.Unmarshal(m, b)
+}
+func (m *PauseTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseTaskRequest.Merge(m, src)
+}
+func (m *PauseTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseTaskRequest.Size(m)
+}
+func (m *PauseTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseTaskRequest proto.InternalMessageInfo
+
+func (m *PauseTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+type PauseTaskResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseTaskResponse) Reset()         { *m = PauseTaskResponse{} }
+func (m *PauseTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseTaskResponse) ProtoMessage()    {}
+func (*PauseTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{39}
+}
+
+func (m *PauseTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseTaskResponse.Unmarshal(m, b)
+}
+func (m *PauseTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *PauseTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseTaskResponse.Merge(m, src)
+}
+func (m *PauseTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_PauseTaskResponse.Size(m)
+}
+func (m *PauseTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseTaskResponse proto.InternalMessageInfo
+
+type ResumeTaskRequest struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeTaskRequest) Reset()         { *m = ResumeTaskRequest{} }
+func (m *ResumeTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeTaskRequest) ProtoMessage()    {}
+func (*ResumeTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{40}
+}
+
+func (m *ResumeTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeTaskRequest.Unmarshal(m, b)
+}
+func (m *ResumeTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeTaskRequest.Merge(m, src)
+}
+func (m *ResumeTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeTaskRequest.Size(m)
+}
+func (m *ResumeTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeTaskRequest proto.InternalMessageInfo
+
+func (m *ResumeTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+type ResumeTaskResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeTaskResponse) Reset()         { *m = ResumeTaskResponse{} }
+func (m *ResumeTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeTaskResponse) ProtoMessage()    {}
+func (*ResumeTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{41}
+}
+
+func (m *ResumeTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeTaskResponse.Unmarshal(m, b)
+}
+func (m *ResumeTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *ResumeTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeTaskResponse.Merge(m, src)
+}
+func (m *ResumeTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_ResumeTaskResponse.Size(m)
+}
+func (m *ResumeTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeTaskResponse proto.InternalMessageInfo
+
+type TaskProvidesRootFSRequest struct {
+	// Task is the configuration of the task about to be started
+	Task                 *TaskConfig `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TaskProvidesRootFSRequest) Reset()         { *m = TaskProvidesRootFSRequest{} }
+func (m *TaskProvidesRootFSRequest) String() string { return proto.CompactTextString(m) }
+func (*TaskProvidesRootFSRequest) ProtoMessage()    {}
+func (*TaskProvidesRootFSRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{42}
+}
+
+func (m *TaskProvidesRootFSRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TaskProvidesRootFSRequest.Unmarshal(m, b)
+}
+func (m *TaskProvidesRootFSRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TaskProvidesRootFSRequest.Marshal(b, m, deterministic)
+}
+func (m *TaskProvidesRootFSRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskProvidesRootFSRequest.Merge(m, src)
+}
+func (m *TaskProvidesRootFSRequest) XXX_Size() int {
+	return xxx_messageInfo_TaskProvidesRootFSRequest.Size(m)
+}
+func (m *TaskProvidesRootFSRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskProvidesRootFSRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskProvidesRootFSRequest proto.InternalMessageInfo
+
+func (m *TaskProvidesRootFSRequest) GetTask() *TaskConfig {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+type TaskProvidesRootFSResponse struct {
+	// ProvidesRootfs is true if the driver populates the root filesystem of
+	// the task itself
+	ProvidesRootfs       bool     `protobuf:"varint,1,opt,name=provides_rootfs,json=providesRootfs,proto3" json:"provides_rootfs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskProvidesRootFSResponse) Reset()         { *m = TaskProvidesRootFSResponse{} }
+func (m *TaskProvidesRootFSResponse) String() string { return proto.CompactTextString(m) }
+func (*TaskProvidesRootFSResponse) ProtoMessage()    {}
+func (*TaskProvidesRootFSResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{43}
+}
+
+func (m *TaskProvidesRootFSResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TaskProvidesRootFSResponse.Unmarshal(m, b)
+}
+func (m *TaskProvidesRootFSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TaskProvidesRootFSResponse.Marshal(b, m, deterministic)
+}
+func (m *TaskProvidesRootFSResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskProvidesRootFSResponse.Merge(m, src)
+}
+func (m *TaskProvidesRootFSResponse) XXX_Size() int {
+	return xxx_messageInfo_TaskProvidesRootFSResponse.Size(m)
+}
+func (m *TaskProvidesRootFSResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskProvidesRootFSResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskProvidesRootFSResponse proto.InternalMessageInfo
+
+func (m *TaskProvidesRootFSResponse) GetProvidesRootfs() bool {
+	if m != nil {
+		return m.ProvidesRootfs
+	}
+	return false
+}
+
 type DriverCapabilities struct {
 	// SendSignals indicates that the driver can send process signals (ex. SIGUSR1)
 	// to the task.
@@ -1847,6 +2326,7 @@ type DriverCapabilities struct {
 	FsIsolation           DriverCapabilities_FSIsolation              `protobuf:"varint,3,opt,name=fs_isolation,json=fsIsolation,proto3,enum=hashicorp.nomad.plugins.drivers.proto.DriverCapabilities_FSIsolation" json:"fs_isolation,omitempty"`
 	NetworkIsolationModes []NetworkIsolationSpec_NetworkIsolationMode `protobuf:"varint,4,rep,packed,name=network_isolation_modes,json=networkIsolationModes,proto3,enum=hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec_NetworkIsolationMode" json:"network_isolation_modes,omitempty"`
 	MustCreateNetwork     bool                                        `protobuf:"varint,5,opt,name=must_create_network,json=mustCreateNetwork,proto3" json:"must_create_network,omitempty"`
+	Pause                 bool                                        `protobuf:"varint,12,opt,name=pause,proto3" json:"pause,omitempty"`
 	// MountConfigs indicates whether the driver supports mount configurations.
 	MountConfigs DriverCapabilities_MountConfigs `protobuf:"varint,6,opt,name=mount_configs,json=mountConfigs,proto3,enum=hashicorp.nomad.plugins.drivers.proto.DriverCapabilities_MountConfigs" json:"mount_configs,omitempty"`
 	// disable_log_collection indicates whether the driver has the capability of
@@ -1854,7 +2334,16 @@ type DriverCapabilities struct {
 	DisableLogCollection bool `protobuf:"varint,8,opt,name=disable_log_collection,json=disableLogCollection,proto3" json:"disable_log_collection,omitempty"`
 	// dynamic_workload_users indicates the task is capable of using UID/GID
 	// assigned from the Nomad client as user credentials for the task.
-	DynamicWorkloadUsers bool     `protobuf:"varint,9,opt,name=dynamic_workload_users,json=dynamicWorkloadUsers,proto3" json:"dynamic_workload_users,omitempty"`
+	DynamicWorkloadUsers bool `protobuf:"varint,9,opt,name=dynamic_workload_users,json=dynamicWorkloadUsers,proto3" json:"dynamic_workload_users,omitempty"`
+	// checkpoint indicates the driver can checkpoint a running task and
+	// restore it from the checkpoint image.
+	Checkpoint bool `protobuf:"varint,10,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	// update_resources indicates the driver can change the resources of a
+	// running task without restarting it.
+	UpdateResources bool `protobuf:"varint,11,opt,name=update_resources,json=updateResources,proto3" json:"update_resources,omitempty"`
+	// provides_rootfs indicates the driver may populate the root filesystem
+	// of a chroot task itself, such as by unpacking an image into it.
+	ProvidesRootfs       bool     `protobuf:"varint,13,opt,name=provides_rootfs,json=providesRootfs,proto3" json:"provides_rootfs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1864,7 +2353,7 @@ func (m *DriverCapabilities) Reset()         { *m = DriverCapabilities{} }
 func (m *DriverCapabilities) String() string { return proto.CompactTextString(m) }
 func (*DriverCapabilities) ProtoMessage()    {}
 func (*DriverCapabilities) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{32}
+	return fileDescriptor_4a8f45747846a74d, []int{44}
 }
 
 func (m *DriverCapabilities) XXX_Unmarshal(b []byte) error {
@@ -1941,6 +2430,34 @@ func (m *DriverCapabilities) GetDynamicWorkloadUsers() bool {
 	return false
 }
 
+func (m *DriverCapabilities) GetCheckpoint() bool {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return false
+}
+
+func (m *DriverCapabilities) GetUpdateResources() bool {
+	if m != nil {
+		return m.UpdateResources
+	}
+	return false
+}
+
+func (m *DriverCapabilities) GetPause() bool {
+	if m != nil {
+		return m.Pause
+	}
+	return false
+}
+
+func (m *DriverCapabilities) GetProvidesRootfs() bool {
+	if m != nil {
+		return m.ProvidesRootfs
+	}
+	return false
+}
+
 type NetworkIsolationSpec struct {
 	Mode                 NetworkIsolationSpec_NetworkIsolationMode `protobuf:"varint,1,opt,name=mode,proto3,enum=hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec_NetworkIsolationMode" json:"mode,omitempty"`
 	Path                 string                                    `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
@@ -1955,7 +2472,7 @@ func (m *NetworkIsolationSpec) Reset()         { *m = NetworkIsolationSpec{} }
 func (m *NetworkIsolationSpec) String() string { return proto.CompactTextString(m) }
 func (*NetworkIsolationSpec) ProtoMessage()    {}
 func (*NetworkIsolationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{33}
+	return fileDescriptor_4a8f45747846a74d, []int{45}
 }
 
 func (m *NetworkIsolationSpec) XXX_Unmarshal(b []byte) error {
@@ -2016,7 +2533,7 @@ func (m *HostsConfig) Reset()         { *m = HostsConfig{} }
 func (m *HostsConfig) String() string { return proto.CompactTextString(m) }
 func (*HostsConfig) ProtoMessage()    {}
 func (*HostsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{34}
+	return fileDescriptor_4a8f45747846a74d, []int{46}
 }
 
 func (m *HostsConfig) XXX_Unmarshal(b []byte) error {
@@ -2064,7 +2581,7 @@ func (m *DNSConfig) Reset()         { *m = DNSConfig{} }
 func (m *DNSConfig) String() string { return proto.CompactTextString(m) }
 func (*DNSConfig) ProtoMessage()    {}
 func (*DNSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{35}
+	return fileDescriptor_4a8f45747846a74d, []int{47}
 }
 
 func (m *DNSConfig) XXX_Unmarshal(b []byte) error {
@@ -2166,7 +2683,7 @@ func (m *TaskConfig) Reset()         { *m = TaskConfig{} }
 func (m *TaskConfig) String() string { return proto.CompactTextString(m) }
 func (*TaskConfig) ProtoMessage()    {}
 func (*TaskConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{36}
+	return fileDescriptor_4a8f45747846a74d, []int{48}
 }
 
 func (m *TaskConfig) XXX_Unmarshal(b []byte) error {
@@ -2358,7 +2875,7 @@ func (m *Resources) Reset()         { *m = Resources{} }
 func (m *Resources) String() string { return proto.CompactTextString(m) }
 func (*Resources) ProtoMessage()    {}
 func (*Resources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{37}
+	return fileDescriptor_4a8f45747846a74d, []int{49}
 }
 
 func (m *Resources) XXX_Unmarshal(b []byte) error {
@@ -2413,7 +2930,7 @@ func (m *AllocatedTaskResources) Reset()         { *m = AllocatedTaskResources{}
 func (m *AllocatedTaskResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedTaskResources) ProtoMessage()    {}
 func (*AllocatedTaskResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{38}
+	return fileDescriptor_4a8f45747846a74d, []int{50}
 }
 
 func (m *AllocatedTaskResources) XXX_Unmarshal(b []byte) error {
@@ -2466,7 +2983,7 @@ func (m *AllocatedCpuResources) Reset()         { *m = AllocatedCpuResources{} }
 func (m *AllocatedCpuResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedCpuResources) ProtoMessage()    {}
 func (*AllocatedCpuResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{39}
+	return fileDescriptor_4a8f45747846a74d, []int{51}
 }
 
 func (m *AllocatedCpuResources) XXX_Unmarshal(b []byte) error {
@@ -2506,7 +3023,7 @@ func (m *AllocatedMemoryResources) Reset()         { *m = AllocatedMemoryResourc
 func (m *AllocatedMemoryResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedMemoryResources) ProtoMessage()    {}
 func (*AllocatedMemoryResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{40}
+	return fileDescriptor_4a8f45747846a74d, []int{52}
 }
 
 func (m *AllocatedMemoryResources) XXX_Unmarshal(b []byte) error {
@@ -2557,7 +3074,7 @@ func (m *NetworkResource) Reset()         { *m = NetworkResource{} }
 func (m *NetworkResource) String() string { return proto.CompactTextString(m) }
 func (*NetworkResource) ProtoMessage()    {}
 func (*NetworkResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{41}
+	return fileDescriptor_4a8f45747846a74d, []int{53}
 }
 
 func (m *NetworkResource) XXX_Unmarshal(b []byte) error {
@@ -2632,7 +3149,7 @@ func (m *NetworkPort) Reset()         { *m = NetworkPort{} }
 func (m *NetworkPort) String() string { return proto.CompactTextString(m) }
 func (*NetworkPort) ProtoMessage()    {}
 func (*NetworkPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{42}
+	return fileDescriptor_4a8f45747846a74d, []int{54}
 }
 
 func (m *NetworkPort) XXX_Unmarshal(b []byte) error {
@@ -2681,7 +3198,7 @@ func (m *PortMapping) Reset()         { *m = PortMapping{} }
 func (m *PortMapping) String() string { return proto.CompactTextString(m) }
 func (*PortMapping) ProtoMessage()    {}
 func (*PortMapping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{43}
+	return fileDescriptor_4a8f45747846a74d, []int{55}
 }
 
 func (m *PortMapping) XXX_Unmarshal(b []byte) error {
@@ -2758,7 +3275,7 @@ func (m *LinuxResources) Reset()         { *m = LinuxResources{} }
 func (m *LinuxResources) String() string { return proto.CompactTextString(m) }
 func (*LinuxResources) ProtoMessage()    {}
 func (*LinuxResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{44}
+	return fileDescriptor_4a8f45747846a74d, []int{56}
 }
 
 func (m *LinuxResources) XXX_Unmarshal(b []byte) error {
@@ -2855,7 +3372,7 @@ func (m *Mount) Reset()         { *m = Mount{} }
 func (m *Mount) String() string { return proto.CompactTextString(m) }
 func (*Mount) ProtoMessage()    {}
 func (*Mount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{45}
+	return fileDescriptor_4a8f45747846a74d, []int{57}
 }
 
 func (m *Mount) XXX_Unmarshal(b []byte) error {
@@ -2933,7 +3450,7 @@ func (m *Device) Reset()         { *m = Device{} }
 func (m *Device) String() string { return proto.CompactTextString(m) }
 func (*Device) ProtoMessage()    {}
 func (*Device) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{46}
+	return fileDescriptor_4a8f45747846a74d, []int{58}
 }
 
 func (m *Device) XXX_Unmarshal(b []byte) error {
@@ -2995,7 +3512,7 @@ func (m *TaskHandle) Reset()         { *m = TaskHandle{} }
 func (m *TaskHandle) String() string { return proto.CompactTextString(m) }
 func (*TaskHandle) ProtoMessage()    {}
 func (*TaskHandle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{47}
+	return fileDescriptor_4a8f45747846a74d, []int{59}
 }
 
 func (m *TaskHandle) XXX_Unmarshal(b []byte) error {
@@ -3063,7 +3580,7 @@ func (m *NetworkOverride) Reset()         { *m = NetworkOverride{} }
 func (m *NetworkOverride) String() string { return proto.CompactTextString(m) }
 func (*NetworkOverride) ProtoMessage()    {}
 func (*NetworkOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{48}
+	return fileDescriptor_4a8f45747846a74d, []int{60}
 }
 
 func (m *NetworkOverride) XXX_Unmarshal(b []byte) error {
@@ -3122,7 +3639,7 @@ func (m *ExitResult) Reset()         { *m = ExitResult{} }
 func (m *ExitResult) String() string { return proto.CompactTextString(m) }
 func (*ExitResult) ProtoMessage()    {}
 func (*ExitResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{49}
+	return fileDescriptor_4a8f45747846a74d, []int{61}
 }
 
 func (m *ExitResult) XXX_Unmarshal(b []byte) error {
@@ -3186,7 +3703,7 @@ func (m *TaskStatus) Reset()         { *m = TaskStatus{} }
 func (m *TaskStatus) String() string { return proto.CompactTextString(m) }
 func (*TaskStatus) ProtoMessage()    {}
 func (*TaskStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{50}
+	return fileDescriptor_4a8f45747846a74d, []int{62}
 }
 
 func (m *TaskStatus) XXX_Unmarshal(b []byte) error {
@@ -3262,7 +3779,7 @@ func (m *TaskDriverStatus) Reset()         { *m = TaskDriverStatus{} }
 func (m *TaskDriverStatus) String() string { return proto.CompactTextString(m) }
 func (*TaskDriverStatus) ProtoMessage()    {}
 func (*TaskDriverStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{51}
+	return fileDescriptor_4a8f45747846a74d, []int{63}
 }
 
 func (m *TaskDriverStatus) XXX_Unmarshal(b []byte) error {
@@ -3308,7 +3825,7 @@ func (m *TaskStats) Reset()         { *m = TaskStats{} }
 func (m *TaskStats) String() string { return proto.CompactTextString(m) }
 func (*TaskStats) ProtoMessage()    {}
 func (*TaskStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{52}
+	return fileDescriptor_4a8f45747846a74d, []int{64}
 }
 
 func (m *TaskStats) XXX_Unmarshal(b []byte) error {
@@ -3362,6 +3879,7 @@ type TaskResourceUsage struct {
 	Cpu *CPUUsage `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
 	// Memory usage stats
 	Memory               *MemoryUsage `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Process              *ProcessInfo `protobuf:"bytes,3,opt,name=process,proto3" json:"process,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -3371,7 +3889,7 @@ func (m *TaskResourceUsage) Reset()         { *m = TaskResourceUsage{} }
 func (m *TaskResourceUsage) String() string { return proto.CompactTextString(m) }
 func (*TaskResourceUsage) ProtoMessage()    {}
 func (*TaskResourceUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{53}
+	return fileDescriptor_4a8f45747846a74d, []int{65}
 }
 
 func (m *TaskResourceUsage) XXX_Unmarshal(b []byte) error {
@@ -3406,6 +3924,60 @@ func (m *TaskResourceUsage) GetMemory() *MemoryUsage {
 	return nil
 }
 
+func (m *TaskResourceUsage) GetProcess() *ProcessInfo {
+	if m != nil {
+		return m.Process
+	}
+	return nil
+}
+
+type ProcessInfo struct {
+	Ppid                 int64    `protobuf:"varint,1,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	Command              string   `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProcessInfo) Reset()         { *m = ProcessInfo{} }
+func (m *ProcessInfo) String() string { return proto.CompactTextString(m) }
+func (*ProcessInfo) ProtoMessage()    {}
+func (*ProcessInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{66}
+}
+
+func (m *ProcessInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessInfo.Unmarshal(m, b)
+}
+func (m *ProcessInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessInfo.Marshal(b, m, deterministic)
+}
+func (m *ProcessInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessInfo.Merge(m, src)
+}
+func (m *ProcessInfo) XXX_Size() int {
+	return xxx_messageInfo_ProcessInfo.Size(m)
+}
+func (m *ProcessInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessInfo proto.InternalMessageInfo
+
+func (m *ProcessInfo) GetPpid() int64 {
+	if m != nil {
+		return m.Ppid
+	}
+	return 0
+}
+
+func (m *ProcessInfo) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
 type CPUUsage struct {
 	SystemMode       float64 `protobuf:"fixed64,1,opt,name=system_mode,json=systemMode,proto3" json:"system_mode,omitempty"`
 	UserMode         float64 `protobuf:"fixed64,2,opt,name=user_mode,json=userMode,proto3" json:"user_mode,omitempty"`
@@ -3424,7 +3996,7 @@ func (m *CPUUsage) Reset()         { *m = CPUUsage{} }
 func (m *CPUUsage) String() string { return proto.CompactTextString(m) }
 func (*CPUUsage) ProtoMessage()    {}
 func (*CPUUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{54}
+	return fileDescriptor_4a8f45747846a74d, []int{67}
 }
 
 func (m *CPUUsage) XXX_Unmarshal(b []byte) error {
@@ -3513,7 +4085,7 @@ func (m *MemoryUsage) Reset()         { *m = MemoryUsage{} }
 func (m *MemoryUsage) String() string { return proto.CompactTextString(m) }
 func (*MemoryUsage) ProtoMessage()    {}
 func (*MemoryUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{55}
+	return fileDescriptor_4a8f45747846a74d, []int{68}
 }
 
 func (m *MemoryUsage) XXX_Unmarshal(b []byte) error {
@@ -3612,7 +4184,7 @@ func (m *DriverTaskEvent) Reset()         { *m = DriverTaskEvent{} }
 func (m *DriverTaskEvent) String() string { return proto.CompactTextString(m) }
 func (*DriverTaskEvent) ProtoMessage()    {}
 func (*DriverTaskEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{56}
+	return fileDescriptor_4a8f45747846a74d, []int{69}
 }
 
 func (m *DriverTaskEvent) XXX_Unmarshal(b []byte) error {
@@ -3719,6 +4291,18 @@ func init() {
 	proto.RegisterType((*CreateNetworkResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.CreateNetworkResponse")
 	proto.RegisterType((*DestroyNetworkRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.DestroyNetworkRequest")
 	proto.RegisterType((*DestroyNetworkResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.DestroyNetworkResponse")
+	proto.RegisterType((*CheckpointTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.CheckpointTaskRequest")
+	proto.RegisterType((*CheckpointTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.CheckpointTaskResponse")
+	proto.RegisterType((*RestoreTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.RestoreTaskRequest")
+	proto.RegisterType((*RestoreTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.RestoreTaskResponse")
+	proto.RegisterType((*UpdateTaskResourcesRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.UpdateTaskResourcesRequest")
+	proto.RegisterType((*UpdateTaskResourcesResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.UpdateTaskResourcesResponse")
+	proto.RegisterType((*PauseTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.PauseTaskRequest")
+	proto.RegisterType((*PauseTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.PauseTaskResponse")
+	proto.RegisterType((*ResumeTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.ResumeTaskRequest")
+	proto.RegisterType((*ResumeTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.ResumeTaskResponse")
+	proto.RegisterType((*TaskProvidesRootFSRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskProvidesRootFSRequest")
+	proto.RegisterType((*TaskProvidesRootFSResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskProvidesRootFSResponse")
 	proto.RegisterType((*DriverCapabilities)(nil), "hashicorp.nomad.plugins.drivers.proto.DriverCapabilities")
 	proto.RegisterType((*NetworkIsolationSpec)(nil), "hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec")
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec.LabelsEntry")
@@ -3747,6 +4331,7 @@ func init() {
 	proto.RegisterType((*TaskStats)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskStats")
 	proto.RegisterMapType((map[string]*TaskResourceUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskStats.ResourceUsageByPidEntry")
 	proto.RegisterType((*TaskResourceUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskResourceUsage")
+	proto.RegisterType((*ProcessInfo)(nil), "hashicorp.nomad.plugins.drivers.proto.ProcessInfo")
 	proto.RegisterType((*CPUUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.CPUUsage")
 	proto.RegisterType((*MemoryUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.MemoryUsage")
 	proto.RegisterType((*DriverTaskEvent)(nil), "hashicorp.nomad.plugins.drivers.proto.DriverTaskEvent")
@@ -3758,254 +4343,276 @@ func init() {
 }
 
 var fileDescriptor_4a8f45747846a74d = []byte{
-	// 3938 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4f, 0x73, 0xdb, 0x48,
-	0x76, 0x17, 0xf8, 0x4f, 0xe4, 0x23, 0x45, 0x41, 0x2d, 0xc9, 0xa6, 0x39, 0x9b, 0x8c, 0x07, 0x5b,
-	0x93, 0x52, 0x76, 0x67, 0xe8, 0x59, 0x6d, 0x32, 0x1e, 0x7b, 0x3d, 0xeb, 0xe1, 0x50, 0xb4, 0x45,
-	0x5b, 0xa2, 0x94, 0x26, 0x15, 0xaf, 0xe3, 0x64, 0x10, 0x08, 0x68, 0x53, 0xb0, 0x48, 0x00, 0x83,
-	0x06, 0x65, 0x69, 0x53, 0xa9, 0xa4, 0x36, 0x55, 0xa9, 0x4d, 0x55, 0x52, 0xc9, 0x65, 0xb2, 0x97,
-	0x9c, 0xb6, 0x2a, 0xa7, 0x54, 0xee, 0xa9, 0x4d, 0xed, 0x29, 0x87, 0x7c, 0x89, 0x1c, 0x92, 0x5b,
-	0xae, 0xf9, 0x04, 0xd9, 0xea, 0x3f, 0x00, 0x01, 0x92, 0x1e, 0x83, 0x94, 0x4f, 0xe4, 0x7b, 0xdd,
-	0xfd, 0xeb, 0x87, 0xf7, 0x5e, 0xbf, 0x7e, 0xdd, 0xfd, 0x40, 0xf3, 0x86, 0xe3, 0x81, 0xed, 0xd0,
-	0x3b, 0x96, 0x6f, 0x5f, 0x10, 0x9f, 0xde, 0xf1, 0x7c, 0x37, 0x70, 0x25, 0xd5, 0xe0, 0x04, 0xfa,
-	0xf0, 0xcc, 0xa0, 0x67, 0xb6, 0xe9, 0xfa, 0x5e, 0xc3, 0x71, 0x47, 0x86, 0xd5, 0x90, 0x63, 0x1a,
-	0x72, 0x8c, 0xe8, 0x56, 0xff, 0xed, 0x81, 0xeb, 0x0e, 0x86, 0x44, 0x20, 0x9c, 0x8e, 0x5f, 0xde,
-	0xb1, 0xc6, 0xbe, 0x11, 0xd8, 0xae, 0x23, 0xdb, 0xdf, 0x9f, 0x6e, 0x0f, 0xec, 0x11, 0xa1, 0x81,
-	0x31, 0xf2, 0x64, 0x87, 0x0f, 0x43, 0x59, 0xe8, 0x99, 0xe1, 0x13, 0xeb, 0xce, 0x99, 0x39, 0xa4,
-	0x1e, 0x31, 0xd9, 0xaf, 0xce, 0xfe, 0xc8, 0x6e, 0x1f, 0x4d, 0x75, 0xa3, 0x81, 0x3f, 0x36, 0x83,
-	0x50, 0x72, 0x23, 0x08, 0x7c, 0xfb, 0x74, 0x1c, 0x10, 0xd1, 0x5b, 0xbb, 0x05, 0x37, 0xfb, 0x06,
-	0x3d, 0x6f, 0xb9, 0xce, 0x4b, 0x7b, 0xd0, 0x33, 0xcf, 0xc8, 0xc8, 0xc0, 0xe4, 0xeb, 0x31, 0xa1,
-	0x81, 0xf6, 0xc7, 0x50, 0x9b, 0x6d, 0xa2, 0x9e, 0xeb, 0x50, 0x82, 0xbe, 0x80, 0x1c, 0x9b, 0xb2,
-	0xa6, 0xdc, 0x56, 0x76, 0xca, 0xbb, 0x1f, 0x35, 0xde, 0xa4, 0x02, 0x21, 0x43, 0x43, 0x8a, 0xda,
-	0xe8, 0x79, 0xc4, 0xc4, 0x7c, 0xa4, 0xb6, 0x0d, 0x9b, 0x2d, 0xc3, 0x33, 0x4e, 0xed, 0xa1, 0x1d,
-	0xd8, 0x84, 0x86, 0x93, 0x8e, 0x61, 0x2b, 0xc9, 0x96, 0x13, 0xfe, 0x09, 0x54, 0xcc, 0x18, 0x5f,
-	0x4e, 0x7c, 0xaf, 0x91, 0x4a, 0xf7, 0x8d, 0x3d, 0x4e, 0x25, 0x80, 0x13, 0x70, 0xda, 0x16, 0xa0,
-	0x47, 0xb6, 0x33, 0x20, 0xbe, 0xe7, 0xdb, 0x4e, 0x10, 0x0a, 0xf3, 0xeb, 0x2c, 0x6c, 0x26, 0xd8,
-	0x52, 0x98, 0x57, 0x00, 0x91, 0x1e, 0x99, 0x28, 0xd9, 0x9d, 0xf2, 0xee, 0x93, 0x94, 0xa2, 0xcc,
-	0xc1, 0x6b, 0x34, 0x23, 0xb0, 0xb6, 0x13, 0xf8, 0x57, 0x38, 0x86, 0x8e, 0xbe, 0x82, 0xc2, 0x19,
-	0x31, 0x86, 0xc1, 0x59, 0x2d, 0x73, 0x5b, 0xd9, 0xa9, 0xee, 0x3e, 0xba, 0xc6, 0x3c, 0xfb, 0x1c,
-	0xa8, 0x17, 0x18, 0x01, 0xc1, 0x12, 0x15, 0x7d, 0x0c, 0x48, 0xfc, 0xd3, 0x2d, 0x42, 0x4d, 0xdf,
-	0xf6, 0x98, 0x4b, 0xd6, 0xb2, 0xb7, 0x95, 0x9d, 0x12, 0xde, 0x10, 0x2d, 0x7b, 0x93, 0x86, 0xba,
-	0x07, 0xeb, 0x53, 0xd2, 0x22, 0x15, 0xb2, 0xe7, 0xe4, 0x8a, 0x5b, 0xa4, 0x84, 0xd9, 0x5f, 0xf4,
-	0x18, 0xf2, 0x17, 0xc6, 0x70, 0x4c, 0xb8, 0xc8, 0xe5, 0xdd, 0x1f, 0xbc, 0xcd, 0x3d, 0xa4, 0x8b,
-	0x4e, 0xf4, 0x80, 0xc5, 0xf8, 0xfb, 0x99, 0xcf, 0x14, 0xed, 0x1e, 0x94, 0x63, 0x72, 0xa3, 0x2a,
-	0xc0, 0x49, 0x77, 0xaf, 0xdd, 0x6f, 0xb7, 0xfa, 0xed, 0x3d, 0x75, 0x05, 0xad, 0x41, 0xe9, 0xa4,
-	0xbb, 0xdf, 0x6e, 0x1e, 0xf4, 0xf7, 0x9f, 0xab, 0x0a, 0x2a, 0xc3, 0x6a, 0x48, 0x64, 0xb4, 0x4b,
-	0x40, 0x98, 0x98, 0xee, 0x05, 0xf1, 0x99, 0x23, 0x4b, 0xab, 0xa2, 0x9b, 0xb0, 0x1a, 0x18, 0xf4,
-	0x5c, 0xb7, 0x2d, 0x29, 0x73, 0x81, 0x91, 0x1d, 0x0b, 0x75, 0xa0, 0x70, 0x66, 0x38, 0xd6, 0xf0,
-	0xed, 0x72, 0x27, 0x55, 0xcd, 0xc0, 0xf7, 0xf9, 0x40, 0x2c, 0x01, 0x98, 0x77, 0x27, 0x66, 0x16,
-	0x06, 0xd0, 0x9e, 0x83, 0xda, 0x0b, 0x0c, 0x3f, 0x88, 0x8b, 0xd3, 0x86, 0x1c, 0x9b, 0x5f, 0x7a,
-	0xf4, 0x22, 0x73, 0x8a, 0x95, 0x89, 0xf9, 0x70, 0xed, 0xff, 0x32, 0xb0, 0x11, 0xc3, 0x96, 0x9e,
-	0xfa, 0x0c, 0x0a, 0x3e, 0xa1, 0xe3, 0x61, 0xc0, 0xe1, 0xab, 0xbb, 0x0f, 0x53, 0xc2, 0xcf, 0x20,
-	0x35, 0x30, 0x87, 0xc1, 0x12, 0x0e, 0xed, 0x80, 0x2a, 0x46, 0xe8, 0xc4, 0xf7, 0x5d, 0x5f, 0x1f,
-	0xd1, 0x01, 0xd7, 0x5a, 0x09, 0x57, 0x05, 0xbf, 0xcd, 0xd8, 0x87, 0x74, 0x10, 0xd3, 0x6a, 0xf6,
-	0x9a, 0x5a, 0x45, 0x06, 0xa8, 0x0e, 0x09, 0x5e, 0xbb, 0xfe, 0xb9, 0xce, 0x54, 0xeb, 0xdb, 0x16,
-	0xa9, 0xe5, 0x38, 0xe8, 0xa7, 0x29, 0x41, 0xbb, 0x62, 0xf8, 0x91, 0x1c, 0x8d, 0xd7, 0x9d, 0x24,
-	0x43, 0xfb, 0x3e, 0x14, 0xc4, 0x97, 0x32, 0x4f, 0xea, 0x9d, 0xb4, 0x5a, 0xed, 0x5e, 0x4f, 0x5d,
-	0x41, 0x25, 0xc8, 0xe3, 0x76, 0x1f, 0x33, 0x0f, 0x2b, 0x41, 0xfe, 0x51, 0xb3, 0xdf, 0x3c, 0x50,
-	0x33, 0xda, 0xf7, 0x60, 0xfd, 0x99, 0x61, 0x07, 0x69, 0x9c, 0x4b, 0x73, 0x41, 0x9d, 0xf4, 0x95,
-	0xd6, 0xe9, 0x24, 0xac, 0x93, 0x5e, 0x35, 0xed, 0x4b, 0x3b, 0x98, 0xb2, 0x87, 0x0a, 0x59, 0xe2,
-	0xfb, 0xd2, 0x04, 0xec, 0xaf, 0xf6, 0x1a, 0xd6, 0x7b, 0x81, 0xeb, 0xa5, 0xf2, 0xfc, 0x1f, 0xc2,
-	0x2a, 0xdb, 0x6d, 0xdc, 0x71, 0x20, 0x5d, 0xff, 0x56, 0x43, 0xec, 0x46, 0x8d, 0x70, 0x37, 0x6a,
-	0xec, 0xc9, 0xdd, 0x0a, 0x87, 0x3d, 0xd1, 0x0d, 0x28, 0x50, 0x7b, 0xe0, 0x18, 0x43, 0x19, 0x2d,
-	0x24, 0xa5, 0x21, 0xe6, 0xe4, 0xe1, 0xc4, 0xd2, 0xf1, 0x5b, 0x80, 0xf6, 0x08, 0x0d, 0x7c, 0xf7,
-	0x2a, 0x95, 0x3c, 0x5b, 0x90, 0x7f, 0xe9, 0xfa, 0xa6, 0x58, 0x88, 0x45, 0x2c, 0x08, 0xb6, 0xa8,
-	0x12, 0x20, 0x12, 0xfb, 0x63, 0x40, 0x1d, 0x87, 0xed, 0x29, 0xe9, 0x0c, 0xf1, 0x0f, 0x19, 0xd8,
-	0x4c, 0xf4, 0x97, 0xc6, 0x58, 0x7e, 0x1d, 0xb2, 0xc0, 0x34, 0xa6, 0x62, 0x1d, 0xa2, 0x23, 0x28,
-	0x88, 0x1e, 0x52, 0x93, 0x77, 0x17, 0x00, 0x12, 0xdb, 0x94, 0x84, 0x93, 0x30, 0x73, 0x9d, 0x3e,
-	0xfb, 0x6e, 0x9d, 0xfe, 0x35, 0xa8, 0xe1, 0x77, 0xd0, 0xb7, 0xda, 0xe6, 0x09, 0x6c, 0x9a, 0xee,
-	0x70, 0x48, 0x4c, 0xe6, 0x0d, 0xba, 0xed, 0x04, 0xc4, 0xbf, 0x30, 0x86, 0x6f, 0xf7, 0x1b, 0x34,
-	0x19, 0xd5, 0x91, 0x83, 0xb4, 0x17, 0xb0, 0x11, 0x9b, 0x58, 0x1a, 0xe2, 0x11, 0xe4, 0x29, 0x63,
-	0x48, 0x4b, 0x7c, 0xb2, 0xa0, 0x25, 0x28, 0x16, 0xc3, 0xb5, 0x4d, 0x01, 0xde, 0xbe, 0x20, 0x4e,
-	0xf4, 0x59, 0xda, 0x1e, 0x6c, 0xf4, 0xb8, 0x9b, 0xa6, 0xf2, 0xc3, 0x89, 0x8b, 0x67, 0x12, 0x2e,
-	0xbe, 0x05, 0x28, 0x8e, 0x22, 0x1d, 0xf1, 0x0a, 0xd6, 0xdb, 0x97, 0xc4, 0x4c, 0x85, 0x5c, 0x83,
-	0x55, 0xd3, 0x1d, 0x8d, 0x0c, 0xc7, 0xaa, 0x65, 0x6e, 0x67, 0x77, 0x4a, 0x38, 0x24, 0xe3, 0x6b,
-	0x31, 0x9b, 0x76, 0x2d, 0x6a, 0x7f, 0xa7, 0x80, 0x3a, 0x99, 0x5b, 0x2a, 0x92, 0x49, 0x1f, 0x58,
-	0x0c, 0x88, 0xcd, 0x5d, 0xc1, 0x92, 0x92, 0xfc, 0x30, 0x5c, 0x08, 0x3e, 0xf1, 0xfd, 0x58, 0x38,
-	0xca, 0x5e, 0x33, 0x1c, 0x69, 0xfb, 0xf0, 0x9d, 0x50, 0x9c, 0x5e, 0xe0, 0x13, 0x63, 0x64, 0x3b,
-	0x83, 0xce, 0xd1, 0x91, 0x47, 0x84, 0xe0, 0x08, 0x41, 0xce, 0x32, 0x02, 0x43, 0x0a, 0xc6, 0xff,
-	0xb3, 0x45, 0x6f, 0x0e, 0x5d, 0x1a, 0x2d, 0x7a, 0x4e, 0x68, 0xff, 0x99, 0x85, 0xda, 0x0c, 0x54,
-	0xa8, 0xde, 0x17, 0x90, 0xa7, 0x24, 0x18, 0x7b, 0xd2, 0x55, 0xda, 0xa9, 0x05, 0x9e, 0x8f, 0xd7,
-	0xe8, 0x31, 0x30, 0x2c, 0x30, 0xd1, 0x00, 0x8a, 0x41, 0x70, 0xa5, 0x53, 0xfb, 0xa7, 0x61, 0x42,
-	0x70, 0x70, 0x5d, 0xfc, 0x3e, 0xf1, 0x47, 0xb6, 0x63, 0x0c, 0x7b, 0xf6, 0x4f, 0x09, 0x5e, 0x0d,
-	0x82, 0x2b, 0xf6, 0x07, 0x3d, 0x67, 0x0e, 0x6f, 0xd9, 0x8e, 0x54, 0x7b, 0x6b, 0xd9, 0x59, 0x62,
-	0x0a, 0xc6, 0x02, 0xb1, 0x7e, 0x00, 0x79, 0xfe, 0x4d, 0xcb, 0x38, 0xa2, 0x0a, 0xd9, 0x20, 0xb8,
-	0xe2, 0x42, 0x15, 0x31, 0xfb, 0x5b, 0x7f, 0x00, 0x95, 0xf8, 0x17, 0x30, 0x47, 0x3a, 0x23, 0xf6,
-	0xe0, 0x4c, 0x38, 0x58, 0x1e, 0x4b, 0x8a, 0x59, 0xf2, 0xb5, 0x6d, 0xc9, 0x94, 0x35, 0x8f, 0x05,
-	0xa1, 0xfd, 0x5b, 0x06, 0x6e, 0xcd, 0xd1, 0x8c, 0x74, 0xd6, 0x17, 0x09, 0x67, 0x7d, 0x47, 0x5a,
-	0x08, 0x3d, 0xfe, 0x45, 0xc2, 0xe3, 0xdf, 0x21, 0x38, 0x5b, 0x36, 0x37, 0xa0, 0x40, 0x2e, 0xed,
-	0x80, 0x58, 0x52, 0x55, 0x92, 0x8a, 0x2d, 0xa7, 0xdc, 0x75, 0x97, 0xd3, 0x21, 0x6c, 0xb5, 0x7c,
-	0x62, 0x04, 0x44, 0x86, 0xf2, 0xd0, 0xff, 0x6f, 0x41, 0xd1, 0x18, 0x0e, 0x5d, 0x73, 0x62, 0xd6,
-	0x55, 0x4e, 0x77, 0x2c, 0x54, 0x87, 0xe2, 0x99, 0x4b, 0x03, 0xc7, 0x18, 0x11, 0x19, 0xbc, 0x22,
-	0x5a, 0xfb, 0x46, 0x81, 0xed, 0x29, 0x3c, 0x69, 0x85, 0x53, 0xa8, 0xda, 0xd4, 0x1d, 0xf2, 0x0f,
-	0xd4, 0x63, 0x27, 0xbc, 0x1f, 0x2d, 0xb6, 0xd5, 0x74, 0x42, 0x0c, 0x7e, 0xe0, 0x5b, 0xb3, 0xe3,
-	0x24, 0xf7, 0x38, 0x3e, 0xb9, 0x25, 0x57, 0x7a, 0x48, 0x6a, 0xff, 0xa8, 0xc0, 0xb6, 0xdc, 0xe1,
-	0xd3, 0x7f, 0xe8, 0xac, 0xc8, 0x99, 0x77, 0x2d, 0xb2, 0x56, 0x83, 0x1b, 0xd3, 0x72, 0xc9, 0x98,
-	0xff, 0xdf, 0x79, 0x40, 0xb3, 0xa7, 0x4b, 0xf4, 0x01, 0x54, 0x28, 0x71, 0x2c, 0x5d, 0xec, 0x17,
-	0x62, 0x2b, 0x2b, 0xe2, 0x32, 0xe3, 0x89, 0x8d, 0x83, 0xb2, 0x10, 0x48, 0x2e, 0xa5, 0xb4, 0x45,
-	0xcc, 0xff, 0xa3, 0x33, 0xa8, 0xbc, 0xa4, 0x7a, 0x34, 0x37, 0x77, 0xa8, 0x6a, 0xea, 0xb0, 0x36,
-	0x2b, 0x47, 0xe3, 0x51, 0x2f, 0xfa, 0x2e, 0x5c, 0x7e, 0x49, 0x23, 0x02, 0xfd, 0x5c, 0x81, 0x9b,
-	0x61, 0x5a, 0x31, 0x51, 0xdf, 0xc8, 0xb5, 0x08, 0xad, 0xe5, 0x6e, 0x67, 0x77, 0xaa, 0xbb, 0xc7,
-	0xd7, 0xd0, 0xdf, 0x0c, 0xf3, 0xd0, 0xb5, 0x08, 0xde, 0x76, 0xe6, 0x70, 0x29, 0x6a, 0xc0, 0xe6,
-	0x68, 0x4c, 0x03, 0x5d, 0x78, 0x81, 0x2e, 0x3b, 0xd5, 0xf2, 0x5c, 0x2f, 0x1b, 0xac, 0x29, 0xe1,
-	0xab, 0xe8, 0x1c, 0xd6, 0x46, 0xee, 0xd8, 0x09, 0x74, 0x93, 0x9f, 0x7f, 0x68, 0xad, 0xb0, 0xd0,
-	0xc1, 0x78, 0x8e, 0x96, 0x0e, 0x19, 0x9c, 0x38, 0x4d, 0x51, 0x5c, 0x19, 0xc5, 0x28, 0xf4, 0x7b,
-	0x70, 0xc3, 0xb2, 0xa9, 0x71, 0x3a, 0x24, 0xfa, 0xd0, 0x1d, 0xe8, 0x93, 0x1c, 0xa6, 0x56, 0xe4,
-	0xf2, 0x6d, 0xc9, 0xd6, 0x03, 0x77, 0xd0, 0x8a, 0xda, 0xf8, 0xa8, 0x2b, 0xc7, 0x18, 0xd9, 0xa6,
-	0xce, 0x44, 0x1e, 0xba, 0x86, 0xa5, 0x8f, 0x29, 0xf1, 0x69, 0xad, 0x24, 0x47, 0x89, 0xd6, 0x67,
-	0xb2, 0xf1, 0x84, 0xb5, 0x69, 0xf7, 0xa1, 0x1c, 0xb3, 0x17, 0x2a, 0x42, 0xae, 0x7b, 0xd4, 0x6d,
-	0xab, 0x2b, 0x08, 0xa0, 0xd0, 0xda, 0xc7, 0x47, 0x47, 0x7d, 0x71, 0xfc, 0xe8, 0x1c, 0x36, 0x1f,
-	0xb7, 0xd5, 0x0c, 0x63, 0x9f, 0x74, 0xff, 0xb0, 0xdd, 0x39, 0x50, 0xb3, 0x5a, 0x1b, 0x2a, 0xf1,
-	0xaf, 0x40, 0x08, 0xaa, 0x27, 0xdd, 0xa7, 0xdd, 0xa3, 0x67, 0x5d, 0xfd, 0xf0, 0xe8, 0xa4, 0xdb,
-	0x67, 0x87, 0x98, 0x2a, 0x40, 0xb3, 0xfb, 0x7c, 0x42, 0xaf, 0x41, 0xa9, 0x7b, 0x14, 0x92, 0x4a,
-	0x3d, 0xa3, 0x2a, 0x4f, 0x72, 0xc5, 0x55, 0xb5, 0x88, 0x2b, 0x3e, 0x19, 0xb9, 0x01, 0xd1, 0xd9,
-	0x16, 0x41, 0xb5, 0xff, 0xc8, 0xc2, 0xd6, 0x3c, 0x23, 0x23, 0x0b, 0x72, 0xcc, 0x61, 0xe4, 0xd1,
-	0xf2, 0xdd, 0xfb, 0x0b, 0x47, 0x67, 0xeb, 0xc4, 0x33, 0xe4, 0x5e, 0x52, 0xc2, 0xfc, 0x3f, 0xd2,
-	0xa1, 0x30, 0x34, 0x4e, 0xc9, 0x90, 0xd6, 0xb2, 0xfc, 0xf2, 0xe5, 0xf1, 0x75, 0xe6, 0x3e, 0xe0,
-	0x48, 0xe2, 0xe6, 0x45, 0xc2, 0xa2, 0x3e, 0x94, 0x59, 0xb4, 0xa4, 0x42, 0x9d, 0x32, 0x80, 0xef,
-	0xa6, 0x9c, 0x65, 0x7f, 0x32, 0x12, 0xc7, 0x61, 0xea, 0xf7, 0xa0, 0x1c, 0x9b, 0x6c, 0xce, 0xc5,
-	0xc9, 0x56, 0xfc, 0xe2, 0xa4, 0x14, 0xbf, 0x05, 0x79, 0x38, 0x6b, 0x03, 0xa6, 0x23, 0xe6, 0x24,
-	0xfb, 0x47, 0xbd, 0xbe, 0x38, 0xa2, 0x3e, 0xc6, 0x47, 0x27, 0xc7, 0xaa, 0xc2, 0x98, 0xfd, 0x66,
-	0xef, 0xa9, 0x9a, 0x89, 0x7c, 0x28, 0xab, 0xb5, 0xa0, 0x1c, 0x93, 0x2b, 0xb1, 0x3d, 0x28, 0xc9,
-	0xed, 0x81, 0x05, 0x68, 0xc3, 0xb2, 0x7c, 0x42, 0xa9, 0x94, 0x23, 0x24, 0xb5, 0x17, 0x50, 0xda,
-	0xeb, 0xf6, 0x24, 0x44, 0x0d, 0x56, 0x29, 0xf1, 0xd9, 0x77, 0xf3, 0x2b, 0xb0, 0x12, 0x0e, 0x49,
-	0x06, 0x4e, 0x89, 0xe1, 0x9b, 0x67, 0x84, 0xca, 0xa4, 0x22, 0xa2, 0xd9, 0x28, 0x97, 0x5f, 0x25,
-	0x09, 0xdb, 0x95, 0x70, 0x48, 0x6a, 0xff, 0x5f, 0x04, 0x98, 0x5c, 0x6b, 0xa0, 0x2a, 0x64, 0xa2,
-	0x60, 0x9f, 0xb1, 0x2d, 0xe6, 0x07, 0xb1, 0xcd, 0x8c, 0xff, 0x47, 0xbb, 0xb0, 0x3d, 0xa2, 0x03,
-	0xcf, 0x30, 0xcf, 0x75, 0x79, 0x1b, 0x21, 0x62, 0x02, 0x0f, 0x9c, 0x15, 0xbc, 0x29, 0x1b, 0xe5,
-	0x92, 0x17, 0xb8, 0x07, 0x90, 0x25, 0xce, 0x05, 0x0f, 0x72, 0xe5, 0xdd, 0xfb, 0x0b, 0x5f, 0xb7,
-	0x34, 0xda, 0xce, 0x85, 0xf0, 0x15, 0x06, 0x83, 0x74, 0x00, 0x8b, 0x5c, 0xd8, 0x26, 0xd1, 0x19,
-	0x68, 0x9e, 0x83, 0x7e, 0xb1, 0x38, 0xe8, 0x1e, 0xc7, 0x88, 0xa0, 0x4b, 0x56, 0x48, 0xa3, 0x2e,
-	0x94, 0x7c, 0x42, 0xdd, 0xb1, 0x6f, 0x12, 0x11, 0xe9, 0xd2, 0x9f, 0x88, 0x70, 0x38, 0x0e, 0x4f,
-	0x20, 0xd0, 0x1e, 0x14, 0x78, 0x80, 0xa3, 0xb5, 0x55, 0x2e, 0xec, 0x47, 0x29, 0xc1, 0x78, 0x74,
-	0xc1, 0x72, 0x2c, 0x7a, 0x0c, 0xab, 0x42, 0x44, 0x5a, 0x2b, 0x72, 0x98, 0x8f, 0xd3, 0x46, 0x5f,
-	0x3e, 0x0a, 0x87, 0xa3, 0x99, 0x55, 0x59, 0x60, 0xe4, 0x71, 0xb1, 0x84, 0xf9, 0x7f, 0xf4, 0x1e,
-	0x94, 0xc4, 0x66, 0x6f, 0xd9, 0x7e, 0x0d, 0x84, 0x73, 0x72, 0xc6, 0x9e, 0xed, 0xa3, 0xf7, 0xa1,
-	0x2c, 0x92, 0x3a, 0x9d, 0x47, 0x85, 0x32, 0x6f, 0x06, 0xc1, 0x3a, 0x66, 0xb1, 0x41, 0x74, 0x20,
-	0xbe, 0x2f, 0x3a, 0x54, 0xa2, 0x0e, 0xc4, 0xf7, 0x79, 0x87, 0xdf, 0x81, 0x75, 0x9e, 0x0a, 0x0f,
-	0x7c, 0x77, 0xec, 0xe9, 0xdc, 0xa7, 0xd6, 0x78, 0xa7, 0x35, 0xc6, 0x7e, 0xcc, 0xb8, 0x5d, 0xe6,
-	0x5c, 0xb7, 0xa0, 0xf8, 0xca, 0x3d, 0x15, 0x1d, 0xaa, 0x62, 0x1d, 0xbc, 0x72, 0x4f, 0xc3, 0xa6,
-	0x28, 0x1d, 0x59, 0x4f, 0xa6, 0x23, 0x5f, 0xc3, 0x8d, 0xd9, 0x7d, 0x95, 0xa7, 0x25, 0xea, 0xf5,
-	0xd3, 0x92, 0x2d, 0x67, 0x5e, 0x1c, 0xfe, 0x12, 0xb2, 0x96, 0x43, 0x6b, 0x1b, 0x0b, 0x39, 0x47,
-	0xb4, 0x8e, 0x31, 0x1b, 0x8c, 0xb6, 0xa1, 0xc0, 0x3e, 0xd6, 0xb6, 0x6a, 0x48, 0x84, 0x9e, 0x57,
-	0xee, 0x69, 0xc7, 0x42, 0xdf, 0x81, 0x12, 0xfb, 0x7e, 0xea, 0x19, 0x26, 0xa9, 0x6d, 0xf2, 0x96,
-	0x09, 0x83, 0x19, 0xca, 0x71, 0x2d, 0x22, 0x54, 0xb4, 0x25, 0x0c, 0xc5, 0x18, 0x5c, 0x47, 0x37,
-	0x61, 0x95, 0x37, 0xda, 0x56, 0x6d, 0x5b, 0x9c, 0x38, 0x18, 0xd9, 0xb1, 0x90, 0x06, 0x6b, 0x9e,
-	0xe1, 0x13, 0x27, 0xd0, 0xe5, 0x8c, 0x37, 0x78, 0x73, 0x59, 0x30, 0x9f, 0xb0, 0x79, 0xeb, 0x9f,
-	0x42, 0x31, 0x5c, 0x0c, 0x8b, 0x84, 0xc9, 0xfa, 0x03, 0xa8, 0x26, 0x97, 0xd2, 0x42, 0x41, 0xf6,
-	0x9f, 0x33, 0x50, 0x8a, 0x16, 0x0d, 0x72, 0x60, 0x93, 0x1b, 0x95, 0xa5, 0xa6, 0xfa, 0x64, 0x0d,
-	0x8a, 0x84, 0xf8, 0xf3, 0x94, 0x6a, 0x6e, 0x86, 0x08, 0xf2, 0x64, 0x2e, 0x17, 0x24, 0x8a, 0x90,
-	0x27, 0xf3, 0x7d, 0x05, 0xeb, 0x43, 0xdb, 0x19, 0x5f, 0xc6, 0xe6, 0x12, 0x99, 0xec, 0xef, 0xa7,
-	0x9c, 0xeb, 0x80, 0x8d, 0x9e, 0xcc, 0x51, 0x1d, 0x26, 0x68, 0xb4, 0x0f, 0x79, 0xcf, 0xf5, 0x83,
-	0x70, 0xcf, 0x4c, 0xbb, 0x9b, 0x1d, 0xbb, 0x7e, 0x70, 0x68, 0x78, 0x1e, 0x3b, 0xac, 0x09, 0x00,
-	0xed, 0x9b, 0x0c, 0xdc, 0x98, 0xff, 0x61, 0xa8, 0x0b, 0x59, 0xd3, 0x1b, 0x4b, 0x25, 0x3d, 0x58,
-	0x54, 0x49, 0x2d, 0x6f, 0x3c, 0x91, 0x9f, 0x01, 0xa1, 0x67, 0x50, 0x18, 0x91, 0x91, 0xeb, 0x5f,
-	0x49, 0x5d, 0x3c, 0x5c, 0x14, 0xf2, 0x90, 0x8f, 0x9e, 0xa0, 0x4a, 0x38, 0x84, 0xa1, 0x28, 0x17,
-	0x13, 0x95, 0x61, 0x7b, 0xc1, 0xeb, 0xb4, 0x10, 0x12, 0x47, 0x38, 0xda, 0xa7, 0xb0, 0x3d, 0xf7,
-	0x53, 0xd0, 0x6f, 0x01, 0x98, 0xde, 0x58, 0xe7, 0xcf, 0x1d, 0xc2, 0x83, 0xb2, 0xb8, 0x64, 0x7a,
-	0xe3, 0x1e, 0x67, 0x68, 0x2f, 0xa0, 0xf6, 0x26, 0x79, 0xd9, 0x1a, 0x13, 0x12, 0xeb, 0xa3, 0x53,
-	0xae, 0x83, 0x2c, 0x2e, 0x0a, 0xc6, 0xe1, 0x29, 0x5b, 0x4a, 0x61, 0xa3, 0x71, 0xc9, 0x3a, 0x64,
-	0x79, 0x87, 0xb2, 0xec, 0x60, 0x5c, 0x1e, 0x9e, 0x6a, 0xbf, 0xc8, 0xc0, 0xfa, 0x94, 0xc8, 0xec,
-	0xc8, 0x2a, 0x02, 0x70, 0x78, 0x19, 0x20, 0x28, 0x16, 0x8d, 0x4d, 0xdb, 0x0a, 0xaf, 0x91, 0xf9,
-	0x7f, 0xbe, 0x0f, 0x7b, 0xf2, 0x8a, 0x37, 0x63, 0x7b, 0x6c, 0xf9, 0x8c, 0x4e, 0xed, 0x80, 0xf2,
-	0xa4, 0x28, 0x8f, 0x05, 0x81, 0x9e, 0x43, 0xd5, 0x27, 0x7c, 0xff, 0xb7, 0x74, 0xe1, 0x65, 0xf9,
-	0x85, 0xbc, 0x4c, 0x4a, 0xc8, 0x9c, 0x0d, 0xaf, 0x85, 0x48, 0x8c, 0xa2, 0xe8, 0x19, 0xac, 0x85,
-	0xc9, 0xb4, 0x40, 0x2e, 0x2c, 0x8d, 0x5c, 0x91, 0x40, 0x1c, 0x58, 0xbb, 0x07, 0xe5, 0x58, 0x23,
-	0xfb, 0x30, 0x9e, 0xfd, 0x49, 0x9d, 0x08, 0x22, 0x19, 0x2d, 0xf2, 0x32, 0x5a, 0x68, 0xa7, 0x50,
-	0x8e, 0xad, 0x8b, 0x45, 0x86, 0x32, 0x7d, 0x06, 0x2e, 0xd7, 0x67, 0x1e, 0x67, 0x02, 0x97, 0xc5,
-	0x49, 0x96, 0x79, 0xe9, 0xb6, 0xc7, 0x35, 0x5a, 0xc2, 0x05, 0x46, 0x76, 0x3c, 0xed, 0x57, 0x19,
-	0xa8, 0x26, 0x97, 0x74, 0xe8, 0x47, 0x1e, 0xf1, 0x6d, 0xd7, 0x8a, 0xf9, 0xd1, 0x31, 0x67, 0x30,
-	0x5f, 0x61, 0xcd, 0x5f, 0x8f, 0xdd, 0xc0, 0x08, 0x7d, 0xc5, 0xf4, 0xc6, 0x7f, 0xc0, 0xe8, 0x29,
-	0x1f, 0xcc, 0x4e, 0xf9, 0x20, 0xfa, 0x08, 0x90, 0x74, 0xa5, 0xa1, 0x3d, 0xb2, 0x03, 0xfd, 0xf4,
-	0x2a, 0x20, 0xc2, 0xc6, 0x59, 0xac, 0x8a, 0x96, 0x03, 0xd6, 0xf0, 0x25, 0xe3, 0x33, 0xc7, 0x73,
-	0xdd, 0x91, 0x4e, 0x4d, 0xd7, 0x27, 0xba, 0x61, 0xbd, 0xe2, 0xa7, 0xb5, 0x2c, 0x2e, 0xbb, 0xee,
-	0xa8, 0xc7, 0x78, 0x4d, 0xeb, 0x15, 0xdb, 0x88, 0x4d, 0x6f, 0x4c, 0x49, 0xa0, 0xb3, 0x1f, 0x9e,
-	0xbb, 0x94, 0x30, 0x08, 0x56, 0xcb, 0x1b, 0x53, 0xf4, 0x5d, 0x58, 0x0b, 0x3b, 0xf0, 0xbd, 0x58,
-	0x26, 0x01, 0x15, 0xd9, 0x85, 0xf3, 0x90, 0x06, 0x95, 0x63, 0xe2, 0x9b, 0xc4, 0x09, 0xfa, 0xb6,
-	0x79, 0x4e, 0xf9, 0xb1, 0x4b, 0xc1, 0x09, 0x9e, 0x3c, 0xb5, 0x84, 0xb3, 0x8d, 0xc8, 0x88, 0x6a,
-	0xff, 0xaa, 0x40, 0x9e, 0xa7, 0x2c, 0x4c, 0x29, 0x7c, 0xbb, 0xe7, 0xd9, 0x80, 0x4c, 0x75, 0x19,
-	0x83, 0xe7, 0x02, 0xef, 0x41, 0x89, 0x2b, 0x3f, 0x76, 0xc2, 0xe0, 0x79, 0x30, 0x6f, 0xac, 0x43,
-	0xd1, 0x27, 0x86, 0xe5, 0x3a, 0xc3, 0xf0, 0x16, 0x2c, 0xa2, 0xd1, 0xef, 0x82, 0xea, 0xf9, 0xae,
-	0x67, 0x0c, 0x26, 0x07, 0x67, 0x69, 0xbe, 0xf5, 0x18, 0x9f, 0xa7, 0xe8, 0xdf, 0x85, 0x35, 0x4a,
-	0x44, 0x64, 0x17, 0x4e, 0x92, 0x17, 0x9f, 0x29, 0x99, 0xfc, 0x44, 0xa0, 0x7d, 0x0d, 0x05, 0xb1,
-	0x71, 0x5d, 0x43, 0xde, 0x8f, 0x01, 0x09, 0x45, 0x32, 0x07, 0x19, 0xd9, 0x94, 0xca, 0x2c, 0x9b,
-	0x3f, 0xe5, 0x8a, 0x96, 0xe3, 0x49, 0x83, 0xf6, 0x5f, 0x8a, 0xc8, 0xb7, 0xc5, 0x23, 0x1b, 0x4b,
-	0xcc, 0xd9, 0xaa, 0x61, 0x47, 0x5b, 0x71, 0x9b, 0x17, 0x92, 0xa8, 0x03, 0x05, 0x99, 0x56, 0x67,
-	0x96, 0x7d, 0xa3, 0x94, 0x00, 0xe1, 0xdd, 0x3e, 0x91, 0x37, 0x1b, 0x8b, 0xde, 0xed, 0x13, 0x71,
-	0xb7, 0x4f, 0xd0, 0x07, 0x50, 0x91, 0x09, 0xbf, 0x80, 0xcb, 0xf1, 0x7c, 0xbf, 0x6c, 0x45, 0x0f,
-	0x28, 0x44, 0xfb, 0x5f, 0x25, 0x8a, 0x7b, 0xe1, 0x43, 0x07, 0xfa, 0x0a, 0x8a, 0x2c, 0x84, 0xe8,
-	0x23, 0xc3, 0x93, 0xcf, 0xf6, 0xad, 0xe5, 0xde, 0x50, 0xc2, 0x5d, 0x51, 0xa4, 0xeb, 0xab, 0x9e,
-	0xa0, 0x58, 0xfc, 0x64, 0x47, 0xa5, 0x30, 0x7e, 0xb2, 0xff, 0xe8, 0x43, 0xa8, 0x1a, 0xe3, 0xc0,
-	0xd5, 0x0d, 0xeb, 0x82, 0xf8, 0x81, 0x4d, 0x89, 0xf4, 0xa5, 0x35, 0xc6, 0x6d, 0x86, 0xcc, 0xfa,
-	0x7d, 0xa8, 0xc4, 0x31, 0xdf, 0x96, 0xb7, 0xe4, 0xe3, 0x79, 0xcb, 0x9f, 0x02, 0x4c, 0x2e, 0x0d,
-	0x99, 0x8f, 0x90, 0x4b, 0x3b, 0xd0, 0xcd, 0xf0, 0x6c, 0x9e, 0xc7, 0x45, 0xc6, 0x68, 0x31, 0x67,
-	0x4c, 0xbe, 0x68, 0xe4, 0xc3, 0x17, 0x0d, 0x16, 0x1d, 0xd8, 0x82, 0x3e, 0xb7, 0x87, 0xc3, 0xe8,
-	0x22, 0xb3, 0xe4, 0xba, 0xa3, 0xa7, 0x9c, 0xa1, 0xfd, 0x3a, 0x23, 0x7c, 0x45, 0xbc, 0x4d, 0xa5,
-	0x3a, 0x9b, 0xbd, 0x2b, 0x53, 0xdf, 0x03, 0xa0, 0x81, 0xe1, 0xb3, 0x24, 0xcc, 0x08, 0xaf, 0x52,
-	0xeb, 0x33, 0x4f, 0x22, 0xfd, 0xb0, 0x58, 0x06, 0x97, 0x64, 0xef, 0x66, 0x80, 0x3e, 0x87, 0x8a,
-	0xe9, 0x8e, 0xbc, 0x21, 0x91, 0x83, 0xf3, 0x6f, 0x1d, 0x5c, 0x8e, 0xfa, 0x37, 0x83, 0xd8, 0x05,
-	0x6e, 0xe1, 0xba, 0x17, 0xb8, 0xbf, 0x52, 0xc4, 0x13, 0x5b, 0xfc, 0x85, 0x0f, 0x0d, 0xe6, 0x94,
-	0x91, 0x3c, 0x5e, 0xf2, 0xb9, 0xf0, 0xdb, 0x6a, 0x48, 0xea, 0x9f, 0xa7, 0x29, 0xda, 0x78, 0x73,
-	0x5a, 0xfc, 0xef, 0x59, 0x28, 0x45, 0xaf, 0x6b, 0x33, 0xb6, 0xff, 0x0c, 0x4a, 0x51, 0xa5, 0x92,
-	0x0c, 0x10, 0xdf, 0x6a, 0x9e, 0xa8, 0x33, 0x7a, 0x09, 0xc8, 0x18, 0x0c, 0xa2, 0x74, 0x57, 0x1f,
-	0x53, 0x63, 0x10, 0xbe, 0x6d, 0x7e, 0xb6, 0x80, 0x1e, 0xc2, 0xfd, 0xf1, 0x84, 0x8d, 0xc7, 0xaa,
-	0x31, 0x18, 0x24, 0x38, 0xe8, 0xcf, 0x60, 0x3b, 0x39, 0x87, 0x7e, 0x7a, 0xa5, 0x7b, 0xb6, 0x25,
-	0xef, 0x00, 0xf6, 0x17, 0x7d, 0x60, 0x6c, 0x24, 0xe0, 0xbf, 0xbc, 0x3a, 0xb6, 0x2d, 0xa1, 0x73,
-	0xe4, 0xcf, 0x34, 0xd4, 0xff, 0x02, 0x6e, 0xbe, 0xa1, 0xfb, 0x1c, 0x1b, 0x74, 0x93, 0x85, 0x33,
-	0xcb, 0x2b, 0x21, 0x66, 0xbd, 0x5f, 0x2a, 0xe2, 0x1d, 0x34, 0xa9, 0x93, 0x66, 0x3c, 0x4f, 0xbf,
-	0x93, 0x72, 0x9e, 0xd6, 0xf1, 0x89, 0x80, 0xe7, 0xa9, 0xf9, 0x93, 0xa9, 0xd4, 0x3c, 0x6d, 0x42,
-	0x26, 0x32, 0x5c, 0x01, 0x24, 0x11, 0xb4, 0x7f, 0xc9, 0x42, 0x31, 0x44, 0xe7, 0x27, 0xf8, 0x2b,
-	0x1a, 0x90, 0x91, 0x1e, 0x5d, 0x2f, 0x2a, 0x18, 0x04, 0x8b, 0xef, 0xa8, 0xef, 0x41, 0x69, 0x4c,
-	0x89, 0x2f, 0x9a, 0x33, 0xbc, 0xb9, 0xc8, 0x18, 0xbc, 0xf1, 0x7d, 0x28, 0x07, 0x6e, 0x60, 0x0c,
-	0xf5, 0x80, 0xe7, 0x0b, 0x59, 0x31, 0x9a, 0xb3, 0x78, 0xb6, 0x80, 0xbe, 0x0f, 0x1b, 0xc1, 0x99,
-	0xef, 0x06, 0xc1, 0x90, 0xe5, 0xaa, 0x3c, 0x73, 0x12, 0x89, 0x4e, 0x0e, 0xab, 0x51, 0x83, 0xc8,
-	0xa8, 0x28, 0x8b, 0xde, 0x93, 0xce, 0xcc, 0x75, 0x79, 0x10, 0xc9, 0xe1, 0xb5, 0x88, 0xcb, 0x5c,
-	0x9b, 0x6d, 0x9e, 0x9e, 0xc8, 0x48, 0x78, 0xac, 0x50, 0x70, 0x48, 0x22, 0x1d, 0xd6, 0x47, 0xc4,
-	0xa0, 0x63, 0x9f, 0x58, 0xfa, 0x4b, 0x9b, 0x0c, 0x2d, 0x71, 0xf1, 0x52, 0x4d, 0x7d, 0xdc, 0x08,
-	0xd5, 0xd2, 0x78, 0xc4, 0x47, 0xe3, 0x6a, 0x08, 0x27, 0x68, 0x96, 0x39, 0x88, 0x7f, 0x68, 0x1d,
-	0xca, 0xbd, 0xe7, 0xbd, 0x7e, 0xfb, 0x50, 0x3f, 0x3c, 0xda, 0x6b, 0xcb, 0xda, 0xa8, 0x5e, 0x1b,
-	0x0b, 0x52, 0x61, 0xed, 0xfd, 0xa3, 0x7e, 0xf3, 0x40, 0xef, 0x77, 0x5a, 0x4f, 0x7b, 0x6a, 0x06,
-	0x6d, 0xc3, 0x46, 0x7f, 0x1f, 0x1f, 0xf5, 0xfb, 0x07, 0xed, 0x3d, 0xfd, 0xb8, 0x8d, 0x3b, 0x47,
-	0x7b, 0x3d, 0x35, 0x8b, 0x10, 0x54, 0x27, 0xec, 0x7e, 0xe7, 0xb0, 0xad, 0xe6, 0x50, 0x19, 0x56,
-	0x8f, 0xdb, 0xb8, 0xd5, 0xee, 0xf6, 0xd5, 0xbc, 0xf6, 0x8b, 0x2c, 0x94, 0x63, 0x56, 0x64, 0x8e,
-	0xec, 0x53, 0x71, 0xae, 0xc9, 0x61, 0xf6, 0x97, 0xbf, 0xe5, 0x1a, 0xe6, 0x99, 0xb0, 0x4e, 0x0e,
-	0x0b, 0x82, 0x9f, 0x65, 0x8c, 0xcb, 0xd8, 0x3a, 0xcf, 0xe1, 0xe2, 0xc8, 0xb8, 0x14, 0x20, 0x1f,
-	0x40, 0xe5, 0x9c, 0xf8, 0x0e, 0x19, 0xca, 0x76, 0x61, 0x91, 0xb2, 0xe0, 0x89, 0x2e, 0x3b, 0xa0,
-	0xca, 0x2e, 0x13, 0x18, 0x61, 0x8e, 0xaa, 0xe0, 0x1f, 0x86, 0x60, 0x5b, 0x90, 0x17, 0xcd, 0xab,
-	0x62, 0x7e, 0x4e, 0xb0, 0x6d, 0x8a, 0xbe, 0x36, 0x3c, 0x9e, 0x43, 0xe6, 0x30, 0xff, 0x8f, 0x4e,
-	0x67, 0xed, 0x53, 0xe0, 0xf6, 0xb9, 0xb7, 0xb8, 0x3b, 0xbf, 0xc9, 0x44, 0x67, 0x91, 0x89, 0x56,
-	0x21, 0x8b, 0xc3, 0x82, 0xa2, 0x56, 0xb3, 0xb5, 0xcf, 0xcc, 0xb2, 0x06, 0xa5, 0xc3, 0xe6, 0x4f,
-	0xf4, 0x93, 0x9e, 0xb8, 0xd5, 0x57, 0xa1, 0xf2, 0xb4, 0x8d, 0xbb, 0xed, 0x03, 0xc9, 0xc9, 0xa2,
-	0x2d, 0x50, 0x25, 0x67, 0xd2, 0x2f, 0xc7, 0x10, 0xc4, 0xdf, 0x3c, 0x2a, 0x42, 0xae, 0xf7, 0xac,
-	0x79, 0xac, 0x16, 0xb4, 0xff, 0xc9, 0xc0, 0xba, 0xd8, 0x16, 0xa2, 0xd2, 0x87, 0x37, 0x3f, 0xfd,
-	0xc6, 0x6f, 0xb1, 0x32, 0xc9, 0x5b, 0xac, 0x30, 0x09, 0xe5, 0xbb, 0x7a, 0x76, 0x92, 0x84, 0xf2,
-	0x9b, 0x9d, 0x44, 0xc4, 0xcf, 0x2d, 0x12, 0xf1, 0x6b, 0xb0, 0x3a, 0x22, 0x34, 0xb2, 0x5b, 0x09,
-	0x87, 0x24, 0xb2, 0xa1, 0x6c, 0x38, 0x8e, 0x1b, 0x18, 0xe2, 0x6a, 0xb8, 0xb0, 0xd0, 0x66, 0x38,
-	0xf5, 0xc5, 0x8d, 0xe6, 0x04, 0x49, 0x04, 0xe6, 0x38, 0x76, 0xfd, 0xc7, 0xa0, 0x4e, 0x77, 0x58,
-	0x64, 0x3b, 0xfc, 0xde, 0x0f, 0x26, 0xbb, 0x21, 0x61, 0xeb, 0x42, 0xbe, 0xb3, 0xa8, 0x2b, 0x8c,
-	0xc0, 0x27, 0xdd, 0x6e, 0xa7, 0xfb, 0x58, 0x55, 0x10, 0x40, 0xa1, 0xfd, 0x93, 0x4e, 0xbf, 0xbd,
-	0xa7, 0x66, 0x76, 0x7f, 0xb9, 0x01, 0x05, 0x21, 0x24, 0xfa, 0x46, 0x66, 0x02, 0xf1, 0xb2, 0x5a,
-	0xf4, 0xe3, 0x85, 0x33, 0xea, 0x44, 0xa9, 0x6e, 0xfd, 0xe1, 0xd2, 0xe3, 0xe5, 0x33, 0xe6, 0x0a,
-	0xfa, 0x1b, 0x05, 0x2a, 0x89, 0x27, 0xcc, 0xb4, 0x57, 0xe3, 0x73, 0xaa, 0x78, 0xeb, 0x3f, 0x5a,
-	0x6a, 0x6c, 0x24, 0xcb, 0xcf, 0x15, 0x28, 0xc7, 0xea, 0x57, 0xd1, 0xbd, 0x65, 0x6a, 0x5e, 0x85,
-	0x24, 0xf7, 0x97, 0x2f, 0x97, 0xd5, 0x56, 0x3e, 0x51, 0xd0, 0x5f, 0x2b, 0x50, 0x8e, 0x55, 0x72,
-	0xa6, 0x16, 0x65, 0xb6, 0xee, 0x34, 0xb5, 0x28, 0xf3, 0x0a, 0x47, 0x57, 0xd0, 0x5f, 0x2a, 0x50,
-	0x8a, 0xaa, 0x32, 0xd1, 0xdd, 0xc5, 0xeb, 0x38, 0x85, 0x10, 0x9f, 0x2d, 0x5b, 0x00, 0xaa, 0xad,
-	0xa0, 0x3f, 0x87, 0x62, 0x58, 0xc2, 0x88, 0xd2, 0xee, 0x5e, 0x53, 0xf5, 0x91, 0xf5, 0xbb, 0x0b,
-	0x8f, 0x8b, 0x4f, 0x1f, 0xd6, 0x15, 0xa6, 0x9e, 0x7e, 0xaa, 0x02, 0xb2, 0x7e, 0x77, 0xe1, 0x71,
-	0xd1, 0xf4, 0xcc, 0x13, 0x62, 0xe5, 0x87, 0xa9, 0x3d, 0x61, 0xb6, 0xee, 0x31, 0xb5, 0x27, 0xcc,
-	0xab, 0x76, 0x14, 0x82, 0xc4, 0x0a, 0x18, 0x53, 0x0b, 0x32, 0x5b, 0x24, 0x99, 0x5a, 0x90, 0x39,
-	0xf5, 0x92, 0xda, 0x0a, 0xfa, 0x99, 0x12, 0x3f, 0x17, 0xdc, 0x5d, 0xb8, 0x4e, 0x6f, 0x41, 0x97,
-	0x9c, 0xa9, 0x14, 0xe4, 0x0b, 0xf4, 0x67, 0xf2, 0x16, 0x43, 0x94, 0xf9, 0xa1, 0x45, 0xc0, 0x12,
-	0x95, 0x81, 0xf5, 0x4f, 0x97, 0xdb, 0x6c, 0xb8, 0x10, 0x7f, 0xa5, 0x00, 0x4c, 0x0a, 0x02, 0x53,
-	0x0b, 0x31, 0x53, 0x89, 0x58, 0xbf, 0xb7, 0xc4, 0xc8, 0xf8, 0x02, 0x09, 0x0b, 0x96, 0x52, 0x2f,
-	0x90, 0xa9, 0x82, 0xc5, 0xd4, 0x0b, 0x64, 0xba, 0xd8, 0x50, 0x5b, 0x41, 0xff, 0xa4, 0xc0, 0xc6,
-	0x4c, 0xc1, 0x14, 0x7a, 0x78, 0xcd, 0x9a, 0xb9, 0xfa, 0x17, 0xcb, 0x03, 0x84, 0xa2, 0xed, 0x28,
-	0x9f, 0x28, 0xe8, 0x6f, 0x15, 0x58, 0x4b, 0x16, 0x92, 0xa4, 0xde, 0xa5, 0xe6, 0x94, 0x5e, 0xd5,
-	0x1f, 0x2c, 0x37, 0x38, 0xd2, 0xd6, 0xdf, 0x2b, 0x50, 0x4d, 0xd6, 0x14, 0xa1, 0x07, 0x8b, 0x85,
-	0x85, 0x29, 0x81, 0x3e, 0x5f, 0x72, 0x74, 0x28, 0xd1, 0x97, 0xab, 0x7f, 0x94, 0x17, 0xd9, 0x5b,
-	0x81, 0xff, 0xfc, 0xf0, 0x37, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4f, 0xda, 0xef, 0xe8, 0xfd, 0x34,
-	0x00, 0x00,
+	// 4302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0x4f, 0x73, 0xdb, 0x48,
+	0x76, 0x37, 0x48, 0x91, 0x22, 0x1f, 0x29, 0x0a, 0x6a, 0x49, 0x36, 0xcd, 0xd9, 0xdd, 0xf1, 0x62,
+	0x6b, 0x12, 0x67, 0x67, 0x86, 0x9e, 0xd5, 0x26, 0xe3, 0x3f, 0x63, 0xaf, 0x87, 0xa6, 0x68, 0x8b,
+	0xb6, 0x44, 0x29, 0x4d, 0x2a, 0x5e, 0xc7, 0xc9, 0x20, 0x10, 0xd0, 0xa2, 0x60, 0x91, 0x00, 0x06,
+	0x0d, 0xca, 0xd2, 0xa4, 0x52, 0x49, 0x6d, 0x6a, 0x53, 0x9b, 0xaa, 0xa4, 0x92, 0xcb, 0x64, 0x0f,
+	0x49, 0xe5, 0x96, 0x53, 0x2a, 0xf7, 0xd4, 0xa6, 0xf6, 0xb4, 0x87, 0x7c, 0x89, 0x5c, 0x72, 0x4b,
+	0xe5, 0x94, 0x7c, 0x82, 0xa4, 0xfa, 0x0f, 0x40, 0x80, 0xa4, 0xc7, 0x20, 0xe5, 0x3d, 0x89, 0xef,
+	0x75, 0xbf, 0x5f, 0x3f, 0xbc, 0x7e, 0xfd, 0xfa, 0x75, 0xf7, 0x13, 0x68, 0xde, 0x60, 0xd4, 0xb7,
+	0x1d, 0x7a, 0xcb, 0xf2, 0xed, 0x33, 0xe2, 0xd3, 0x5b, 0x9e, 0xef, 0x06, 0xae, 0xa4, 0xea, 0x9c,
+	0x40, 0x1f, 0x9c, 0x18, 0xf4, 0xc4, 0x36, 0x5d, 0xdf, 0xab, 0x3b, 0xee, 0xd0, 0xb0, 0xea, 0x52,
+	0xa6, 0x2e, 0x65, 0x44, 0xb7, 0xda, 0x77, 0xfa, 0xae, 0xdb, 0x1f, 0x10, 0x81, 0x70, 0x34, 0x3a,
+	0xbe, 0x65, 0x8d, 0x7c, 0x23, 0xb0, 0x5d, 0x47, 0xb6, 0xbf, 0x3f, 0xd9, 0x1e, 0xd8, 0x43, 0x42,
+	0x03, 0x63, 0xe8, 0xc9, 0x0e, 0x1f, 0x84, 0xba, 0xd0, 0x13, 0xc3, 0x27, 0xd6, 0xad, 0x13, 0x73,
+	0x40, 0x3d, 0x62, 0xb2, 0xbf, 0x3a, 0xfb, 0x21, 0xbb, 0x7d, 0x34, 0xd1, 0x8d, 0x06, 0xfe, 0xc8,
+	0x0c, 0x42, 0xcd, 0x8d, 0x20, 0xf0, 0xed, 0xa3, 0x51, 0x40, 0x44, 0x6f, 0xed, 0x3a, 0x5c, 0xeb,
+	0x19, 0xf4, 0xb4, 0xe9, 0x3a, 0xc7, 0x76, 0xbf, 0x6b, 0x9e, 0x90, 0xa1, 0x81, 0xc9, 0x97, 0x23,
+	0x42, 0x03, 0xed, 0x0f, 0xa0, 0x3a, 0xdd, 0x44, 0x3d, 0xd7, 0xa1, 0x04, 0x7d, 0x0e, 0x4b, 0x6c,
+	0xc8, 0xaa, 0x72, 0x43, 0xb9, 0x59, 0xda, 0xfa, 0xa8, 0xfe, 0x26, 0x13, 0x08, 0x1d, 0xea, 0x52,
+	0xd5, 0x7a, 0xd7, 0x23, 0x26, 0xe6, 0x92, 0xda, 0x26, 0xac, 0x37, 0x0d, 0xcf, 0x38, 0xb2, 0x07,
+	0x76, 0x60, 0x13, 0x1a, 0x0e, 0x3a, 0x82, 0x8d, 0x24, 0x5b, 0x0e, 0xf8, 0x87, 0x50, 0x36, 0x63,
+	0x7c, 0x39, 0xf0, 0xdd, 0x7a, 0x2a, 0xdb, 0xd7, 0xb7, 0x39, 0x95, 0x00, 0x4e, 0xc0, 0x69, 0x1b,
+	0x80, 0x1e, 0xdb, 0x4e, 0x9f, 0xf8, 0x9e, 0x6f, 0x3b, 0x41, 0xa8, 0xcc, 0x2f, 0xb3, 0xb0, 0x9e,
+	0x60, 0x4b, 0x65, 0x5e, 0x01, 0x44, 0x76, 0x64, 0xaa, 0x64, 0x6f, 0x96, 0xb6, 0x9e, 0xa6, 0x54,
+	0x65, 0x06, 0x5e, 0xbd, 0x11, 0x81, 0xb5, 0x9c, 0xc0, 0xbf, 0xc0, 0x31, 0x74, 0xf4, 0x05, 0xe4,
+	0x4f, 0x88, 0x31, 0x08, 0x4e, 0xaa, 0x99, 0x1b, 0xca, 0xcd, 0xca, 0xd6, 0xe3, 0x4b, 0x8c, 0xb3,
+	0xc3, 0x81, 0xba, 0x81, 0x11, 0x10, 0x2c, 0x51, 0xd1, 0xc7, 0x80, 0xc4, 0x2f, 0xdd, 0x22, 0xd4,
+	0xf4, 0x6d, 0x8f, 0xb9, 0x64, 0x35, 0x7b, 0x43, 0xb9, 0x59, 0xc4, 0x6b, 0xa2, 0x65, 0x7b, 0xdc,
+	0x50, 0xf3, 0x60, 0x75, 0x42, 0x5b, 0xa4, 0x42, 0xf6, 0x94, 0x5c, 0xf0, 0x19, 0x29, 0x62, 0xf6,
+	0x13, 0x3d, 0x81, 0xdc, 0x99, 0x31, 0x18, 0x11, 0xae, 0x72, 0x69, 0xeb, 0x07, 0x6f, 0x73, 0x0f,
+	0xe9, 0xa2, 0x63, 0x3b, 0x60, 0x21, 0x7f, 0x2f, 0x73, 0x47, 0xd1, 0xee, 0x42, 0x29, 0xa6, 0x37,
+	0xaa, 0x00, 0x1c, 0x76, 0xb6, 0x5b, 0xbd, 0x56, 0xb3, 0xd7, 0xda, 0x56, 0xaf, 0xa0, 0x15, 0x28,
+	0x1e, 0x76, 0x76, 0x5a, 0x8d, 0xdd, 0xde, 0xce, 0x0b, 0x55, 0x41, 0x25, 0x58, 0x0e, 0x89, 0x8c,
+	0x76, 0x0e, 0x08, 0x13, 0xd3, 0x3d, 0x23, 0x3e, 0x73, 0x64, 0x39, 0xab, 0xe8, 0x1a, 0x2c, 0x07,
+	0x06, 0x3d, 0xd5, 0x6d, 0x4b, 0xea, 0x9c, 0x67, 0x64, 0xdb, 0x42, 0x6d, 0xc8, 0x9f, 0x18, 0x8e,
+	0x35, 0x78, 0xbb, 0xde, 0x49, 0x53, 0x33, 0xf0, 0x1d, 0x2e, 0x88, 0x25, 0x00, 0xf3, 0xee, 0xc4,
+	0xc8, 0x62, 0x02, 0xb4, 0x17, 0xa0, 0x76, 0x03, 0xc3, 0x0f, 0xe2, 0xea, 0xb4, 0x60, 0x89, 0x8d,
+	0x5f, 0x55, 0xe6, 0x1e, 0x53, 0xac, 0x4c, 0xcc, 0xc5, 0xb5, 0xff, 0xcd, 0xc0, 0x5a, 0x0c, 0x5b,
+	0x7a, 0xea, 0x73, 0xc8, 0xfb, 0x84, 0x8e, 0x06, 0x01, 0x87, 0xaf, 0x6c, 0x3d, 0x4c, 0x09, 0x3f,
+	0x85, 0x54, 0xc7, 0x1c, 0x06, 0x4b, 0x38, 0x74, 0x13, 0x54, 0x21, 0xa1, 0x13, 0xdf, 0x77, 0x7d,
+	0x7d, 0x48, 0xfb, 0xdc, 0x6a, 0x45, 0x5c, 0x11, 0xfc, 0x16, 0x63, 0xef, 0xd1, 0x7e, 0xcc, 0xaa,
+	0xd9, 0x4b, 0x5a, 0x15, 0x19, 0xa0, 0x3a, 0x24, 0x78, 0xed, 0xfa, 0xa7, 0x3a, 0x33, 0xad, 0x6f,
+	0x5b, 0xa4, 0xba, 0xc4, 0x41, 0x3f, 0x4d, 0x09, 0xda, 0x11, 0xe2, 0xfb, 0x52, 0x1a, 0xaf, 0x3a,
+	0x49, 0x86, 0xf6, 0x21, 0xe4, 0xc5, 0x97, 0x32, 0x4f, 0xea, 0x1e, 0x36, 0x9b, 0xad, 0x6e, 0x57,
+	0xbd, 0x82, 0x8a, 0x90, 0xc3, 0xad, 0x1e, 0x66, 0x1e, 0x56, 0x84, 0xdc, 0xe3, 0x46, 0xaf, 0xb1,
+	0xab, 0x66, 0xb4, 0xef, 0xc3, 0xea, 0x73, 0xc3, 0x0e, 0xd2, 0x38, 0x97, 0xe6, 0x82, 0x3a, 0xee,
+	0x2b, 0x67, 0xa7, 0x9d, 0x98, 0x9d, 0xf4, 0xa6, 0x69, 0x9d, 0xdb, 0xc1, 0xc4, 0x7c, 0xa8, 0x90,
+	0x25, 0xbe, 0x2f, 0xa7, 0x80, 0xfd, 0xd4, 0x5e, 0xc3, 0x6a, 0x37, 0x70, 0xbd, 0x54, 0x9e, 0xff,
+	0x43, 0x58, 0x66, 0xbb, 0x8d, 0x3b, 0x0a, 0xa4, 0xeb, 0x5f, 0xaf, 0x8b, 0xdd, 0xa8, 0x1e, 0xee,
+	0x46, 0xf5, 0x6d, 0xb9, 0x5b, 0xe1, 0xb0, 0x27, 0xba, 0x0a, 0x79, 0x6a, 0xf7, 0x1d, 0x63, 0x20,
+	0xa3, 0x85, 0xa4, 0x34, 0x04, 0xea, 0x78, 0x60, 0xe9, 0xf8, 0x4d, 0x40, 0xdb, 0x84, 0x06, 0xbe,
+	0x7b, 0x91, 0x4a, 0x9f, 0x0d, 0xc8, 0x1d, 0xbb, 0xbe, 0x29, 0x16, 0x62, 0x01, 0x0b, 0x82, 0x2d,
+	0xaa, 0x04, 0x88, 0xc4, 0xfe, 0x18, 0x50, 0xdb, 0x61, 0x7b, 0x4a, 0xba, 0x89, 0xf8, 0xdb, 0x0c,
+	0xac, 0x27, 0xfa, 0xcb, 0xc9, 0x58, 0x7c, 0x1d, 0xb2, 0xc0, 0x34, 0xa2, 0x62, 0x1d, 0xa2, 0x7d,
+	0xc8, 0x8b, 0x1e, 0xd2, 0x92, 0xb7, 0xe7, 0x00, 0x12, 0xdb, 0x94, 0x84, 0x93, 0x30, 0x33, 0x9d,
+	0x3e, 0xfb, 0x6e, 0x9d, 0xfe, 0x35, 0xa8, 0xe1, 0x77, 0xd0, 0xb7, 0xce, 0xcd, 0x53, 0x58, 0x37,
+	0xdd, 0xc1, 0x80, 0x98, 0xcc, 0x1b, 0x74, 0xdb, 0x09, 0x88, 0x7f, 0x66, 0x0c, 0xde, 0xee, 0x37,
+	0x68, 0x2c, 0xd5, 0x96, 0x42, 0xda, 0x4b, 0x58, 0x8b, 0x0d, 0x2c, 0x27, 0xe2, 0x31, 0xe4, 0x28,
+	0x63, 0xc8, 0x99, 0xf8, 0x64, 0xce, 0x99, 0xa0, 0x58, 0x88, 0x6b, 0xeb, 0x02, 0xbc, 0x75, 0x46,
+	0x9c, 0xe8, 0xb3, 0xb4, 0x6d, 0x58, 0xeb, 0x72, 0x37, 0x4d, 0xe5, 0x87, 0x63, 0x17, 0xcf, 0x24,
+	0x5c, 0x7c, 0x03, 0x50, 0x1c, 0x45, 0x3a, 0xe2, 0x05, 0xac, 0xb6, 0xce, 0x89, 0x99, 0x0a, 0xb9,
+	0x0a, 0xcb, 0xa6, 0x3b, 0x1c, 0x1a, 0x8e, 0x55, 0xcd, 0xdc, 0xc8, 0xde, 0x2c, 0xe2, 0x90, 0x8c,
+	0xaf, 0xc5, 0x6c, 0xda, 0xb5, 0xa8, 0xfd, 0xb5, 0x02, 0xea, 0x78, 0x6c, 0x69, 0x48, 0xa6, 0x7d,
+	0x60, 0x31, 0x20, 0x36, 0x76, 0x19, 0x4b, 0x4a, 0xf2, 0xc3, 0x70, 0x21, 0xf8, 0xc4, 0xf7, 0x63,
+	0xe1, 0x28, 0x7b, 0xc9, 0x70, 0xa4, 0xed, 0xc0, 0xb7, 0x42, 0x75, 0xba, 0x81, 0x4f, 0x8c, 0xa1,
+	0xed, 0xf4, 0xdb, 0xfb, 0xfb, 0x1e, 0x11, 0x8a, 0x23, 0x04, 0x4b, 0x96, 0x11, 0x18, 0x52, 0x31,
+	0xfe, 0x9b, 0x2d, 0x7a, 0x73, 0xe0, 0xd2, 0x68, 0xd1, 0x73, 0x42, 0xfb, 0xf7, 0x2c, 0x54, 0xa7,
+	0xa0, 0x42, 0xf3, 0xbe, 0x84, 0x1c, 0x25, 0xc1, 0xc8, 0x93, 0xae, 0xd2, 0x4a, 0xad, 0xf0, 0x6c,
+	0xbc, 0x7a, 0x97, 0x81, 0x61, 0x81, 0x89, 0xfa, 0x50, 0x08, 0x82, 0x0b, 0x9d, 0xda, 0x5f, 0x85,
+	0x09, 0xc1, 0xee, 0x65, 0xf1, 0x7b, 0xc4, 0x1f, 0xda, 0x8e, 0x31, 0xe8, 0xda, 0x5f, 0x11, 0xbc,
+	0x1c, 0x04, 0x17, 0xec, 0x07, 0x7a, 0xc1, 0x1c, 0xde, 0xb2, 0x1d, 0x69, 0xf6, 0xe6, 0xa2, 0xa3,
+	0xc4, 0x0c, 0x8c, 0x05, 0x62, 0x6d, 0x17, 0x72, 0xfc, 0x9b, 0x16, 0x71, 0x44, 0x15, 0xb2, 0x41,
+	0x70, 0xc1, 0x95, 0x2a, 0x60, 0xf6, 0xb3, 0x76, 0x1f, 0xca, 0xf1, 0x2f, 0x60, 0x8e, 0x74, 0x42,
+	0xec, 0xfe, 0x89, 0x70, 0xb0, 0x1c, 0x96, 0x14, 0x9b, 0xc9, 0xd7, 0xb6, 0x25, 0x53, 0xd6, 0x1c,
+	0x16, 0x84, 0xf6, 0xaf, 0x19, 0xb8, 0x3e, 0xc3, 0x32, 0xd2, 0x59, 0x5f, 0x26, 0x9c, 0xf5, 0x1d,
+	0x59, 0x21, 0xf4, 0xf8, 0x97, 0x09, 0x8f, 0x7f, 0x87, 0xe0, 0x6c, 0xd9, 0x5c, 0x85, 0x3c, 0x39,
+	0xb7, 0x03, 0x62, 0x49, 0x53, 0x49, 0x2a, 0xb6, 0x9c, 0x96, 0x2e, 0xbb, 0x9c, 0xf6, 0x60, 0xa3,
+	0xe9, 0x13, 0x23, 0x20, 0x32, 0x94, 0x87, 0xfe, 0x7f, 0x1d, 0x0a, 0xc6, 0x60, 0xe0, 0x9a, 0xe3,
+	0x69, 0x5d, 0xe6, 0x74, 0xdb, 0x42, 0x35, 0x28, 0x9c, 0xb8, 0x34, 0x70, 0x8c, 0x21, 0x91, 0xc1,
+	0x2b, 0xa2, 0xb5, 0xaf, 0x15, 0xd8, 0x9c, 0xc0, 0x93, 0xb3, 0x70, 0x04, 0x15, 0x9b, 0xba, 0x03,
+	0xfe, 0x81, 0x7a, 0xec, 0x84, 0xf7, 0xd9, 0x7c, 0x5b, 0x4d, 0x3b, 0xc4, 0xe0, 0x07, 0xbe, 0x15,
+	0x3b, 0x4e, 0x72, 0x8f, 0xe3, 0x83, 0x5b, 0x72, 0xa5, 0x87, 0xa4, 0xf6, 0x77, 0x0a, 0x6c, 0xca,
+	0x1d, 0x3e, 0xfd, 0x87, 0x4e, 0xab, 0x9c, 0x79, 0xd7, 0x2a, 0x6b, 0x55, 0xb8, 0x3a, 0xa9, 0x97,
+	0x8c, 0xf9, 0x01, 0x6c, 0x36, 0x4f, 0x88, 0x79, 0xea, 0xb9, 0xb6, 0x93, 0x2a, 0xff, 0x40, 0xdf,
+	0x06, 0xb0, 0x87, 0x46, 0x9f, 0xe8, 0x9e, 0x21, 0x57, 0x48, 0x11, 0x17, 0x39, 0xe7, 0xc0, 0x08,
+	0x4e, 0xd0, 0xf7, 0x60, 0x65, 0x40, 0x8c, 0x33, 0xa2, 0xfb, 0x23, 0xc7, 0xb1, 0x9d, 0xbe, 0x74,
+	0xaa, 0x32, 0x67, 0x62, 0xc1, 0x63, 0xfa, 0x4c, 0x8e, 0x2a, 0xf5, 0xf9, 0x8a, 0x1d, 0x79, 0x68,
+	0xe0, 0xfa, 0xe4, 0xdd, 0x9f, 0x31, 0xde, 0xa2, 0xba, 0xf6, 0x2b, 0x05, 0xd6, 0x13, 0x83, 0x8f,
+	0xd3, 0x5c, 0x79, 0x02, 0x50, 0x7e, 0x1d, 0x27, 0x80, 0xcc, 0xbb, 0x4d, 0x86, 0x7e, 0xaa, 0x40,
+	0xed, 0xd0, 0xb3, 0x8c, 0x20, 0xfc, 0x08, 0x77, 0xe4, 0x9b, 0xe4, 0xed, 0x79, 0x51, 0x07, 0x8a,
+	0x7e, 0xd8, 0xb9, 0x9a, 0x99, 0x2b, 0x75, 0x19, 0x0f, 0x32, 0x86, 0xd0, 0xbe, 0x0d, 0xef, 0xcd,
+	0x54, 0x43, 0x4e, 0xf4, 0x87, 0xa0, 0x1e, 0x18, 0x23, 0x4a, 0x52, 0xe5, 0xbc, 0xeb, 0xb0, 0x16,
+	0xeb, 0x2c, 0x11, 0x3e, 0x82, 0x35, 0x16, 0x66, 0x86, 0xe9, 0x20, 0x36, 0x00, 0xc5, 0x7b, 0x4b,
+	0x8c, 0x23, 0xb8, 0xce, 0xe8, 0x03, 0xdf, 0x3d, 0xb3, 0x2d, 0x42, 0xb1, 0xeb, 0x06, 0x8f, 0xbb,
+	0xef, 0xf8, 0x64, 0xdb, 0x82, 0xda, 0xac, 0x31, 0xa4, 0x73, 0xfd, 0x26, 0xac, 0x7a, 0xb2, 0x45,
+	0xf7, 0x5d, 0x37, 0x38, 0x16, 0x79, 0x63, 0x01, 0x57, 0xbc, 0x98, 0xc0, 0x31, 0xd5, 0xfe, 0x3b,
+	0x0f, 0x68, 0xfa, 0x1e, 0x08, 0x7d, 0x17, 0xca, 0x94, 0x38, 0x96, 0x2e, 0x32, 0xbb, 0x50, 0xb8,
+	0xc4, 0x78, 0x22, 0xc5, 0xa3, 0x2c, 0x59, 0x21, 0xe7, 0x32, 0xae, 0x14, 0x30, 0xff, 0x8d, 0x4e,
+	0xa0, 0x7c, 0x4c, 0xf5, 0x28, 0x4a, 0xf0, 0x55, 0x5a, 0x49, 0x9d, 0x80, 0x4c, 0xeb, 0x51, 0x7f,
+	0xdc, 0x8d, 0x22, 0x10, 0x2e, 0x1d, 0xd3, 0x88, 0x40, 0x3f, 0x53, 0xe0, 0x5a, 0xe8, 0xf3, 0xe3,
+	0x40, 0x37, 0x74, 0x2d, 0x42, 0xab, 0x4b, 0x37, 0xb2, 0x37, 0x2b, 0x5b, 0x07, 0x97, 0x88, 0x74,
+	0x53, 0xcc, 0x3d, 0xd7, 0x22, 0x78, 0xd3, 0x99, 0xc1, 0xa5, 0xa8, 0x0e, 0xeb, 0xc3, 0x11, 0x0d,
+	0x74, 0x11, 0xaf, 0x75, 0xd9, 0xa9, 0x9a, 0xe3, 0x76, 0x59, 0x63, 0x4d, 0x89, 0x5d, 0x05, 0x9d,
+	0xc2, 0xca, 0xd0, 0x1d, 0x39, 0x81, 0x6e, 0xf2, 0xf9, 0xa4, 0xd5, 0xfc, 0x5c, 0x57, 0x58, 0x33,
+	0xac, 0xb4, 0xc7, 0xe0, 0x84, 0x77, 0x50, 0x5c, 0x1e, 0xc6, 0x28, 0xf4, 0xdb, 0x70, 0xd5, 0xb2,
+	0xa9, 0x71, 0x34, 0x20, 0xfa, 0xc0, 0xed, 0xeb, 0xe3, 0xd3, 0x46, 0xb5, 0xc0, 0xf5, 0xdb, 0x90,
+	0xad, 0xbb, 0x6e, 0xbf, 0x19, 0xb5, 0x71, 0xa9, 0x0b, 0xc7, 0x18, 0xda, 0xa6, 0xce, 0x54, 0x1e,
+	0xb8, 0x86, 0xa5, 0x8f, 0x28, 0xf1, 0x69, 0xb5, 0x28, 0xa5, 0x44, 0xeb, 0x73, 0xd9, 0x78, 0xc8,
+	0xda, 0xd0, 0x77, 0x00, 0xcc, 0x28, 0xfe, 0x56, 0x81, 0xf7, 0x8c, 0x71, 0xd0, 0x6f, 0x81, 0x3a,
+	0xe2, 0x6b, 0x57, 0x1f, 0x87, 0x84, 0x12, 0xef, 0xb5, 0x2a, 0xf8, 0xd1, 0x7a, 0x66, 0xb9, 0x92,
+	0xc7, 0x96, 0x66, 0xb5, 0x2c, 0xb2, 0x5e, 0x4e, 0xcc, 0xf2, 0xea, 0x95, 0x99, 0x5e, 0x7d, 0x0f,
+	0x4a, 0x31, 0xcf, 0x41, 0x05, 0x58, 0xea, 0xec, 0x77, 0x5a, 0xea, 0x15, 0x04, 0x90, 0x6f, 0xee,
+	0xe0, 0xfd, 0xfd, 0x9e, 0xb8, 0xb2, 0x68, 0xef, 0x35, 0x9e, 0xb4, 0xd4, 0x0c, 0x63, 0x1f, 0x76,
+	0x7e, 0xaf, 0xd5, 0xde, 0x55, 0xb3, 0x5a, 0x0b, 0xca, 0x71, 0x7b, 0x22, 0x04, 0x95, 0xc3, 0xce,
+	0xb3, 0xce, 0xfe, 0xf3, 0x8e, 0xbe, 0xb7, 0x7f, 0xd8, 0xe9, 0xb1, 0x8b, 0x8f, 0x0a, 0x40, 0xa3,
+	0xf3, 0x62, 0x4c, 0xaf, 0x40, 0xb1, 0xb3, 0x1f, 0x92, 0x4a, 0x2d, 0xa3, 0x2a, 0x4f, 0x97, 0x0a,
+	0xcb, 0x6a, 0x01, 0x97, 0x7d, 0x32, 0x74, 0x03, 0xa2, 0xb3, 0x25, 0x4b, 0xb5, 0x5f, 0x65, 0x61,
+	0x63, 0x96, 0xbb, 0x21, 0x0b, 0x96, 0x98, 0xeb, 0xca, 0xeb, 0xa8, 0x77, 0xef, 0xb9, 0x1c, 0x9d,
+	0xad, 0xd8, 0xd8, 0x16, 0xc5, 0x7f, 0x23, 0x1d, 0xf2, 0x03, 0xe3, 0x88, 0x0c, 0x68, 0x35, 0xcb,
+	0x2f, 0x6c, 0x9f, 0x5c, 0x66, 0xec, 0x5d, 0x8e, 0x24, 0x6e, 0x6b, 0x25, 0x2c, 0xea, 0x41, 0x89,
+	0x65, 0x58, 0x54, 0x98, 0x53, 0x26, 0x7d, 0x5b, 0x29, 0x47, 0xd9, 0x19, 0x4b, 0xe2, 0x38, 0x4c,
+	0xed, 0x2e, 0x94, 0x62, 0x83, 0xcd, 0xb8, 0x6c, 0xdd, 0x88, 0x5f, 0xb6, 0x16, 0xe3, 0x37, 0xa7,
+	0x0f, 0x61, 0x63, 0x96, 0x8d, 0x98, 0x93, 0xec, 0xec, 0x77, 0x7b, 0xe2, 0x5a, 0xeb, 0x09, 0xde,
+	0x3f, 0x3c, 0x50, 0x15, 0xc6, 0xec, 0x35, 0xba, 0xcf, 0xd4, 0x4c, 0xe4, 0x43, 0x59, 0xad, 0x09,
+	0xa5, 0x98, 0x5e, 0x89, 0x94, 0x52, 0x49, 0xa6, 0x94, 0x2c, 0xa9, 0x33, 0x2c, 0xcb, 0x27, 0x94,
+	0x4a, 0x3d, 0x42, 0x52, 0x7b, 0x09, 0xc5, 0xed, 0x4e, 0x57, 0x42, 0x54, 0x61, 0x99, 0x12, 0x9f,
+	0x7d, 0x37, 0xbf, 0x36, 0x2f, 0xe2, 0x90, 0x64, 0xe0, 0x94, 0x18, 0xbe, 0x79, 0xc2, 0x77, 0x4f,
+	0xd6, 0x14, 0xd1, 0x4c, 0xca, 0xe5, 0xd7, 0xcf, 0x62, 0xee, 0x8a, 0x38, 0x24, 0xb5, 0xff, 0x2b,
+	0x00, 0x8c, 0x37, 0x0c, 0x54, 0x81, 0x4c, 0xb4, 0x71, 0x65, 0x6c, 0x8b, 0xf9, 0x41, 0x2c, 0x01,
+	0xe6, 0xbf, 0xd1, 0x16, 0x6c, 0x0e, 0x69, 0xdf, 0x33, 0xcc, 0x53, 0x5d, 0xde, 0x60, 0x8a, 0xe8,
+	0xc4, 0x43, 0x78, 0x19, 0xaf, 0xcb, 0x46, 0x19, 0x7c, 0x04, 0xee, 0x2e, 0x64, 0x89, 0x73, 0xc6,
+	0xc3, 0x6d, 0x69, 0xeb, 0xde, 0xdc, 0x1b, 0x59, 0xbd, 0xe5, 0x9c, 0x09, 0x5f, 0x61, 0x30, 0x48,
+	0x07, 0xb0, 0xc8, 0x99, 0x6d, 0x12, 0x9d, 0x81, 0xe6, 0x38, 0xe8, 0xe7, 0xf3, 0x83, 0x6e, 0x73,
+	0x8c, 0x08, 0xba, 0x68, 0x85, 0x74, 0x32, 0x15, 0xc9, 0x5f, 0x3a, 0x15, 0x41, 0xdb, 0x90, 0xe7,
+	0xa1, 0x96, 0x56, 0x97, 0x6f, 0x64, 0xbf, 0xf1, 0xbd, 0x27, 0x09, 0xc6, 0xa3, 0x0b, 0x96, 0xb2,
+	0xe8, 0x09, 0x2c, 0x0b, 0x15, 0x69, 0xb5, 0xc0, 0x61, 0x3e, 0x4e, 0xbb, 0x0f, 0x70, 0x29, 0x1c,
+	0x4a, 0xb3, 0x59, 0x65, 0x21, 0x9a, 0x47, 0xe8, 0x22, 0xe6, 0xbf, 0xd1, 0x7b, 0x50, 0x14, 0x07,
+	0x04, 0xcb, 0xf6, 0x79, 0x40, 0x2e, 0x62, 0x71, 0x62, 0xd8, 0xb6, 0x7d, 0xf4, 0x3e, 0x94, 0xc4,
+	0x41, 0x50, 0x24, 0xae, 0x25, 0xde, 0x0c, 0x82, 0xc5, 0x93, 0x6e, 0xd1, 0x81, 0xf8, 0xbe, 0xe8,
+	0x50, 0x8e, 0x3a, 0x10, 0xdf, 0xe7, 0x1d, 0x7e, 0x03, 0x56, 0x79, 0x5a, 0xd4, 0xf7, 0xdd, 0x91,
+	0xa7, 0x73, 0x9f, 0x5a, 0xe1, 0x9d, 0x56, 0x18, 0xfb, 0x09, 0xe3, 0x76, 0x98, 0x73, 0x5d, 0x87,
+	0xc2, 0x2b, 0xf7, 0x48, 0x74, 0xa8, 0x88, 0x75, 0xf0, 0xca, 0x3d, 0x0a, 0x9b, 0xa2, 0x23, 0xcc,
+	0x6a, 0xf2, 0x08, 0xf3, 0x25, 0x5c, 0x9d, 0xde, 0xe1, 0xf9, 0x51, 0x46, 0xbd, 0xfc, 0x51, 0x66,
+	0xc3, 0x99, 0xc1, 0x45, 0x8f, 0x20, 0x6b, 0x39, 0xb4, 0xba, 0x36, 0x97, 0x73, 0x44, 0xeb, 0x18,
+	0x33, 0x61, 0xb4, 0x09, 0x79, 0xf6, 0xb1, 0xb6, 0x55, 0x45, 0x22, 0xf4, 0xbc, 0x72, 0x8f, 0xda,
+	0x16, 0xfa, 0x16, 0x14, 0xd9, 0xf7, 0x53, 0xcf, 0x30, 0x49, 0x75, 0x9d, 0xb7, 0x8c, 0x19, 0x6c,
+	0xa2, 0x1c, 0xd7, 0x22, 0xc2, 0x44, 0x1b, 0x62, 0xa2, 0x18, 0x83, 0xdb, 0xe8, 0x1a, 0x2c, 0xf3,
+	0x46, 0xdb, 0xaa, 0x6e, 0x8a, 0xec, 0x93, 0x91, 0x6d, 0x0b, 0x69, 0xb0, 0xe2, 0x19, 0x3e, 0x71,
+	0x02, 0x5d, 0x8e, 0x78, 0x95, 0x37, 0x97, 0x04, 0xf3, 0x29, 0x1b, 0xb7, 0xf6, 0x29, 0x14, 0xc2,
+	0xc5, 0x30, 0x4f, 0x98, 0xac, 0xdd, 0x87, 0x4a, 0x72, 0x29, 0xcd, 0x15, 0x64, 0xff, 0x29, 0x03,
+	0xc5, 0xf1, 0x6e, 0xee, 0xc0, 0x3a, 0x9f, 0x54, 0x23, 0x20, 0x56, 0x6c, 0xef, 0x17, 0x19, 0xf0,
+	0x83, 0x94, 0x66, 0x6e, 0x84, 0x08, 0xc9, 0xcc, 0x1f, 0x45, 0xc8, 0xe3, 0xf1, 0xbe, 0x80, 0xd5,
+	0x81, 0xed, 0x8c, 0xce, 0xf5, 0xc9, 0xa3, 0xc7, 0xef, 0xa4, 0x1c, 0x6b, 0x97, 0x49, 0x8f, 0xc7,
+	0xa8, 0x0c, 0x12, 0x34, 0xda, 0x81, 0x9c, 0xe7, 0xfa, 0x41, 0xb8, 0x67, 0xa6, 0xdd, 0xcd, 0x0e,
+	0x5c, 0x3f, 0xd8, 0x33, 0x3c, 0x8f, 0x5d, 0xf0, 0x08, 0x00, 0xed, 0xeb, 0x0c, 0x5c, 0x9d, 0xfd,
+	0x61, 0xa8, 0x03, 0x59, 0xd3, 0x1b, 0x49, 0x23, 0xdd, 0x9f, 0xd7, 0x48, 0x4d, 0x6f, 0x34, 0xd6,
+	0x9f, 0x01, 0xb1, 0x47, 0xaf, 0x21, 0x19, 0xba, 0xfe, 0x85, 0xb4, 0xc5, 0xc3, 0x79, 0x21, 0xf7,
+	0xb8, 0xf4, 0x18, 0x55, 0xc2, 0x21, 0x0c, 0x05, 0xb9, 0x98, 0xa8, 0x0c, 0xdb, 0x73, 0x9e, 0x3a,
+	0x43, 0x48, 0x1c, 0xe1, 0x68, 0x9f, 0xc2, 0xe6, 0xcc, 0x4f, 0x61, 0x87, 0x6d, 0xd3, 0x1b, 0xe9,
+	0xfc, 0x89, 0x54, 0x78, 0x50, 0x16, 0x17, 0x4d, 0x6f, 0xd4, 0xe5, 0x0c, 0xed, 0x25, 0x54, 0xdf,
+	0xa4, 0x2f, 0x5b, 0x63, 0x42, 0x63, 0x7d, 0x78, 0xc4, 0x6d, 0x90, 0xc5, 0x05, 0xc1, 0xd8, 0x3b,
+	0x62, 0x4b, 0x29, 0x6c, 0x34, 0xce, 0x59, 0x87, 0x2c, 0xef, 0x50, 0x92, 0x1d, 0x8c, 0xf3, 0xbd,
+	0x23, 0xed, 0xe7, 0x19, 0x58, 0x9d, 0x50, 0x99, 0x5d, 0x73, 0x89, 0x00, 0x1c, 0x1e, 0x0c, 0x05,
+	0xc5, 0xa2, 0xb1, 0x69, 0x5b, 0xe1, 0xd3, 0x13, 0xff, 0xcd, 0xf7, 0x61, 0x4f, 0x3e, 0x0b, 0x65,
+	0x6c, 0x8f, 0x2d, 0x9f, 0xe1, 0x91, 0x1d, 0x50, 0x9e, 0x14, 0xe5, 0xb0, 0x20, 0xd0, 0x0b, 0xa8,
+	0xf8, 0x84, 0xef, 0xff, 0x96, 0x2e, 0xbc, 0x2c, 0x37, 0x97, 0x97, 0x49, 0x0d, 0x99, 0xb3, 0xe1,
+	0x95, 0x10, 0x89, 0x51, 0x14, 0x3d, 0x87, 0x95, 0x30, 0xad, 0x17, 0xc8, 0xf9, 0x85, 0x91, 0xcb,
+	0x12, 0x88, 0x03, 0xb3, 0xd7, 0xe8, 0x58, 0x23, 0xfb, 0x30, 0x9e, 0xfd, 0x49, 0x9b, 0x08, 0x22,
+	0x19, 0x2d, 0x72, 0x32, 0x5a, 0x68, 0x47, 0x50, 0x8a, 0xad, 0x8b, 0x79, 0x44, 0x99, 0x3d, 0x03,
+	0x97, 0xdb, 0x33, 0x87, 0x33, 0x81, 0xcb, 0xe2, 0x24, 0xcb, 0xbc, 0x74, 0xdb, 0xe3, 0x16, 0x2d,
+	0xe2, 0x3c, 0x23, 0xdb, 0x9e, 0xf6, 0x8b, 0x0c, 0x54, 0x92, 0x4b, 0x3a, 0xf4, 0x23, 0x8f, 0xf8,
+	0xb6, 0x6b, 0xc5, 0xfc, 0xe8, 0x80, 0x33, 0x98, 0xaf, 0xb0, 0xe6, 0x2f, 0x47, 0x6e, 0x60, 0x84,
+	0xbe, 0x62, 0x7a, 0xa3, 0xdf, 0x65, 0xf4, 0x84, 0x0f, 0x66, 0x27, 0x7c, 0x10, 0x7d, 0x04, 0x48,
+	0xba, 0xd2, 0xc0, 0x1e, 0xda, 0x81, 0x7e, 0x74, 0x11, 0x10, 0x31, 0xc7, 0x59, 0xac, 0x8a, 0x96,
+	0x5d, 0xd6, 0xf0, 0x88, 0xf1, 0x99, 0xe3, 0xb9, 0xee, 0x50, 0xa7, 0xa6, 0xeb, 0x13, 0xdd, 0xb0,
+	0x5e, 0xf1, 0x73, 0x63, 0x16, 0x97, 0x5c, 0x77, 0xd8, 0x65, 0xbc, 0x86, 0xf5, 0x8a, 0x6d, 0xc4,
+	0xa6, 0x37, 0xa2, 0x24, 0xd0, 0xd9, 0x1f, 0x9e, 0xbb, 0x14, 0x31, 0x08, 0x56, 0xd3, 0x1b, 0x51,
+	0x76, 0x3d, 0x16, 0x76, 0xe0, 0x7b, 0xb1, 0x4c, 0x02, 0xca, 0xb2, 0x0b, 0xe7, 0x21, 0x0d, 0xca,
+	0x07, 0xc4, 0x37, 0x89, 0x13, 0xf4, 0x6c, 0xf3, 0x94, 0xf2, 0x03, 0xa0, 0x82, 0x13, 0x3c, 0x79,
+	0x6a, 0x09, 0x47, 0x1b, 0x92, 0x21, 0xd5, 0xfe, 0x45, 0x81, 0x1c, 0x4f, 0x59, 0x98, 0x51, 0xf8,
+	0x76, 0xcf, 0xb3, 0x01, 0x99, 0xea, 0x32, 0x06, 0xcf, 0x05, 0xde, 0x83, 0x22, 0x37, 0x7e, 0xec,
+	0x84, 0xc1, 0xf3, 0x60, 0xde, 0x58, 0x83, 0x82, 0x4f, 0x0c, 0xcb, 0x75, 0x06, 0xe1, 0xcd, 0x79,
+	0x44, 0xb3, 0x53, 0xa1, 0xe7, 0xbb, 0x9e, 0xd1, 0x1f, 0x1f, 0xe1, 0xe5, 0xf4, 0xad, 0xc6, 0xf8,
+	0x3c, 0x45, 0xff, 0x1e, 0xac, 0x50, 0x22, 0x22, 0xbb, 0x70, 0x92, 0x9c, 0xf8, 0x4c, 0xc9, 0xe4,
+	0x27, 0x02, 0xed, 0x4b, 0xc8, 0x8b, 0x8d, 0xeb, 0x12, 0xfa, 0x7e, 0x0c, 0x48, 0x18, 0x92, 0x39,
+	0xc8, 0xd0, 0xa6, 0x54, 0x66, 0xd9, 0xbc, 0xfc, 0x43, 0xb4, 0x1c, 0x8c, 0x1b, 0xb4, 0xff, 0x50,
+	0x00, 0xc6, 0xd7, 0x72, 0x2c, 0x31, 0x67, 0xab, 0x86, 0x1d, 0xb2, 0xc5, 0x0b, 0x40, 0x48, 0xb2,
+	0x3b, 0x3f, 0x99, 0x56, 0x67, 0x16, 0xbd, 0xfd, 0x91, 0x00, 0xe1, 0x7b, 0x20, 0x91, 0x77, 0x2c,
+	0xf3, 0xbe, 0x07, 0x12, 0xf1, 0x1e, 0x48, 0xd8, 0x4d, 0x8f, 0x4c, 0xf8, 0x05, 0xdc, 0x12, 0xcf,
+	0xf7, 0x4b, 0x56, 0xf4, 0xe8, 0x4a, 0xb4, 0xff, 0x52, 0xa2, 0xb8, 0x17, 0xde, 0x07, 0xa2, 0x2f,
+	0xa0, 0xc0, 0x42, 0x88, 0x3e, 0x34, 0x3c, 0x59, 0xea, 0xd3, 0x5c, 0xec, 0xaa, 0x31, 0xdc, 0x15,
+	0x45, 0xba, 0xbe, 0xec, 0x09, 0x8a, 0xc5, 0x4f, 0x76, 0x54, 0x0a, 0xe3, 0x27, 0xfb, 0x8d, 0x3e,
+	0x80, 0x8a, 0x31, 0x0a, 0x5c, 0xdd, 0xb0, 0xce, 0x88, 0x1f, 0xd8, 0x94, 0x48, 0x5f, 0x5a, 0x61,
+	0xdc, 0x46, 0xc8, 0xac, 0xdd, 0x83, 0x72, 0x1c, 0xf3, 0x6d, 0x79, 0x4b, 0x2e, 0x9e, 0xb7, 0xfc,
+	0x11, 0xc0, 0xf8, 0xa1, 0x81, 0xf9, 0x08, 0x7b, 0xb5, 0xd0, 0xcd, 0xf0, 0x6c, 0x9e, 0xc3, 0x05,
+	0xc6, 0x68, 0x32, 0x67, 0x4c, 0xbe, 0x82, 0xe6, 0xc2, 0x57, 0x50, 0x16, 0x1d, 0xd8, 0x82, 0x3e,
+	0xb5, 0x07, 0x83, 0xe8, 0xf1, 0xa3, 0xe8, 0xba, 0xc3, 0x67, 0x9c, 0xa1, 0xfd, 0x32, 0x23, 0x7c,
+	0x45, 0xbc, 0x67, 0xa7, 0x3a, 0x9b, 0xbd, 0xab, 0xa9, 0xbe, 0x0b, 0x40, 0x03, 0xc3, 0x67, 0x49,
+	0x98, 0x11, 0x3e, 0xbf, 0xd4, 0xa6, 0x9e, 0x51, 0x7b, 0x61, 0x81, 0x1d, 0x2e, 0xca, 0xde, 0x8d,
+	0x00, 0x3d, 0x80, 0xb2, 0xe9, 0x0e, 0xbd, 0x01, 0x91, 0xc2, 0xb9, 0xb7, 0x0a, 0x97, 0xa2, 0xfe,
+	0x8d, 0x20, 0xf6, 0xe8, 0x93, 0xbf, 0xec, 0xa3, 0xcf, 0x2f, 0x14, 0xf1, 0x2c, 0x1f, 0xaf, 0x0a,
+	0x40, 0xfd, 0x19, 0xa5, 0x67, 0x4f, 0x16, 0x2c, 0x31, 0xf8, 0xa6, 0xba, 0xb3, 0xda, 0x83, 0x34,
+	0x85, 0x5e, 0x6f, 0x4e, 0x8b, 0xff, 0x2d, 0x0b, 0xc5, 0x70, 0x5a, 0xa6, 0xe7, 0xfe, 0x0e, 0x14,
+	0xa3, 0xea, 0xc6, 0x6a, 0xe6, 0xad, 0x16, 0x1e, 0x77, 0x46, 0xc7, 0x80, 0x8c, 0x7e, 0x3f, 0x4a,
+	0x77, 0xf5, 0x11, 0x35, 0xfa, 0x61, 0x3d, 0xc4, 0x9d, 0x39, 0xec, 0x10, 0xee, 0x8f, 0x87, 0x4c,
+	0x1e, 0xab, 0x46, 0xbf, 0x9f, 0xe0, 0xa0, 0x3f, 0x86, 0xcd, 0xe4, 0x18, 0xfa, 0xd1, 0x85, 0xee,
+	0xd9, 0x96, 0xbc, 0x03, 0xd8, 0x99, 0xd3, 0x33, 0x69, 0x3d, 0x01, 0xff, 0xe8, 0xe2, 0xc0, 0xb6,
+	0x84, 0xcd, 0x91, 0x3f, 0xd5, 0x50, 0xfb, 0x53, 0xb8, 0xf6, 0x86, 0xee, 0x33, 0xe6, 0xa0, 0x93,
+	0x2c, 0xb6, 0x5b, 0xdc, 0x08, 0xb1, 0xd9, 0xfb, 0x1f, 0x05, 0xd6, 0xa6, 0x3a, 0xa0, 0x46, 0x3c,
+	0x4f, 0xbf, 0x95, 0x72, 0x9c, 0xe6, 0xc1, 0xa1, 0x80, 0x67, 0xb2, 0xe8, 0xe9, 0x44, 0x6a, 0x9e,
+	0x36, 0x21, 0x13, 0x19, 0xae, 0x00, 0x0a, 0xb3, 0xf1, 0x5d, 0x58, 0xf6, 0x7c, 0xd7, 0x24, 0x94,
+	0x56, 0xb3, 0x73, 0x81, 0x1d, 0x08, 0xa9, 0xb6, 0x73, 0xec, 0xe2, 0x10, 0x42, 0xfb, 0x0c, 0x4a,
+	0x31, 0x3e, 0xbf, 0x41, 0xf4, 0xec, 0x30, 0x5f, 0xe2, 0xbf, 0x93, 0x4f, 0xe5, 0x4a, 0xec, 0xa9,
+	0x5c, 0xfb, 0xe7, 0x2c, 0x14, 0xc2, 0x0f, 0xe5, 0x97, 0x09, 0x17, 0x34, 0x20, 0x43, 0x3d, 0xba,
+	0xe9, 0x54, 0x30, 0x08, 0x16, 0xdf, 0xdc, 0xdf, 0x83, 0xe2, 0x88, 0x12, 0x5f, 0x34, 0x67, 0x78,
+	0x73, 0x81, 0x31, 0x78, 0xe3, 0xfb, 0x50, 0x0a, 0xdc, 0xc0, 0x18, 0xe8, 0x01, 0x4f, 0x5d, 0xb2,
+	0x42, 0x9a, 0xb3, 0x78, 0xe2, 0x82, 0x3e, 0x84, 0xb5, 0xe0, 0xc4, 0x77, 0x83, 0x60, 0xc0, 0xd2,
+	0x66, 0x9e, 0xc4, 0x89, 0x9c, 0x6b, 0x09, 0xab, 0x51, 0x83, 0x48, 0xee, 0x28, 0xdb, 0x48, 0xc6,
+	0x9d, 0xd9, 0x2a, 0xe2, 0xf1, 0x6c, 0x09, 0xaf, 0x44, 0x5c, 0xb6, 0xca, 0xd8, 0x97, 0x79, 0x22,
+	0x39, 0xe2, 0x61, 0x4b, 0xc1, 0x21, 0x89, 0x74, 0x58, 0x1d, 0x12, 0x83, 0x8e, 0x7c, 0x62, 0xe9,
+	0xc7, 0x36, 0x19, 0x58, 0xe2, 0x0e, 0xa8, 0x92, 0xfa, 0xe4, 0x13, 0x9a, 0xa5, 0xfe, 0x98, 0x4b,
+	0xe3, 0x4a, 0x08, 0x27, 0x68, 0x96, 0xc4, 0x88, 0x5f, 0x68, 0x15, 0x4a, 0xdd, 0x17, 0xdd, 0x5e,
+	0x6b, 0x4f, 0xdf, 0xdb, 0xdf, 0x6e, 0xc9, 0xd2, 0xce, 0x6e, 0x0b, 0x0b, 0x52, 0x61, 0xed, 0xbd,
+	0xfd, 0x5e, 0x63, 0x57, 0xef, 0xb5, 0x9b, 0xcf, 0xba, 0x6a, 0x06, 0x6d, 0xc2, 0x5a, 0x6f, 0x07,
+	0xef, 0xf7, 0x7a, 0xbb, 0xad, 0x6d, 0xfd, 0xa0, 0x85, 0xdb, 0xfb, 0xdb, 0x5d, 0x35, 0xcb, 0xae,
+	0xb1, 0xc7, 0xec, 0x5e, 0x7b, 0xaf, 0xa5, 0x2e, 0xb1, 0x62, 0xbe, 0x83, 0x16, 0x6e, 0xb6, 0x3a,
+	0x3d, 0x35, 0xa7, 0xfd, 0x3c, 0x0b, 0xa5, 0x98, 0x43, 0xb1, 0x35, 0xe5, 0x53, 0x71, 0xc4, 0x5a,
+	0xc2, 0xec, 0x27, 0x2f, 0x45, 0x31, 0xcc, 0x13, 0x31, 0x3b, 0x4b, 0x58, 0x10, 0xfc, 0x58, 0x65,
+	0x9c, 0xc7, 0x42, 0xce, 0x12, 0x2e, 0x0c, 0x8d, 0x73, 0x01, 0xf2, 0x5d, 0x28, 0x9f, 0x12, 0xdf,
+	0x21, 0x03, 0xd9, 0x2e, 0x66, 0xa4, 0x24, 0x78, 0xa2, 0xcb, 0x4d, 0x50, 0x65, 0x97, 0x31, 0x8c,
+	0x98, 0x8e, 0x8a, 0xe0, 0xef, 0x85, 0x60, 0x1b, 0x90, 0x13, 0xcd, 0xcb, 0x62, 0x7c, 0x4e, 0x30,
+	0x9f, 0xa4, 0xaf, 0x0d, 0x8f, 0xa7, 0xb3, 0x4b, 0x98, 0xff, 0x46, 0x47, 0xd3, 0xf3, 0x93, 0xe7,
+	0xf3, 0x73, 0x77, 0xfe, 0x95, 0xf5, 0xa6, 0x29, 0x3a, 0x89, 0xa6, 0x68, 0x19, 0xb2, 0x38, 0xac,
+	0x87, 0x6c, 0x36, 0x9a, 0x3b, 0x6c, 0x5a, 0x56, 0xa0, 0xb8, 0xd7, 0xf8, 0xb1, 0x7e, 0xd8, 0x15,
+	0x0f, 0x0c, 0x2a, 0x94, 0x9f, 0xb5, 0x70, 0xa7, 0xb5, 0x2b, 0x39, 0x59, 0xb4, 0x01, 0xaa, 0xe4,
+	0x8c, 0xfb, 0x2d, 0x31, 0x04, 0xf1, 0x33, 0xc7, 0x2e, 0x9c, 0xbb, 0xcf, 0x1b, 0x07, 0x6a, 0x5e,
+	0xfb, 0xcf, 0x0c, 0xac, 0x8a, 0x1d, 0x2a, 0xaa, 0xdc, 0x7a, 0xf3, 0x83, 0x6b, 0xfc, 0x42, 0x2d,
+	0x93, 0xbc, 0x50, 0x0b, 0xf3, 0x61, 0x9e, 0x60, 0x64, 0xc7, 0xf9, 0x30, 0xbf, 0x64, 0x4a, 0x6c,
+	0x3e, 0x4b, 0xf3, 0x6c, 0x3e, 0x55, 0x58, 0x1e, 0x12, 0x1a, 0xcd, 0x5b, 0x11, 0x87, 0x24, 0xb2,
+	0xa1, 0x64, 0x38, 0x8e, 0x1b, 0x18, 0xe2, 0x96, 0x3a, 0x3f, 0xd7, 0xbe, 0x3c, 0xf1, 0xc5, 0xf5,
+	0xc6, 0x18, 0x49, 0xec, 0x11, 0x71, 0xec, 0xda, 0x8f, 0x40, 0x9d, 0xec, 0x30, 0xcf, 0xce, 0xfc,
+	0xfd, 0x1f, 0x8c, 0x37, 0x66, 0xc2, 0xd6, 0x85, 0x7c, 0xf2, 0x51, 0xaf, 0x30, 0x02, 0x1f, 0x76,
+	0x3a, 0xed, 0xce, 0x13, 0x55, 0x61, 0x0f, 0x45, 0xad, 0x1f, 0xb7, 0x59, 0x8d, 0x75, 0x66, 0xeb,
+	0xa7, 0x55, 0xc8, 0x0b, 0x25, 0xd1, 0xd7, 0x32, 0x29, 0x89, 0xff, 0x57, 0x00, 0xfa, 0xd1, 0xdc,
+	0xc9, 0x7d, 0xe2, 0x3f, 0x0d, 0x6a, 0x0f, 0x17, 0x96, 0x97, 0xcf, 0xd0, 0x57, 0xd0, 0x5f, 0x2a,
+	0x50, 0x4e, 0xbc, 0xeb, 0xa6, 0xbd, 0xa5, 0x9f, 0xf1, 0x4f, 0x08, 0xb5, 0xcf, 0x16, 0x92, 0x8d,
+	0x74, 0xf9, 0x99, 0x02, 0xa5, 0x58, 0xf9, 0x3d, 0xba, 0xbb, 0x48, 0xc9, 0xbe, 0xd0, 0xe4, 0xde,
+	0xe2, 0xd5, 0xfe, 0xda, 0x95, 0x4f, 0x14, 0xf4, 0x17, 0x0a, 0x94, 0x62, 0x85, 0xe8, 0xa9, 0x55,
+	0x99, 0x2e, 0x9b, 0xaf, 0xdd, 0x5b, 0x44, 0x34, 0xb2, 0xc9, 0x9f, 0x29, 0x50, 0x8c, 0x8a, 0xca,
+	0xd1, 0xed, 0xf9, 0xcb, 0xd0, 0x85, 0x12, 0x77, 0x16, 0xad, 0x5f, 0xd7, 0xae, 0xa0, 0x3f, 0x81,
+	0x42, 0x58, 0x81, 0x8d, 0xd2, 0xee, 0x5e, 0x13, 0xe5, 0xdd, 0xb5, 0xdb, 0x73, 0xcb, 0xc5, 0x87,
+	0x0f, 0xcb, 0xa2, 0x53, 0x0f, 0x3f, 0x51, 0xc0, 0x5d, 0xbb, 0x3d, 0xb7, 0x5c, 0x34, 0x3c, 0xf3,
+	0x84, 0x58, 0xf5, 0x74, 0x6a, 0x4f, 0x98, 0x2e, 0xdb, 0xae, 0xdd, 0x5b, 0x44, 0x34, 0xa1, 0x48,
+	0xac, 0xfe, 0x3a, 0xb5, 0x22, 0xd3, 0x35, 0xde, 0xb5, 0x7b, 0x8b, 0x88, 0x46, 0x8a, 0xfc, 0x44,
+	0x89, 0x1f, 0x51, 0x6e, 0xcf, 0x5d, 0x66, 0x3c, 0xa7, 0x4b, 0x4e, 0x15, 0x3a, 0xf3, 0x05, 0xfa,
+	0x13, 0x79, 0xa1, 0x22, 0xaa, 0x94, 0xd1, 0x3c, 0x60, 0x89, 0xc2, 0xe6, 0xda, 0xa7, 0x8b, 0x6d,
+	0x36, 0x5c, 0x89, 0x3f, 0x57, 0x00, 0xc6, 0xf5, 0xcc, 0xa9, 0x95, 0x98, 0x2a, 0xa4, 0xae, 0xdd,
+	0x5d, 0x40, 0x32, 0xbe, 0x40, 0xc2, 0x7a, 0xcb, 0xd4, 0x0b, 0x64, 0xa2, 0xde, 0xba, 0x76, 0x7b,
+	0x6e, 0xb9, 0x68, 0xf8, 0x7f, 0x50, 0x60, 0x6d, 0xaa, 0xde, 0x13, 0x3d, 0xbc, 0x64, 0xc9, 0x6f,
+	0xed, 0xf3, 0xc5, 0x01, 0x42, 0xd5, 0x6e, 0x2a, 0x9f, 0x28, 0xe8, 0xaf, 0x14, 0x58, 0x49, 0x56,
+	0xd7, 0xa4, 0xde, 0xa5, 0x66, 0x54, 0x8e, 0xd6, 0xee, 0x2f, 0x26, 0x1c, 0x59, 0xeb, 0x6f, 0x14,
+	0xa8, 0xc8, 0xf5, 0x1d, 0xea, 0x73, 0x7f, 0xbe, 0xb0, 0x30, 0xa1, 0xd0, 0x83, 0x05, 0xa5, 0x13,
+	0x1a, 0x25, 0x8b, 0x22, 0x53, 0x6b, 0x34, 0xb3, 0x82, 0xb3, 0xf6, 0x60, 0x41, 0xe9, 0x44, 0xa4,
+	0x8b, 0xd5, 0x43, 0xce, 0xb1, 0xf9, 0x4e, 0x16, 0x70, 0xd6, 0xee, 0x2d, 0x22, 0x1a, 0x29, 0xf2,
+	0x8f, 0x0a, 0xac, 0xcf, 0xa8, 0x25, 0x44, 0x8d, 0x94, 0xa8, 0x6f, 0x2e, 0x87, 0xac, 0x3d, 0xba,
+	0x0c, 0x44, 0x22, 0x3b, 0x88, 0x0a, 0x14, 0x53, 0x87, 0xe2, 0xc9, 0xfa, 0xc7, 0xda, 0x9d, 0xf9,
+	0x05, 0x23, 0x15, 0x58, 0x0c, 0x1c, 0x17, 0x38, 0xa6, 0x8e, 0x81, 0x53, 0x15, 0x94, 0xb5, 0xbb,
+	0x0b, 0x48, 0x46, 0x5a, 0xfc, 0xbd, 0x02, 0x68, 0xba, 0xd8, 0x11, 0xcd, 0x53, 0x1d, 0x32, 0xb3,
+	0x16, 0xb3, 0xd6, 0xb8, 0x04, 0x42, 0xa8, 0xdd, 0xa3, 0xe5, 0xdf, 0xcf, 0x89, 0x03, 0x52, 0x9e,
+	0xff, 0xf9, 0xe1, 0xff, 0x0f, 0x00, 0x96, 0x23, 0x50, 0x9c, 0x1f, 0x3d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4073,6 +4680,12 @@ type DriverClient interface {
 	// DestroyNetwork destroys a previously created network. This rpc is only
 	// implemented if the driver needs to manage network namespace creation.
 	DestroyNetwork(ctx context.Context, in *DestroyNetworkRequest, opts ...grpc.CallOption) (*DestroyNetworkResponse, error)
+	CheckpointTask(ctx context.Context, in *CheckpointTaskRequest, opts ...grpc.CallOption) (*CheckpointTaskResponse, error)
+	RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*RestoreTaskResponse, error)
+	UpdateTaskResources(ctx context.Context, in *UpdateTaskResourcesRequest, opts ...grpc.CallOption) (*UpdateTaskResourcesResponse, error)
+	PauseTask(ctx context.Context, in *PauseTaskRequest, opts ...grpc.CallOption) (*PauseTaskResponse, error)
+	ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...grpc.CallOption) (*ResumeTaskResponse, error)
+	TaskProvidesRootFS(ctx context.Context, in *TaskProvidesRootFSRequest, opts ...grpc.CallOption) (*TaskProvidesRootFSResponse, error)
 }
 
 type driverClient struct {
@@ -4318,6 +4931,60 @@ func (c *driverClient) DestroyNetwork(ctx context.Context, in *DestroyNetworkReq
 	return out, nil
 }
 
+func (c *driverClient) CheckpointTask(ctx context.Context, in *CheckpointTaskRequest, opts ...grpc.CallOption) (*CheckpointTaskResponse, error) {
+	out := new(CheckpointTaskResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/CheckpointTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*RestoreTaskResponse, error) {
+	out := new(RestoreTaskResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/RestoreTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) UpdateTaskResources(ctx context.Context, in *UpdateTaskResourcesRequest, opts ...grpc.CallOption) (*UpdateTaskResourcesResponse, error) {
+	out := new(UpdateTaskResourcesResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/UpdateTaskResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) PauseTask(ctx context.Context, in *PauseTaskRequest, opts ...grpc.CallOption) (*PauseTaskResponse, error) {
+	out := new(PauseTaskResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/PauseTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...grpc.CallOption) (*ResumeTaskResponse, error) {
+	out := new(ResumeTaskResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/ResumeTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) TaskProvidesRootFS(ctx context.Context, in *TaskProvidesRootFSRequest, opts ...grpc.CallOption) (*TaskProvidesRootFSResponse, error) {
+	out := new(TaskProvidesRootFSResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/TaskProvidesRootFS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DriverServer is the server API for Driver service.
 type DriverServer interface {
 	// TaskConfigSchema returns the schema for parsing the driver
@@ -4373,6 +5040,12 @@ type DriverServer interface {
 	// DestroyNetwork destroys a previously created network. This rpc is only
 	// implemented if the driver needs to manage network namespace creation.
 	DestroyNetwork(context.Context, *DestroyNetworkRequest) (*DestroyNetworkResponse, error)
+	CheckpointTask(context.Context, *CheckpointTaskRequest) (*CheckpointTaskResponse, error)
+	RestoreTask(context.Context, *RestoreTaskRequest) (*RestoreTaskResponse, error)
+	UpdateTaskResources(context.Context, *UpdateTaskResourcesRequest) (*UpdateTaskResourcesResponse, error)
+	PauseTask(context.Context, *PauseTaskRequest) (*PauseTaskResponse, error)
+	ResumeTask(context.Context, *ResumeTaskRequest) (*ResumeTaskResponse, error)
+	TaskProvidesRootFS(context.Context, *TaskProvidesRootFSRequest) (*TaskProvidesRootFSResponse, error)
 }
 
 // UnimplementedDriverServer can be embedded to have forward compatible implementations.
@@ -4427,6 +5100,24 @@ func (*UnimplementedDriverServer) CreateNetwork(ctx context.Context, req *Create
 func (*UnimplementedDriverServer) DestroyNetwork(ctx context.Context, req *DestroyNetworkRequest) (*DestroyNetworkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyNetwork not implemented")
 }
+func (*UnimplementedDriverServer) CheckpointTask(ctx context.Context, req *CheckpointTaskRequest) (*CheckpointTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointTask not implemented")
+}
+func (*UnimplementedDriverServer) RestoreTask(ctx context.Context, req *RestoreTaskRequest) (*RestoreTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTask not implemented")
+}
+func (*UnimplementedDriverServer) UpdateTaskResources(ctx context.Context, req *UpdateTaskResourcesRequest) (*UpdateTaskResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskResources not implemented")
+}
+func (*UnimplementedDriverServer) PauseTask(ctx context.Context, req *PauseTaskRequest) (*PauseTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTask not implemented")
+}
+func (*UnimplementedDriverServer) ResumeTask(ctx context.Context, req *ResumeTaskRequest) (*ResumeTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTask not implemented")
+}
+func (*UnimplementedDriverServer) TaskProvidesRootFS(ctx context.Context, req *TaskProvidesRootFSRequest) (*TaskProvidesRootFSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskProvidesRootFS not implemented")
+}
 
 func RegisterDriverServer(s *grpc.Server, srv DriverServer) {
 	s.RegisterService(&_Driver_serviceDesc, srv)
@@ -4737,6 +5428,114 @@ func _Driver_DestroyNetwork_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Driver_CheckpointTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).CheckpointTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/CheckpointTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).CheckpointTask(ctx, req.(*CheckpointTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_RestoreTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).RestoreTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/RestoreTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).RestoreTask(ctx, req.(*RestoreTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_UpdateTaskResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).UpdateTaskResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/UpdateTaskResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).UpdateTaskResources(ctx, req.(*UpdateTaskResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_PauseTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).PauseTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/PauseTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).PauseTask(ctx, req.(*PauseTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_ResumeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).ResumeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/ResumeTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).ResumeTask(ctx, req.(*ResumeTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_TaskProvidesRootFS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskProvidesRootFSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).TaskProvidesRootFS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/TaskProvidesRootFS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).TaskProvidesRootFS(ctx, req.(*TaskProvidesRootFSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Driver_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hashicorp.nomad.plugins.drivers.proto.Driver",
 	HandlerType: (*DriverServer)(nil),
@@ -4789,6 +5588,30 @@ var _Driver_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyNetwork",
 			Handler:    _Driver_DestroyNetwork_Handler,
 		},
+		{
+			MethodName: "CheckpointTask",
+			Handler:    _Driver_CheckpointTask_Handler,
+		},
+		{
+			MethodName: "RestoreTask",
+			Handler:    _Driver_RestoreTask_Handler,
+		},
+		{
+			MethodName: "UpdateTaskResources",
+			Handler:    _Driver_UpdateTaskResources_Handler,
+		},
+		{
+			MethodName: "PauseTask",
+			Handler:    _Driver_PauseTask_Handler,
+		},
+		{
+			MethodName: "ResumeTask",
+			Handler:    _Driver_ResumeTask_Handler,
+		},
+		{
+			MethodName: "TaskProvidesRootFS",
+			Handler:    _Driver_TaskProvidesRootFS_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
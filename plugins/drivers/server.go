@@ -50,6 +50,10 @@ func (b *driverPluginServer) Capabilities(ctx context.Context, req *proto.Capabi
 			MustCreateNetwork:     caps.MustInitiateNetwork,
 			NetworkIsolationModes: []proto.NetworkIsolationSpec_NetworkIsolationMode{},
 			DynamicWorkloadUsers:  caps.DynamicWorkloadUsers,
+			Checkpoint:            caps.Checkpoint,
+			UpdateResources:       caps.UpdateResources,
+			Pause:                 caps.Pause,
+			ProvidesRootfs:        caps.ProvidesRootFS,
 		},
 	}
 
@@ -423,3 +427,103 @@ func (b *driverPluginServer) DestroyNetwork(ctx context.Context, req *proto.Dest
 
 	return &proto.DestroyNetworkResponse{}, nil
 }
+
+func (b *driverPluginServer) CheckpointTask(ctx context.Context, req *proto.CheckpointTaskRequest) (*proto.CheckpointTaskResponse, error) {
+	cp, ok := b.impl.(DriverCheckpointer)
+	if !ok {
+		return nil, fmt.Errorf("CheckpointTask RPC not supported by driver")
+	}
+
+	err := cp.CheckpointTask(req.TaskId, req.ImagePath, req.LeaveRunning)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CheckpointTaskResponse{}, nil
+}
+
+func (b *driverPluginServer) RestoreTask(ctx context.Context, req *proto.RestoreTaskRequest) (*proto.RestoreTaskResponse, error) {
+	cp, ok := b.impl.(DriverCheckpointer)
+	if !ok {
+		return nil, fmt.Errorf("RestoreTask RPC not supported by driver")
+	}
+
+	handle, net, err := cp.RestoreTask(taskConfigFromProto(req.Task), req.ImagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbNet *proto.NetworkOverride
+	if net != nil {
+		pbNet = &proto.NetworkOverride{
+			PortMap:       map[string]int32{},
+			Addr:          net.IP,
+			AutoAdvertise: net.AutoAdvertise,
+		}
+		for k, v := range net.PortMap {
+			if v > math.MaxInt32 {
+				return nil, fmt.Errorf("port map out of bounds")
+			}
+			pbNet.PortMap[k] = int32(v)
+		}
+	}
+
+	return &proto.RestoreTaskResponse{
+		Handle:          taskHandleToProto(handle),
+		NetworkOverride: pbNet,
+	}, nil
+}
+
+func (b *driverPluginServer) UpdateTaskResources(ctx context.Context, req *proto.UpdateTaskResourcesRequest) (*proto.UpdateTaskResourcesResponse, error) {
+	updater, ok := b.impl.(DriverTaskResourceUpdater)
+	if !ok {
+		return nil, fmt.Errorf("UpdateTaskResources RPC not supported by driver")
+	}
+
+	err := updater.UpdateTaskResources(req.TaskId, ResourcesFromProto(req.Resources))
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.UpdateTaskResourcesResponse{}, nil
+}
+
+func (b *driverPluginServer) PauseTask(ctx context.Context, req *proto.PauseTaskRequest) (*proto.PauseTaskResponse, error) {
+	pauser, ok := b.impl.(DriverPauser)
+	if !ok {
+		return nil, fmt.Errorf("PauseTask RPC not supported by driver")
+	}
+
+	if err := pauser.PauseTask(req.TaskId); err != nil {
+		return nil, err
+	}
+
+	return &proto.PauseTaskResponse{}, nil
+}
+
+func (b *driverPluginServer) ResumeTask(ctx context.Context, req *proto.ResumeTaskRequest) (*proto.ResumeTaskResponse, error) {
+	pauser, ok := b.impl.(DriverPauser)
+	if !ok {
+		return nil, fmt.Errorf("ResumeTask RPC not supported by driver")
+	}
+
+	if err := pauser.ResumeTask(req.TaskId); err != nil {
+		return nil, err
+	}
+
+	return &proto.ResumeTaskResponse{}, nil
+}
+
+func (b *driverPluginServer) TaskProvidesRootFS(ctx context.Context, req *proto.TaskProvidesRootFSRequest) (*proto.TaskProvidesRootFSResponse, error) {
+	provider, ok := b.impl.(DriverRootFSProvider)
+	if !ok {
+		return nil, fmt.Errorf("TaskProvidesRootFS RPC not supported by driver")
+	}
+
+	provides, err := provider.TaskProvidesRootFS(taskConfigFromProto(req.Task))
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.TaskProvidesRootFSResponse{ProvidesRootfs: provides}, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testutils
+
+import (
+	"context"
+	"testing"
+
+	hclog "github.com/hashicorp/go-hclog"
+	plugin "github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/hashicorp/nomad/plugins/drivers/conformance"
+)
+
+// RunConformance runs the driver conformance suite and reports each scenario
+// as a subtest.
+func RunConformance(t *testing.T, cfg *conformance.Config) {
+	t.Helper()
+
+	if cfg.Logger == nil {
+		cfg.Logger = testlog.HCLogger(t)
+	}
+
+	report := conformance.Run(cfg)
+	for _, res := range report.Results {
+		t.Run(res.Name, func(t *testing.T) {
+			switch res.Status {
+			case conformance.StatusFail:
+				t.Fatal(res.Message)
+			case conformance.StatusSkip:
+				t.Skip(res.Message)
+			}
+		})
+	}
+}
+
+// InProcessLauncher returns a conformance.LaunchFunc that serves a new
+// driver built by factory over an in-memory gRPC connection. The suite then
+// exercises the same plugin client as with a plugin binary. Killing the
+// plugin cancels the context passed to factory.
+func InProcessLauncher(t *testing.T, factory func(context.Context, hclog.Logger) drivers.DriverPlugin) conformance.LaunchFunc {
+	return func(logger hclog.Logger) (drivers.DriverPlugin, func(), error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		d := factory(ctx, logger)
+
+		client, server := plugin.TestPluginGRPCConn(t,
+			true,
+			map[string]plugin.Plugin{
+				base.PluginTypeDriver: drivers.NewDriverPlugin(d, logger),
+				base.PluginTypeBase:   &base.PluginBase{Impl: d},
+			},
+		)
+
+		kill := func() {
+			_ = client.Close()
+			server.Stop()
+			cancel()
+		}
+
+		raw, err := client.Dispense(base.PluginTypeDriver)
+		if err != nil {
+			kill()
+			return nil, nil, err
+		}
+
+		return raw.(drivers.DriverPlugin), kill, nil
+	}
+}
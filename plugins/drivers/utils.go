@@ -490,10 +490,17 @@ func resourceUsageToProto(ru *ResourceUsage) *proto.TaskResourceUsage {
 		KernelMaxUsage: ru.MemoryStats.KernelMaxUsage,
 	}
 
-	return &proto.TaskResourceUsage{
+	pb := &proto.TaskResourceUsage{
 		Cpu:    cpu,
 		Memory: memory,
 	}
+	if ru.Process != nil {
+		pb.Process = &proto.ProcessInfo{
+			Ppid:    int64(ru.Process.PPid),
+			Command: ru.Process.Command,
+		}
+	}
+	return pb
 }
 
 func resourceUsageFromProto(pb *proto.TaskResourceUsage) *ResourceUsage {
@@ -524,10 +531,17 @@ func resourceUsageFromProto(pb *proto.TaskResourceUsage) *ResourceUsage {
 		}
 	}
 
-	return &ResourceUsage{
+	ru := &ResourceUsage{
 		CpuStats:    &cpu,
 		MemoryStats: &memory,
 	}
+	if pb.Process != nil {
+		ru.Process = &ProcessInfo{
+			PPid:    int(pb.Process.Ppid),
+			Command: pb.Process.Command,
+		}
+	}
+	return ru
 }
 
 func BytesToMB(bytes int64) int64 {
@@ -32,6 +32,10 @@ func TestResourceUsageRoundTrip(t *testing.T) {
 			KernelMaxUsage: 45,
 			Measured:       []string{"RSS", "Swap"},
 		},
+		Process: &ProcessInfo{
+			PPid:    1234,
+			Command: "/bin/sh -c sleep 10",
+		},
 	}
 
 	parsed := resourceUsageFromProto(resourceUsageToProto(input))
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scorer
+
+import (
+	"context"
+
+	"github.com/LK4D4/joincontext"
+	"github.com/hashicorp/nomad/helper/pluginutils/grpcutils"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/scorer/proto"
+)
+
+// scorerPluginClient implements the client side of a remote scorer plugin,
+// using gRPC to communicate to the remote plugin.
+type scorerPluginClient struct {
+	// basePluginClient is embedded to give access to the base plugin methods.
+	*base.BasePluginClient
+
+	client proto.ScorerPluginClient
+
+	// doneCtx is closed when the plugin exits
+	doneCtx context.Context
+}
+
+// Score is used to retrieve the scores of a set of nodes from the scorer
+// plugin. If the context is cancelled, the error will be propagated.
+func (s *scorerPluginClient) Score(ctx context.Context, req *ScoreRequest) (*ScoreResponse, error) {
+	// Join the passed context and the shutdown context
+	joinedCtx, _ := joincontext.Join(ctx, s.doneCtx)
+
+	resp, err := s.client.Score(joinedCtx, convertStructScoreRequest(req))
+	if err != nil {
+		return nil, grpcutils.HandleReqCtxGrpcErr(err, ctx, s.doneCtx)
+	}
+
+	return &ScoreResponse{
+		Scores: resp.GetScores(),
+	}, nil
+}
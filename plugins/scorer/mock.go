@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scorer
+
+import (
+	"context"
+
+	"github.com/hashicorp/nomad/plugins/base"
+)
+
+type ScoreFn func(context.Context, *ScoreRequest) (*ScoreResponse, error)
+
+// MockScorerPlugin is used for testing.
+// Each function can be set as a closure to make assertions about how data
+// is passed through the base plugin layer.
+type MockScorerPlugin struct {
+	*base.MockPlugin
+	ScoreF ScoreFn
+}
+
+func (p *MockScorerPlugin) Score(ctx context.Context, req *ScoreRequest) (*ScoreResponse, error) {
+	return p.ScoreF(ctx, req)
+}
+
+// StaticScores returns the passed scores
+func StaticScores(scores map[string]float64) ScoreFn {
+	return func(_ context.Context, _ *ScoreRequest) (*ScoreResponse, error) {
+		return &ScoreResponse{Scores: scores}, nil
+	}
+}
+
+// ErrorScore returns the passed error
+func ErrorScore(err error) ScoreFn {
+	return func(_ context.Context, _ *ScoreRequest) (*ScoreResponse, error) {
+		return nil, err
+	}
+}
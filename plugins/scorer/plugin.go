@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scorer
+
+import (
+	"context"
+
+	log "github.com/hashicorp/go-hclog"
+	plugin "github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/nomad/plugins/base"
+	bproto "github.com/hashicorp/nomad/plugins/base/proto"
+	"github.com/hashicorp/nomad/plugins/scorer/proto"
+	"google.golang.org/grpc"
+)
+
+// PluginScorer wraps a ScorerPlugin and implements go-plugins GRPCPlugin
+// interface to expose the interface over gRPC.
+type PluginScorer struct {
+	plugin.NetRPCUnsupportedPlugin
+	Impl ScorerPlugin
+}
+
+func (p *PluginScorer) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	proto.RegisterScorerPluginServer(s, &scorerPluginServer{
+		impl:   p.Impl,
+		broker: broker,
+	})
+	return nil
+}
+
+func (p *PluginScorer) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &scorerPluginClient{
+		doneCtx: ctx,
+		client:  proto.NewScorerPluginClient(c),
+		BasePluginClient: &base.BasePluginClient{
+			Client:  bproto.NewBasePluginClient(c),
+			DoneCtx: ctx,
+		},
+	}, nil
+}
+
+// Serve is used to serve a scorer plugin
+func Serve(scorer ScorerPlugin, logger log.Logger) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: base.Handshake,
+		Plugins: map[string]plugin.Plugin{
+			base.PluginTypeBase:   &base.PluginBase{Impl: scorer},
+			base.PluginTypeScorer: &PluginScorer{Impl: scorer},
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
+		Logger:     logger,
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scorer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	plugin "github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/shoenig/test/must"
+)
+
+func testScorerClient(t *testing.T, mock *MockScorerPlugin) ScorerPlugin {
+	t.Helper()
+
+	client, server := plugin.TestPluginGRPCConn(t, true, map[string]plugin.Plugin{
+		base.PluginTypeBase:   &base.PluginBase{Impl: mock},
+		base.PluginTypeScorer: &PluginScorer{Impl: mock},
+	})
+	t.Cleanup(server.Stop)
+	t.Cleanup(func() { client.Close() })
+
+	raw, err := client.Dispense(base.PluginTypeScorer)
+	must.NoError(t, err)
+
+	impl, ok := raw.(ScorerPlugin)
+	must.True(t, ok)
+	return impl
+}
+
+func TestScorerPlugin_PluginInfo(t *testing.T) {
+	ci.Parallel(t)
+
+	mock := &MockScorerPlugin{
+		MockPlugin: &base.MockPlugin{
+			PluginInfoF: func() (*base.PluginInfoResponse, error) {
+				return &base.PluginInfoResponse{
+					Type:              base.PluginTypeScorer,
+					PluginApiVersions: []string{ApiVersion010},
+					PluginVersion:     "v0.1.1",
+					Name:              "mock_scorer",
+				}, nil
+			},
+		},
+	}
+	impl := testScorerClient(t, mock)
+
+	resp, err := impl.PluginInfo()
+	must.NoError(t, err)
+	must.Eq(t, base.PluginTypeScorer, resp.Type)
+	must.Eq(t, []string{ApiVersion010}, resp.PluginApiVersions)
+	must.Eq(t, "v0.1.1", resp.PluginVersion)
+	must.Eq(t, "mock_scorer", resp.Name)
+}
+
+func TestScorerPlugin_Score(t *testing.T) {
+	ci.Parallel(t)
+
+	var received *ScoreRequest
+	mock := &MockScorerPlugin{
+		ScoreF: func(_ context.Context, req *ScoreRequest) (*ScoreResponse, error) {
+			received = req
+			return &ScoreResponse{
+				Scores: map[string]float64{"node-1": 0.5, "node-2": -1},
+			}, nil
+		},
+	}
+	impl := testScorerClient(t, mock)
+
+	req := &ScoreRequest{
+		Namespace: "default",
+		JobID:     "example",
+		JobType:   "service",
+		TaskGroup: "web",
+		Meta:      map[string]string{"tier": "gold"},
+		Nodes: []*Node{
+			{
+				ID:         "node-1",
+				Name:       "client-1",
+				Datacenter: "dc1",
+				NodePool:   "default",
+				NodeClass:  "spot",
+				Attributes: map[string]string{"kernel.name": "linux"},
+				Meta:       map[string]string{"rack": "r1"},
+			},
+			{
+				ID:         "node-2",
+				Name:       "client-2",
+				Datacenter: "dc1",
+				NodePool:   "default",
+			},
+		},
+	}
+
+	resp, err := impl.Score(context.Background(), req)
+	must.NoError(t, err)
+	must.Eq(t, req, received)
+	must.Eq(t, map[string]float64{"node-1": 0.5, "node-2": -1}, resp.Scores)
+}
+
+func TestScorerPlugin_Score_Error(t *testing.T) {
+	ci.Parallel(t)
+
+	mock := &MockScorerPlugin{
+		ScoreF: ErrorScore(errors.New("power budget unavailable")),
+	}
+	impl := testScorerClient(t, mock)
+
+	_, err := impl.Score(context.Background(), &ScoreRequest{})
+	must.ErrorContains(t, err, "power budget unavailable")
+}
+
+func TestScorerPlugin_Score_CancelCtx(t *testing.T) {
+	ci.Parallel(t)
+
+	mock := &MockScorerPlugin{
+		ScoreF: func(ctx context.Context, _ *ScoreRequest) (*ScoreResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	}
+	impl := testScorerClient(t, mock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	_, err := impl.Score(ctx, &ScoreRequest{})
+	must.ErrorIs(t, err, context.Canceled)
+}
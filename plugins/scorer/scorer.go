@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scorer
+
+import (
+	"context"
+
+	"github.com/hashicorp/nomad/plugins/base"
+)
+
+// ScorerPlugin is the interface for a plugin that contributes a score to the
+// ranking of nodes during scheduling. Scorer plugins are run by the Nomad
+// servers and allow operators to account for signals that Nomad does not
+// track itself, such as rack power budgets or the interruption risk of spot
+// instances.
+type ScorerPlugin interface {
+	base.BasePlugin
+
+	// Score returns a score for the nodes in the request. The passed context
+	// is cancelled when the scheduler is no longer waiting for the result.
+	Score(ctx context.Context, req *ScoreRequest) (*ScoreResponse, error)
+}
+
+// ScoreRequest is the set of nodes to score for the placement of a task
+// group.
+type ScoreRequest struct {
+	// Namespace and JobID identify the job being scheduled.
+	Namespace string
+	JobID     string
+
+	// JobType is the type of the job being scheduled.
+	JobType string
+
+	// TaskGroup is the name of the task group being placed.
+	TaskGroup string
+
+	// Meta is the merged job and task group metadata.
+	Meta map[string]string
+
+	// Nodes is the set of nodes to score.
+	Nodes []*Node
+}
+
+// Node is the subset of a node's attributes made available to scorer
+// plugins.
+type Node struct {
+	ID         string
+	Name       string
+	Datacenter string
+	NodePool   string
+	NodeClass  string
+	Attributes map[string]string
+	Meta       map[string]string
+}
+
+// ScoreResponse contains the scores computed by the plugin.
+type ScoreResponse struct {
+	// Scores maps node IDs to their score. Scores must be in the range
+	// [-1, 1], where a positive score makes the node more desirable and a
+	// negative score makes it less desirable. Nodes without a score are not
+	// affected by the plugin.
+	Scores map[string]float64
+}
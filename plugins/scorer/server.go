@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scorer
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/nomad/plugins/scorer/proto"
+)
+
+// scorerPluginServer wraps a scorer plugin and exposes it via gRPC.
+type scorerPluginServer struct {
+	broker *plugin.GRPCBroker
+	impl   ScorerPlugin
+}
+
+func (s *scorerPluginServer) Score(ctx context.Context, req *proto.ScoreRequest) (*proto.ScoreResponse, error) {
+	resp, err := s.impl.Score(ctx, convertProtoScoreRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	presp := &proto.ScoreResponse{}
+	if resp != nil {
+		presp.Scores = resp.Scores
+	}
+	return presp, nil
+}
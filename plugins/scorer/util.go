@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scorer
+
+import (
+	"github.com/hashicorp/nomad/plugins/scorer/proto"
+)
+
+func convertStructScoreRequest(in *ScoreRequest) *proto.ScoreRequest {
+	if in == nil {
+		return &proto.ScoreRequest{}
+	}
+
+	out := &proto.ScoreRequest{
+		Namespace: in.Namespace,
+		JobId:     in.JobID,
+		JobType:   in.JobType,
+		TaskGroup: in.TaskGroup,
+		Meta:      in.Meta,
+		Nodes:     make([]*proto.Node, 0, len(in.Nodes)),
+	}
+
+	for _, n := range in.Nodes {
+		if n == nil {
+			continue
+		}
+		out.Nodes = append(out.Nodes, &proto.Node{
+			ID:         n.ID,
+			Name:       n.Name,
+			Datacenter: n.Datacenter,
+			NodePool:   n.NodePool,
+			NodeClass:  n.NodeClass,
+			Attributes: n.Attributes,
+			Meta:       n.Meta,
+		})
+	}
+
+	return out
+}
+
+func convertProtoScoreRequest(in *proto.ScoreRequest) *ScoreRequest {
+	if in == nil {
+		return &ScoreRequest{}
+	}
+
+	out := &ScoreRequest{
+		Namespace: in.Namespace,
+		JobID:     in.JobId,
+		JobType:   in.JobType,
+		TaskGroup: in.TaskGroup,
+		Meta:      in.Meta,
+		Nodes:     make([]*Node, 0, len(in.Nodes)),
+	}
+
+	for _, n := range in.Nodes {
+		if n == nil {
+			continue
+		}
+		out.Nodes = append(out.Nodes, &Node{
+			ID:         n.ID,
+			Name:       n.Name,
+			Datacenter: n.Datacenter,
+			NodePool:   n.NodePool,
+			NodeClass:  n.NodeClass,
+			Attributes: n.Attributes,
+			Meta:       n.Meta,
+		})
+	}
+
+	return out
+}
@@ -182,7 +182,7 @@ FieldsLoop:
 	}
 
 	// Object changes that can be done in-place are log configs, services,
-	// constraints, affinity or spread.
+	// constraints, affinity, spread, or cpu and memory resources.
 
 	if !destructive {
 	ObjectsLoop:
@@ -200,6 +200,15 @@ FieldsLoop:
 					}
 				}
 				continue
+			case "Resources":
+				// cpu and memory changes are only in-place when the drivers
+				// on the nodes can resize running tasks, which the scheduler
+				// reports as the group's destructive updates
+				if !resourcesInplace(oDiff) || parent.Updates[UpdateTypeDestructiveUpdate] > 0 {
+					destructive = true
+					break ObjectsLoop
+				}
+				continue
 			default:
 				destructive = true
 				break ObjectsLoop
@@ -213,3 +222,24 @@ FieldsLoop:
 		diff.Annotations = append(diff.Annotations, AnnotationForcesInplaceUpdate)
 	}
 }
+
+// resourcesInplace returns whether a task resources diff only changes the cpu
+// and memory, which the client can apply to the running task in place.
+func resourcesInplace(diff *structs.ObjectDiff) bool {
+	for _, oDiff := range diff.Objects {
+		if oDiff.Type != structs.DiffTypeNone {
+			return false
+		}
+	}
+	for _, fDiff := range diff.Fields {
+		if fDiff.Type == structs.DiffTypeNone {
+			continue
+		}
+		switch fDiff.Name {
+		case "CPU", "MemoryMB", "MemoryMaxMB":
+		default:
+			return false
+		}
+	}
+	return true
+}
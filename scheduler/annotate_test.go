@@ -378,6 +378,97 @@ func TestAnnotateTask(t *testing.T) {
 			Parent:  &structs.TaskGroupDiff{Type: structs.DiffTypeEdited},
 			Desired: AnnotationForcesInplaceUpdate,
 		},
+		{
+			Diff: &structs.TaskDiff{
+				Type: structs.DiffTypeEdited,
+				Objects: []*structs.ObjectDiff{
+					{
+						Type: structs.DiffTypeEdited,
+						Name: "Resources",
+						Fields: []*structs.FieldDiff{
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "CPU",
+								Old:  "100",
+								New:  "200",
+							},
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "MemoryMB",
+								Old:  "256",
+								New:  "512",
+							},
+						},
+					},
+				},
+			},
+			Parent:  &structs.TaskGroupDiff{Type: structs.DiffTypeEdited},
+			Desired: AnnotationForcesInplaceUpdate,
+		},
+		{
+			Diff: &structs.TaskDiff{
+				Type: structs.DiffTypeEdited,
+				Objects: []*structs.ObjectDiff{
+					{
+						Type: structs.DiffTypeEdited,
+						Name: "Resources",
+						Fields: []*structs.FieldDiff{
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "MemoryMB",
+								Old:  "256",
+								New:  "512",
+							},
+						},
+					},
+				},
+			},
+			Parent: &structs.TaskGroupDiff{
+				Type:    structs.DiffTypeEdited,
+				Updates: map[string]uint64{UpdateTypeDestructiveUpdate: 1},
+			},
+			Desired: AnnotationForcesDestructiveUpdate,
+		},
+		{
+			Diff: &structs.TaskDiff{
+				Type: structs.DiffTypeEdited,
+				Objects: []*structs.ObjectDiff{
+					{
+						Type: structs.DiffTypeEdited,
+						Name: "Resources",
+						Fields: []*structs.FieldDiff{
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "Cores",
+								Old:  "1",
+								New:  "2",
+							},
+						},
+					},
+				},
+			},
+			Parent:  &structs.TaskGroupDiff{Type: structs.DiffTypeEdited},
+			Desired: AnnotationForcesDestructiveUpdate,
+		},
+		{
+			Diff: &structs.TaskDiff{
+				Type: structs.DiffTypeEdited,
+				Objects: []*structs.ObjectDiff{
+					{
+						Type: structs.DiffTypeEdited,
+						Name: "Resources",
+						Objects: []*structs.ObjectDiff{
+							{
+								Type: structs.DiffTypeAdded,
+								Name: "Device",
+							},
+						},
+					},
+				},
+			},
+			Parent:  &structs.TaskGroupDiff{Type: structs.DiffTypeEdited},
+			Desired: AnnotationForcesDestructiveUpdate,
+		},
 		{
 			Diff: &structs.TaskDiff{
 				Type: structs.DiffTypeEdited,
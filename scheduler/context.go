@@ -25,6 +25,10 @@ type Context interface {
 	// Metrics returns the current metrics
 	Metrics() *structs.AllocMetric
 
+	// Tracer returns the recorder of scheduler decisions for the eval. It
+	// is nil when evaluation tracing is disabled.
+	Tracer() *structs.EvalTraceRecorder
+
 	// Reset is invoked after making a placement
 	Reset()
 
@@ -141,6 +145,7 @@ type EvalContext struct {
 	plan        *structs.Plan
 	logger      log.Logger
 	metrics     *structs.AllocMetric
+	tracer      *structs.EvalTraceRecorder
 	eligibility *EvalEligibility
 }
 
@@ -172,12 +177,24 @@ func (e *EvalContext) Metrics() *structs.AllocMetric {
 	return e.metrics
 }
 
+func (e *EvalContext) Tracer() *structs.EvalTraceRecorder {
+	return e.tracer
+}
+
+// SetTracer sets the recorder that receives every scheduler decision made
+// with this context.
+func (e *EvalContext) SetTracer(tracer *structs.EvalTraceRecorder) {
+	e.tracer = tracer
+	e.metrics.SetTracer(tracer)
+}
+
 func (e *EvalContext) SetState(s State) {
 	e.state = s
 }
 
 func (e *EvalContext) Reset() {
 	e.metrics = new(structs.AllocMetric)
+	e.metrics.SetTracer(e.tracer)
 }
 
 func (e *EvalContext) ProposedAllocs(nodeID string) ([]*structs.Allocation, error) {
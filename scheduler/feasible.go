@@ -11,6 +11,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-memdb"
 	"github.com/hashicorp/go-version"
@@ -920,6 +921,151 @@ func (c *ConstraintChecker) meetsConstraint(constraint *structs.Constraint, opti
 	return checkConstraint(c.ctx, constraint.Operand, lVal, rVal, lOk, rOk)
 }
 
+// ReservationChecker finds the reservations that hold capacity on a node for
+// other jobs. A job is only allowed to use the capacity held by the
+// reservation it references, so the capacity held by every other reservation
+// on the node that starts before the job placements are expected to end must
+// be treated as unavailable.
+type ReservationChecker struct {
+	ctx          Context
+	reservations []*structs.Reservation
+}
+
+// NewReservationChecker creates a ReservationChecker.
+func NewReservationChecker(ctx Context) *ReservationChecker {
+	return &ReservationChecker{ctx: ctx}
+}
+
+// SetJob loads the reservations that hold capacity against the placements of
+// the job and aren't referenced by it.
+func (c *ReservationChecker) SetJob(job *structs.Job) {
+	c.reservations = nil
+
+	reservations, err := JobReservations(c.ctx.State(), job, job.NodePool, time.Now())
+	if err != nil {
+		c.ctx.Logger().Named("reservations").Error("failed to lookup reservations", "error", err)
+		return
+	}
+	for _, res := range reservations {
+		if res.Namespace == job.Namespace && res.Name == job.Reservation {
+			continue
+		}
+		c.reservations = append(c.reservations, res)
+	}
+}
+
+// Held returns the reservations that hold capacity on the node.
+func (c *ReservationChecker) Held(node *structs.Node) []*structs.Reservation {
+	var held []*structs.Reservation
+	for _, res := range c.reservations {
+		if reservationMatchesNode(c.ctx, res, node) {
+			held = append(held, res)
+		}
+	}
+	return held
+}
+
+// JobReservations returns the reservations targeting the node pool that hold
+// capacity against the placements of the job: those that haven't expired and
+// start before the placements are expected to end.
+//
+// Placements of batch jobs that reference a reservation are expected to end
+// with its window. Every other placement has no expected end, since service
+// and system jobs run until they are stopped and the run time of batch jobs is
+// unknown, so every reservation that hasn't expired holds capacity against it.
+// This keeps long-running placements from taking the capacity of reservations
+// whose window hasn't started yet, as nothing evicts them once it does.
+func JobReservations(s State, job *structs.Job, pool string, now time.Time) ([]*structs.Reservation, error) {
+	reservations, err := poolReservations(s, pool)
+	if err != nil || len(reservations) == 0 {
+		return nil, err
+	}
+
+	var end time.Time
+	if job.Reservation != "" && (job.Type == structs.JobTypeBatch || job.Type == structs.JobTypeSysBatch) {
+		res, err := s.ReservationByName(nil, job.Namespace, job.Reservation)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			end = res.EndTime
+		}
+	}
+
+	var held []*structs.Reservation
+	for _, res := range reservations {
+		if res.IsExpired(now) {
+			continue
+		}
+		if !end.IsZero() && !res.StartTime.Before(end) {
+			continue
+		}
+		held = append(held, res)
+	}
+	return held, nil
+}
+
+// poolReservations returns the reservations targeting the node pool, which
+// includes those targeting the built-in "all" node pool.
+func poolReservations(s State, pool string) ([]*structs.Reservation, error) {
+	var iters []memdb.ResultIterator
+	if pool == structs.NodePoolAll {
+		// Jobs in the "all" node pool can be placed on any node, so the
+		// reservations targeting every node pool apply.
+		iter, err := s.Reservations(nil, state.SortDefault)
+		if err != nil {
+			return nil, err
+		}
+		iters = append(iters, iter)
+	} else {
+		for _, p := range []string{pool, structs.NodePoolAll} {
+			iter, err := s.ReservationsByNodePool(nil, p)
+			if err != nil {
+				return nil, err
+			}
+			iters = append(iters, iter)
+		}
+	}
+
+	var reservations []*structs.Reservation
+	for _, iter := range iters {
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			reservations = append(reservations, raw.(*structs.Reservation))
+		}
+	}
+	return reservations, nil
+}
+
+// reservationMatchesNode returns true if the node is targeted by the
+// reservation. The constraints are checked directly instead of with a
+// ConstraintChecker so nodes that don't match a reservation aren't recorded as
+// filtered.
+func reservationMatchesNode(ctx ConstraintContext, res *structs.Reservation, node *structs.Node) bool {
+	if !res.MatchesNode(node) {
+		return false
+	}
+	for _, constraint := range res.Constraints {
+		lVal, lOk := resolveTarget(constraint.LTarget, node)
+		rVal, rOk := resolveTarget(constraint.RTarget, node)
+		if !checkConstraint(ctx, constraint.Operand, lVal, rVal, lOk, rOk) {
+			return false
+		}
+	}
+	return true
+}
+
+// reservationConstraintContext is the ConstraintContext used to match the
+// reservation constraints outside of an evaluation, such as in the plan
+// applier.
+type reservationConstraintContext struct {
+	EvalCache
+	metrics structs.AllocMetric
+}
+
+func (c *reservationConstraintContext) Metrics() *structs.AllocMetric {
+	return &c.metrics
+}
+
 // resolveTarget is used to resolve the LTarget and RTarget of a Constraint.
 func resolveTarget(target string, node *structs.Node) (string, bool) {
 	// If no prefix, this must be a literal value
@@ -3562,3 +3562,55 @@ func TestCheckAttributeConstraint(t *testing.T) {
 		}
 	}
 }
+
+func TestJobReservations(t *testing.T) {
+	ci.Parallel(t)
+
+	store, _ := testContext(t)
+	now := time.Now()
+
+	// The job reservation ends before the pending reservation starts.
+	active := mock.Reservation()
+	pending := mock.Reservation()
+	pending.StartTime = active.EndTime.Add(time.Hour)
+	pending.EndTime = pending.StartTime.Add(time.Hour)
+	expired := mock.Reservation()
+	expired.EndTime = now.Add(-time.Minute)
+	expired.StartTime = expired.EndTime.Add(-time.Hour)
+	otherPool := mock.Reservation()
+	otherPool.NodePool = "other"
+	allPools := mock.Reservation()
+	allPools.NodePool = structs.NodePoolAll
+	must.NoError(t, store.UpsertReservations(structs.MsgTypeTestSetup, 1000,
+		[]*structs.Reservation{active, pending, expired, otherPool, allPools}))
+
+	names := func(reservations []*structs.Reservation) []string {
+		var out []string
+		for _, res := range reservations {
+			out = append(out, res.Name)
+		}
+		return out
+	}
+
+	// Every reservation that hasn't expired holds capacity against service
+	// placements.
+	job := mock.Job()
+	got, err := JobReservations(store, job, job.NodePool, now)
+	must.NoError(t, err)
+	must.SliceContainsAll(t, []string{active.Name, pending.Name, allPools.Name}, names(got))
+
+	// Batch placements that reference a reservation are expected to end with
+	// its window, so reservations starting after it don't hold capacity.
+	job = mock.BatchJob()
+	job.Reservation = active.Name
+	got, err = JobReservations(store, job, job.NodePool, now)
+	must.NoError(t, err)
+	must.SliceContainsAll(t, []string{active.Name, allPools.Name}, names(got))
+
+	// Jobs in the "all" node pool are held against every node pool.
+	job = mock.Job()
+	job.NodePool = structs.NodePoolAll
+	got, err = JobReservations(store, job, job.NodePool, now)
+	must.NoError(t, err)
+	must.SliceContainsAll(t, []string{active.Name, pending.Name, otherPool.Name, allPools.Name}, names(got))
+}
@@ -6,6 +6,7 @@ package scheduler
 import (
 	"fmt"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"time"
 
@@ -108,6 +109,7 @@ type GenericScheduler struct {
 	plan       *structs.Plan
 	planResult *structs.PlanResult
 	ctx        *EvalContext
+	tracer     *structs.EvalTraceRecorder
 	stack      *GenericStack
 
 	// followUpEvals are evals with WaitUntil set, which are delayed until that time
@@ -161,6 +163,11 @@ func (s *GenericScheduler) Process(eval *structs.Evaluation) (err error) {
 	// Update our logger with the eval's information
 	s.logger = s.logger.With("eval_id", eval.ID, "job_id", eval.JobID, "namespace", eval.Namespace)
 
+	// Record every scheduler decision if evaluation tracing is enabled. The
+	// trace covers all the scheduling attempts of the eval.
+	s.tracer = newEvalTracer(s.state, s.planner, eval)
+	defer writeEvalTrace(s.logger, s.planner, s.tracer)
+
 	// Verify the evaluation trigger reason is understood
 	switch eval.TriggeredBy {
 	case structs.EvalTriggerJobRegister, structs.EvalTriggerJobDeregister,
@@ -170,7 +177,8 @@ func (s *GenericScheduler) Process(eval *structs.Evaluation) (err error) {
 		structs.EvalTriggerPeriodicJob, structs.EvalTriggerMaxPlans,
 		structs.EvalTriggerDeploymentWatcher, structs.EvalTriggerRetryFailedAlloc,
 		structs.EvalTriggerFailedFollowUp, structs.EvalTriggerPreemption,
-		structs.EvalTriggerScaling, structs.EvalTriggerMaxDisconnectTimeout, structs.EvalTriggerReconnect:
+		structs.EvalTriggerScaling, structs.EvalTriggerMaxDisconnectTimeout, structs.EvalTriggerReconnect,
+		structs.EvalTriggerNodePoolRebalance:
 	default:
 		desc := fmt.Sprintf("scheduler cannot handle '%s' evaluation reason",
 			eval.TriggeredBy)
@@ -278,9 +286,13 @@ func (s *GenericScheduler) process() (bool, error) {
 
 	// Create an evaluation context
 	s.ctx = NewEvalContext(s.eventsCh, s.state, s.plan, s.logger)
+	s.ctx.SetTracer(s.tracer)
 
 	// Construct the placement stack
 	s.stack = NewGenericStack(s.batch, s.ctx)
+	if provider, ok := s.planner.(NodeScorerProvider); ok {
+		s.stack.SetNodeScorers(provider.NodeScorers())
+	}
 	if !s.job.Stopped() {
 		s.setJob(s.job)
 	}
@@ -525,6 +537,10 @@ func (s *GenericScheduler) computePlacements(destructive, place []placementResul
 	// Capture current time to use as the start time for any rescheduled allocations
 	now := time.Now()
 
+	// Track the placements made for gang task groups so they can be backed
+	// out if any part of the gang fails to place.
+	var gangPlaced []*gangPlacement
+
 	// Have to handle destructive changes first as we need to discount their
 	// resources. To understand this imagine the resources were reduced and the
 	// count was scaled up.
@@ -600,6 +616,13 @@ func (s *GenericScheduler) computePlacements(destructive, place []placementResul
 			// Compute penalty nodes for rescheduled allocs
 			selectOptions := getSelectOptions(prevAllocation, preferredNode)
 			selectOptions.AllocName = missing.Name()
+
+			// Penalize the node allocations are migrated off of to rebalance its
+			// node pool, otherwise the replacement may land back on it.
+			if prevAllocation != nil && prevAllocation.DesiredTransition.ShouldMigrate() &&
+				s.eval.TriggeredBy == structs.EvalTriggerNodePoolRebalance {
+				selectOptions.PenaltyNodeIDs[prevAllocation.NodeID] = struct{}{}
+			}
 			option := s.selectNextOption(tg, selectOptions)
 
 			// Store the available nodes by datacenter
@@ -628,7 +651,8 @@ func (s *GenericScheduler) computePlacements(destructive, place []placementResul
 					Tasks:          option.TaskResources,
 					TaskLifecycles: option.TaskLifecycles,
 					Shared: structs.AllocatedSharedResources{
-						DiskMB: int64(tg.EphemeralDisk.SizeMB),
+						DiskMB:   int64(tg.EphemeralDisk.SizeMB),
+						DiskIOPS: int64(tg.EphemeralDisk.IOPS),
 					},
 				}
 				if option.AllocResources != nil {
@@ -704,6 +728,15 @@ func (s *GenericScheduler) computePlacements(destructive, place []placementResul
 				// Track the placement
 				s.plan.AppendAlloc(alloc, downgradedJob)
 
+				if s.job.Gang.Includes(tg.Name) {
+					gangPlaced = append(gangPlaced, &gangPlacement{
+						alloc:         alloc,
+						prevAlloc:     prevAllocation,
+						stopPrevAlloc: stopPrevAlloc,
+						rescheduling:  missing.IsRescheduling(),
+					})
+				}
+
 			} else {
 				// Lazy initialize the failed map
 				if s.failedTGAllocs == nil {
@@ -736,9 +769,80 @@ func (s *GenericScheduler) computePlacements(destructive, place []placementResul
 		}
 	}
 
+	s.revertGangPlacements(gangPlaced)
 	return nil
 }
 
+// gangPlacement is a placement made for a task group that is part of the
+// job's gang.
+type gangPlacement struct {
+	alloc         *structs.Allocation
+	prevAlloc     *structs.Allocation
+	stopPrevAlloc bool
+	rescheduling  bool
+}
+
+// revertGangPlacements removes the placements of the job's gang task groups
+// from the plan if any of the gang task groups failed to place, so that the
+// gang is either placed in its entirety or not at all. The failed task groups
+// remain in failedTGAllocs so a single blocked eval is created for the job.
+func (s *GenericScheduler) revertGangPlacements(placed []*gangPlacement) {
+	if len(placed) == 0 || len(s.failedTGAllocs) == 0 {
+		return
+	}
+
+	failed := false
+	for tg := range s.failedTGAllocs {
+		if s.job.Gang.Includes(tg) {
+			failed = true
+			break
+		}
+	}
+	if !failed {
+		return
+	}
+
+	for _, p := range placed {
+		preempted := s.plan.RemoveAlloc(p.alloc)
+		s.revertGangPreemptions(p.alloc.TaskGroup, preempted)
+
+		// Back out the stop of the previous allocation since its replacement
+		// will not be placed.
+		if p.stopPrevAlloc {
+			s.plan.RemoveUpdate(p.prevAlloc)
+		}
+
+		// Keep the reschedule tracker of the previous allocation so the
+		// reschedule is retried by the blocked eval.
+		if p.prevAlloc != nil && p.rescheduling {
+			annotateRescheduleTracker(p.prevAlloc, structs.LastRescheduleFailedToPlace)
+		}
+	}
+
+	s.logger.Debug("gang task group failed to place, reverted gang placements",
+		"reverted", len(placed))
+}
+
+// revertGangPreemptions removes the annotations for preemptions that were
+// backed out of the plan along with a gang placement.
+func (s *GenericScheduler) revertGangPreemptions(tg string, preempted []*structs.Allocation) {
+	if len(preempted) == 0 || !s.eval.AnnotatePlan || s.plan.Annotations == nil {
+		return
+	}
+
+	for _, stop := range preempted {
+		s.plan.Annotations.PreemptedAllocs = slices.DeleteFunc(s.plan.Annotations.PreemptedAllocs,
+			func(a *structs.AllocListStub) bool { return a.ID == stop.ID })
+		delete(s.plan.Annotations.PreemptionReasons, stop.ID)
+	}
+
+	if s.plan.Annotations.DesiredTGUpdates != nil {
+		if desired := s.plan.Annotations.DesiredTGUpdates[tg]; desired != nil {
+			desired.Preemptions -= uint64(len(preempted))
+		}
+	}
+}
+
 // setJob updates the stack with the given job and job's node pool scheduler
 // configuration.
 func (s *GenericScheduler) setJob(job *structs.Job) error {
@@ -855,6 +959,21 @@ func (s *GenericScheduler) findPreferredNode(place placementResult) (*structs.No
 	if prev == nil {
 		return nil, nil
 	}
+
+	// Allocations migrated to rebalance their node pool prefer the node the
+	// rebalance fit them on, otherwise the replacement may land on another
+	// lightly utilized node and the donor node is never emptied.
+	if s.eval.TriggeredBy == structs.EvalTriggerNodePoolRebalance &&
+		prev.DesiredTransition.ShouldMigrate() && prev.DesiredTransition.RebalanceNodeID != "" {
+		node, err := s.state.NodeByID(memdb.NewWatchSet(), prev.DesiredTransition.RebalanceNodeID)
+		if err != nil {
+			return nil, err
+		}
+		if node != nil && node.Ready() {
+			return node, nil
+		}
+	}
+
 	if place.TaskGroup().EphemeralDisk.Sticky || place.TaskGroup().EphemeralDisk.Migrate {
 		var preferredNode *structs.Node
 		ws := memdb.NewWatchSet()
@@ -911,7 +1030,7 @@ func (s *GenericScheduler) handlePreemptions(option *RankedNode, alloc *structs.
 		preemptedAllocIDs = append(preemptedAllocIDs, stop.ID)
 
 		if s.eval.AnnotatePlan && s.plan.Annotations != nil {
-			s.plan.Annotations.PreemptedAllocs = append(s.plan.Annotations.PreemptedAllocs, stop.Stub(nil))
+			annotatePreemption(s.plan.Annotations, stop, alloc, option)
 			if s.plan.Annotations.DesiredTGUpdates != nil {
 				desired := s.plan.Annotations.DesiredTGUpdates[missing.TaskGroup().Name]
 				desired.Preemptions += 1
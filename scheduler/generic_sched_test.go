@@ -170,7 +170,7 @@ func TestServiceSched_JobRegister_StickyAllocs(t *testing.T) {
 
 	// Update the job to force a rolling upgrade
 	updated := job.Copy()
-	updated.TaskGroups[0].Tasks[0].Resources.CPU += 10
+	updated.TaskGroups[0].Tasks[0].Config["command"] = "/bin/other"
 	require.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, updated))
 
 	// Create a mock evaluation to handle the update
@@ -302,7 +302,7 @@ func TestServiceSched_JobRegister_StickyHostVolumes(t *testing.T) {
 
 	// Update the job to force a rolling upgrade
 	updated := job.Copy()
-	updated.TaskGroups[0].Tasks[0].Resources.CPU += 10
+	updated.TaskGroups[0].Tasks[0].Config["command"] = "/bin/other"
 	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, updated))
 
 	// Create a mock evaluation to handle the update
@@ -1380,6 +1380,245 @@ func TestServiceSched_JobRegister_AllocFail(t *testing.T) {
 	h.AssertEvalStatus(t, structs.EvalStatusComplete)
 }
 
+func TestServiceSched_JobRegister_Gang(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name           string
+		gang           *structs.JobGang
+		bigFits        bool
+		expectPlaced   map[string]int
+		expectFailedTG []string
+	}{
+		{
+			name:         "all groups placed",
+			gang:         &structs.JobGang{},
+			bigFits:      true,
+			expectPlaced: map[string]int{"web": 2, "big": 1},
+		},
+		{
+			name:           "all groups in gang",
+			gang:           &structs.JobGang{},
+			expectPlaced:   map[string]int{},
+			expectFailedTG: []string{"big"},
+		},
+		{
+			name:           "listed groups in gang",
+			gang:           &structs.JobGang{Groups: []string{"big"}},
+			expectPlaced:   map[string]int{"web": 2},
+			expectFailedTG: []string{"big"},
+		},
+		{
+			name:           "no gang",
+			expectPlaced:   map[string]int{"web": 2},
+			expectFailedTG: []string{"big"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewHarness(t)
+
+			node := mock.Node()
+			must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+
+			job := mock.Job()
+			job.Gang = tc.gang
+			job.TaskGroups[0].Count = 2
+
+			big := job.TaskGroups[0].Copy()
+			big.Name = "big"
+			big.Count = 1
+			if !tc.bigFits {
+				big.Tasks[0].Resources.CPU = node.NodeResources.Processors.TotalCompute() * 2
+			}
+			job.TaskGroups = append(job.TaskGroups, big)
+			must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job))
+
+			eval := &structs.Evaluation{
+				Namespace:   structs.DefaultNamespace,
+				ID:          uuid.Generate(),
+				Priority:    job.Priority,
+				TriggeredBy: structs.EvalTriggerJobRegister,
+				JobID:       job.ID,
+				Status:      structs.EvalStatusPending,
+			}
+			must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+
+			must.NoError(t, h.Process(NewServiceScheduler, eval))
+
+			placed := map[string]int{}
+			for _, plan := range h.Plans {
+				must.Eq(t, tc.gang != nil, plan.AllAtOnce)
+				for _, allocs := range plan.NodeAllocation {
+					for _, alloc := range allocs {
+						placed[alloc.TaskGroup]++
+					}
+				}
+			}
+			must.Eq(t, tc.expectPlaced, placed)
+
+			must.Len(t, 1, h.Evals)
+			outEval := h.Evals[0]
+			must.MapLen(t, len(tc.expectFailedTG), outEval.FailedTGAllocs)
+			for _, tg := range tc.expectFailedTG {
+				must.MapContainsKey(t, outEval.FailedTGAllocs, tg)
+			}
+
+			if len(tc.expectFailedTG) == 0 {
+				must.SliceEmpty(t, h.CreateEvals)
+			} else {
+				must.Len(t, 1, h.CreateEvals)
+				must.Eq(t, structs.EvalStatusBlocked, h.CreateEvals[0].Status)
+				must.Eq(t, h.CreateEvals[0].ID, outEval.BlockedEval)
+			}
+
+			// Allocations that were not placed must remain queued.
+			for _, tg := range job.TaskGroups {
+				must.Eq(t, tg.Count-placed[tg.Name], outEval.QueuedAllocations[tg.Name],
+					must.Sprintf("unexpected queued allocations for %q", tg.Name))
+			}
+		})
+	}
+}
+
+func TestServiceSched_JobRegister_Reservation(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name         string
+		startOffset  time.Duration
+		useReserved  bool
+		expectPlaced int
+	}{
+		{
+			name:         "active reservation holds capacity",
+			expectPlaced: 2,
+		},
+		{
+			name:         "job references reservation",
+			useReserved:  true,
+			expectPlaced: 10,
+		},
+		{
+			name:         "pending reservation holds capacity",
+			startOffset:  time.Hour,
+			expectPlaced: 2,
+		},
+		{
+			name:         "expired reservation",
+			startOffset:  -2 * time.Hour,
+			expectPlaced: 10,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewHarness(t)
+
+			node := mock.Node()
+			must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+
+			// Reserve all the node memory except for enough to run two
+			// allocations of the mock job.
+			res := mock.Reservation()
+			res.StartTime = res.StartTime.Add(tc.startOffset)
+			res.EndTime = res.EndTime.Add(tc.startOffset)
+			res.Resources.CPU = 0
+			res.Resources.MemoryMB = int(node.NodeResources.Memory.MemoryMB -
+				node.ReservedResources.Memory.MemoryMB - 512)
+			must.NoError(t, h.State.UpsertReservations(structs.MsgTypeTestSetup, h.NextIndex(),
+				[]*structs.Reservation{res}))
+
+			job := mock.Job()
+			if tc.useReserved {
+				job.Reservation = res.Name
+			}
+			must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job))
+
+			eval := &structs.Evaluation{
+				Namespace:   structs.DefaultNamespace,
+				ID:          uuid.Generate(),
+				Priority:    job.Priority,
+				TriggeredBy: structs.EvalTriggerJobRegister,
+				JobID:       job.ID,
+				Status:      structs.EvalStatusPending,
+			}
+			must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+
+			must.NoError(t, h.Process(NewServiceScheduler, eval))
+
+			placed := 0
+			for _, plan := range h.Plans {
+				for _, allocs := range plan.NodeAllocation {
+					placed += len(allocs)
+				}
+			}
+			must.Eq(t, tc.expectPlaced, placed)
+
+			must.Len(t, 1, h.Evals)
+			outEval := h.Evals[0]
+			if tc.expectPlaced < job.TaskGroups[0].Count {
+				must.MapContainsKey(t, outEval.FailedTGAllocs, "web")
+				metrics := outEval.FailedTGAllocs["web"]
+				must.MapContainsKey(t, metrics.DimensionExhausted, "reserved memory")
+			} else {
+				must.MapEmpty(t, outEval.FailedTGAllocs)
+			}
+		})
+	}
+}
+
+func TestServiceSched_JobRegister_NodeScorers(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+
+	var nodes []*structs.Node
+	for i := 0; i < 10; i++ {
+		node := mock.Node()
+		nodes = append(nodes, node)
+		must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+	}
+
+	// Score every node poorly except for one.
+	scores := make(map[string]float64, len(nodes))
+	for _, node := range nodes {
+		scores[node.ID] = -1
+	}
+	preferred := nodes[7]
+	scores[preferred.ID] = 1
+	h.Scorers = []NodeScorer{&testNodeScorer{name: "rack-power", scores: scores}}
+
+	job := mock.Job()
+	job.TaskGroups[0].Count = 1
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+
+	must.NoError(t, h.Process(NewServiceScheduler, eval))
+	must.Len(t, 1, h.Plans)
+
+	plan := h.Plans[0]
+	must.MapLen(t, 1, plan.NodeAllocation)
+	must.Len(t, 1, plan.NodeAllocation[preferred.ID])
+
+	// The external score is visible in the allocation metrics.
+	alloc := plan.NodeAllocation[preferred.ID][0]
+	top := alloc.Metrics.MaxNormScore()
+	must.NotNil(t, top)
+	must.Eq(t, preferred.ID, top.NodeID)
+	must.Eq(t, 1.0, top.Scores["rack-power"])
+}
+
 func TestServiceSched_JobRegister_CreateBlockedEval(t *testing.T) {
 	ci.Parallel(t)
 
@@ -3157,6 +3396,95 @@ func TestServiceSched_JobModify_InPlace(t *testing.T) {
 	}
 }
 
+// TestServiceSched_JobModify_Resize asserts that cpu and memory changes are
+// only updated in place when the driver on the alloc's node can resize running
+// tasks, and otherwise follow the update strategy.
+func TestServiceSched_JobModify_Resize(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name        string
+		driver      string
+		expectStop  int
+		expectPlace int
+	}{
+		{
+			name:        "exec resizes in place",
+			driver:      "exec",
+			expectPlace: 10,
+		},
+		{
+			name:        "docker is destructive",
+			driver:      "docker",
+			expectStop:  4,
+			expectPlace: 4,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewHarness(t)
+
+			// docker doesn't report that it can update task resources
+			var nodes []*structs.Node
+			for i := 0; i < 10; i++ {
+				node := mock.Node()
+				node.Drivers["docker"] = &structs.DriverInfo{Detected: true, Healthy: true}
+				node.Attributes["driver.docker"] = "1"
+				nodes = append(nodes, node)
+				must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+			}
+
+			job := mock.Job()
+			job.TaskGroups[0].Tasks[0].Driver = tc.driver
+			job.TaskGroups[0].Update = &structs.UpdateStrategy{
+				MaxParallel:     4,
+				HealthCheck:     structs.UpdateStrategyHealthCheck_Checks,
+				MinHealthyTime:  10 * time.Second,
+				HealthyDeadline: 10 * time.Minute,
+			}
+			must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job))
+
+			var allocs []*structs.Allocation
+			for i := 0; i < 10; i++ {
+				alloc := mock.AllocForNode(nodes[i])
+				alloc.Job = job
+				alloc.JobID = job.ID
+				alloc.Name = fmt.Sprintf("my-job.web[%d]", i)
+				allocs = append(allocs, alloc)
+			}
+			must.NoError(t, h.State.UpsertAllocs(structs.MsgTypeTestSetup, h.NextIndex(), allocs))
+
+			job2 := job.Copy()
+			job2.TaskGroups[0].Tasks[0].Resources.MemoryMB += 64
+			must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job2))
+
+			eval := &structs.Evaluation{
+				Namespace:   structs.DefaultNamespace,
+				ID:          uuid.Generate(),
+				Priority:    50,
+				TriggeredBy: structs.EvalTriggerJobRegister,
+				JobID:       job.ID,
+				Status:      structs.EvalStatusPending,
+			}
+			must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+			must.NoError(t, h.Process(NewServiceScheduler, eval))
+			must.Len(t, 1, h.Plans)
+			plan := h.Plans[0]
+
+			var stopped, placed []*structs.Allocation
+			for _, updates := range plan.NodeUpdate {
+				stopped = append(stopped, updates...)
+			}
+			for _, allocs := range plan.NodeAllocation {
+				placed = append(placed, allocs...)
+			}
+			must.Len(t, tc.expectStop, stopped)
+			must.Len(t, tc.expectPlace, placed)
+		})
+	}
+}
+
 // TestServiceSched_JobModify_InPlace08 asserts that inplace updates of
 // allocations created with Nomad 0.8 do not cause panics.
 //
@@ -4059,6 +4387,111 @@ func TestServiceSched_NodeDrain(t *testing.T) {
 	h.AssertEvalStatus(t, structs.EvalStatusComplete)
 }
 
+func TestServiceSched_NodePoolRebalance(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+
+	donor := mock.Node()
+	target := mock.Node()
+	must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), donor))
+	must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), target))
+
+	// Another alloc on the donor makes it the better bin packing fit.
+	other := mock.Alloc()
+	other.NodeID = donor.ID
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, other.Job))
+
+	job := mock.Job()
+	job.TaskGroups[0].Count = 1
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job))
+
+	alloc := mock.Alloc()
+	alloc.Job = job
+	alloc.JobID = job.ID
+	alloc.NodeID = donor.ID
+	alloc.Name = "my-job.web[0]"
+	alloc.DesiredTransition.Migrate = pointer.Of(true)
+	must.NoError(t, h.State.UpsertAllocs(structs.MsgTypeTestSetup, h.NextIndex(),
+		[]*structs.Allocation{other, alloc}))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    50,
+		TriggeredBy: structs.EvalTriggerNodePoolRebalance,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+	must.NoError(t, h.Process(NewServiceScheduler, eval))
+
+	// The replacement must not land back on the node being emptied.
+	must.Len(t, 1, h.Plans)
+	plan := h.Plans[0]
+	must.Len(t, 1, plan.NodeUpdate[donor.ID])
+	must.MapNotContainsKey(t, plan.NodeAllocation, donor.ID)
+	must.Len(t, 1, plan.NodeAllocation[target.ID])
+}
+
+func TestServiceSched_NodePoolRebalance_Target(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+
+	donor := mock.Node()
+	planned := mock.Node()
+	must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), donor))
+	must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), planned))
+
+	// The other nodes are better bin packing fits than the node the rebalance
+	// planned the move for.
+	other := mock.Job()
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, other))
+	var allocs []*structs.Allocation
+	for i := 0; i < 5; i++ {
+		node := mock.Node()
+		must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+
+		alloc := mock.AllocForNode(node)
+		alloc.Job = other
+		alloc.JobID = other.ID
+		alloc.Name = fmt.Sprintf("my-job.web[%d]", i)
+		allocs = append(allocs, alloc)
+	}
+
+	job := mock.Job()
+	job.TaskGroups[0].Count = 1
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job))
+
+	alloc := mock.AllocForNode(donor)
+	alloc.Job = job
+	alloc.JobID = job.ID
+	alloc.Name = "my-job.web[0]"
+	alloc.DesiredTransition.Migrate = pointer.Of(true)
+	alloc.DesiredTransition.RebalanceNodeID = planned.ID
+	allocs = append(allocs, alloc)
+	must.NoError(t, h.State.UpsertAllocs(structs.MsgTypeTestSetup, h.NextIndex(), allocs))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    50,
+		TriggeredBy: structs.EvalTriggerNodePoolRebalance,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+	must.NoError(t, h.Process(NewServiceScheduler, eval))
+
+	// The replacement is placed on the node the rebalance fit it on.
+	must.Len(t, 1, h.Plans)
+	plan := h.Plans[0]
+	must.Len(t, 1, plan.NodeUpdate[donor.ID])
+	must.MapLen(t, 1, plan.NodeAllocation)
+	must.Len(t, 1, plan.NodeAllocation[planned.ID])
+}
+
 func TestServiceSched_NodeDrain_Down(t *testing.T) {
 	ci.Parallel(t)
 
@@ -6616,6 +7049,102 @@ func TestServiceSched_Preemption(t *testing.T) {
 	require.Equal(expectedPreemptedAllocs, actualPreemptedAllocs)
 }
 
+// TestServiceSched_Preemption_AnnotatePlan asserts that plan annotations
+// explain why each allocation is preempted.
+func TestServiceSched_Preemption_AnnotatePlan(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+
+	legacyCpuResources, processorResources := cpuResources(1000)
+	node := mock.Node()
+	node.Resources = nil
+	node.ReservedResources = nil
+	node.NodeResources = &structs.NodeResources{
+		Processors: processorResources,
+		Cpu:        legacyCpuResources,
+		Memory: structs.NodeMemoryResources{
+			MemoryMB: 2048,
+		},
+		Disk: structs.NodeDiskResources{
+			DiskMB: 100 * 1024,
+		},
+		Networks: []*structs.NetworkResource{
+			{
+				Mode:   "host",
+				Device: "eth0",
+				CIDR:   "192.168.0.100/32",
+				MBits:  1000,
+			},
+		},
+	}
+	must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+
+	// Create a low priority job using most of the node
+	lowJob := mock.Job()
+	lowJob.Priority = 30
+	lowJob.PreemptionCost = 7
+	lowJob.TaskGroups[0].Count = 1
+	lowJob.TaskGroups[0].Networks = nil
+	lowJob.TaskGroups[0].Tasks[0].Resources.CPU = 800
+	lowJob.TaskGroups[0].Tasks[0].Resources.MemoryMB = 1024
+	lowJob.TaskGroups[0].Tasks[0].Resources.Networks = nil
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, lowJob))
+
+	lowEval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    lowJob.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       lowJob.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{lowEval}))
+	must.NoError(t, h.Process(NewServiceScheduler, lowEval))
+
+	lowAllocs, err := h.State.AllocsByJob(nil, lowJob.Namespace, lowJob.ID, false)
+	must.NoError(t, err)
+	must.Len(t, 1, lowAllocs)
+
+	// Plan a high priority job that needs the CPU of the low priority job
+	highJob := mock.Job()
+	highJob.Priority = 100
+	highJob.TaskGroups[0].Count = 1
+	highJob.TaskGroups[0].Networks = nil
+	highJob.TaskGroups[0].Tasks[0].Resources.CPU = 500
+	highJob.TaskGroups[0].Tasks[0].Resources.MemoryMB = 256
+	highJob.TaskGroups[0].Tasks[0].Resources.Networks = nil
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, highJob))
+
+	highEval := &structs.Evaluation{
+		Namespace:    structs.DefaultNamespace,
+		ID:           uuid.Generate(),
+		Priority:     highJob.Priority,
+		TriggeredBy:  structs.EvalTriggerJobRegister,
+		JobID:        highJob.ID,
+		Status:       structs.EvalStatusPending,
+		AnnotatePlan: true,
+	}
+	must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{highEval}))
+	must.NoError(t, h.Process(NewServiceScheduler, highEval))
+
+	must.Len(t, 2, h.Plans)
+	annotations := h.Plans[1].Annotations
+	must.NotNil(t, annotations)
+	must.Len(t, 1, annotations.PreemptedAllocs)
+	must.Eq(t, lowAllocs[0].ID, annotations.PreemptedAllocs[0].ID)
+
+	reason := annotations.PreemptionReasons[lowAllocs[0].ID]
+	must.NotNil(t, reason)
+	must.Eq(t, &structs.PreemptionReason{
+		PreemptedFor:   structs.AllocName(highJob.ID, "web", 0),
+		NodeID:         node.ID,
+		Resources:      []string{"cpu"},
+		JobPriority:    30,
+		PreemptionCost: 7,
+	}, reason)
+}
+
 // TestServiceSched_Migrate_NonCanary asserts that when rescheduling
 // non-canary allocations, a single allocation is migrated
 func TestServiceSched_Migrate_NonCanary(t *testing.T) {
@@ -7430,3 +7959,71 @@ func initNodeAndAllocs(t *testing.T, h *Harness, job *structs.Job,
 	return node, job, allocs
 
 }
+
+func TestServiceSched_EvalTrace(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name    string
+		enabled bool
+	}{
+		{name: "enabled", enabled: true},
+		{name: "disabled", enabled: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewHarness(t)
+			must.NoError(t, h.State.SchedulerSetConfig(h.NextIndex(), &structs.SchedulerConfiguration{
+				EvalTraceEnabled: tc.enabled,
+			}))
+
+			// One node fails the job's kernel constraint.
+			linux := mock.Node()
+			must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), linux))
+			windows := mock.Node()
+			windows.Attributes["kernel.name"] = "windows"
+			windows.ComputeClass()
+			must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), windows))
+
+			job := mock.Job()
+			job.TaskGroups[0].Count = 1
+			must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job))
+
+			eval := &structs.Evaluation{
+				Namespace:   structs.DefaultNamespace,
+				ID:          uuid.Generate(),
+				Priority:    job.Priority,
+				TriggeredBy: structs.EvalTriggerJobRegister,
+				JobID:       job.ID,
+				Status:      structs.EvalStatusPending,
+			}
+			must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+			must.NoError(t, h.Process(NewServiceScheduler, eval))
+			must.Len(t, 1, h.Plans)
+
+			if !tc.enabled {
+				must.Len(t, 0, h.EvalTraces)
+				return
+			}
+
+			must.Len(t, 1, h.EvalTraces)
+			trace := h.EvalTraces[0]
+			must.Eq(t, eval.ID, trace.EvalID)
+			must.Eq(t, job.ID, trace.JobID)
+
+			decisions := map[string]string{}
+			for _, ev := range trace.Events {
+				must.Eq(t, "web", ev.TaskGroup)
+				must.Eq(t, structs.AllocName(job.ID, "web", 0), ev.AllocName)
+				decisions[ev.NodeID+"/"+ev.Decision] = ev.Reason
+			}
+			must.MapContainsKeys(t, decisions, []string{
+				windows.ID + "/" + structs.EvalTraceDecisionFiltered,
+				linux.ID + "/" + structs.EvalTraceDecisionScored,
+				linux.ID + "/" + structs.EvalTraceDecisionSelected,
+			})
+			must.StrContains(t, decisions[windows.ID+"/"+structs.EvalTraceDecisionFiltered], "kernel.name")
+		})
+	}
+}
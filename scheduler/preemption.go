@@ -6,6 +6,7 @@ package scheduler
 import (
 	"maps"
 	"math"
+	"slices"
 	"sort"
 
 	"github.com/hashicorp/nomad/helper"
@@ -17,20 +18,27 @@ import (
 // number of allocations being preempted exceeds max_parallel value in the job's migrate block
 const maxParallelPenalty = 50.0
 
+// preemptionCostPenalty is the score penalty applied to allocations per unit
+// of the preemption cost of their job, so that among allocations of the same
+// priority the ones that are cheaper to preempt are chosen first.
+const preemptionCostPenalty = 0.1
+
 type groupedAllocs struct {
 	priority int
 	allocs   []*structs.Allocation
 }
 
 type allocInfo struct {
-	maxParallel int
-	resources   *structs.ComparableResources
+	maxParallel    int
+	preemptionCost int
+	resources      *structs.ComparableResources
 }
 
 func (ai *allocInfo) Copy() *allocInfo {
 	return &allocInfo{
-		maxParallel: ai.maxParallel,
-		resources:   ai.resources.Copy(),
+		maxParallel:    ai.maxParallel,
+		preemptionCost: ai.preemptionCost,
+		resources:      ai.resources.Copy(),
 	}
 }
 
@@ -129,6 +137,12 @@ type Preemptor struct {
 	// currentAllocs is the candidate set used to find preemptible allocations
 	currentAllocs []*structs.Allocation
 
+	// plannedPreemptions are the allocations already preempted by the plan
+	plannedPreemptions []*structs.Allocation
+
+	// budgets enforces the preemption budgets of the candidates, if set
+	budgets *PreemptionBudgetChecker
+
 	// ctx is the context from the scheduler stack
 	ctx Context
 }
@@ -156,10 +170,18 @@ func (p *Preemptor) Copy() *Preemptor {
 		jobID:                  p.jobID,
 		nodeRemainingResources: p.nodeRemainingResources.Copy(),
 		currentAllocs:          helper.CopySlice(p.currentAllocs),
+		plannedPreemptions:     p.plannedPreemptions,
+		budgets:                p.budgets,
 		ctx:                    p.ctx,
 	}
 }
 
+// SetBudgets sets the checker used to enforce the preemption budgets of the
+// candidates.
+func (p *Preemptor) SetBudgets(budgets *PreemptionBudgetChecker) {
+	p.budgets = budgets
+}
+
 // SetNode sets the node
 func (p *Preemptor) SetNode(node *structs.Node) {
 	nodeRemainingResources := node.NodeResources.Comparable()
@@ -187,7 +209,11 @@ func (p *Preemptor) SetCandidates(allocs []*structs.Allocation) {
 		if tg != nil && tg.Migrate != nil {
 			maxParallel = tg.Migrate.MaxParallel
 		}
-		p.allocDetails[alloc.ID] = &allocInfo{maxParallel: maxParallel, resources: alloc.AllocatedResources.Comparable()}
+		p.allocDetails[alloc.ID] = &allocInfo{
+			maxParallel:    maxParallel,
+			preemptionCost: alloc.Job.PreemptionCost,
+			resources:      alloc.AllocatedResources.Comparable(),
+		}
 		p.currentAllocs = append(p.currentAllocs, alloc)
 	}
 }
@@ -198,6 +224,7 @@ func (p *Preemptor) SetPreemptions(allocs []*structs.Allocation) {
 
 	// Clear out existing values since this can be called more than once
 	p.currentPreemptions = make(map[structs.NamespacedID]map[string]int)
+	p.plannedPreemptions = allocs
 
 	// Initialize counts
 	for _, alloc := range allocs {
@@ -221,6 +248,15 @@ func (p *Preemptor) getNumPreemptions(alloc *structs.Allocation) int {
 	return c
 }
 
+// withinBudget returns whether preempting the allocation along with the
+// allocations already picked stays within the preemption budgets.
+func (p *Preemptor) withinBudget(alloc *structs.Allocation, picked []*structs.Allocation) bool {
+	if p.budgets == nil {
+		return true
+	}
+	return p.budgets.Allows(append(slices.Clone(picked), alloc), p.plannedPreemptions)
+}
+
 // PreemptForTaskGroup computes a list of allocations to preempt to accommodate
 // the resources asked for. Only allocs with a job priority < 10 of jobPriority are considered
 // This method is meant only for finding preemptible allocations based on CPU/Memory/Disk
@@ -234,7 +270,7 @@ func (p *Preemptor) PreemptForTaskGroup(resourceAsk *structs.AllocatedResources)
 	}
 
 	// Group candidates by priority, filter out ineligible allocs
-	allocsByPriority := filterAndGroupPreemptibleAllocs(p.jobPriority, p.currentAllocs)
+	allocsByPriority := p.filterAndGroupPreemptibleAllocs(p.currentAllocs)
 
 	var bestAllocs []*structs.Allocation
 	allRequirementsMet := false
@@ -250,15 +286,23 @@ func (p *Preemptor) PreemptForTaskGroup(resourceAsk *structs.AllocatedResources)
 			bestDistance := math.MaxFloat64
 			// Find the alloc with the closest distance
 			for index, alloc := range allocGrp.allocs {
+				if !p.withinBudget(alloc, bestAllocs) {
+					continue
+				}
 				currentPreemptionCount := p.getNumPreemptions(alloc)
 				allocDetails := p.allocDetails[alloc.ID]
 				maxParallel := allocDetails.maxParallel
-				distance := scoreForTaskGroup(resourcesNeeded, allocDetails.resources, maxParallel, currentPreemptionCount)
+				distance := scoreForTaskGroup(resourcesNeeded, allocDetails.resources, maxParallel, currentPreemptionCount, allocDetails.preemptionCost)
 				if distance < bestDistance {
 					bestDistance = distance
 					closestAllocIndex = index
 				}
 			}
+
+			// Stop if the remaining allocs are out of preemption budget
+			if closestAllocIndex < 0 {
+				break
+			}
 			closestAlloc := allocGrp.allocs[closestAllocIndex]
 			closestResources := p.allocDetails[closestAlloc.ID].resources
 			availableResources.Add(closestResources)
@@ -335,8 +379,8 @@ func (p *Preemptor) PreemptForNetwork(networkResourceAsk *structs.NetworkResourc
 		// We only check first network - TODO: why?!?!
 		net := networks[0]
 
-		// Filter out alloc that's ineligible due to priority
-		if p.jobPriority-alloc.Job.Priority < 10 {
+		// Filter out alloc that's ineligible due to priority or budget
+		if !p.preemptible(alloc) {
 			// Populate any reserved ports used by
 			// this allocation that cannot be preempted
 			for _, port := range net.ReservedPorts {
@@ -429,7 +473,7 @@ OUTER:
 		}
 
 		// Split by priority
-		allocsByPriority := filterAndGroupPreemptibleAllocs(p.jobPriority, currentAllocs)
+		allocsByPriority := p.filterAndGroupPreemptibleAllocs(currentAllocs)
 
 		for _, allocsGrp := range allocsByPriority {
 			allocs := allocsGrp.allocs
@@ -441,6 +485,9 @@ OUTER:
 
 			// Iterate over allocs until end of if requirements have been met
 			for _, alloc := range allocs {
+				if !p.withinBudget(alloc, allocsToPreempt) {
+					continue
+				}
 				allocResources := p.allocDetails[alloc.ID].resources
 				preemptedBandwidth += allocResources.Flattened.Networks[0].MBits
 				allocsToPreempt = append(allocsToPreempt, alloc)
@@ -546,7 +593,7 @@ func (p *Preemptor) PreemptForDevice(ask *structs.RequestedDevice, devAlloc *dev
 OUTER:
 	for deviceIDTuple, allocsGrp := range deviceToAllocs {
 		// First group and sort allocations using this device by priority
-		allocsByPriority := filterAndGroupPreemptibleAllocs(p.jobPriority, allocsGrp.allocs)
+		allocsByPriority := p.filterAndGroupPreemptibleAllocs(allocsGrp.allocs)
 
 		// Reset preempted count for this device
 		preemptedCount := 0
@@ -556,6 +603,10 @@ OUTER:
 
 		for _, grpAllocs := range allocsByPriority {
 			for _, alloc := range grpAllocs.allocs {
+				if !p.withinBudget(alloc, preemptedAllocs) {
+					continue
+				}
+
 				// Look up the device instance from the device allocator
 				devInst := devAlloc.Devices[deviceIDTuple]
 
@@ -587,6 +638,7 @@ OUTER:
 // all options. The net priority is the sum of unique priorities in each option
 func selectBestAllocs(preemptionOptions []*deviceGroupAllocs, neededCount int) []*structs.Allocation {
 	bestPriority := math.MaxInt32
+	bestCost := math.MaxInt32
 	var bestAllocs []*structs.Allocation
 
 	// We iterate over allocations in priority order, so its possible
@@ -609,8 +661,9 @@ func selectBestAllocs(preemptionOptions []*deviceGroupAllocs, neededCount int) [
 			return instanceCount1 > instanceCount2
 		})
 
-		// Filter and calculate net priority
+		// Filter and calculate net priority and total preemption cost
 		preemptedInstanceCount := 0
+		totalCost := 0
 		for _, alloc := range allocGrp.allocs {
 			if preemptedInstanceCount >= neededCount {
 				break
@@ -618,14 +671,16 @@ func selectBestAllocs(preemptionOptions []*deviceGroupAllocs, neededCount int) [
 			instanceCount := devInst[alloc.ID]
 			preemptedInstanceCount += instanceCount
 			filteredAllocs = append(filteredAllocs, alloc)
+			totalCost += alloc.Job.PreemptionCost
 			_, ok := priorities[alloc.Job.Priority]
 			if !ok {
 				priorities[alloc.Job.Priority] = struct{}{}
 				netPriority += alloc.Job.Priority
 			}
 		}
-		if netPriority < bestPriority {
+		if netPriority < bestPriority || (netPriority == bestPriority && totalCost < bestCost) {
 			bestPriority = netPriority
+			bestCost = totalCost
 			bestAllocs = filteredAllocs
 		}
 	}
@@ -665,18 +720,19 @@ func networkResourceDistance(resourceUsed *structs.NetworkResource, resourceNeed
 
 // scoreForTaskGroup is used to calculate a score (lower is better) based on the distance between
 // the needed resource and requirements. A penalty is added when the choice already has some existing
-// allocations in the plan that are being preempted.
-func scoreForTaskGroup(resourceAsk *structs.ComparableResources, resourceUsed *structs.ComparableResources, maxParallel int, numPreemptedAllocs int) float64 {
+// allocations in the plan that are being preempted, and for the preemption cost of its job.
+func scoreForTaskGroup(resourceAsk *structs.ComparableResources, resourceUsed *structs.ComparableResources, maxParallel int, numPreemptedAllocs int, preemptionCost int) float64 {
 	maxParallelScorePenalty := 0.0
 	if maxParallel > 0 && numPreemptedAllocs >= maxParallel {
 		maxParallelScorePenalty = float64((numPreemptedAllocs+1)-maxParallel) * maxParallelPenalty
 	}
-	return basicResourceDistance(resourceAsk, resourceUsed) + maxParallelScorePenalty
+	costPenalty := float64(preemptionCost) * preemptionCostPenalty
+	return basicResourceDistance(resourceAsk, resourceUsed) + maxParallelScorePenalty + costPenalty
 }
 
 // scoreForNetwork is similar to scoreForTaskGroup
 // but only uses network Mbits to calculate a preemption score
-func scoreForNetwork(resourceUsed *structs.NetworkResource, resourceNeeded *structs.NetworkResource, maxParallel int, numPreemptedAllocs int) float64 {
+func scoreForNetwork(resourceUsed *structs.NetworkResource, resourceNeeded *structs.NetworkResource, maxParallel int, numPreemptedAllocs int, preemptionCost int) float64 {
 	if resourceUsed == nil || resourceNeeded == nil {
 		return math.MaxFloat64
 	}
@@ -684,22 +740,32 @@ func scoreForNetwork(resourceUsed *structs.NetworkResource, resourceNeeded *stru
 	if maxParallel > 0 && numPreemptedAllocs >= maxParallel {
 		maxParallelScorePenalty = float64((numPreemptedAllocs+1)-maxParallel) * maxParallelPenalty
 	}
-	return networkResourceDistance(resourceUsed, resourceNeeded) + maxParallelScorePenalty
+	costPenalty := float64(preemptionCost) * preemptionCostPenalty
+	return networkResourceDistance(resourceUsed, resourceNeeded) + maxParallelScorePenalty + costPenalty
+}
+
+// preemptible returns whether the allocation can be preempted at all. Allocs
+// whose priority is within a delta of 10 of the job being placed can't be
+// preempted, which also skips any allocs of the current job for which we are
+// attempting preemption. Allocs whose preemption budget is exhausted by the
+// preemptions already in the plan can't be preempted either.
+func (p *Preemptor) preemptible(alloc *structs.Allocation) bool {
+	if p.jobPriority-alloc.Job.Priority < 10 {
+		return false
+	}
+	return p.withinBudget(alloc, nil)
 }
 
 // filterAndGroupPreemptibleAllocs groups allocations by priority after filtering allocs
-// that are not preemptible based on the jobPriority arg
-func filterAndGroupPreemptibleAllocs(jobPriority int, current []*structs.Allocation) []*groupedAllocs {
+// that are not preemptible
+func (p *Preemptor) filterAndGroupPreemptibleAllocs(current []*structs.Allocation) []*groupedAllocs {
 	allocsByPriority := make(map[int][]*structs.Allocation)
 	for _, alloc := range current {
 		if alloc.Job == nil {
 			continue
 		}
 
-		// Skip allocs whose priority is within a delta of 10
-		// This also skips any allocs of the current job
-		// for which we are attempting preemption
-		if jobPriority-alloc.Job.Priority < 10 {
+		if !p.preemptible(alloc) {
 			continue
 		}
 		grpAllocs, ok := allocsByPriority[alloc.Job.Priority]
@@ -783,7 +849,7 @@ func (p *Preemptor) distanceComparatorForNetwork(allocs []*structs.Allocation, n
 		firstAllocNetResourceUsed = firstAllocNetworks[0]
 	}
 
-	distance1 := scoreForNetwork(firstAllocNetResourceUsed, networkResourceAsk, maxParallel1, currentPreemptionCount1)
+	distance1 := scoreForNetwork(firstAllocNetResourceUsed, networkResourceAsk, maxParallel1, currentPreemptionCount1, firstAlloc.Job.PreemptionCost)
 
 	secondAlloc := allocs[j]
 	currentPreemptionCount2 := p.getNumPreemptions(secondAlloc)
@@ -800,6 +866,27 @@ func (p *Preemptor) distanceComparatorForNetwork(allocs []*structs.Allocation, n
 		secondAllocNetResourceUsed = secondAllocNetworks[0]
 	}
 
-	distance2 := scoreForNetwork(secondAllocNetResourceUsed, networkResourceAsk, maxParallel2, currentPreemptionCount2)
+	distance2 := scoreForNetwork(secondAllocNetResourceUsed, networkResourceAsk, maxParallel2, currentPreemptionCount2, secondAlloc.Job.PreemptionCost)
 	return distance1 < distance2
 }
+
+// annotatePreemption adds the preempted allocation to the plan annotations,
+// along with the reason it is preempted for the placement of alloc.
+func annotatePreemption(annotations *structs.PlanAnnotations, stop, alloc *structs.Allocation, option *RankedNode) {
+	annotations.PreemptedAllocs = append(annotations.PreemptedAllocs, stop.Stub(nil))
+
+	reason := &structs.PreemptionReason{
+		PreemptedFor: alloc.Name,
+		NodeID:       option.Node.ID,
+		Resources:    slices.Clone(option.PreemptedResources),
+	}
+	if stop.Job != nil {
+		reason.JobPriority = stop.Job.Priority
+		reason.PreemptionCost = stop.Job.PreemptionCost
+	}
+
+	if annotations.PreemptionReasons == nil {
+		annotations.PreemptionReasons = make(map[string]*structs.PreemptionReason)
+	}
+	annotations.PreemptionReasons[stop.ID] = reason
+}
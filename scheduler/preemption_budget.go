@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// PreemptionBudgetChecker enforces the preemption budgets of the namespaces
+// and jobs of the allocations considered for preemption. The budgets and the
+// number of allocations already preempted within their window are looked up
+// lazily and cached until the next call to SetJob.
+type PreemptionBudgetChecker struct {
+	ctx        Context
+	now        time.Time
+	namespaces map[string]*budgetUsage
+	jobs       map[structs.NamespacedID]*budgetUsage
+}
+
+// budgetUsage is a preemption budget along with the number of allocations
+// preempted within its window before the evaluation. A nil budget doesn't
+// limit preemptions.
+type budgetUsage struct {
+	budget *structs.PreemptionBudget
+	used   int
+}
+
+// allows returns whether the budget has room for the number of preemptions
+// proposed within the evaluation.
+func (u *budgetUsage) allows(proposed int) bool {
+	if u.budget == nil {
+		return true
+	}
+	return u.used+proposed <= u.budget.MaxAllocs
+}
+
+// NewPreemptionBudgetChecker creates a PreemptionBudgetChecker.
+func NewPreemptionBudgetChecker(ctx Context) *PreemptionBudgetChecker {
+	return &PreemptionBudgetChecker{
+		ctx:        ctx,
+		now:        time.Now(),
+		namespaces: make(map[string]*budgetUsage),
+		jobs:       make(map[structs.NamespacedID]*budgetUsage),
+	}
+}
+
+// SetJob resets the cached budgets for the evaluation of a new job.
+func (c *PreemptionBudgetChecker) SetJob(*structs.Job) {
+	c.now = time.Now()
+	clear(c.namespaces)
+	clear(c.jobs)
+}
+
+// Allows returns whether preempting the allocations, in addition to the
+// preemptions already planned, stays within the preemption budgets of their
+// namespaces and jobs.
+func (c *PreemptionBudgetChecker) Allows(preempted, planned []*structs.Allocation) bool {
+	if len(preempted) == 0 {
+		return true
+	}
+
+	nsCounts := make(map[string]int)
+	jobCounts := make(map[structs.NamespacedID]int)
+	for _, allocs := range [][]*structs.Allocation{planned, preempted} {
+		for _, alloc := range allocs {
+			nsCounts[alloc.Namespace]++
+			jobCounts[structs.NewNamespacedID(alloc.JobID, alloc.Namespace)]++
+		}
+	}
+
+	for _, alloc := range preempted {
+		if !c.namespaceUsage(alloc.Namespace).allows(nsCounts[alloc.Namespace]) {
+			return false
+		}
+		id := structs.NewNamespacedID(alloc.JobID, alloc.Namespace)
+		if !c.jobUsage(id).allows(jobCounts[id]) {
+			return false
+		}
+	}
+	return true
+}
+
+// namespaceUsage returns the preemption budget usage of the namespace.
+func (c *PreemptionBudgetChecker) namespaceUsage(namespace string) *budgetUsage {
+	if usage, ok := c.namespaces[namespace]; ok {
+		return usage
+	}
+
+	usage := &budgetUsage{}
+	c.namespaces[namespace] = usage
+
+	ns, err := c.ctx.State().NamespaceByName(nil, namespace)
+	if err != nil {
+		c.ctx.Logger().Named("preemption").Error("failed to lookup namespace",
+			"namespace", namespace, "error", err)
+		usage.budget = &structs.PreemptionBudget{}
+		return usage
+	}
+	if ns == nil || ns.PreemptionBudget == nil {
+		return usage
+	}
+	usage.budget = ns.PreemptionBudget
+
+	iter, err := c.ctx.State().AllocsPreemptedByNamespace(nil, namespace)
+	if err != nil {
+		c.ctx.Logger().Named("preemption").Error("failed to lookup preempted namespace allocations",
+			"namespace", namespace, "error", err)
+		usage.budget = &structs.PreemptionBudget{}
+		return usage
+	}
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		if c.preemptedWithin(raw.(*structs.Allocation), usage.budget.Window) {
+			usage.used++
+		}
+	}
+	return usage
+}
+
+// jobUsage returns the preemption budget usage of the job.
+func (c *PreemptionBudgetChecker) jobUsage(id structs.NamespacedID) *budgetUsage {
+	if usage, ok := c.jobs[id]; ok {
+		return usage
+	}
+
+	usage := &budgetUsage{}
+	c.jobs[id] = usage
+
+	job, err := c.ctx.State().JobByID(nil, id.Namespace, id.ID)
+	if err != nil {
+		c.ctx.Logger().Named("preemption").Error("failed to lookup job",
+			"job_id", id.ID, "namespace", id.Namespace, "error", err)
+		usage.budget = &structs.PreemptionBudget{}
+		return usage
+	}
+	if job == nil || job.PreemptionBudget == nil {
+		return usage
+	}
+	usage.budget = job.PreemptionBudget
+
+	allocs, err := c.ctx.State().AllocsByJob(nil, id.Namespace, id.ID, true)
+	if err != nil {
+		c.ctx.Logger().Named("preemption").Error("failed to lookup job allocations",
+			"job_id", id.ID, "namespace", id.Namespace, "error", err)
+		usage.budget = &structs.PreemptionBudget{}
+		return usage
+	}
+	for _, alloc := range allocs {
+		if c.preemptedWithin(alloc, usage.budget.Window) {
+			usage.used++
+		}
+	}
+	return usage
+}
+
+// preemptedWithin returns whether the allocation was preempted within the
+// window.
+func (c *PreemptionBudgetChecker) preemptedWithin(alloc *structs.Allocation, window time.Duration) bool {
+	if alloc.PreemptedTime == 0 {
+		return false
+	}
+	return alloc.PreemptedTime >= c.now.Add(-window).UnixNano()
+}
@@ -8,6 +8,7 @@ import (
 	"maps"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/client/lib/numalib"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	psstructs "github.com/hashicorp/nomad/plugins/shared/structs"
+	"github.com/shoenig/test/must"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1436,6 +1438,148 @@ func TestPreemption_Normal(t *testing.T) {
 	}
 }
 
+// TestPreemption_CostAndBudgets asserts that the preemption cost of jobs and
+// the preemption budgets of namespaces and jobs are taken into account when
+// choosing the allocations to preempt.
+func TestPreemption_CostAndBudgets(t *testing.T) {
+	ci.Parallel(t)
+
+	legacyCpuResources, processorResources := cpuResources(4000)
+	nodeResources := &structs.NodeResources{
+		Processors: processorResources,
+		Cpu:        legacyCpuResources,
+		Memory: structs.NodeMemoryResources{
+			MemoryMB: 8192,
+		},
+		Disk: structs.NodeDiskResources{
+			DiskMB: 100 * 1024,
+		},
+	}
+
+	type testCase struct {
+		name string
+
+		// costlyCost and cheapCost are the preemption costs of the jobs of
+		// the two low priority allocations on the node. The costly alloc is
+		// the closest fit for the resource ask.
+		costlyCost int
+		cheapCost  int
+
+		cheapBudget     *structs.PreemptionBudget
+		namespaceBudget *structs.PreemptionBudget
+
+		// recentPreemptions is the number of allocations of the default
+		// namespace preempted within the namespace budget window.
+		recentPreemptions int
+
+		expected string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "closest fit without cost",
+			expected: "costly",
+		},
+		{
+			name:       "cheapest to preempt with cost",
+			costlyCost: 100,
+			expected:   "cheap",
+		},
+		{
+			name:        "job budget exhausted",
+			costlyCost:  100,
+			cheapBudget: &structs.PreemptionBudget{MaxAllocs: 0, Window: time.Hour},
+			expected:    "costly",
+		},
+		{
+			name:            "namespace budget has room",
+			costlyCost:      100,
+			namespaceBudget: &structs.PreemptionBudget{MaxAllocs: 2, Window: time.Hour},
+			expected:        "cheap",
+		},
+		{
+			name:              "namespace budget exhausted",
+			namespaceBudget:   &structs.PreemptionBudget{MaxAllocs: 1, Window: time.Hour},
+			recentPreemptions: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			node := mock.Node()
+			node.NodeResources = nodeResources
+			node.ReservedResources = nil
+
+			state, ctx := testContext(t)
+			must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 1000, node))
+
+			if tc.namespaceBudget != nil {
+				ns := mock.Namespace()
+				ns.Name = structs.DefaultNamespace
+				ns.PreemptionBudget = tc.namespaceBudget
+				must.NoError(t, state.UpsertNamespaces(1001, []*structs.Namespace{ns}))
+			}
+
+			costlyJob := mock.Job()
+			costlyJob.Priority = 20
+			costlyJob.PreemptionCost = tc.costlyCost
+
+			cheapJob := mock.Job()
+			cheapJob.Priority = 20
+			cheapJob.PreemptionCost = tc.cheapCost
+			cheapJob.PreemptionBudget = tc.cheapBudget
+
+			for i, job := range []*structs.Job{costlyJob, cheapJob} {
+				must.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, uint64(1002+i), nil, job))
+			}
+
+			costly := createAlloc(uuid.Generate(), costlyJob, &structs.Resources{CPU: 1800, MemoryMB: 3700})
+			cheap := createAlloc(uuid.Generate(), cheapJob, &structs.Resources{CPU: 1800, MemoryMB: 3800})
+			allocs := []*structs.Allocation{costly, cheap}
+			for i := 0; i < tc.recentPreemptions; i++ {
+				preempted := createAlloc(uuid.Generate(), mock.Job(), &structs.Resources{CPU: 100, MemoryMB: 100})
+				preempted.DesiredStatus = structs.AllocDesiredStatusEvict
+				preempted.ClientStatus = structs.AllocClientStatusComplete
+				preempted.PreemptedByAllocation = uuid.Generate()
+				preempted.PreemptedTime = time.Now().UnixNano()
+				allocs = append(allocs, preempted)
+			}
+			for _, alloc := range allocs {
+				alloc.NodeID = node.ID
+			}
+			must.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 1010, allocs))
+
+			static := NewStaticRankIterator(ctx, []*RankedNode{{Node: node}})
+			binPackIter := NewBinPackIterator(ctx, static, true, 100)
+			job := mock.Job()
+			job.Priority = 100
+			binPackIter.SetJob(job)
+			binPackIter.SetSchedulerConfiguration(testSchedulerConfig)
+			binPackIter.SetTaskGroup(&structs.TaskGroup{
+				EphemeralDisk: &structs.EphemeralDisk{},
+				Tasks: []*structs.Task{
+					{
+						Name:      "web",
+						Resources: &structs.Resources{CPU: 1000, MemoryMB: 2000},
+					},
+				},
+			})
+
+			option := binPackIter.Next()
+			if tc.expected == "" {
+				must.Nil(t, option)
+				return
+			}
+
+			must.NotNil(t, option)
+			must.Len(t, 1, option.PreemptedAllocs)
+			expectedID := map[string]string{"costly": costly.ID, "cheap": cheap.ID}[tc.expected]
+			must.Eq(t, expectedID, option.PreemptedAllocs[0].ID)
+			must.Eq(t, []string{"cpu"}, option.PreemptedResources)
+		})
+	}
+}
+
 // TestPreemptionMultiple tests evicting multiple allocations in the same time
 func TestPreemptionMultiple(t *testing.T) {
 	ci.Parallel(t)
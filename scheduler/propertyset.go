@@ -6,6 +6,7 @@ package scheduler
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	log "github.com/hashicorp/go-hclog"
 	memdb "github.com/hashicorp/go-memdb"
@@ -13,6 +14,10 @@ import (
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
+// propertyPathSeparator separates the values of the attributes of a property
+// set with a target path.
+const propertyPathSeparator = "\x00"
+
 // propertySet is used to track the values used for a particular property.
 type propertySet struct {
 	// ctx is used to lookup the plan and state
@@ -33,6 +38,11 @@ type propertySet struct {
 	// targetAttribute is the attribute this property set is checking
 	targetAttribute string
 
+	// targetPath is optionally set when the property set is checking a path
+	// of attributes, in which case the value of a node is the combination of
+	// the values of every attribute in the path.
+	targetPath []string
+
 	// targetValues are the set of attribute values that are explicitly expected,
 	// so we can combine the count of values that belong to any implicit targets.
 	targetValues *set.Set[string]
@@ -135,6 +145,15 @@ func (p *propertySet) setTargetAttributeWithCount(targetAttribute string, allowe
 	p.PopulateProposed()
 }
 
+// SetTargetAttributePath is used to populate this property set with a path
+// of attributes. This is used when evaluating hierarchical spread blocks,
+// where each level of the hierarchy counts allocations by the values of the
+// attributes from the top of the hierarchy down to the level.
+func (p *propertySet) SetTargetAttributePath(path []string, taskGroup string) {
+	p.targetPath = path
+	p.setTargetAttributeWithCount(path[len(path)-1], 0, taskGroup)
+}
+
 func (p *propertySet) SetTargetValues(values []string) {
 	p.targetValues = set.From(values)
 }
@@ -247,7 +266,7 @@ func (p *propertySet) UsedCount(option *structs.Node, _ string) (string, string,
 	}
 
 	// Get the nodes property value
-	nValue, ok := getProperty(option, p.targetAttribute)
+	nValue, ok := p.nodeProperty(option)
 	targetPropertyValue := p.targetedPropertyValue(nValue)
 	if !ok {
 		return nValue, fmt.Sprintf("missing property %q", p.targetAttribute), 0
@@ -342,7 +361,7 @@ func (p *propertySet) populateProperties(allocs []*structs.Allocation, nodes map
 	properties map[string]uint64) {
 
 	for _, alloc := range allocs {
-		nProperty, ok := getProperty(nodes[alloc.NodeID], p.targetAttribute)
+		nProperty, ok := p.nodeProperty(nodes[alloc.NodeID])
 		if !ok {
 			continue
 		}
@@ -352,6 +371,25 @@ func (p *propertySet) populateProperties(allocs []*structs.Allocation, nodes map
 	}
 }
 
+// nodeProperty is used to lookup the value of the property set target on the
+// node. When the property set has a target path, the value is the combination
+// of the values of each attribute in the path joined by propertyPathSeparator.
+func (p *propertySet) nodeProperty(n *structs.Node) (string, bool) {
+	if len(p.targetPath) == 0 {
+		return getProperty(n, p.targetAttribute)
+	}
+
+	values := make([]string, 0, len(p.targetPath))
+	for _, attr := range p.targetPath {
+		value, ok := getProperty(n, attr)
+		if !ok {
+			return "", false
+		}
+		values = append(values, value)
+	}
+	return strings.Join(values, propertyPathSeparator), true
+}
+
 // getProperty is used to lookup the property value on the node
 func getProperty(n *structs.Node, property string) (string, bool) {
 	if n == nil || property == "" {
@@ -19,7 +19,7 @@ import (
 const (
 	// binPackingMaxFitScore is the maximum possible bin packing fitness score.
 	// This is used to normalize bin packing score to a value between 0 and 1
-	binPackingMaxFitScore = 18.0
+	binPackingMaxFitScore = structs.BinPackingMaxFitScore
 )
 
 // Rank is used to provide a score and various ranking metadata
@@ -40,6 +40,10 @@ type RankedNode struct {
 	// PreemptedAllocs is used by the BinpackIterator to identify allocs
 	// that should be preempted in order to make the placement
 	PreemptedAllocs []*structs.Allocation
+
+	// PreemptedResources are the resources exhausted on the node that
+	// required the PreemptedAllocs to be preempted
+	PreemptedResources []string
 }
 
 func (r *RankedNode) GoString() string {
@@ -166,16 +170,20 @@ type BinPackIterator struct {
 	taskGroup              *structs.TaskGroup
 	memoryOversubscription bool
 	scoreFit               func(*structs.Node, *structs.ComparableResources) float64
+	reservations           *ReservationChecker
+	budgets                *PreemptionBudgetChecker
 }
 
 // NewBinPackIterator returns a BinPackIterator which tries to fit tasks
 // potentially evicting other tasks based on a given priority.
 func NewBinPackIterator(ctx Context, source RankIterator, evict bool, priority int) *BinPackIterator {
 	return &BinPackIterator{
-		ctx:      ctx,
-		source:   source,
-		evict:    evict,
-		priority: priority,
+		ctx:          ctx,
+		source:       source,
+		evict:        evict,
+		priority:     priority,
+		reservations: NewReservationChecker(ctx),
+		budgets:      NewPreemptionBudgetChecker(ctx),
 
 		// These are default values that may be overwritten by
 		// SetSchedulerConfiguration.
@@ -187,6 +195,8 @@ func NewBinPackIterator(ctx Context, source RankIterator, evict bool, priority i
 func (iter *BinPackIterator) SetJob(job *structs.Job) {
 	iter.priority = job.Priority
 	iter.jobId = job.NamespacedID()
+	iter.reservations.SetJob(job)
+	iter.budgets.SetJob(job)
 }
 
 func (iter *BinPackIterator) SetTaskGroup(taskGroup *structs.TaskGroup) {
@@ -257,6 +267,14 @@ NEXTNODE:
 		devAllocator := newDeviceAllocator(iter.ctx, option.Node)
 		devAllocator.AddAllocs(proposed)
 
+		// The capacity held by active reservations the job doesn't reference
+		// is treated as reserved by the node when checking fit.
+		node := option.Node
+		var heldDevices []*structs.AllocatedDeviceResource
+		if held := iter.reservations.Held(option.Node); len(held) > 0 {
+			node, heldDevices = holdReservations(option.Node, held, proposed, devAllocator)
+		}
+
 		// Track the affinities of the devices
 		totalDeviceAffinityWeight := 0.0
 		sumMatchingAffinities := 0.0
@@ -268,15 +286,18 @@ NEXTNODE:
 			TaskLifecycles: make(map[string]*structs.TaskLifecycleConfig,
 				len(iter.taskGroup.Tasks)),
 			Shared: structs.AllocatedSharedResources{
-				DiskMB: int64(iter.taskGroup.EphemeralDisk.SizeMB),
+				DiskMB:   int64(iter.taskGroup.EphemeralDisk.SizeMB),
+				DiskIOPS: int64(iter.taskGroup.EphemeralDisk.IOPS),
 			},
 		}
 
 		var allocsToPreempt []*structs.Allocation
+		var preemptedResources []string
 
 		// Initialize preemptor with node
 		preemptor := NewPreemptor(iter.priority, iter.ctx, &iter.jobId)
-		preemptor.SetNode(option.Node)
+		preemptor.SetNode(node)
+		preemptor.SetBudgets(iter.budgets)
 
 		// Count the number of existing preemptions
 		allPreemptions := iter.ctx.Plan().NodePreemptions
@@ -333,6 +354,7 @@ NEXTNODE:
 					continue NEXTNODE
 				}
 				allocsToPreempt = append(allocsToPreempt, netPreemptions...)
+				preemptedResources = append(preemptedResources, fmt.Sprintf("network: %s", err))
 
 				// First subtract out preempted allocations
 				proposed = structs.RemoveAllocs(proposed, netPreemptions)
@@ -363,6 +385,7 @@ NEXTNODE:
 			option.AllocResources = &structs.AllocatedSharedResources{
 				Networks: []*structs.NetworkResource{nwRes},
 				DiskMB:   int64(iter.taskGroup.EphemeralDisk.SizeMB),
+				DiskIOPS: int64(iter.taskGroup.EphemeralDisk.IOPS),
 				Ports:    offer,
 			}
 
@@ -409,6 +432,7 @@ NEXTNODE:
 						continue NEXTNODE
 					}
 					allocsToPreempt = append(allocsToPreempt, netPreemptions...)
+					preemptedResources = append(preemptedResources, fmt.Sprintf("network: %s", err))
 
 					// First subtract out preempted allocations
 					proposed = structs.RemoveAllocs(proposed, netPreemptions)
@@ -577,6 +601,7 @@ NEXTNODE:
 				totalDeviceAffinityWeightSnapshot := totalDeviceAffinityWeight
 				preemptorSnapshot := preemptor.Copy()
 				allocsToPreemptSnapshot := helper.CopySlice(allocsToPreempt)
+				preemptedResourcesSnapshot := slices.Clone(preemptedResources)
 				proposedSnapshot := helper.CopySlice(proposed)
 
 				var offerErr error = nil
@@ -610,6 +635,7 @@ NEXTNODE:
 								totalDeviceAffinityWeight = totalDeviceAffinityWeightSnapshot
 								preemptor = preemptorSnapshot
 								allocsToPreempt = allocsToPreemptSnapshot
+								preemptedResources = preemptedResourcesSnapshot
 								proposed = proposedSnapshot
 							}
 
@@ -628,6 +654,9 @@ NEXTNODE:
 							// use a device allocator with new set of proposed allocs
 							devAllocatorEvict := newDeviceAllocator(iter.ctx, option.Node)
 							devAllocatorEvict.AddAllocs(proposed)
+							for _, d := range heldDevices {
+								devAllocatorEvict.AddReserved(d)
+							}
 
 							// attempt the offer again
 							offerEvict, sumAffinitiesEvict, err := devAllocatorEvict.createOffer(memory, device)
@@ -640,6 +669,7 @@ NEXTNODE:
 
 							offer = offerEvict
 							sumAffinities = sumAffinitiesEvict
+							preemptedResources = append(preemptedResources, fmt.Sprintf("devices: %s", offerErr))
 						}
 
 						// assign the offer for this device to our allocator
@@ -735,7 +765,14 @@ NEXTNODE:
 		proposed = append(proposed, &structs.Allocation{AllocatedResources: total})
 
 		// Check if these allocations fit, if they do not, simply skip this node
-		fit, dim, util, _ := structs.AllocsFit(option.Node, proposed, netIdx, false)
+		fit, dim, util, _ := structs.AllocsFit(node, proposed, netIdx, false)
+		if !fit && node != option.Node {
+			// Report the dimension as reserved if the allocation would fit
+			// without the capacity held by reservations.
+			if fitUnheld, _, _, _ := structs.AllocsFit(option.Node, proposed, netIdx, false); fitUnheld {
+				dim = "reserved " + dim
+			}
+		}
 		netIdx.Release()
 		if !fit {
 			// Skip the node if evictions are not enabled
@@ -759,13 +796,21 @@ NEXTNODE:
 				iter.ctx.Metrics().ExhaustedNode(option.Node, dim)
 				continue
 			}
+			preemptedResources = append(preemptedResources, dim)
 		}
 		if len(allocsToPreempt) > 0 {
+			// Preemptions for separate resources may together exceed the
+			// preemption budgets even though each of them fits.
+			if !iter.budgets.Allows(allocsToPreempt, currentPreemptions) {
+				iter.ctx.Metrics().ExhaustedNode(option.Node, "preemption budget")
+				continue
+			}
 			option.PreemptedAllocs = allocsToPreempt
+			option.PreemptedResources = preemptedResources
 		}
 
 		// Score the fit normally otherwise
-		fitness := iter.scoreFit(option.Node, util)
+		fitness := iter.scoreFit(node, util)
 		normalizedFit := fitness / binPackingMaxFitScore
 		option.Scores = append(option.Scores, normalizedFit)
 		iter.ctx.Metrics().ScoreNode(option.Node, "binpack", normalizedFit)
@@ -785,6 +830,164 @@ func (iter *BinPackIterator) Reset() {
 	iter.source.Reset()
 }
 
+// holdReservations returns a copy of the node with the CPU and memory held by
+// the reservations added to the node reserved resources, and reserves the
+// devices held by the reservations in the device allocator. Capacity already
+// used by allocations of jobs that reference a reservation counts towards the
+// amount held by the reservation.
+func holdReservations(node *structs.Node, reservations []*structs.Reservation,
+	proposed []*structs.Allocation, devAllocator *deviceAllocator) (*structs.Node, []*structs.AllocatedDeviceResource) {
+
+	var heldCPU, heldMemoryMB int64
+	var heldDevices []*structs.AllocatedDeviceResource
+
+	for _, res := range reservations {
+		cpu, memoryMB, devices := reservationRemaining(res, proposed)
+		heldCPU += cpu
+		heldMemoryMB += memoryMB
+
+		for _, ask := range devices {
+			// Hold as many of the remaining devices as are still free.
+			mem := &memoryNodeMatcher{memoryNode: -1}
+			for count := ask.Count; count > 0; count-- {
+				held := ask.Copy()
+				held.Count = count
+				if offer, _, _ := devAllocator.createOffer(mem, held); offer != nil {
+					devAllocator.AddReserved(offer)
+					heldDevices = append(heldDevices, offer)
+					break
+				}
+			}
+		}
+	}
+
+	return holdNodeResources(node, heldCPU, heldMemoryMB), heldDevices
+}
+
+// ReservationsFit returns whether the proposed allocations leave the capacity
+// held by the reservations that target the node available. The scheduler only
+// holds the capacity of the reservations a job doesn't reference, but the
+// plan applier checks every reservation returned by JobReservations, so that
+// it can reject plans that were computed before a reservation was created or
+// concurrently with other plans. This is equivalent because allocations of
+// jobs referencing a reservation use its capacity first.
+//
+// Devices are held by counting the free healthy instances matching the name of
+// the requested device, without evaluating device constraints.
+func ReservationsFit(node *structs.Node, reservations []*structs.Reservation, proposed []*structs.Allocation) (bool, string, error) {
+	var held []*structs.Reservation
+	ctx := new(reservationConstraintContext)
+	for _, res := range reservations {
+		if reservationMatchesNode(ctx, res, node) {
+			held = append(held, res)
+		}
+	}
+	if len(held) == 0 {
+		return true, "", nil
+	}
+
+	accounter := structs.NewDeviceAccounter(node)
+	accounter.AddAllocs(proposed)
+
+	var heldCPU, heldMemoryMB int64
+	for _, res := range held {
+		cpu, memoryMB, devices := reservationRemaining(res, proposed)
+		heldCPU += cpu
+		heldMemoryMB += memoryMB
+
+		for _, ask := range devices {
+			if !holdDeviceInstances(accounter, ask) {
+				return false, fmt.Sprintf("reservation %q: devices", res.Name), nil
+			}
+		}
+	}
+
+	if heldCPU == 0 && heldMemoryMB == 0 {
+		return true, "", nil
+	}
+	fit, dimension, _, err := structs.AllocsFit(holdNodeResources(node, heldCPU, heldMemoryMB), proposed, nil, false)
+	if err != nil || !fit {
+		return false, "reserved " + dimension, err
+	}
+	return true, "", nil
+}
+
+// reservationRemaining returns the CPU, memory, and devices of the reservation
+// that aren't used by the allocations of jobs referencing it.
+func reservationRemaining(res *structs.Reservation, allocs []*structs.Allocation) (int64, int64, []*structs.RequestedDevice) {
+	var usedCPU, usedMemoryMB int64
+	var usedDevices []*structs.AllocatedDeviceResource
+	for _, alloc := range allocs {
+		if alloc.Job == nil || alloc.Job.Namespace != res.Namespace || alloc.Job.Reservation != res.Name {
+			continue
+		}
+		cr := alloc.AllocatedResources.Comparable()
+		usedCPU += cr.Flattened.Cpu.CpuShares
+		usedMemoryMB += cr.Flattened.Memory.MemoryMB
+		for _, tr := range alloc.AllocatedResources.Tasks {
+			usedDevices = append(usedDevices, tr.Devices...)
+		}
+	}
+
+	var devices []*structs.RequestedDevice
+	for _, ask := range res.Resources.Devices {
+		count := ask.Count
+		for _, used := range usedDevices {
+			if !used.ID().Matches(ask.ID()) {
+				continue
+			}
+			count -= min(count, uint64(len(used.DeviceIDs)))
+		}
+		if count > 0 {
+			remaining := ask.Copy()
+			remaining.Count = count
+			devices = append(devices, remaining)
+		}
+	}
+
+	return max(0, int64(res.Resources.CPU)-usedCPU), max(0, int64(res.Resources.MemoryMB)-usedMemoryMB), devices
+}
+
+// holdDeviceInstances marks as many free instances of the requested device as
+// it asks for as used. It returns false if there aren't enough free instances.
+func holdDeviceInstances(accounter *structs.DeviceAccounter, ask *structs.RequestedDevice) bool {
+	count := ask.Count
+	for id, devInst := range accounter.Devices {
+		if !id.Matches(ask.ID()) {
+			continue
+		}
+		for instanceID, used := range devInst.Instances {
+			if count == 0 {
+				return true
+			}
+			if used == 0 {
+				devInst.Instances[instanceID]++
+				count--
+			}
+		}
+	}
+	return count == 0
+}
+
+// holdNodeResources returns a copy of the node with the CPU and memory added to
+// its reserved resources.
+func holdNodeResources(node *structs.Node, cpu, memoryMB int64) *structs.Node {
+	if cpu == 0 && memoryMB == 0 {
+		return node
+	}
+
+	// Only the reserved resources are modified, so a shallow copy of the node
+	// is enough.
+	held := *node
+	held.ReservedResources = node.ReservedResources.Copy()
+	if held.ReservedResources == nil {
+		held.ReservedResources = &structs.NodeReservedResources{}
+	}
+	held.ReservedResources.Cpu.CpuShares += cpu
+	held.ReservedResources.Memory.MemoryMB += memoryMB
+	return &held
+}
+
 // JobAntiAffinityIterator is used to apply an anti-affinity to allocating
 // along side other allocations from this job. This is used to help distribute
 // load across the cluster.
@@ -989,6 +1192,125 @@ func matchesAffinity(ctx Context, affinity *structs.Affinity, option *structs.No
 	return checkAffinity(ctx, affinity.Operand, lVal, rVal, lOk, rOk)
 }
 
+// ExternalScoreIterator is used to apply the scores of external node scorers,
+// such as scorer plugins. Each scorer is called once per task group with the
+// full set of nodes and the results are cached, since an external scorer is
+// orders of magnitude more expensive to call than the other iterators.
+type ExternalScoreIterator struct {
+	ctx     Context
+	source  RankIterator
+	scorers []NodeScorer
+
+	job   *structs.Job
+	tg    *structs.TaskGroup
+	nodes []*structs.Node
+
+	// scores holds the cached scores of each scorer for the task group, and
+	// scored tracks the nodes that have been sent to the scorers.
+	scores []map[string]float64
+	scored map[string]struct{}
+}
+
+// NewExternalScoreIterator is used to create an ExternalScoreIterator that
+// applies the scores of the given scorers.
+func NewExternalScoreIterator(ctx Context, source RankIterator) *ExternalScoreIterator {
+	return &ExternalScoreIterator{
+		ctx:    ctx,
+		source: source,
+	}
+}
+
+func (iter *ExternalScoreIterator) SetScorers(scorers []NodeScorer) {
+	iter.scorers = scorers
+	iter.resetScores()
+}
+
+func (iter *ExternalScoreIterator) SetNodes(nodes []*structs.Node) {
+	iter.nodes = nodes
+	iter.resetScores()
+}
+
+func (iter *ExternalScoreIterator) SetJob(job *structs.Job) {
+	iter.job = job
+	iter.tg = nil
+	iter.resetScores()
+}
+
+func (iter *ExternalScoreIterator) SetTaskGroup(tg *structs.TaskGroup) {
+	if iter.tg != nil && iter.tg.Name == tg.Name {
+		return
+	}
+	iter.tg = tg
+	iter.resetScores()
+}
+
+func (iter *ExternalScoreIterator) hasScorers() bool {
+	return len(iter.scorers) > 0
+}
+
+func (iter *ExternalScoreIterator) resetScores() {
+	iter.scores = nil
+	iter.scored = nil
+}
+
+func (iter *ExternalScoreIterator) Next() *RankedNode {
+	option := iter.source.Next()
+	if option == nil || !iter.hasScorers() || iter.job == nil || iter.tg == nil {
+		return option
+	}
+
+	if iter.scores == nil {
+		iter.score(iter.nodes)
+	}
+	if _, ok := iter.scored[option.Node.ID]; !ok {
+		// The node wasn't part of the set of nodes given to the stack, which
+		// happens when placing on preferred nodes.
+		iter.score([]*structs.Node{option.Node})
+	}
+
+	for i, scorer := range iter.scorers {
+		score, ok := iter.scores[i][option.Node.ID]
+		if !ok || math.IsNaN(score) {
+			continue
+		}
+		score = max(-1, min(1, score))
+		option.Scores = append(option.Scores, score)
+		iter.ctx.Metrics().ScoreNode(option.Node, scorer.Name(), score)
+	}
+	return option
+}
+
+// score calls each scorer with the given nodes and caches the results.
+// Failing scorers are logged and don't score the nodes.
+func (iter *ExternalScoreIterator) score(nodes []*structs.Node) {
+	if iter.scores == nil {
+		iter.scores = make([]map[string]float64, len(iter.scorers))
+		iter.scored = make(map[string]struct{}, len(nodes))
+	}
+	for _, node := range nodes {
+		iter.scored[node.ID] = struct{}{}
+	}
+
+	for i, scorer := range iter.scorers {
+		scores, err := scorer.ScoreNodes(iter.job, iter.tg, nodes)
+		if err != nil {
+			iter.ctx.Logger().Warn("failed to score nodes",
+				"scorer", scorer.Name(), "task_group", iter.tg.Name, "error", err)
+			continue
+		}
+		if iter.scores[i] == nil {
+			iter.scores[i] = make(map[string]float64, len(scores))
+		}
+		for nodeID, score := range scores {
+			iter.scores[i][nodeID] = score
+		}
+	}
+}
+
+func (iter *ExternalScoreIterator) Reset() {
+	iter.source.Reset()
+}
+
 // ScoreNormalizationIterator is used to combine scores from various prior
 // iterators and combine them into one final score. The current implementation
 // averages the scores together.
@@ -4,6 +4,8 @@
 package scheduler
 
 import (
+	"errors"
+	"math"
 	"sort"
 	"testing"
 
@@ -372,10 +374,11 @@ func TestBinPackIterator_Network_Success(t *testing.T) {
 	require.Equal(out[0], nodes[0])
 	require.Equal(out[1], nodes[1])
 
-	// First node should have a perfect score
-	require.Equal(1.0, out[0].FinalScore)
+	// First node should have a perfect CPU and memory fit, but only uses 800
+	// of its 1000 MBits of network bandwidth
+	require.InDelta(0.978, out[0].FinalScore, 0.001)
 
-	if out[1].FinalScore < 0.50 || out[1].FinalScore > 0.60 {
+	if out[1].FinalScore < 0.70 || out[1].FinalScore > 0.72 {
 		t.Fatalf("Bad Score: %v", out[1].FinalScore)
 	}
 
@@ -1521,6 +1524,109 @@ func TestBinPackIterator_ExistingAlloc(t *testing.T) {
 	}
 }
 
+func TestBinPackIterator_DiskIOPS(t *testing.T) {
+	state, ctx := testContext(t)
+	nodes := []*RankedNode{
+		{
+			Node: &structs.Node{
+				// Not enough disk IOPS left
+				ID: uuid.Generate(),
+				NodeResources: &structs.NodeResources{
+					Processors: processorResources2048,
+					Cpu:        legacyCpuResources2048,
+					Memory: structs.NodeMemoryResources{
+						MemoryMB: 2048,
+					},
+					Disk: structs.NodeDiskResources{
+						DiskMB: 4096,
+						IOPS:   1000,
+					},
+				},
+			},
+		},
+		{
+			Node: &structs.Node{
+				// Enough disk IOPS left
+				ID: uuid.Generate(),
+				NodeResources: &structs.NodeResources{
+					Processors: processorResources2048,
+					Cpu:        legacyCpuResources2048,
+					Memory: structs.NodeMemoryResources{
+						MemoryMB: 2048,
+					},
+					Disk: structs.NodeDiskResources{
+						DiskMB: 4096,
+						IOPS:   2000,
+					},
+				},
+			},
+		},
+	}
+	static := NewStaticRankIterator(ctx, nodes)
+
+	// Add an existing IO heavy allocation to each node
+	var allocs []*structs.Allocation
+	for _, node := range nodes {
+		j := mock.Job()
+		alloc := &structs.Allocation{
+			Namespace: structs.DefaultNamespace,
+			ID:        uuid.Generate(),
+			EvalID:    uuid.Generate(),
+			NodeID:    node.Node.ID,
+			JobID:     j.ID,
+			Job:       j,
+			AllocatedResources: &structs.AllocatedResources{
+				Tasks: map[string]*structs.AllocatedTaskResources{
+					"web": {
+						Cpu: structs.AllocatedCpuResources{
+							CpuShares: 512,
+						},
+						Memory: structs.AllocatedMemoryResources{
+							MemoryMB: 512,
+						},
+					},
+				},
+				Shared: structs.AllocatedSharedResources{
+					DiskMB:   100,
+					DiskIOPS: 800,
+				},
+			},
+			DesiredStatus: structs.AllocDesiredStatusRun,
+			ClientStatus:  structs.AllocClientStatusPending,
+			TaskGroup:     "web",
+		}
+		must.NoError(t, state.UpsertJobSummary(998, mock.JobSummary(alloc.JobID)))
+		allocs = append(allocs, alloc)
+	}
+	must.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 1000, allocs))
+
+	taskGroup := &structs.TaskGroup{
+		EphemeralDisk: &structs.EphemeralDisk{
+			SizeMB: 100,
+			IOPS:   500,
+		},
+		Tasks: []*structs.Task{
+			{
+				Name: "web",
+				Resources: &structs.Resources{
+					CPU:      512,
+					MemoryMB: 512,
+				},
+			},
+		},
+	}
+	binp := NewBinPackIterator(ctx, static, false, 0)
+	binp.SetTaskGroup(taskGroup)
+	binp.SetSchedulerConfiguration(testSchedulerConfig)
+
+	scoreNorm := NewScoreNormalizationIterator(ctx, binp)
+
+	out := collectRanked(scoreNorm)
+	must.Len(t, 1, out)
+	must.Eq(t, nodes[1], out[0])
+	must.Eq(t, 1, ctx.metrics.DimensionExhausted["disk iops"])
+}
+
 func TestBinPackIterator_ExistingAlloc_PlannedEvict(t *testing.T) {
 	state, ctx := testContext(t)
 	nodes := []*RankedNode{
@@ -2334,3 +2440,95 @@ func TestNodeAffinityIterator(t *testing.T) {
 	}
 
 }
+
+// testNodeScorer is a NodeScorer that returns static scores and records the
+// nodes it was called with.
+type testNodeScorer struct {
+	name   string
+	scores map[string]float64
+	err    error
+	calls  [][]string
+}
+
+func (s *testNodeScorer) Name() string { return s.name }
+
+func (s *testNodeScorer) ScoreNodes(_ *structs.Job, _ *structs.TaskGroup, nodes []*structs.Node) (map[string]float64, error) {
+	ids := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		ids = append(ids, node.ID)
+	}
+	s.calls = append(s.calls, ids)
+	return s.scores, s.err
+}
+
+func TestExternalScoreIterator(t *testing.T) {
+	_, ctx := testContext(t)
+	nodes := []*RankedNode{
+		{Node: mock.Node()},
+		{Node: mock.Node()},
+		{Node: mock.Node()},
+		{Node: mock.Node()},
+	}
+	baseNodes := []*structs.Node{nodes[0].Node, nodes[1].Node, nodes[2].Node}
+
+	power := &testNodeScorer{
+		name: "rack-power",
+		scores: map[string]float64{
+			nodes[0].Node.ID: 0.5,
+			nodes[1].Node.ID: 3,
+			nodes[2].Node.ID: math.NaN(),
+			nodes[3].Node.ID: -0.25,
+		},
+	}
+	broken := &testNodeScorer{
+		name: "spot-risk",
+		err:  errors.New("spot price feed unavailable"),
+	}
+
+	job := mock.Job()
+	tg := job.TaskGroups[0]
+
+	static := NewStaticRankIterator(ctx, nodes)
+	externalScore := NewExternalScoreIterator(ctx, static)
+	externalScore.SetScorers([]NodeScorer{power, broken})
+	externalScore.SetNodes(baseNodes)
+	externalScore.SetJob(job)
+	externalScore.SetTaskGroup(tg)
+
+	scoreNorm := NewScoreNormalizationIterator(ctx, externalScore)
+	out := collectRanked(scoreNorm)
+	must.Len(t, 4, out)
+
+	// Scores are clamped to [-1, 1] and invalid scores are ignored.
+	expected := map[string]float64{
+		nodes[0].Node.ID: 0.5,
+		nodes[1].Node.ID: 1,
+		nodes[2].Node.ID: 0,
+		nodes[3].Node.ID: -0.25,
+	}
+	for _, n := range out {
+		must.Eq(t, expected[n.Node.ID], n.FinalScore)
+	}
+
+	// The scorers are called once with the base nodes and once more for the
+	// node that isn't part of the base nodes.
+	must.Eq(t, [][]string{
+		{nodes[0].Node.ID, nodes[1].Node.ID, nodes[2].Node.ID},
+		{nodes[3].Node.ID},
+	}, power.calls)
+	must.Len(t, 2, broken.calls)
+
+	// Scores are cached for the task group.
+	scoreNorm.Reset()
+	externalScore.SetTaskGroup(tg)
+	collectRanked(scoreNorm)
+	must.Len(t, 2, power.calls)
+
+	// Changing the task group scores the nodes again.
+	tg2 := tg.Copy()
+	tg2.Name = "other"
+	scoreNorm.Reset()
+	externalScore.SetTaskGroup(tg2)
+	collectRanked(scoreNorm)
+	must.Len(t, 4, power.calls)
+}
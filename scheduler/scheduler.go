@@ -118,6 +118,24 @@ type State interface {
 	// CSIVolumeByID fetch CSI volumes, containing controller jobs
 	CSIVolumesByNodeID(memdb.WatchSet, string, string) (memdb.ResultIterator, error)
 
+	// Reservations returns an iterator over all reservations.
+	Reservations(ws memdb.WatchSet, sort state.SortOption) (memdb.ResultIterator, error)
+
+	// ReservationsByNodePool returns an iterator over the reservations that
+	// target a node pool.
+	ReservationsByNodePool(ws memdb.WatchSet, pool string) (memdb.ResultIterator, error)
+
+	// ReservationByName returns the reservation with the given name in the
+	// namespace.
+	ReservationByName(ws memdb.WatchSet, namespace, name string) (*structs.Reservation, error)
+
+	// NamespaceByName is used to lookup a namespace.
+	NamespaceByName(ws memdb.WatchSet, name string) (*structs.Namespace, error)
+
+	// AllocsPreemptedByNamespace returns an iterator over the allocations in
+	// the namespace that were preempted.
+	AllocsPreemptedByNamespace(ws memdb.WatchSet, namespace string) (memdb.ResultIterator, error)
+
 	// HostVolumeByID fetches host volume by its ID
 	HostVolumeByID(memdb.WatchSet, string, string, bool) (*structs.HostVolume, error)
 
@@ -160,3 +178,31 @@ type Planner interface {
 	// servers should be verified.
 	ServersMeetMinimumVersion(minVersion *version.Version, checkFailedServers bool) bool
 }
+
+// NodeScorer is used to score nodes using information that is external to
+// the scheduler, such as the power budget of a rack or the interruption risk
+// of spot instances.
+type NodeScorer interface {
+	// Name is the name of the scorer. It is used as the key of the score in
+	// the allocation metrics.
+	Name() string
+
+	// ScoreNodes returns the scores of the given nodes for the placement of
+	// the task group, keyed by node ID. Scores must be in the range [-1, 1].
+	// Nodes without a score are not affected by the scorer.
+	ScoreNodes(job *structs.Job, tg *structs.TaskGroup, nodes []*structs.Node) (map[string]float64, error)
+}
+
+// NodeScorerProvider is an optional interface implemented by planners that
+// provide external node scorers to the scheduler.
+type NodeScorerProvider interface {
+	// NodeScorers returns the node scorers to apply when ranking nodes.
+	NodeScorers() []NodeScorer
+}
+
+// EvalTraceWriter is an optional interface implemented by planners that can
+// store the decision trace of the evaluation being processed.
+type EvalTraceWriter interface {
+	// UpsertEvalTrace stores the trace of the current evaluation.
+	UpsertEvalTrace(*structs.EvalTrace) error
+}
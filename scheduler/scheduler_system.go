@@ -40,6 +40,7 @@ type SystemScheduler struct {
 	plan       *structs.Plan
 	planResult *structs.PlanResult
 	ctx        *EvalContext
+	tracer     *structs.EvalTraceRecorder
 	stack      *SystemStack
 
 	nodes         []*structs.Node
@@ -91,6 +92,11 @@ func (s *SystemScheduler) Process(eval *structs.Evaluation) (err error) {
 	// Update our logger with the eval's information
 	s.logger = s.logger.With("eval_id", eval.ID, "job_id", eval.JobID, "namespace", eval.Namespace)
 
+	// Record every scheduler decision if evaluation tracing is enabled. The
+	// trace covers all the scheduling attempts of the eval.
+	s.tracer = newEvalTracer(s.state, s.planner, eval)
+	defer writeEvalTrace(s.logger, s.planner, s.tracer)
+
 	// Verify the evaluation trigger reason is understood
 	if !s.canHandle(eval.TriggeredBy) {
 		desc := fmt.Sprintf("scheduler cannot handle '%s' evaluation reason", eval.TriggeredBy)
@@ -152,6 +158,7 @@ func (s *SystemScheduler) process() (bool, error) {
 
 	// Create an evaluation context
 	s.ctx = NewEvalContext(s.eventsCh, s.state, s.plan, s.logger)
+	s.ctx.SetTracer(s.tracer)
 
 	// Construct the placement stack
 	s.stack = NewSystemStack(s.sysbatch, s.ctx)
@@ -449,7 +456,8 @@ func (s *SystemScheduler) computePlacements(place []allocTuple) error {
 			Tasks:          option.TaskResources,
 			TaskLifecycles: option.TaskLifecycles,
 			Shared: structs.AllocatedSharedResources{
-				DiskMB: int64(missing.TaskGroup.EphemeralDisk.SizeMB),
+				DiskMB:   int64(missing.TaskGroup.EphemeralDisk.SizeMB),
+				DiskIOPS: int64(missing.TaskGroup.EphemeralDisk.IOPS),
 			},
 		}
 
@@ -495,7 +503,7 @@ func (s *SystemScheduler) computePlacements(place []allocTuple) error {
 
 				preemptedAllocIDs = append(preemptedAllocIDs, stop.ID)
 				if s.eval.AnnotatePlan && s.plan.Annotations != nil {
-					s.plan.Annotations.PreemptedAllocs = append(s.plan.Annotations.PreemptedAllocs, stop.Stub(nil))
+					annotatePreemption(s.plan.Annotations, stop, alloc, option)
 					if s.plan.Annotations.DesiredTGUpdates != nil {
 						desired := s.plan.Annotations.DesiredTGUpdates[tgName]
 						desired.Preemptions += 1
@@ -4,6 +4,10 @@
 package scheduler
 
 import (
+	"fmt"
+	"math"
+	"strings"
+
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -45,6 +49,28 @@ type SpreadIterator struct {
 	// existing allocs are computed once, and allocs from the plan are updated
 	// when Reset is called
 	groupPropertySets map[string][]*propertySet
+
+	// groupHierarchies is a memoized map from task group to the hierarchical
+	// spreads that apply to it. It is updated the same way as
+	// groupPropertySets.
+	groupHierarchies map[string][]*spreadHierarchy
+
+	// nodes is the set of nodes considered for placement. It is used to find
+	// the values of each level of a hierarchical spread that are not used by
+	// any allocation yet.
+	nodes []*structs.Node
+}
+
+// spreadHierarchy tracks the allocations of a hierarchical spread. It has a
+// property set per level of the hierarchy, which counts allocations by the
+// path of attribute values from the top level down to the level.
+type spreadHierarchy struct {
+	spread *structs.Spread
+	levels []*propertySet
+
+	// domains is the set of values of each level across the nodes considered
+	// for placement. It is computed lazily.
+	domains []map[string]struct{}
 }
 
 type spreadAttributeMap map[string]*spreadInfo
@@ -59,6 +85,7 @@ func NewSpreadIterator(ctx Context, source RankIterator) *SpreadIterator {
 		ctx:               ctx,
 		source:            source,
 		groupPropertySets: make(map[string][]*propertySet),
+		groupHierarchies:  make(map[string][]*spreadHierarchy),
 		tgSpreadInfo:      make(map[string]spreadAttributeMap),
 		lowestSpreadBoost: -1.0,
 	}
@@ -72,6 +99,13 @@ func (iter *SpreadIterator) Reset() {
 			ps.PopulateProposed()
 		}
 	}
+	for _, hierarchies := range iter.groupHierarchies {
+		for _, h := range hierarchies {
+			for _, ps := range h.levels {
+				ps.PopulateProposed()
+			}
+		}
+	}
 }
 
 func (iter *SpreadIterator) SetJob(job *structs.Job) {
@@ -85,6 +119,17 @@ func (iter *SpreadIterator) SetJob(job *structs.Job) {
 	// versions of spread/properties to the new job version
 	iter.tgSpreadInfo = make(map[string]spreadAttributeMap)
 	iter.groupPropertySets = make(map[string][]*propertySet)
+	iter.groupHierarchies = make(map[string][]*spreadHierarchy)
+}
+
+// SetNodes sets the nodes considered for placement.
+func (iter *SpreadIterator) SetNodes(nodes []*structs.Node) {
+	iter.nodes = nodes
+	for _, hierarchies := range iter.groupHierarchies {
+		for _, h := range hierarchies {
+			h.domains = nil
+		}
+	}
 }
 
 func (iter *SpreadIterator) SetTaskGroup(tg *structs.TaskGroup) {
@@ -94,6 +139,10 @@ func (iter *SpreadIterator) SetTaskGroup(tg *structs.TaskGroup) {
 	if _, ok := iter.groupPropertySets[tg.Name]; !ok {
 		// First add property sets that are at the job level for this task group
 		for _, spread := range iter.jobSpreads {
+			if len(spread.Hierarchy) > 0 {
+				iter.addSpreadHierarchy(tg, spread)
+				continue
+			}
 			pset := NewPropertySet(iter.ctx, iter.job)
 			pset.SetTargetAttribute(spread.Attribute, tg.Name)
 			pset.SetTargetValues(helper.ConvertSlice(spread.SpreadTarget,
@@ -103,6 +152,10 @@ func (iter *SpreadIterator) SetTaskGroup(tg *structs.TaskGroup) {
 
 		// Include property sets at the task group level
 		for _, spread := range tg.Spreads {
+			if len(spread.Hierarchy) > 0 {
+				iter.addSpreadHierarchy(tg, spread)
+				continue
+			}
 			pset := NewPropertySet(iter.ctx, iter.job)
 			pset.SetTargetAttribute(spread.Attribute, tg.Name)
 			pset.SetTargetValues(helper.ConvertSlice(spread.SpreadTarget,
@@ -112,7 +165,8 @@ func (iter *SpreadIterator) SetTaskGroup(tg *structs.TaskGroup) {
 	}
 
 	// Check if there are any spreads configured
-	iter.hasSpread = len(iter.groupPropertySets[tg.Name]) != 0
+	iter.hasSpread = len(iter.groupPropertySets[tg.Name]) != 0 ||
+		len(iter.groupHierarchies[tg.Name]) != 0
 
 	// Build tgSpreadInfo at the task group level
 	if _, ok := iter.tgSpreadInfo[tg.Name]; !ok {
@@ -121,6 +175,21 @@ func (iter *SpreadIterator) SetTaskGroup(tg *structs.TaskGroup) {
 
 }
 
+// addSpreadHierarchy builds the property sets of a hierarchical spread for the
+// task group.
+func (iter *SpreadIterator) addSpreadHierarchy(tg *structs.TaskGroup, spread *structs.Spread) {
+	h := &spreadHierarchy{
+		spread: spread,
+		levels: make([]*propertySet, 0, len(spread.Hierarchy)),
+	}
+	for i := range spread.Hierarchy {
+		pset := NewPropertySet(iter.ctx, iter.job)
+		pset.SetTargetAttributePath(spread.Hierarchy[:i+1], tg.Name)
+		h.levels = append(h.levels, pset)
+	}
+	iter.groupHierarchies[tg.Name] = append(iter.groupHierarchies[tg.Name], h)
+}
+
 func (iter *SpreadIterator) hasSpreads() bool {
 	return iter.hasSpread
 }
@@ -201,6 +270,12 @@ func (iter *SpreadIterator) Next() *RankedNode {
 			}
 		}
 
+		// Add the weighted score of each hierarchical spread
+		for _, h := range iter.groupHierarchies[tgName] {
+			spreadWeight := float64(h.spread.Weight) / float64(iter.sumSpreadWeights)
+			totalSpreadScore += iter.hierarchicalSpreadScore(h, option.Node) * spreadWeight
+		}
+
 		if totalSpreadScore != 0.0 {
 			option.Scores = append(option.Scores, totalSpreadScore)
 			iter.ctx.Metrics().ScoreNode(option.Node, "allocation-spread", totalSpreadScore)
@@ -209,6 +284,86 @@ func (iter *SpreadIterator) Next() *RankedNode {
 	}
 }
 
+// hierarchicalSpreadScore is a scoring helper that calculates the score of the
+// option for a hierarchical spread. At each level of the hierarchy, the
+// option's value is compared to its siblings, the values of the level that
+// share the same parent, such as the racks of a zone. Each level is weighted
+// twice as much as the level below it so that balancing a level takes
+// precedence over balancing its descendants. The score of each level is
+// recorded in the allocation metrics to explain the placement.
+func (iter *SpreadIterator) hierarchicalSpreadScore(h *spreadHierarchy, option *structs.Node) float64 {
+	if h.domains == nil {
+		h.domains = make([]map[string]struct{}, len(h.levels))
+		for i, pset := range h.levels {
+			h.domains[i] = make(map[string]struct{})
+			for _, node := range iter.nodes {
+				if value, ok := pset.nodeProperty(node); ok {
+					h.domains[i][value] = struct{}{}
+				}
+			}
+		}
+	}
+
+	numLevels := len(h.levels)
+	sumLevelWeights := float64(int(1)<<numLevels - 1)
+
+	score := 0.0
+	parent := ""
+	for i, pset := range h.levels {
+		value, errorMsg, _ := pset.UsedCount(option, iter.tg.Name)
+		if errorMsg != "" {
+			// Use the maximum penalty when the node is missing an attribute
+			// of the hierarchy
+			iter.ctx.Logger().Named("spread").Debug("error building spread hierarchy for task group",
+				"task_group", iter.tg.Name, "error", errorMsg)
+			return -1.0
+		}
+
+		levelScore := hierarchyLevelScore(pset.GetCombinedUseMap(), h.domains[i], parent, value)
+		levelWeight := float64(int(1)<<(numLevels-1-i)) / sumLevelWeights
+		score += levelScore * levelWeight
+
+		iter.ctx.Metrics().ScoreNode(option,
+			fmt.Sprintf("allocation-spread.%d.%s", i+1, h.spread.Hierarchy[i]), levelScore)
+		parent = value
+	}
+	return score
+}
+
+// hierarchyLevelScore compares the number of allocations using a value of a
+// hierarchy level to the mean of its siblings, the values that share the same
+// parent. Siblings are found in both the used values and the known domains of
+// the level. The score is positive when the value is used less than its
+// siblings and negative when it is used more.
+func hierarchyLevelScore(combinedUseMap map[string]uint64, domains map[string]struct{}, parent, value string) float64 {
+	prefix := ""
+	if parent != "" {
+		prefix = parent + propertyPathSeparator
+	}
+
+	var total uint64
+	siblings := map[string]struct{}{value: {}}
+	for v, count := range combinedUseMap {
+		if strings.HasPrefix(v, prefix) {
+			total += count
+			siblings[v] = struct{}{}
+		}
+	}
+	if total == 0 {
+		// Nothing placed yet under the parent
+		return 0.0
+	}
+	for v := range domains {
+		if strings.HasPrefix(v, prefix) {
+			siblings[v] = struct{}{}
+		}
+	}
+
+	mean := float64(total) / float64(len(siblings))
+	score := (mean - float64(combinedUseMap[value])) / math.Max(mean, 1)
+	return max(-1.0, min(1.0, score))
+}
+
 // evenSpreadScoreBoost is a scoring helper that calculates the score
 // for the option when even spread is desired (all attribute values get equal preference)
 func evenSpreadScoreBoost(pset *propertySet, option *structs.Node) float64 {
@@ -274,6 +429,14 @@ func (iter *SpreadIterator) computeSpreadInfo(tg *structs.TaskGroup) {
 	combinedSpreads = append(combinedSpreads, tg.Spreads...)
 	combinedSpreads = append(combinedSpreads, iter.jobSpreads...)
 	for _, spread := range combinedSpreads {
+		iter.sumSpreadWeights += int32(spread.Weight)
+
+		// Hierarchical spreads are always even, so they have no desired
+		// counts
+		if len(spread.Hierarchy) > 0 {
+			continue
+		}
+
 		si := &spreadInfo{weight: spread.Weight, desiredCounts: make(map[string]float64)}
 		sumDesiredCounts := 0.0
 		for _, st := range spread.SpreadTarget {
@@ -287,7 +450,6 @@ func (iter *SpreadIterator) computeSpreadInfo(tg *structs.TaskGroup) {
 			si.desiredCounts[implicitTarget] = remainingCount
 		}
 		spreadInfos[spread.Attribute] = si
-		iter.sumSpreadWeights += int32(spread.Weight)
 	}
 	iter.tgSpreadInfo[tg.Name] = spreadInfos
 }
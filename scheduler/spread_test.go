@@ -1155,3 +1155,141 @@ func TestSpread_ImplicitTargets(t *testing.T) {
 		})
 	}
 }
+
+func TestSpreadIterator_Hierarchy(t *testing.T) {
+	ci.Parallel(t)
+
+	state, ctx := testContext(t)
+
+	// Zone z1 has two racks and zone z2 has a single rack
+	layout := [][2]string{{"z1", "r1"}, {"z1", "r2"}, {"z2", "r3"}}
+	var nodes []*RankedNode
+	for i, l := range layout {
+		node := mock.Node()
+		node.Meta["zone"] = l[0]
+		node.Meta["rack"] = l[1]
+		must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, uint64(100+i), node))
+		nodes = append(nodes, &RankedNode{Node: node})
+	}
+
+	// Node without the rack attribute
+	missing := mock.Node()
+	missing.Meta["zone"] = "z2"
+	must.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 110, missing))
+	nodes = append(nodes, &RankedNode{Node: missing})
+
+	job := mock.Job()
+	tg := job.TaskGroups[0]
+	tg.Count = 6
+
+	// Place two allocs in z1/r1 and one alloc in z2/r3
+	var allocs []*structs.Allocation
+	for _, node := range []*structs.Node{nodes[0].Node, nodes[0].Node, nodes[2].Node} {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.TaskGroup = tg.Name
+		alloc.NodeID = node.ID
+		allocs = append(allocs, alloc)
+	}
+	must.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 1000, allocs))
+
+	tg.Spreads = []*structs.Spread{{
+		Weight:    100,
+		Hierarchy: []string{"${meta.zone}", "${meta.rack}"},
+	}}
+
+	static := NewStaticRankIterator(ctx, nodes)
+	spreadIter := NewSpreadIterator(ctx, static)
+	spreadIter.SetJob(job)
+	spreadIter.SetNodes([]*structs.Node{nodes[0].Node, nodes[1].Node, nodes[2].Node, missing})
+	spreadIter.SetTaskGroup(tg)
+	scoreNorm := NewScoreNormalizationIterator(ctx, spreadIter)
+
+	out := collectRanked(scoreNorm)
+	must.Len(t, 4, out)
+
+	// The zone level has a weight of 2/3 and the rack level a weight of 1/3.
+	// z1 has 2 allocs and z2 has 1, so the zones score -1/3 and 1/3. Within
+	// z1, r1 has 2 allocs and r2 has none, so the racks score -1 and 1. r3 is
+	// the only rack of z2 so it scores 0.
+	expected := map[string]float64{
+		nodes[0].Node.ID: -2.0/9.0 - 1.0/3.0,
+		nodes[1].Node.ID: -2.0/9.0 + 1.0/3.0,
+		nodes[2].Node.ID: 2.0 / 9.0,
+		missing.ID:       -1.0,
+	}
+	for _, rn := range out {
+		must.Eq(t, expected[rn.Node.ID], rn.FinalScore, must.Func(func() string {
+			return fmt.Sprintf("unexpected score for node %s", rn.Node.ID)
+		}))
+	}
+
+	// The score of each level is available in the metrics
+	ctx.Metrics().PopulateScoreMetaData()
+	var z2Scores map[string]float64
+	for _, meta := range ctx.Metrics().ScoreMetaData {
+		if meta.NodeID == nodes[2].Node.ID {
+			z2Scores = meta.Scores
+		}
+	}
+	must.Eq(t, map[string]float64{
+		"allocation-spread.1.${meta.zone}": 1.0 / 3.0,
+		"allocation-spread.2.${meta.rack}": 0,
+		"allocation-spread":                2.0 / 9.0,
+	}, z2Scores)
+}
+
+func TestSpread_Hierarchy(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+
+	// Zone z1 has three racks and zone z2 has a single rack, with two nodes
+	// per rack
+	racks := map[string]string{"r1": "z1", "r2": "z1", "r3": "z1", "r4": "z2"}
+	nodeRacks := map[string]string{}
+	for rack, zone := range racks {
+		for i := 0; i < 2; i++ {
+			node := mock.Node()
+			node.Meta["zone"] = zone
+			node.Meta["rack"] = rack
+			must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+			nodeRacks[node.ID] = rack
+		}
+	}
+
+	job := mock.MinJob()
+	job.TaskGroups[0].Count = 6
+	job.TaskGroups[0].Spreads = []*structs.Spread{{
+		Weight:    100,
+		Hierarchy: []string{"${meta.zone}", "${meta.rack}"},
+	}}
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), nil, job))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+
+	must.NoError(t, h.Process(NewServiceScheduler, eval))
+	must.Len(t, 1, h.Plans)
+
+	zoneCounts := map[string]int{}
+	rackCounts := map[string]int{}
+	for nodeID, allocs := range h.Plans[0].NodeAllocation {
+		rack := nodeRacks[nodeID]
+		rackCounts[rack] += len(allocs)
+		zoneCounts[racks[rack]] += len(allocs)
+	}
+
+	// Allocations are balanced across zones first, and then across the racks
+	// of each zone
+	must.Eq(t, map[string]int{"z1": 3, "z2": 3}, zoneCounts)
+	must.Eq(t, map[string]int{"r1": 1, "r2": 1, "r3": 1, "r4": 3}, rackCounts)
+}
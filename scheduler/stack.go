@@ -71,6 +71,7 @@ type GenericStack struct {
 	maxScore                   *MaxScoreIterator
 	nodeAffinity               *NodeAffinityIterator
 	spread                     *SpreadIterator
+	externalScore              *ExternalScoreIterator
 	scoreNorm                  *ScoreNormalizationIterator
 }
 
@@ -81,6 +82,8 @@ func (s *GenericStack) SetNodes(baseNodes []*structs.Node) {
 
 	// Update the set of base nodes
 	s.source.SetNodes(baseNodes)
+	s.spread.SetNodes(baseNodes)
+	s.externalScore.SetNodes(baseNodes)
 
 	// Apply a limit function. This is to avoid scanning *every* possible node.
 	// For batch jobs we only need to evaluate 2 options and depend on the
@@ -114,6 +117,7 @@ func (s *GenericStack) SetJob(job *structs.Job) {
 	s.jobAntiAff.SetJob(job)
 	s.nodeAffinity.SetJob(job)
 	s.spread.SetJob(job)
+	s.externalScore.SetJob(job)
 	s.ctx.Eligibility().SetJob(job)
 	s.taskGroupCSIVolumes.SetNamespace(job.Namespace)
 	s.taskGroupCSIVolumes.SetJobID(job.ID)
@@ -130,6 +134,11 @@ func (s *GenericStack) SetSchedulerConfiguration(schedConfig *structs.SchedulerC
 	s.binPack.SetSchedulerConfiguration(schedConfig)
 }
 
+// SetNodeScorers sets the external node scorers used to rank nodes.
+func (s *GenericStack) SetNodeScorers(scorers []NodeScorer) {
+	s.externalScore.SetScorers(scorers)
+}
+
 func (s *GenericStack) Select(tg *structs.TaskGroup, options *SelectOptions) *RankedNode {
 
 	// This block handles trying to select from preferred nodes if options specify them
@@ -151,6 +160,7 @@ func (s *GenericStack) Select(tg *structs.TaskGroup, options *SelectOptions) *Ra
 	s.maxScore.Reset()
 	s.ctx.Reset()
 	start := time.Now()
+	s.ctx.Tracer().SetPlacement(tg.Name, options.AllocName)
 
 	// Get the task groups constraints.
 	tgConstr := taskGroupConstraints(tg)
@@ -177,8 +187,9 @@ func (s *GenericStack) Select(tg *structs.TaskGroup, options *SelectOptions) *Ra
 	}
 	s.nodeAffinity.SetTaskGroup(tg)
 	s.spread.SetTaskGroup(tg)
+	s.externalScore.SetTaskGroup(tg)
 
-	if s.nodeAffinity.hasAffinities() || s.spread.hasSpreads() {
+	if s.nodeAffinity.hasAffinities() || s.spread.hasSpreads() || s.externalScore.hasScorers() {
 		// scoring spread across all nodes has quadratic behavior, so
 		// we need to consider a subset of nodes to keep evaluaton times
 		// reasonable but enough to ensure spread is correct. this
@@ -195,6 +206,7 @@ func (s *GenericStack) Select(tg *structs.TaskGroup, options *SelectOptions) *Ra
 
 	// Find the node with the max score
 	option := s.maxScore.Next()
+	traceSelection(s.ctx, option)
 
 	// Store the compute time
 	s.ctx.Metrics().AllocationTime = time.Since(start)
@@ -346,6 +358,7 @@ func (s *SystemStack) Select(tg *structs.TaskGroup, options *SelectOptions) *Ran
 	s.scoreNorm.Reset()
 	s.ctx.Reset()
 	start := time.Now()
+	s.ctx.Tracer().SetPlacement(tg.Name, options.AllocName)
 
 	// Get the task groups constraints.
 	tgConstr := taskGroupConstraints(tg)
@@ -369,6 +382,7 @@ func (s *SystemStack) Select(tg *structs.TaskGroup, options *SelectOptions) *Ran
 
 	// Get the next option that satisfies the constraints.
 	option := s.scoreNorm.Next()
+	traceSelection(s.ctx, option)
 
 	// Store the compute time
 	s.ctx.Metrics().AllocationTime = time.Since(start)
@@ -460,8 +474,11 @@ func NewGenericStack(batch bool, ctx Context) *GenericStack {
 	// Apply scores based on spread block
 	s.spread = NewSpreadIterator(ctx, s.nodeAffinity)
 
+	// Apply scores from external node scorers
+	s.externalScore = NewExternalScoreIterator(ctx, s.spread)
+
 	// Add the preemption options scoring iterator
-	preemptionScorer := NewPreemptionScoringIterator(ctx, s.spread)
+	preemptionScorer := NewPreemptionScoringIterator(ctx, s.externalScore)
 
 	// Normalizes scores by averaging them across various scorers
 	s.scoreNorm = NewScoreNormalizationIterator(ctx, preemptionScorer)
@@ -499,3 +516,12 @@ type tgConstrainTuple struct {
 	// The set of required drivers within the task group.
 	drivers map[string]struct{}
 }
+
+// traceSelection records the outcome of a placement in the eval trace.
+func traceSelection(ctx Context, option *RankedNode) {
+	if option == nil {
+		ctx.Tracer().Failed("no node was feasible with enough resources")
+		return
+	}
+	ctx.Tracer().Selected(option.Node.ID, option.FinalScore)
+}
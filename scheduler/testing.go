@@ -68,6 +68,12 @@ type Harness struct {
 
 	// don't actually write plans back to state
 	noSubmit bool
+
+	// Scorers are the external node scorers provided to the scheduler
+	Scorers []NodeScorer
+
+	// EvalTraces are the eval traces stored by the scheduler
+	EvalTraces []*structs.EvalTrace
 }
 
 // NewHarness is used to make a new testing harness
@@ -264,6 +270,20 @@ func (h *Harness) ServersMeetMinimumVersion(_ *version.Version, _ bool) bool {
 	return h.serversMeetMinimumVersion
 }
 
+// NodeScorers returns the external node scorers of the harness
+func (h *Harness) NodeScorers() []NodeScorer {
+	return h.Scorers
+}
+
+// UpsertEvalTrace stores the trace of the eval being processed.
+func (h *Harness) UpsertEvalTrace(trace *structs.EvalTrace) error {
+	h.planLock.Lock()
+	defer h.planLock.Unlock()
+
+	h.EvalTraces = append(h.EvalTraces, trace)
+	return nil
+}
+
 // NextIndex returns the next index
 func (h *Harness) NextIndex() uint64 {
 	h.nextIndexLock.Lock()
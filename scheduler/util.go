@@ -343,17 +343,17 @@ func tasksUpdated(jobA, jobB *structs.Job, taskGroup string) comparison {
 	return same
 }
 
+// nonNetworkResourcesUpdated returns whether the non-network resources of a
+// task were changed in a way that requires a destructive update. Changes to
+// cpu, memory, and memory_max are not destructive as long as the driver on the
+// alloc's node can apply them to the running task, which is checked by
+// tasksResized once the node is known. Whether the new resources fit on the
+// node is checked when the in-place update is computed.
 func nonNetworkResourcesUpdated(a, b *structs.Resources) comparison {
 	// Inspect the non-network resources
 	switch {
-	case a.CPU != b.CPU:
-		return difference("task cpu", a.CPU, b.CPU)
 	case a.Cores != b.Cores:
 		return difference("task cores", a.Cores, b.Cores)
-	case a.MemoryMB != b.MemoryMB:
-		return difference("task memory", a.MemoryMB, b.MemoryMB)
-	case a.MemoryMaxMB != b.MemoryMaxMB:
-		return difference("task memory max", a.MemoryMaxMB, b.MemoryMaxMB)
 	case !a.Devices.Equal(&b.Devices):
 		return difference("task devices", a.Devices, b.Devices)
 	case !a.NUMA.Equal(b.NUMA):
@@ -364,6 +364,38 @@ func nonNetworkResourcesUpdated(a, b *structs.Resources) comparison {
 	return same
 }
 
+// tasksResized returns whether the cpu or memory of a task changed while the
+// task's driver on the node can't apply the change to the running task. The
+// client would have to restart these tasks outside of the job's update
+// strategy, so the update must be destructive instead.
+func tasksResized(jobA, jobB *structs.Job, taskGroup string, node *structs.Node) comparison {
+	a := jobA.LookupTaskGroup(taskGroup)
+	b := jobB.LookupTaskGroup(taskGroup)
+	if a == nil || b == nil {
+		return same
+	}
+
+	for _, at := range a.Tasks {
+		bt := b.LookupTask(at.Name)
+		if bt == nil || at.Resources == nil || bt.Resources == nil {
+			continue
+		}
+		if node.CanUpdateTaskResources(at.Driver) {
+			continue
+		}
+
+		switch {
+		case at.Resources.CPU != bt.Resources.CPU:
+			return difference("task cpu", at.Resources.CPU, bt.Resources.CPU)
+		case at.Resources.MemoryMB != bt.Resources.MemoryMB:
+			return difference("task memory", at.Resources.MemoryMB, bt.Resources.MemoryMB)
+		case at.Resources.MemoryMaxMB != bt.Resources.MemoryMaxMB:
+			return difference("task memory max", at.Resources.MemoryMaxMB, bt.Resources.MemoryMaxMB)
+		}
+	}
+	return same
+}
+
 // consulUpdated returns true if the Consul namespace or cluster in the task
 // group has been changed.
 //
@@ -612,6 +644,36 @@ func setStatus(logger log.Logger, planner Planner,
 	return planner.UpdateEval(newEval)
 }
 
+// newEvalTracer returns a recorder for the decisions made while processing
+// the eval. It returns nil if tracing is disabled in the scheduler
+// configuration or the planner can't store traces.
+func newEvalTracer(state State, planner Planner, eval *structs.Evaluation) *structs.EvalTraceRecorder {
+	if _, ok := planner.(EvalTraceWriter); !ok {
+		return nil
+	}
+	_, schedConfig, err := state.SchedulerConfig()
+	if err != nil || schedConfig == nil || !schedConfig.EvalTraceEnabled {
+		return nil
+	}
+	return structs.NewEvalTraceRecorder(eval)
+}
+
+// writeEvalTrace stores the trace recorded by the tracer. Traces are only a
+// debugging aid, so failing to store one is logged but doesn't fail the eval.
+func writeEvalTrace(logger log.Logger, planner Planner, tracer *structs.EvalTraceRecorder) {
+	trace := tracer.Trace()
+	if trace == nil {
+		return
+	}
+	writer, ok := planner.(EvalTraceWriter)
+	if !ok {
+		return
+	}
+	if err := writer.UpsertEvalTrace(trace); err != nil {
+		logger.Warn("failed to store eval trace", "error", err)
+	}
+}
+
 // inplaceUpdate attempts to update allocations in-place where possible. It
 // returns the allocs that couldn't be done inplace and then those that could.
 func inplaceUpdate(ctx Context, eval *structs.Evaluation, job *structs.Job,
@@ -668,6 +730,11 @@ func inplaceUpdate(ctx Context, eval *structs.Evaluation, job *structs.Job,
 			continue
 		}
 
+		// The node's driver can't resize the running tasks
+		if c := tasksResized(job, existing, update.TaskGroup.Name, node); c.modified {
+			continue
+		}
+
 		// Set the existing node as the base set
 		stack.SetNodes([]*structs.Node{node})
 
@@ -723,6 +790,7 @@ func inplaceUpdate(ctx Context, eval *structs.Evaluation, job *structs.Job,
 			TaskLifecycles: option.TaskLifecycles,
 			Shared: structs.AllocatedSharedResources{
 				DiskMB:   int64(update.TaskGroup.EphemeralDisk.SizeMB),
+				DiskIOPS: int64(update.TaskGroup.EphemeralDisk.IOPS),
 				Ports:    update.Alloc.AllocatedResources.Shared.Ports,
 				Networks: update.Alloc.AllocatedResources.Shared.Networks.Copy(),
 			},
@@ -915,6 +983,11 @@ func genericAllocUpdateFn(ctx Context, stack Stack, evalID string) allocUpdateTy
 			return false, true, nil
 		}
 
+		// The node's driver can't resize the running tasks
+		if c := tasksResized(newJob, existing.Job, newTG.Name, node); c.modified {
+			return false, true, nil
+		}
+
 		// Set the existing node as the base set
 		stack.SetNodes([]*structs.Node{node})
 
@@ -968,7 +1041,8 @@ func genericAllocUpdateFn(ctx Context, stack Stack, evalID string) allocUpdateTy
 			Tasks:          option.TaskResources,
 			TaskLifecycles: option.TaskLifecycles,
 			Shared: structs.AllocatedSharedResources{
-				DiskMB: int64(newTG.EphemeralDisk.SizeMB),
+				DiskMB:   int64(newTG.EphemeralDisk.SizeMB),
+				DiskIOPS: int64(newTG.EphemeralDisk.IOPS),
 			},
 		}
 
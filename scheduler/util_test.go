@@ -285,9 +285,28 @@ func TestTasksUpdated(t *testing.T) {
 	j10.TaskGroups[0].Tasks[0].Meta["baz"] = "boom"
 	must.True(t, tasksUpdated(j1, j10, name).modified)
 
+	// Changing cpu or memory is applied to the running task in place
 	j11 := mock.Job()
 	j11.TaskGroups[0].Tasks[0].Resources.CPU = 1337
-	must.True(t, tasksUpdated(j1, j11, name).modified)
+	must.False(t, tasksUpdated(j1, j11, name).modified)
+
+	j11m1 := mock.Job()
+	j11m1.TaskGroups[0].Tasks[0].Resources.MemoryMB = 1337
+	must.False(t, tasksUpdated(j1, j11m1, name).modified)
+
+	j11m2 := mock.Job()
+	j11m2.TaskGroups[0].Tasks[0].Resources.MemoryMaxMB = 2048
+	must.False(t, tasksUpdated(j1, j11m2, name).modified)
+
+	// unless the driver on the alloc's node can't resize running tasks
+	node := mock.Node()
+	must.False(t, tasksResized(j1, j11, name, node).modified)
+	must.False(t, tasksResized(j1, j11m2, name, node).modified)
+	delete(node.Drivers["exec"].Attributes, structs.DriverAttrUpdateResources("exec"))
+	must.True(t, tasksResized(j1, j11, name, node).modified)
+	must.True(t, tasksResized(j1, j11m1, name, node).modified)
+	must.True(t, tasksResized(j1, j11m2, name, node).modified)
+	must.False(t, tasksResized(j1, j1.Copy(), name, node).modified)
 
 	j11d1 := mock.Job()
 	j11d1.TaskGroups[0].Tasks[0].Resources.Devices = structs.ResourceDevices{